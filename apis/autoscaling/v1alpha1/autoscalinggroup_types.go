@@ -0,0 +1,359 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag defines a key value pair that can be attached to an AutoScalingGroup.
+type Tag struct {
+	// The key of the tag.
+	Key string `json:"key"`
+
+	// The value of the tag.
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// Determines whether the tag is added to new instances as they are
+	// launched in the group.
+	// +optional
+	PropagateAtLaunch *bool `json:"propagateAtLaunch,omitempty"`
+}
+
+// LaunchTemplateSpecification specifies the launch template and the version
+// of the launch template used to launch instances.
+type LaunchTemplateSpecification struct {
+	// The ID of the launch template.
+	// +optional
+	LaunchTemplateID *string `json:"launchTemplateId,omitempty"`
+
+	// LaunchTemplateIDRef references a LaunchTemplate to retrieve its ID.
+	// +optional
+	LaunchTemplateIDRef *runtimev1alpha1.Reference `json:"launchTemplateIdRef,omitempty"`
+
+	// LaunchTemplateIDSelector selects a reference to a LaunchTemplate to
+	// retrieve its ID.
+	// +optional
+	LaunchTemplateIDSelector *runtimev1alpha1.Selector `json:"launchTemplateIdSelector,omitempty"`
+
+	// The version number, $Latest, or $Default. If not specified, $Default
+	// is used.
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
+// InstanceRefresh configures the replacement of instances that were launched
+// with a launch template other than the one of the group.
+//
+// The AWS SDK used by this provider does not support the instance refresh API
+// of Auto Scaling, so the replacement is done by the provider itself: outdated
+// instances are terminated in batches, without decrementing the desired
+// capacity, and the group launches their replacements. Instances are not
+// drained before they are terminated and no lifecycle hooks or warm-up periods
+// are taken into account beyond the health and lifecycle state reported by
+// Auto Scaling.
+type InstanceRefresh struct {
+	// TerminateOutdatedInstances must be set to true to opt in to the
+	// replacement of outdated instances. Instances of the group are
+	// terminated by the provider when it is set, so make sure that the
+	// workload running on them tolerates this.
+	TerminateOutdatedInstances bool `json:"terminateOutdatedInstances"`
+
+	// The percentage of the desired capacity that must remain in service
+	// and healthy while instances are replaced. At least one instance is
+	// always replaced at a time. Defaults to 90.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MinHealthyPercentage *int64 `json:"minHealthyPercentage,omitempty"`
+}
+
+// StepAdjustment describes an adjustment based on the difference between the
+// value of the alarm metric and the breach threshold.
+type StepAdjustment struct {
+	// The bounds are float64 in AWS SDK but float is not supported by
+	// controller-runtime.
+	// See https://github.com/kubernetes-sigs/controller-tools/issues/245
+
+	// The lower bound for the difference between the alarm threshold and the
+	// metric value. If not specified, the lower bound is negative infinity.
+	// +optional
+	MetricIntervalLowerBound *int64 `json:"metricIntervalLowerBound,omitempty"`
+
+	// The upper bound for the difference between the alarm threshold and the
+	// metric value. If not specified, the upper bound is infinity.
+	// +optional
+	MetricIntervalUpperBound *int64 `json:"metricIntervalUpperBound,omitempty"`
+
+	// The amount by which to scale, based on the adjustment type.
+	ScalingAdjustment int64 `json:"scalingAdjustment"`
+}
+
+// TargetTrackingConfiguration represents a target tracking scaling policy
+// that uses a predefined metric.
+type TargetTrackingConfiguration struct {
+	// The metric type.
+	// +kubebuilder:validation:Enum=ASGAverageCPUUtilization;ASGAverageNetworkIn;ASGAverageNetworkOut;ALBRequestCountPerTarget
+	PredefinedMetricType string `json:"predefinedMetricType"`
+
+	// Identifies the target group for the ALBRequestCountPerTarget metric
+	// type.
+	// +optional
+	ResourceLabel *string `json:"resourceLabel,omitempty"`
+
+	// The target value for the metric.
+	TargetValue int64 `json:"targetValue"`
+
+	// Indicates whether scaling in by the target tracking scaling policy is
+	// disabled.
+	// +optional
+	DisableScaleIn *bool `json:"disableScaleIn,omitempty"`
+}
+
+// ScalingPolicy describes a scaling policy of an AutoScalingGroup.
+type ScalingPolicy struct {
+	// The name of the policy.
+	PolicyName string `json:"policyName"`
+
+	// The policy type.
+	// +kubebuilder:validation:Enum=SimpleScaling;StepScaling;TargetTrackingScaling
+	PolicyType string `json:"policyType"`
+
+	// Specifies whether the ScalingAdjustment is an absolute number or a
+	// percentage of the current capacity. Valid only for SimpleScaling and
+	// StepScaling policies.
+	// +optional
+	// +kubebuilder:validation:Enum=ChangeInCapacity;ExactCapacity;PercentChangeInCapacity
+	AdjustmentType *string `json:"adjustmentType,omitempty"`
+
+	// The amount by which to scale. Required for SimpleScaling policies.
+	// +optional
+	ScalingAdjustment *int64 `json:"scalingAdjustment,omitempty"`
+
+	// The duration of the policy's cooldown period, in seconds. Valid only
+	// for SimpleScaling policies.
+	// +optional
+	Cooldown *int64 `json:"cooldown,omitempty"`
+
+	// The minimum number of instances to scale when the adjustment type is
+	// PercentChangeInCapacity.
+	// +optional
+	MinAdjustmentMagnitude *int64 `json:"minAdjustmentMagnitude,omitempty"`
+
+	// The aggregation type for the CloudWatch metrics. Valid only for
+	// StepScaling policies.
+	// +optional
+	// +kubebuilder:validation:Enum=Minimum;Maximum;Average
+	MetricAggregationType *string `json:"metricAggregationType,omitempty"`
+
+	// The estimated time, in seconds, until a newly launched instance can
+	// contribute to the CloudWatch metrics.
+	// +optional
+	EstimatedInstanceWarmup *int64 `json:"estimatedInstanceWarmup,omitempty"`
+
+	// A set of adjustments that enable you to scale based on the size of the
+	// alarm breach. Required for StepScaling policies.
+	// +optional
+	StepAdjustments []StepAdjustment `json:"stepAdjustments,omitempty"`
+
+	// A target tracking scaling policy. Required for TargetTrackingScaling
+	// policies.
+	// +optional
+	TargetTrackingConfiguration *TargetTrackingConfiguration `json:"targetTrackingConfiguration,omitempty"`
+}
+
+// AutoScalingGroupParameters define the desired state of an AWS Auto Scaling
+// Group.
+type AutoScalingGroupParameters struct {
+	// The launch template used to launch instances.
+	LaunchTemplate LaunchTemplateSpecification `json:"launchTemplate"`
+
+	// The minimum size of the group.
+	// +kubebuilder:validation:Minimum=0
+	MinSize int64 `json:"minSize"`
+
+	// The maximum size of the group.
+	// +kubebuilder:validation:Minimum=0
+	MaxSize int64 `json:"maxSize"`
+
+	// The desired capacity of the group. Leave it empty when the capacity
+	// is managed by scaling policies.
+	// +optional
+	DesiredCapacity *int64 `json:"desiredCapacity,omitempty"`
+
+	// The amount of time, in seconds, after a scaling activity completes
+	// before another scaling activity can start.
+	// +optional
+	DefaultCooldown *int64 `json:"defaultCooldown,omitempty"`
+
+	// The service to use for the health checks.
+	// +optional
+	// +kubebuilder:validation:Enum=EC2;ELB
+	HealthCheckType *string `json:"healthCheckType,omitempty"`
+
+	// The amount of time, in seconds, that Amazon EC2 Auto Scaling waits
+	// before checking the health status of an instance that has come into
+	// service.
+	// +optional
+	HealthCheckGracePeriod *int64 `json:"healthCheckGracePeriod,omitempty"`
+
+	// One or more Availability Zones for the group. Not required if
+	// subnets are specified.
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// The IDs of the subnets in which instances are launched.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs references Subnets to retrieve their SubnetIDs.
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets to retrieve their
+	// SubnetIDs.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// The Amazon Resource Names (ARN) of the target groups to associate with
+	// the group.
+	// +optional
+	TargetGroupARNs []string `json:"targetGroupArns,omitempty"`
+
+	// The policies used to select the instances to terminate on scale in.
+	// +optional
+	TerminationPolicies []string `json:"terminationPolicies,omitempty"`
+
+	// The maximum amount of time, in seconds, that an instance can be in
+	// service.
+	// +optional
+	MaxInstanceLifetime *int64 `json:"maxInstanceLifetime,omitempty"`
+
+	// Indicates whether newly launched instances are protected from
+	// termination by Amazon EC2 Auto Scaling when scaling in.
+	// +optional
+	NewInstancesProtectedFromScaleIn *bool `json:"newInstancesProtectedFromScaleIn,omitempty"`
+
+	// InstanceRefresh configures the replacement of instances that were
+	// launched with an outdated launch template, e.g. after the launch
+	// template or its version was changed. Instances are only replaced if
+	// terminateOutdatedInstances is set to true.
+	// +optional
+	InstanceRefresh *InstanceRefresh `json:"instanceRefresh,omitempty"`
+
+	// The scaling policies of the group.
+	// +optional
+	ScalingPolicies []ScalingPolicy `json:"scalingPolicies,omitempty"`
+
+	// One or more tags for the group.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// An AutoScalingGroupSpec defines the desired state of an AutoScalingGroup.
+type AutoScalingGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AutoScalingGroupParameters `json:"forProvider"`
+}
+
+// Instance describes an instance of an AutoScalingGroup.
+type Instance struct {
+	// The ID of the instance.
+	InstanceID string `json:"instanceId"`
+
+	// The Availability Zone in which the instance is running.
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// The last reported health status of the instance.
+	HealthStatus string `json:"healthStatus,omitempty"`
+
+	// The lifecycle state of the instance.
+	LifecycleState string `json:"lifecycleState,omitempty"`
+
+	// The version of the launch template the instance was launched with.
+	LaunchTemplateVersion string `json:"launchTemplateVersion,omitempty"`
+}
+
+// ScalingPolicyObservation describes an existing scaling policy.
+type ScalingPolicyObservation struct {
+	// The name of the policy.
+	PolicyName string `json:"policyName"`
+
+	// The Amazon Resource Name (ARN) of the policy.
+	PolicyARN string `json:"policyArn,omitempty"`
+}
+
+// AutoScalingGroupObservation keeps the state for the external resource
+type AutoScalingGroupObservation struct {
+	// The Amazon Resource Name (ARN) of the group.
+	AutoScalingGroupARN string `json:"autoScalingGroupArn,omitempty"`
+
+	// The current state of the group when it is being deleted.
+	Status string `json:"status,omitempty"`
+
+	// The current desired capacity of the group.
+	DesiredCapacity int64 `json:"desiredCapacity,omitempty"`
+
+	// The instances of the group.
+	Instances []Instance `json:"instances,omitempty"`
+
+	// The number of instances that were launched with an outdated launch
+	// template and wait to be replaced.
+	OutdatedInstances int64 `json:"outdatedInstances,omitempty"`
+
+	// The scaling policies of the group.
+	ScalingPolicies []ScalingPolicyObservation `json:"scalingPolicies,omitempty"`
+}
+
+// An AutoScalingGroupStatus represents the observed state of an
+// AutoScalingGroup.
+type AutoScalingGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     AutoScalingGroupObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AutoScalingGroup is a managed resource that represents an AWS Auto
+// Scaling Group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DESIRED",type="integer",JSONPath=".status.atProvider.desiredCapacity"
+// +kubebuilder:printcolumn:name="OUTDATED",type="integer",JSONPath=".status.atProvider.outdatedInstances"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AutoScalingGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AutoScalingGroupSpec   `json:"spec"`
+	Status AutoScalingGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AutoScalingGroupList contains a list of AutoScalingGroups
+type AutoScalingGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AutoScalingGroup `json:"items"`
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ec2v1alpha4 "github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// ResolveReferences of this AutoScalingGroup
+func (mg *AutoScalingGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.launchTemplate.launchTemplateId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.LaunchTemplate.LaunchTemplateID),
+		Reference:    mg.Spec.ForProvider.LaunchTemplate.LaunchTemplateIDRef,
+		Selector:     mg.Spec.ForProvider.LaunchTemplate.LaunchTemplateIDSelector,
+		To:           reference.To{Managed: &ec2v1alpha4.LaunchTemplate{}, List: &ec2v1alpha4.LaunchTemplateList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.LaunchTemplate.LaunchTemplateID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.LaunchTemplate.LaunchTemplateIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.subnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SubnetIDs,
+		References:    mg.Spec.ForProvider.SubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.SubnetIDSelector,
+		To:            reference.To{Managed: &ec2v1beta1.Subnet{}, List: &ec2v1beta1.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SubnetIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
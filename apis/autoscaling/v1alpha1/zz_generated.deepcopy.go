@@ -0,0 +1,456 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingGroup) DeepCopyInto(out *AutoScalingGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingGroup.
+func (in *AutoScalingGroup) DeepCopy() *AutoScalingGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AutoScalingGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingGroupList) DeepCopyInto(out *AutoScalingGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AutoScalingGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingGroupList.
+func (in *AutoScalingGroupList) DeepCopy() *AutoScalingGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AutoScalingGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingGroupObservation) DeepCopyInto(out *AutoScalingGroupObservation) {
+	*out = *in
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]Instance, len(*in))
+		copy(*out, *in)
+	}
+	if in.ScalingPolicies != nil {
+		in, out := &in.ScalingPolicies, &out.ScalingPolicies
+		*out = make([]ScalingPolicyObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingGroupObservation.
+func (in *AutoScalingGroupObservation) DeepCopy() *AutoScalingGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingGroupParameters) DeepCopyInto(out *AutoScalingGroupParameters) {
+	*out = *in
+	in.LaunchTemplate.DeepCopyInto(&out.LaunchTemplate)
+	if in.DesiredCapacity != nil {
+		in, out := &in.DesiredCapacity, &out.DesiredCapacity
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DefaultCooldown != nil {
+		in, out := &in.DefaultCooldown, &out.DefaultCooldown
+		*out = new(int64)
+		**out = **in
+	}
+	if in.HealthCheckType != nil {
+		in, out := &in.HealthCheckType, &out.HealthCheckType
+		*out = new(string)
+		**out = **in
+	}
+	if in.HealthCheckGracePeriod != nil {
+		in, out := &in.HealthCheckGracePeriod, &out.HealthCheckGracePeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDRefs != nil {
+		in, out := &in.SubnetIDRefs, &out.SubnetIDRefs
+		*out = make([]corev1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetGroupARNs != nil {
+		in, out := &in.TargetGroupARNs, &out.TargetGroupARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TerminationPolicies != nil {
+		in, out := &in.TerminationPolicies, &out.TerminationPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxInstanceLifetime != nil {
+		in, out := &in.MaxInstanceLifetime, &out.MaxInstanceLifetime
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NewInstancesProtectedFromScaleIn != nil {
+		in, out := &in.NewInstancesProtectedFromScaleIn, &out.NewInstancesProtectedFromScaleIn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InstanceRefresh != nil {
+		in, out := &in.InstanceRefresh, &out.InstanceRefresh
+		*out = new(InstanceRefresh)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScalingPolicies != nil {
+		in, out := &in.ScalingPolicies, &out.ScalingPolicies
+		*out = make([]ScalingPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingGroupParameters.
+func (in *AutoScalingGroupParameters) DeepCopy() *AutoScalingGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingGroupSpec) DeepCopyInto(out *AutoScalingGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingGroupSpec.
+func (in *AutoScalingGroupSpec) DeepCopy() *AutoScalingGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingGroupStatus) DeepCopyInto(out *AutoScalingGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingGroupStatus.
+func (in *AutoScalingGroupStatus) DeepCopy() *AutoScalingGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Instance) DeepCopyInto(out *Instance) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instance.
+func (in *Instance) DeepCopy() *Instance {
+	if in == nil {
+		return nil
+	}
+	out := new(Instance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceRefresh) DeepCopyInto(out *InstanceRefresh) {
+	*out = *in
+	if in.MinHealthyPercentage != nil {
+		in, out := &in.MinHealthyPercentage, &out.MinHealthyPercentage
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceRefresh.
+func (in *InstanceRefresh) DeepCopy() *InstanceRefresh {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceRefresh)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateSpecification) DeepCopyInto(out *LaunchTemplateSpecification) {
+	*out = *in
+	if in.LaunchTemplateID != nil {
+		in, out := &in.LaunchTemplateID, &out.LaunchTemplateID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LaunchTemplateIDRef != nil {
+		in, out := &in.LaunchTemplateIDRef, &out.LaunchTemplateIDRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.LaunchTemplateIDSelector != nil {
+		in, out := &in.LaunchTemplateIDSelector, &out.LaunchTemplateIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateSpecification.
+func (in *LaunchTemplateSpecification) DeepCopy() *LaunchTemplateSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingPolicy) DeepCopyInto(out *ScalingPolicy) {
+	*out = *in
+	if in.AdjustmentType != nil {
+		in, out := &in.AdjustmentType, &out.AdjustmentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScalingAdjustment != nil {
+		in, out := &in.ScalingAdjustment, &out.ScalingAdjustment
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Cooldown != nil {
+		in, out := &in.Cooldown, &out.Cooldown
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinAdjustmentMagnitude != nil {
+		in, out := &in.MinAdjustmentMagnitude, &out.MinAdjustmentMagnitude
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MetricAggregationType != nil {
+		in, out := &in.MetricAggregationType, &out.MetricAggregationType
+		*out = new(string)
+		**out = **in
+	}
+	if in.EstimatedInstanceWarmup != nil {
+		in, out := &in.EstimatedInstanceWarmup, &out.EstimatedInstanceWarmup
+		*out = new(int64)
+		**out = **in
+	}
+	if in.StepAdjustments != nil {
+		in, out := &in.StepAdjustments, &out.StepAdjustments
+		*out = make([]StepAdjustment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetTrackingConfiguration != nil {
+		in, out := &in.TargetTrackingConfiguration, &out.TargetTrackingConfiguration
+		*out = new(TargetTrackingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingPolicy.
+func (in *ScalingPolicy) DeepCopy() *ScalingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingPolicyObservation) DeepCopyInto(out *ScalingPolicyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingPolicyObservation.
+func (in *ScalingPolicyObservation) DeepCopy() *ScalingPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepAdjustment) DeepCopyInto(out *StepAdjustment) {
+	*out = *in
+	if in.MetricIntervalLowerBound != nil {
+		in, out := &in.MetricIntervalLowerBound, &out.MetricIntervalLowerBound
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MetricIntervalUpperBound != nil {
+		in, out := &in.MetricIntervalUpperBound, &out.MetricIntervalUpperBound
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepAdjustment.
+func (in *StepAdjustment) DeepCopy() *StepAdjustment {
+	if in == nil {
+		return nil
+	}
+	out := new(StepAdjustment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.PropagateAtLaunch != nil {
+		in, out := &in.PropagateAtLaunch, &out.PropagateAtLaunch
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetTrackingConfiguration) DeepCopyInto(out *TargetTrackingConfiguration) {
+	*out = *in
+	if in.ResourceLabel != nil {
+		in, out := &in.ResourceLabel, &out.ResourceLabel
+		*out = new(string)
+		**out = **in
+	}
+	if in.DisableScaleIn != nil {
+		in, out := &in.DisableScaleIn, &out.DisableScaleIn
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetTrackingConfiguration.
+func (in *TargetTrackingConfiguration) DeepCopy() *TargetTrackingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetTrackingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -23,6 +23,7 @@ import (
 	acmv1alpha1 "github.com/crossplane/provider-aws/apis/acm/v1alpha1"
 	acmpcav1alpha1 "github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
 	integrationv1alpha1 "github.com/crossplane/provider-aws/apis/applicationintegration/v1alpha1"
+	autoscalingv1alpha1 "github.com/crossplane/provider-aws/apis/autoscaling/v1alpha1"
 	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
 	cachev1beta1 "github.com/crossplane/provider-aws/apis/cache/v1beta1"
 	computev1alpha3 "github.com/crossplane/provider-aws/apis/compute/v1alpha3"
@@ -32,6 +33,7 @@ import (
 	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	eksv1beta1 "github.com/crossplane/provider-aws/apis/eks/v1beta1"
 	elasticloadbalancingv1alpha1 "github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
+	elbv2v1alpha1 "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
 	identityv1alpha1 "github.com/crossplane/provider-aws/apis/identity/v1alpha1"
 	identityv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
 	notificationv1alpha3 "github.com/crossplane/provider-aws/apis/notification/v1alpha1"
@@ -61,6 +63,8 @@ func init() {
 		databasev1alpha1.SchemeBuilder.AddToScheme,
 		eksv1beta1.SchemeBuilder.AddToScheme,
 		integrationv1alpha1.SchemeBuilder.AddToScheme,
+		autoscalingv1alpha1.SchemeBuilder.AddToScheme,
+		elbv2v1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
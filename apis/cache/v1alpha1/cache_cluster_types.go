@@ -0,0 +1,297 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CacheCluster states.
+const (
+	CacheClusterStatusAvailable = "available"
+	CacheClusterStatusCreating  = "creating"
+	CacheClusterStatusModifying = "modifying"
+	CacheClusterStatusDeleting  = "deleting"
+)
+
+// Endpoint represents the information required for client programs to connect
+// to a cache node.
+type Endpoint struct {
+	// Address is the DNS hostname of the cache node.
+	Address string `json:"address,omitempty"`
+
+	// Port number that the cache engine is listening on.
+	Port int `json:"port,omitempty"`
+}
+
+// A Tag is used to tag the ElastiCache resources in AWS.
+type Tag struct {
+	// Key for the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	Value string `json:"value"`
+}
+
+// CacheClusterParameters define the desired state of an AWS ElastiCache Cache
+// Cluster. Most fields map directly to an AWS CacheCluster:
+// https://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_CreateCacheCluster.html#API_CreateCacheCluster_RequestParameters
+type CacheClusterParameters struct {
+	// If true, this parameter causes the modifications in this request and any
+	// pending modifications to be applied, asynchronously and as soon as
+	// possible, regardless of the PreferredMaintenanceWindow setting for the
+	// cluster.
+	//
+	// If false, changes to the cluster are applied on the next maintenance
+	// reboot, or the next failure reboot, whichever occurs first.
+	// +optional
+	ApplyModificationsImmediately bool `json:"applyModificationsImmediately,omitempty"`
+
+	// AZMode specifies whether the nodes in this Memcached cluster are created
+	// in a single Availability Zone or created across multiple Availability
+	// Zones in the cluster's region. Only new nodes are affected by a change
+	// of this value.
+	//
+	// Valid values: single-az | cross-az
+	// +optional
+	AZMode *string `json:"azMode,omitempty"`
+
+	// CacheNodeType specifies the compute and memory capacity of the nodes in
+	// the cluster.
+	CacheNodeType string `json:"cacheNodeType"`
+
+	// CacheParameterGroupName specifies the name of the parameter group to
+	// associate with this cluster. If this argument is omitted, the default
+	// cache parameter group for the specified engine is used.
+	// +optional
+	CacheParameterGroupName *string `json:"cacheParameterGroupName,omitempty"`
+
+	// CacheParameterGroupNameRef is a reference to a CacheParameterGroup used
+	// to set the CacheParameterGroupName.
+	// +optional
+	CacheParameterGroupNameRef *runtimev1alpha1.Reference `json:"cacheParameterGroupNameRef,omitempty"`
+
+	// CacheParameterGroupNameSelector selects a reference to a
+	// CacheParameterGroup used to set the CacheParameterGroupName.
+	// +optional
+	CacheParameterGroupNameSelector *runtimev1alpha1.Selector `json:"cacheParameterGroupNameSelector,omitempty"`
+
+	// CacheSecurityGroupNames specifies a list of cache security group names to
+	// associate with this cluster. Only for EC2-Classic mode.
+	// +optional
+	CacheSecurityGroupNames []string `json:"cacheSecurityGroupNames,omitempty"`
+
+	// CacheSubnetGroupName specifies the name of the cache subnet group to be
+	// used for the cluster.
+	// +immutable
+	// +optional
+	CacheSubnetGroupName *string `json:"cacheSubnetGroupName,omitempty"`
+
+	// CacheSubnetGroupNameRef is a reference to a CacheSubnetGroup used to set
+	// the CacheSubnetGroupName.
+	// +immutable
+	// +optional
+	CacheSubnetGroupNameRef *runtimev1alpha1.Reference `json:"cacheSubnetGroupNameRef,omitempty"`
+
+	// CacheSubnetGroupNameSelector selects a reference to a CacheSubnetGroup
+	// used to set the CacheSubnetGroupName.
+	// +immutable
+	// +optional
+	CacheSubnetGroupNameSelector *runtimev1alpha1.Selector `json:"cacheSubnetGroupNameSelector,omitempty"`
+
+	// Engine is the name of the cache engine to be used for this cluster.
+	//
+	// Valid values: memcached | redis
+	// +immutable
+	Engine string `json:"engine"`
+
+	// EngineVersion specifies the version number of the cache engine to be
+	// used for this cluster. You can upgrade to a newer engine version but you
+	// cannot downgrade to an earlier one.
+	// +optional
+	EngineVersion *string `json:"engineVersion,omitempty"`
+
+	// NotificationTopicARN specifies the Amazon Resource Name (ARN) of the
+	// Amazon Simple Notification Service (SNS) topic to which notifications are
+	// sent.
+	// +optional
+	NotificationTopicARN *string `json:"notificationTopicArn,omitempty"`
+
+	// NumCacheNodes is the number of cache nodes that the cluster should have.
+	// For Memcached clusters, this value must be between 1 and 40. When the
+	// number is decreased, the nodes with the highest IDs are removed.
+	NumCacheNodes int `json:"numCacheNodes"`
+
+	// Port number on which each of the cache nodes accepts connections.
+	// +immutable
+	// +optional
+	Port *int `json:"port,omitempty"`
+
+	// PreferredAvailabilityZone is the EC2 Availability Zone in which the
+	// cluster is created. All nodes belonging to this cluster are placed in
+	// the preferred Availability Zone. If you want to create your nodes across
+	// multiple Availability Zones, use PreferredAvailabilityZones.
+	// +immutable
+	// +optional
+	PreferredAvailabilityZone *string `json:"preferredAvailabilityZone,omitempty"`
+
+	// PreferredAvailabilityZones is a list of the Availability Zones in which
+	// cache nodes are created. The number of Availability Zones listed must
+	// equal the value of NumCacheNodes.
+	// +immutable
+	// +optional
+	PreferredAvailabilityZones []string `json:"preferredAvailabilityZones,omitempty"`
+
+	// PreferredMaintenanceWindow specifies the weekly time range during which
+	// maintenance on the cluster is performed. It is specified as a range in
+	// the format ddd:hh24:mi-ddd:hh24:mi (24H Clock UTC). The minimum
+	// maintenance window is a 60 minute period.
+	//
+	// Example: sun:23:00-mon:01:30
+	// +optional
+	PreferredMaintenanceWindow *string `json:"preferredMaintenanceWindow,omitempty"`
+
+	// SecurityGroupIDs specifies one or more VPC security groups associated
+	// with the cluster.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs are references to SecurityGroups used to set
+	// the SecurityGroupIDs.
+	// +immutable
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used to set
+	// the SecurityGroupIDs.
+	// +immutable
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// A list of cost allocation tags to be added to this resource.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// CacheNode represents a single node of a cache cluster.
+type CacheNode struct {
+	// CacheNodeID is the identifier of the node within its cluster. A node ID
+	// is a numeric identifier (0001, 0002, etc.).
+	CacheNodeID string `json:"cacheNodeId,omitempty"`
+
+	// CacheNodeStatus is the current state of this cache node, one of the
+	// following values: available, creating, rebooting, or deleting.
+	CacheNodeStatus string `json:"cacheNodeStatus,omitempty"`
+
+	// CustomerAvailabilityZone is the Availability Zone where this node was
+	// created and now resides.
+	CustomerAvailabilityZone string `json:"customerAvailabilityZone,omitempty"`
+
+	// Endpoint is the hostname for connecting to this cache node.
+	Endpoint Endpoint `json:"endpoint,omitempty"`
+
+	// ParameterGroupStatus is the status of the parameter group applied to
+	// this cache node.
+	ParameterGroupStatus string `json:"parameterGroupStatus,omitempty"`
+}
+
+// CacheClusterPendingModifiedValues are the settings to be applied to the
+// cluster, either immediately or during the next maintenance window.
+type CacheClusterPendingModifiedValues struct {
+	// CacheNodeIDsToRemove is the list of cache node IDs that are being
+	// removed.
+	CacheNodeIDsToRemove []string `json:"cacheNodeIdsToRemove,omitempty"`
+
+	// CacheNodeType is the cache node type that this cluster is being scaled
+	// to.
+	CacheNodeType string `json:"cacheNodeType,omitempty"`
+
+	// EngineVersion is the new cache engine version that the cluster runs.
+	EngineVersion string `json:"engineVersion,omitempty"`
+
+	// NumCacheNodes is the new number of cache nodes for the cluster.
+	NumCacheNodes int `json:"numCacheNodes,omitempty"`
+}
+
+// CacheClusterObservation contains the observation of the status of the given
+// CacheCluster.
+type CacheClusterObservation struct {
+	// ARN of the cache cluster.
+	ARN string `json:"arn,omitempty"`
+
+	// CacheClusterStatus is the current state of this cluster - available,
+	// creating, deleted, deleting, incompatible-network, modifying, rebooting
+	// cluster nodes, restore-failed or snapshotting.
+	CacheClusterStatus string `json:"cacheClusterStatus,omitempty"`
+
+	// CacheNodes is the list of cache nodes that are members of the cluster.
+	CacheNodes []CacheNode `json:"cacheNodes,omitempty"`
+
+	// ConfigurationEndpoint is the endpoint of a Memcached cluster that can
+	// be used by an application to connect to any node in the cluster.
+	ConfigurationEndpoint Endpoint `json:"configurationEndpoint,omitempty"`
+
+	// NumCacheNodes is the current number of cache nodes in the cluster.
+	NumCacheNodes int `json:"numCacheNodes,omitempty"`
+
+	// PendingModifiedValues is a group of settings to be applied to the
+	// cluster, either immediately or during the next maintenance window.
+	PendingModifiedValues CacheClusterPendingModifiedValues `json:"pendingModifiedValues,omitempty"`
+}
+
+// A CacheClusterSpec defines the desired state of a CacheCluster.
+type CacheClusterSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  CacheClusterParameters `json:"forProvider"`
+}
+
+// A CacheClusterStatus defines the observed state of a CacheCluster.
+type CacheClusterStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     CacheClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CacheCluster is a managed resource that represents an AWS ElastiCache
+// Cache Cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.cacheClusterStatus"
+// +kubebuilder:printcolumn:name="ENGINE",type="string",JSONPath=".spec.forProvider.engine"
+// +kubebuilder:printcolumn:name="NODES",type="integer",JSONPath=".status.atProvider.numCacheNodes"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CacheCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CacheClusterSpec   `json:"spec"`
+	Status CacheClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CacheClusterList contains a list of CacheCluster
+type CacheClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CacheCluster `json:"items"`
+}
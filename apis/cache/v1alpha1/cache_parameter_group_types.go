@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CacheParameter is a single parameter of a cache parameter group.
+type CacheParameter struct {
+	// ParameterName is the name of the parameter.
+	ParameterName string `json:"parameterName"`
+
+	// ParameterValue is the value of the parameter.
+	ParameterValue string `json:"parameterValue"`
+}
+
+// CacheParameterObservation is the observed state of a parameter that was
+// modified from its engine default.
+type CacheParameterObservation struct {
+	// ParameterName is the name of the parameter.
+	ParameterName string `json:"parameterName"`
+
+	// ParameterValue is the value of the parameter.
+	ParameterValue string `json:"parameterValue,omitempty"`
+
+	// ChangeType indicates whether a change to the parameter is applied
+	// immediately or requires a reboot for the change to be applied.
+	ChangeType string `json:"changeType,omitempty"`
+
+	// RequiresReboot is true if the nodes of the clusters using this group
+	// have to be rebooted for a change of the parameter to take effect.
+	RequiresReboot bool `json:"requiresReboot,omitempty"`
+}
+
+// CacheParameterGroupParameters define the desired state of an AWS ElastiCache
+// Cache Parameter Group.
+type CacheParameterGroupParameters struct {
+	// CacheParameterGroupFamily is the name of the cache parameter group
+	// family that the cache parameter group can be used with, e.g.
+	// memcached1.5 or redis5.0.
+	// +immutable
+	CacheParameterGroupFamily string `json:"cacheParameterGroupFamily"`
+
+	// Description for the cache parameter group.
+	// +immutable
+	Description string `json:"description"`
+
+	// Parameters to set in the cache parameter group. Parameters that are
+	// removed from this list are reset to their engine default.
+	// +optional
+	Parameters []CacheParameter `json:"parameters,omitempty"`
+}
+
+// A CacheParameterGroupSpec defines the desired state of a
+// CacheParameterGroup.
+type CacheParameterGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  CacheParameterGroupParameters `json:"forProvider"`
+}
+
+// CacheParameterGroupObservation is the representation of the current state
+// that is observed.
+type CacheParameterGroupObservation struct {
+	// ARN of the cache parameter group.
+	ARN string `json:"arn,omitempty"`
+
+	// Parameters that are modified from their engine default in the group.
+	Parameters []CacheParameterObservation `json:"parameters,omitempty"`
+}
+
+// A CacheParameterGroupStatus represents the observed state of a
+// CacheParameterGroup.
+type CacheParameterGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     CacheParameterGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CacheParameterGroup is a managed resource that represents an AWS
+// ElastiCache Cache Parameter Group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="FAMILY",type="string",JSONPath=".spec.forProvider.cacheParameterGroupFamily"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CacheParameterGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CacheParameterGroupSpec   `json:"spec"`
+	Status CacheParameterGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CacheParameterGroupList contains a list of CacheParameterGroup
+type CacheParameterGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CacheParameterGroup `json:"items"`
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CacheSnapshot states.
+const (
+	CacheSnapshotStatusAvailable = "available"
+	CacheSnapshotStatusCreating  = "creating"
+	CacheSnapshotStatusDeleting  = "deleting"
+)
+
+// CacheSnapshotExport specifies the Amazon S3 bucket a CacheSnapshot is
+// exported to.
+type CacheSnapshotExport struct {
+	// TargetBucket is the name of the Amazon S3 bucket the snapshot is
+	// exported to. ElastiCache must be granted access to the bucket.
+	TargetBucket string `json:"targetBucket"`
+
+	// TargetSnapshotName is the name of the exported snapshot in the bucket.
+	// Defaults to the name of the snapshot.
+	// +optional
+	TargetSnapshotName *string `json:"targetSnapshotName,omitempty"`
+}
+
+// CacheSnapshotParameters define the desired state of an AWS ElastiCache
+// Snapshot.
+type CacheSnapshotParameters struct {
+	// ReplicationGroupID is the identifier of the Redis replication group
+	// that is snapshotted.
+	// +immutable
+	// +optional
+	ReplicationGroupID *string `json:"replicationGroupId,omitempty"`
+
+	// ReplicationGroupIDRef is a reference to a ReplicationGroup used to set
+	// the ReplicationGroupID.
+	// +immutable
+	// +optional
+	ReplicationGroupIDRef *runtimev1alpha1.Reference `json:"replicationGroupIdRef,omitempty"`
+
+	// ReplicationGroupIDSelector selects a reference to a ReplicationGroup
+	// used to set the ReplicationGroupID.
+	// +immutable
+	// +optional
+	ReplicationGroupIDSelector *runtimev1alpha1.Selector `json:"replicationGroupIdSelector,omitempty"`
+
+	// KMSKeyID is the ID of the KMS key used to encrypt the snapshot.
+	// +immutable
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// Export specifies the Amazon S3 bucket the snapshot is exported to once
+	// it is available. Changing it exports the snapshot again.
+	// +optional
+	Export *CacheSnapshotExport `json:"export,omitempty"`
+}
+
+// NodeSnapshot represents the snapshot of an individual cache node.
+type NodeSnapshot struct {
+	// CacheClusterID is the identifier of the cache cluster of the node.
+	CacheClusterID string `json:"cacheClusterId,omitempty"`
+
+	// CacheNodeID is the identifier of the cache node.
+	CacheNodeID string `json:"cacheNodeId,omitempty"`
+
+	// CacheSize is the size of the cache on the node.
+	CacheSize string `json:"cacheSize,omitempty"`
+
+	// NodeGroupID is the identifier of the node group (shard) of the node.
+	NodeGroupID string `json:"nodeGroupId,omitempty"`
+
+	// SnapshotCreateTime is the time the snapshot of the node was completed.
+	SnapshotCreateTime *metav1.Time `json:"snapshotCreateTime,omitempty"`
+}
+
+// CacheSnapshotObservation contains the observation of the status of the
+// given CacheSnapshot.
+type CacheSnapshotObservation struct {
+	// ARN of the snapshot.
+	ARN string `json:"arn,omitempty"`
+
+	// CacheNodeType is the node type of the snapshotted nodes.
+	CacheNodeType string `json:"cacheNodeType,omitempty"`
+
+	// EngineVersion is the version of the engine of the snapshotted nodes.
+	EngineVersion string `json:"engineVersion,omitempty"`
+
+	// ExportedTo is the location in Amazon S3, in the form bucket/name, the
+	// snapshot was last exported to.
+	ExportedTo string `json:"exportedTo,omitempty"`
+
+	// NodeSnapshots is the list of the snapshots of the individual cache
+	// nodes. A node snapshot has a create time once it is completed.
+	NodeSnapshots []NodeSnapshot `json:"nodeSnapshots,omitempty"`
+
+	// NumNodeGroups is the number of node groups (shards) in the snapshot.
+	NumNodeGroups int `json:"numNodeGroups,omitempty"`
+
+	// SnapshotSource indicates whether the snapshot was created manually or
+	// automatically.
+	SnapshotSource string `json:"snapshotSource,omitempty"`
+
+	// SnapshotStatus is the current state of this snapshot - creating,
+	// available, restoring, copying or deleting.
+	SnapshotStatus string `json:"snapshotStatus,omitempty"`
+}
+
+// A CacheSnapshotSpec defines the desired state of a CacheSnapshot.
+type CacheSnapshotSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  CacheSnapshotParameters `json:"forProvider"`
+}
+
+// A CacheSnapshotStatus defines the observed state of a CacheSnapshot.
+type CacheSnapshotStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     CacheSnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CacheSnapshot is a managed resource that represents an AWS ElastiCache
+// Snapshot.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.snapshotStatus"
+// +kubebuilder:printcolumn:name="REPLICATION-GROUP",type="string",JSONPath=".spec.forProvider.replicationGroupId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CacheSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CacheSnapshotSpec   `json:"spec"`
+	Status CacheSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CacheSnapshotList contains a list of CacheSnapshot
+type CacheSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CacheSnapshot `json:"items"`
+}
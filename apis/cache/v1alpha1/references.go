@@ -46,3 +46,52 @@ func (mg *CacheSubnetGroup) ResolveReferences(ctx context.Context, c client.Read
 
 	return nil
 }
+
+// ResolveReferences of this CacheCluster
+func (mg *CacheCluster) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.cacheSubnetGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CacheSubnetGroupName),
+		Reference:    mg.Spec.ForProvider.CacheSubnetGroupNameRef,
+		Selector:     mg.Spec.ForProvider.CacheSubnetGroupNameSelector,
+		To:           reference.To{Managed: &CacheSubnetGroup{}, List: &CacheSubnetGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.CacheSubnetGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CacheSubnetGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.cacheParameterGroupName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CacheParameterGroupName),
+		Reference:    mg.Spec.ForProvider.CacheParameterGroupNameRef,
+		Selector:     mg.Spec.ForProvider.CacheParameterGroupNameSelector,
+		To:           reference.To{Managed: &CacheParameterGroup{}, List: &CacheParameterGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.CacheParameterGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CacheParameterGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.securityGroupIDs
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SecurityGroupIDs,
+		References:    mg.Spec.ForProvider.SecurityGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.SecurityGroupIDSelector,
+		To:            reference.To{Managed: &v1beta1.SecurityGroup{}, List: &v1beta1.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SecurityGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
@@ -45,6 +45,33 @@ var (
 	CacheSubnetGroupGroupVersionKind = SchemeGroupVersion.WithKind(CacheSubnetGroupKind)
 )
 
+// CacheCluster type metadata.
+var (
+	CacheClusterKind             = reflect.TypeOf(CacheCluster{}).Name()
+	CacheClusterGroupKind        = schema.GroupKind{Group: Group, Kind: CacheClusterKind}.String()
+	CacheClusterKindAPIVersion   = CacheClusterKind + "." + SchemeGroupVersion.String()
+	CacheClusterGroupVersionKind = SchemeGroupVersion.WithKind(CacheClusterKind)
+)
+
+// CacheParameterGroup type metadata.
+var (
+	CacheParameterGroupKind             = reflect.TypeOf(CacheParameterGroup{}).Name()
+	CacheParameterGroupGroupKind        = schema.GroupKind{Group: Group, Kind: CacheParameterGroupKind}.String()
+	CacheParameterGroupKindAPIVersion   = CacheParameterGroupKind + "." + SchemeGroupVersion.String()
+	CacheParameterGroupGroupVersionKind = SchemeGroupVersion.WithKind(CacheParameterGroupKind)
+)
+
+// CacheSnapshot type metadata.
+var (
+	CacheSnapshotKind             = reflect.TypeOf(CacheSnapshot{}).Name()
+	CacheSnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: CacheSnapshotKind}.String()
+	CacheSnapshotKindAPIVersion   = CacheSnapshotKind + "." + SchemeGroupVersion.String()
+	CacheSnapshotGroupVersionKind = SchemeGroupVersion.WithKind(CacheSnapshotKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&CacheSubnetGroup{}, &CacheSubnetGroupList{})
+	SchemeBuilder.Register(&CacheCluster{}, &CacheClusterList{})
+	SchemeBuilder.Register(&CacheParameterGroup{}, &CacheParameterGroupList{})
+	SchemeBuilder.Register(&CacheSnapshot{}, &CacheSnapshotList{})
 }
@@ -25,6 +25,600 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheCluster) DeepCopyInto(out *CacheCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheCluster.
+func (in *CacheCluster) DeepCopy() *CacheCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CacheCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheClusterList) DeepCopyInto(out *CacheClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CacheCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheClusterList.
+func (in *CacheClusterList) DeepCopy() *CacheClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CacheClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheClusterObservation) DeepCopyInto(out *CacheClusterObservation) {
+	*out = *in
+	if in.CacheNodes != nil {
+		in, out := &in.CacheNodes, &out.CacheNodes
+		*out = make([]CacheNode, len(*in))
+		copy(*out, *in)
+	}
+	out.ConfigurationEndpoint = in.ConfigurationEndpoint
+	in.PendingModifiedValues.DeepCopyInto(&out.PendingModifiedValues)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheClusterObservation.
+func (in *CacheClusterObservation) DeepCopy() *CacheClusterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheClusterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheClusterParameters) DeepCopyInto(out *CacheClusterParameters) {
+	*out = *in
+	if in.AZMode != nil {
+		in, out := &in.AZMode, &out.AZMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.CacheParameterGroupName != nil {
+		in, out := &in.CacheParameterGroupName, &out.CacheParameterGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CacheParameterGroupNameRef != nil {
+		in, out := &in.CacheParameterGroupNameRef, &out.CacheParameterGroupNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.CacheParameterGroupNameSelector != nil {
+		in, out := &in.CacheParameterGroupNameSelector, &out.CacheParameterGroupNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CacheSecurityGroupNames != nil {
+		in, out := &in.CacheSecurityGroupNames, &out.CacheSecurityGroupNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CacheSubnetGroupName != nil {
+		in, out := &in.CacheSubnetGroupName, &out.CacheSubnetGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CacheSubnetGroupNameRef != nil {
+		in, out := &in.CacheSubnetGroupNameRef, &out.CacheSubnetGroupNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.CacheSubnetGroupNameSelector != nil {
+		in, out := &in.CacheSubnetGroupNameSelector, &out.CacheSubnetGroupNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.NotificationTopicARN != nil {
+		in, out := &in.NotificationTopicARN, &out.NotificationTopicARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
+		**out = **in
+	}
+	if in.PreferredAvailabilityZone != nil {
+		in, out := &in.PreferredAvailabilityZone, &out.PreferredAvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreferredAvailabilityZones != nil {
+		in, out := &in.PreferredAvailabilityZones, &out.PreferredAvailabilityZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreferredMaintenanceWindow != nil {
+		in, out := &in.PreferredMaintenanceWindow, &out.PreferredMaintenanceWindow
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDRefs != nil {
+		in, out := &in.SecurityGroupIDRefs, &out.SecurityGroupIDRefs
+		*out = make([]corev1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDSelector != nil {
+		in, out := &in.SecurityGroupIDSelector, &out.SecurityGroupIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheClusterParameters.
+func (in *CacheClusterParameters) DeepCopy() *CacheClusterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheClusterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheClusterPendingModifiedValues) DeepCopyInto(out *CacheClusterPendingModifiedValues) {
+	*out = *in
+	if in.CacheNodeIDsToRemove != nil {
+		in, out := &in.CacheNodeIDsToRemove, &out.CacheNodeIDsToRemove
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheClusterPendingModifiedValues.
+func (in *CacheClusterPendingModifiedValues) DeepCopy() *CacheClusterPendingModifiedValues {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheClusterPendingModifiedValues)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheClusterSpec) DeepCopyInto(out *CacheClusterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheClusterSpec.
+func (in *CacheClusterSpec) DeepCopy() *CacheClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheClusterStatus) DeepCopyInto(out *CacheClusterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheClusterStatus.
+func (in *CacheClusterStatus) DeepCopy() *CacheClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheNode) DeepCopyInto(out *CacheNode) {
+	*out = *in
+	out.Endpoint = in.Endpoint
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheNode.
+func (in *CacheNode) DeepCopy() *CacheNode {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameter) DeepCopyInto(out *CacheParameter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameter.
+func (in *CacheParameter) DeepCopy() *CacheParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterGroup) DeepCopyInto(out *CacheParameterGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterGroup.
+func (in *CacheParameterGroup) DeepCopy() *CacheParameterGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameterGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CacheParameterGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterGroupList) DeepCopyInto(out *CacheParameterGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CacheParameterGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterGroupList.
+func (in *CacheParameterGroupList) DeepCopy() *CacheParameterGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameterGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CacheParameterGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterGroupObservation) DeepCopyInto(out *CacheParameterGroupObservation) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]CacheParameterObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterGroupObservation.
+func (in *CacheParameterGroupObservation) DeepCopy() *CacheParameterGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameterGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterGroupParameters) DeepCopyInto(out *CacheParameterGroupParameters) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]CacheParameter, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterGroupParameters.
+func (in *CacheParameterGroupParameters) DeepCopy() *CacheParameterGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameterGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterGroupSpec) DeepCopyInto(out *CacheParameterGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterGroupSpec.
+func (in *CacheParameterGroupSpec) DeepCopy() *CacheParameterGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameterGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterGroupStatus) DeepCopyInto(out *CacheParameterGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterGroupStatus.
+func (in *CacheParameterGroupStatus) DeepCopy() *CacheParameterGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameterGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheParameterObservation) DeepCopyInto(out *CacheParameterObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheParameterObservation.
+func (in *CacheParameterObservation) DeepCopy() *CacheParameterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheParameterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheSnapshot) DeepCopyInto(out *CacheSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheSnapshot.
+func (in *CacheSnapshot) DeepCopy() *CacheSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CacheSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheSnapshotExport) DeepCopyInto(out *CacheSnapshotExport) {
+	*out = *in
+	if in.TargetSnapshotName != nil {
+		in, out := &in.TargetSnapshotName, &out.TargetSnapshotName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheSnapshotExport.
+func (in *CacheSnapshotExport) DeepCopy() *CacheSnapshotExport {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheSnapshotExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheSnapshotList) DeepCopyInto(out *CacheSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CacheSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheSnapshotList.
+func (in *CacheSnapshotList) DeepCopy() *CacheSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CacheSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheSnapshotObservation) DeepCopyInto(out *CacheSnapshotObservation) {
+	*out = *in
+	if in.NodeSnapshots != nil {
+		in, out := &in.NodeSnapshots, &out.NodeSnapshots
+		*out = make([]NodeSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheSnapshotObservation.
+func (in *CacheSnapshotObservation) DeepCopy() *CacheSnapshotObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheSnapshotObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheSnapshotParameters) DeepCopyInto(out *CacheSnapshotParameters) {
+	*out = *in
+	if in.ReplicationGroupID != nil {
+		in, out := &in.ReplicationGroupID, &out.ReplicationGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplicationGroupIDRef != nil {
+		in, out := &in.ReplicationGroupIDRef, &out.ReplicationGroupIDRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ReplicationGroupIDSelector != nil {
+		in, out := &in.ReplicationGroupIDSelector, &out.ReplicationGroupIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Export != nil {
+		in, out := &in.Export, &out.Export
+		*out = new(CacheSnapshotExport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheSnapshotParameters.
+func (in *CacheSnapshotParameters) DeepCopy() *CacheSnapshotParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheSnapshotParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheSnapshotSpec) DeepCopyInto(out *CacheSnapshotSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheSnapshotSpec.
+func (in *CacheSnapshotSpec) DeepCopy() *CacheSnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheSnapshotStatus) DeepCopyInto(out *CacheSnapshotStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheSnapshotStatus.
+func (in *CacheSnapshotStatus) DeepCopy() *CacheSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CacheSubnetGroup) DeepCopyInto(out *CacheSubnetGroup) {
 	*out = *in
@@ -162,3 +756,52 @@ func (in *CacheSubnetGroupStatus) DeepCopy() *CacheSubnetGroupStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Endpoint) DeepCopyInto(out *Endpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoint.
+func (in *Endpoint) DeepCopy() *Endpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(Endpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeSnapshot) DeepCopyInto(out *NodeSnapshot) {
+	*out = *in
+	if in.SnapshotCreateTime != nil {
+		in, out := &in.SnapshotCreateTime, &out.SnapshotCreateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSnapshot.
+func (in *NodeSnapshot) DeepCopy() *NodeSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -23,6 +23,216 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
+// GetBindingPhase of this CacheCluster.
+func (mg *CacheCluster) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this CacheCluster.
+func (mg *CacheCluster) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetClassReference of this CacheCluster.
+func (mg *CacheCluster) GetClassReference() *corev1.ObjectReference {
+	return mg.Spec.ClassReference
+}
+
+// GetCondition of this CacheCluster.
+func (mg *CacheCluster) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetProviderReference of this CacheCluster.
+func (mg *CacheCluster) GetProviderReference() runtimev1alpha1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetReclaimPolicy of this CacheCluster.
+func (mg *CacheCluster) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this CacheCluster.
+func (mg *CacheCluster) GetWriteConnectionSecretToReference() *runtimev1alpha1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this CacheCluster.
+func (mg *CacheCluster) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this CacheCluster.
+func (mg *CacheCluster) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetClassReference of this CacheCluster.
+func (mg *CacheCluster) SetClassReference(r *corev1.ObjectReference) {
+	mg.Spec.ClassReference = r
+}
+
+// SetConditions of this CacheCluster.
+func (mg *CacheCluster) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetProviderReference of this CacheCluster.
+func (mg *CacheCluster) SetProviderReference(r runtimev1alpha1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetReclaimPolicy of this CacheCluster.
+func (mg *CacheCluster) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this CacheCluster.
+func (mg *CacheCluster) SetWriteConnectionSecretToReference(r *runtimev1alpha1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetBindingPhase of this CacheParameterGroup.
+func (mg *CacheParameterGroup) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this CacheParameterGroup.
+func (mg *CacheParameterGroup) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetClassReference of this CacheParameterGroup.
+func (mg *CacheParameterGroup) GetClassReference() *corev1.ObjectReference {
+	return mg.Spec.ClassReference
+}
+
+// GetCondition of this CacheParameterGroup.
+func (mg *CacheParameterGroup) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetProviderReference of this CacheParameterGroup.
+func (mg *CacheParameterGroup) GetProviderReference() runtimev1alpha1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetReclaimPolicy of this CacheParameterGroup.
+func (mg *CacheParameterGroup) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this CacheParameterGroup.
+func (mg *CacheParameterGroup) GetWriteConnectionSecretToReference() *runtimev1alpha1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this CacheParameterGroup.
+func (mg *CacheParameterGroup) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this CacheParameterGroup.
+func (mg *CacheParameterGroup) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetClassReference of this CacheParameterGroup.
+func (mg *CacheParameterGroup) SetClassReference(r *corev1.ObjectReference) {
+	mg.Spec.ClassReference = r
+}
+
+// SetConditions of this CacheParameterGroup.
+func (mg *CacheParameterGroup) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetProviderReference of this CacheParameterGroup.
+func (mg *CacheParameterGroup) SetProviderReference(r runtimev1alpha1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetReclaimPolicy of this CacheParameterGroup.
+func (mg *CacheParameterGroup) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this CacheParameterGroup.
+func (mg *CacheParameterGroup) SetWriteConnectionSecretToReference(r *runtimev1alpha1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetBindingPhase of this CacheSnapshot.
+func (mg *CacheSnapshot) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this CacheSnapshot.
+func (mg *CacheSnapshot) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetClassReference of this CacheSnapshot.
+func (mg *CacheSnapshot) GetClassReference() *corev1.ObjectReference {
+	return mg.Spec.ClassReference
+}
+
+// GetCondition of this CacheSnapshot.
+func (mg *CacheSnapshot) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetProviderReference of this CacheSnapshot.
+func (mg *CacheSnapshot) GetProviderReference() runtimev1alpha1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetReclaimPolicy of this CacheSnapshot.
+func (mg *CacheSnapshot) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this CacheSnapshot.
+func (mg *CacheSnapshot) GetWriteConnectionSecretToReference() *runtimev1alpha1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this CacheSnapshot.
+func (mg *CacheSnapshot) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this CacheSnapshot.
+func (mg *CacheSnapshot) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetClassReference of this CacheSnapshot.
+func (mg *CacheSnapshot) SetClassReference(r *corev1.ObjectReference) {
+	mg.Spec.ClassReference = r
+}
+
+// SetConditions of this CacheSnapshot.
+func (mg *CacheSnapshot) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetProviderReference of this CacheSnapshot.
+func (mg *CacheSnapshot) SetProviderReference(r runtimev1alpha1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetReclaimPolicy of this CacheSnapshot.
+func (mg *CacheSnapshot) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this CacheSnapshot.
+func (mg *CacheSnapshot) SetWriteConnectionSecretToReference(r *runtimev1alpha1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
 // GetBindingPhase of this CacheSubnetGroup.
 func (mg *CacheSubnetGroup) GetBindingPhase() runtimev1alpha1.BindingPhase {
 	return mg.Status.GetBindingPhase()
@@ -23,6 +23,7 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 )
 
@@ -58,5 +59,33 @@ func (mg *ReplicationGroup) ResolveReferences(ctx context.Context, c client.Read
 	mg.Spec.ForProvider.CacheSecurityGroupNames = mrsp.ResolvedValues
 	mg.Spec.ForProvider.CacheSecurityGroupNameRefs = mrsp.ResolvedReferences
 
+	// Resolve spec.forProvider.cacheParameterGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CacheParameterGroupName),
+		Reference:    mg.Spec.ForProvider.CacheParameterGroupNameRef,
+		Selector:     mg.Spec.ForProvider.CacheParameterGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha1.CacheParameterGroup{}, List: &v1alpha1.CacheParameterGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.CacheParameterGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CacheParameterGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.snapshotName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SnapshotName),
+		Reference:    mg.Spec.ForProvider.SnapshotNameRef,
+		Selector:     mg.Spec.ForProvider.SnapshotNameSelector,
+		To:           reference.To{Managed: &v1alpha1.CacheSnapshot{}, List: &v1alpha1.CacheSnapshotList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SnapshotName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SnapshotNameRef = rsp.ResolvedReference
+
 	return nil
 }
@@ -140,6 +140,10 @@ type ReplicationGroupPendingModifiedValues struct {
 	// maintenance window.
 	PrimaryClusterID string `json:"primaryClusterId,omitempty"`
 
+	// AuthTokenStatus is the status of an authentication token update, either
+	// ROTATING or SETTING.
+	AuthTokenStatus string `json:"authTokenStatus,omitempty"`
+
 	// Resharding is the status of an online resharding operation.
 	Resharding ReshardingStatus `json:"resharding,omitempty"`
 }
@@ -166,6 +170,15 @@ type SlotMigration struct {
 // ReplicationGroupObservation contains the observation of the status of
 // the given ReplicationGroup.
 type ReplicationGroupObservation struct {
+	// AuthTokenLastModifiedDate is the date the authentication token was last
+	// modified.
+	AuthTokenLastModifiedDate *metav1.Time `json:"authTokenLastModifiedDate,omitempty"`
+
+	// AuthTokenRotateTime is the time an authentication token was last
+	// generated or rotated in by Crossplane. Rotations are scheduled from it
+	// if AWS does not report AuthTokenLastModifiedDate.
+	AuthTokenRotateTime *metav1.Time `json:"authTokenRotateTime,omitempty"`
+
 	// AutomaticFailover indicates the status of Multi-AZ with automatic failover
 	// for this Redis replication group.
 	AutomaticFailover string `json:"automaticFailoverStatus,omitempty"`
@@ -207,6 +220,13 @@ type Tag struct {
 	Value string `json:"value"`
 }
 
+// AuthTokenRotationPolicy specifies how often an authentication token is
+// rotated.
+type AuthTokenRotationPolicy struct {
+	// Interval is the time between two rotations of the token, e.g. 720h.
+	Interval metav1.Duration `json:"interval"`
+}
+
 // A NodeGroupConfigurationSpec specifies the desired state of a node group.
 type NodeGroupConfigurationSpec struct {
 	// PrimaryAvailabilityZone specifies the Availability Zone where the primary
@@ -273,6 +293,16 @@ type ReplicationGroupParameters struct {
 	// +optional
 	AuthEnabled *bool `json:"authEnabled,omitempty"`
 
+	// AuthTokenRotation enables the periodic rotation of the auto-generated
+	// authentication token. A new token is first added alongside the old one
+	// using the ROTATE strategy and published to the connection secret. Once
+	// the replication group is available again the new token is made the only
+	// valid one using the SET strategy. The new token is kept under the
+	// pendingPassword key of the connection secret until it is set. It has no
+	// effect unless AuthEnabled is true and a connection secret is written.
+	// +optional
+	AuthTokenRotation *AuthTokenRotationPolicy `json:"authTokenRotation,omitempty"`
+
 	// AutomaticFailoverEnabled specifies whether a read-only replica is
 	// automatically promoted to read/write primary if the existing primary
 	// fails. If true, Multi-AZ is enabled for this replication group. If false,
@@ -311,6 +341,16 @@ type ReplicationGroupParameters struct {
 	// +optional
 	CacheParameterGroupName *string `json:"cacheParameterGroupName,omitempty"`
 
+	// CacheParameterGroupNameRef is a reference to a CacheParameterGroup used
+	// to set the CacheParameterGroupName.
+	// +optional
+	CacheParameterGroupNameRef *runtimev1alpha1.Reference `json:"cacheParameterGroupNameRef,omitempty"`
+
+	// CacheParameterGroupNameSelector selects a reference to a
+	// CacheParameterGroup used to set the CacheParameterGroupName.
+	// +optional
+	CacheParameterGroupNameSelector *runtimev1alpha1.Selector `json:"cacheParameterGroupNameSelector,omitempty"`
+
 	// CacheSecurityGroupNames specifies a list of cache security group names to
 	// associate with this replication group. Only for EC2-Classic mode.
 	// +optional
@@ -403,7 +443,11 @@ type ReplicationGroupParameters struct {
 	// disabled) either omit this parameter or set it to 1.
 	//
 	// Default: 1
-	// +immutable
+	//
+	// Changing this value of an existing replication group triggers an online
+	// resharding. When scaling in, the node groups with the highest IDs are
+	// removed. The progress of the resharding is reported in
+	// status.atProvider.pendingModifiedValues.resharding.
 	// +optional
 	NumNodeGroups *int `json:"numNodeGroups,omitempty"`
 
@@ -452,7 +496,9 @@ type ReplicationGroupParameters struct {
 
 	// ReplicasPerNodeGroup specifies the number of replica nodes in each node
 	// group (shard). Valid values are 0 to 5.
-	// +immutable
+	//
+	// Changing this value of an existing replication group adds or removes
+	// replicas in every node group.
 	// +optional
 	ReplicasPerNodeGroup *int `json:"replicasPerNodeGroup,omitempty"`
 
@@ -495,6 +541,18 @@ type ReplicationGroupParameters struct {
 	// +optional
 	SnapshotName *string `json:"snapshotName,omitempty"`
 
+	// SnapshotNameRef is a reference to a CacheSnapshot used to set the
+	// SnapshotName.
+	// +immutable
+	// +optional
+	SnapshotNameRef *runtimev1alpha1.Reference `json:"snapshotNameRef,omitempty"`
+
+	// SnapshotNameSelector selects a reference to a CacheSnapshot used to
+	// set the SnapshotName.
+	// +immutable
+	// +optional
+	SnapshotNameSelector *runtimev1alpha1.Selector `json:"snapshotNameSelector,omitempty"`
+
 	// SnapshotRetentionLimit specifies the number of days for which ElastiCache
 	// retains automatic snapshots before deleting them. For example, if you set
 	// SnapshotRetentionLimit to 5, a snapshot that was taken today is retained
@@ -25,6 +25,22 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthTokenRotationPolicy) DeepCopyInto(out *AuthTokenRotationPolicy) {
+	*out = *in
+	out.Interval = in.Interval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthTokenRotationPolicy.
+func (in *AuthTokenRotationPolicy) DeepCopy() *AuthTokenRotationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthTokenRotationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Endpoint) DeepCopyInto(out *Endpoint) {
 	*out = *in
@@ -249,6 +265,14 @@ func (in *ReplicationGroupList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicationGroupObservation) DeepCopyInto(out *ReplicationGroupObservation) {
 	*out = *in
+	if in.AuthTokenLastModifiedDate != nil {
+		in, out := &in.AuthTokenLastModifiedDate, &out.AuthTokenLastModifiedDate
+		*out = (*in).DeepCopy()
+	}
+	if in.AuthTokenRotateTime != nil {
+		in, out := &in.AuthTokenRotateTime, &out.AuthTokenRotateTime
+		*out = (*in).DeepCopy()
+	}
 	out.ConfigurationEndpoint = in.ConfigurationEndpoint
 	if in.MemberClusters != nil {
 		in, out := &in.MemberClusters, &out.MemberClusters
@@ -288,6 +312,11 @@ func (in *ReplicationGroupParameters) DeepCopyInto(out *ReplicationGroupParamete
 		*out = new(bool)
 		**out = **in
 	}
+	if in.AuthTokenRotation != nil {
+		in, out := &in.AuthTokenRotation, &out.AuthTokenRotation
+		*out = new(AuthTokenRotationPolicy)
+		**out = **in
+	}
 	if in.AutomaticFailoverEnabled != nil {
 		in, out := &in.AutomaticFailoverEnabled, &out.AutomaticFailoverEnabled
 		*out = new(bool)
@@ -298,6 +327,16 @@ func (in *ReplicationGroupParameters) DeepCopyInto(out *ReplicationGroupParamete
 		*out = new(string)
 		**out = **in
 	}
+	if in.CacheParameterGroupNameRef != nil {
+		in, out := &in.CacheParameterGroupNameRef, &out.CacheParameterGroupNameRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.CacheParameterGroupNameSelector != nil {
+		in, out := &in.CacheParameterGroupNameSelector, &out.CacheParameterGroupNameSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CacheSecurityGroupNames != nil {
 		in, out := &in.CacheSecurityGroupNames, &out.CacheSecurityGroupNames
 		*out = make([]string, len(*in))
@@ -400,6 +439,16 @@ func (in *ReplicationGroupParameters) DeepCopyInto(out *ReplicationGroupParamete
 		*out = new(string)
 		**out = **in
 	}
+	if in.SnapshotNameRef != nil {
+		in, out := &in.SnapshotNameRef, &out.SnapshotNameRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.SnapshotNameSelector != nil {
+		in, out := &in.SnapshotNameSelector, &out.SnapshotNameSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SnapshotRetentionLimit != nil {
 		in, out := &in.SnapshotRetentionLimit, &out.SnapshotRetentionLimit
 		*out = new(int)
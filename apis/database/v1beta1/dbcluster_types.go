@@ -0,0 +1,331 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// DBCluster states.
+const (
+	// The cluster is healthy and available
+	DBClusterStateAvailable = "available"
+	// The cluster is being created
+	DBClusterStateCreating = "creating"
+	// The cluster is being deleted
+	DBClusterStateDeleting = "deleting"
+	// The cluster is being modified
+	DBClusterStateModifying = "modifying"
+)
+
+// DBClusterParameters define the desired state of an AWS Aurora DB cluster.
+type DBClusterParameters struct {
+	// A list of Availability Zones (AZs) where instances in the DB cluster can
+	// be created.
+	// +immutable
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// The target backtrack window, in seconds. To disable backtracking, set
+	// this value to 0. Currently, Backtrack is only supported for Aurora MySQL
+	// DB clusters. If specified, this value must be set to a number from 0 to
+	// 259,200 (72 hours).
+	// +optional
+	BacktrackWindow *int `json:"backtrackWindow,omitempty"`
+
+	// The number of days for which automated backups are retained. Must be a
+	// value from 1 to 35.
+	// +optional
+	BackupRetentionPeriod *int `json:"backupRetentionPeriod,omitempty"`
+
+	// A value that indicates that the DB cluster should be associated with
+	// the specified CharacterSet.
+	// +immutable
+	// +optional
+	CharacterSetName *string `json:"characterSetName,omitempty"`
+
+	// A value that indicates whether to copy all tags from the DB cluster to
+	// snapshots of the DB cluster.
+	// +optional
+	CopyTagsToSnapshot *bool `json:"copyTagsToSnapshot,omitempty"`
+
+	// The name for your database of up to 64 alphanumeric characters. If you
+	// do not provide a name, Amazon RDS doesn't create a database in the DB
+	// cluster you are creating.
+	// +immutable
+	// +optional
+	DatabaseName *string `json:"databaseName,omitempty"`
+
+	// The name of the DB cluster parameter group to associate with this DB
+	// cluster. If not specified, the default DB cluster parameter group for
+	// the specified DB engine and version is used.
+	// +optional
+	DBClusterParameterGroupName *string `json:"dbClusterParameterGroupName,omitempty"`
+
+	// DBClusterParameterGroupNameRef is a reference to a
+	// DBClusterParameterGroup used to set DBClusterParameterGroupName.
+	// +optional
+	DBClusterParameterGroupNameRef *runtimev1alpha1.Reference `json:"dbClusterParameterGroupNameRef,omitempty"`
+
+	// DBClusterParameterGroupNameSelector selects a reference to a
+	// DBClusterParameterGroup used to set DBClusterParameterGroupName.
+	// +optional
+	DBClusterParameterGroupNameSelector *runtimev1alpha1.Selector `json:"dbClusterParameterGroupNameSelector,omitempty"`
+
+	// A DB subnet group to associate with this DB cluster.
+	// +immutable
+	// +optional
+	DBSubnetGroupName *string `json:"dbSubnetGroupName,omitempty"`
+
+	// DBSubnetGroupNameRef is a reference to a DBSubnetGroup used to set
+	// DBSubnetGroupName.
+	// +immutable
+	// +optional
+	DBSubnetGroupNameRef *runtimev1alpha1.Reference `json:"dbSubnetGroupNameRef,omitempty"`
+
+	// DBSubnetGroupNameSelector selects a reference to a DBSubnetGroup used to
+	// set DBSubnetGroupName.
+	// +immutable
+	// +optional
+	DBSubnetGroupNameSelector *runtimev1alpha1.Selector `json:"dbSubnetGroupNameSelector,omitempty"`
+
+	// A value that indicates whether the DB cluster has deletion protection
+	// enabled. The database can't be deleted when deletion protection is
+	// enabled.
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+
+	// The list of log types that need to be enabled for exporting to CloudWatch
+	// Logs.
+	// +optional
+	EnableCloudwatchLogsExports []string `json:"enableCloudwatchLogsExports,omitempty"`
+
+	// A value that indicates whether to enable the HTTP endpoint for an Aurora
+	// Serverless DB cluster. By default, the HTTP endpoint is disabled.
+	// +optional
+	EnableHTTPEndpoint *bool `json:"enableHttpEndpoint,omitempty"`
+
+	// A value that indicates whether to enable mapping of AWS Identity and
+	// Access Management (IAM) accounts to database accounts.
+	// +optional
+	EnableIAMDatabaseAuthentication *bool `json:"enableIAMDatabaseAuthentication,omitempty"`
+
+	// The name of the database engine to be used for this DB cluster. Valid
+	// Values: aurora (for MySQL 5.6-compatible Aurora), aurora-mysql (for
+	// MySQL 5.7-compatible Aurora), and aurora-postgresql
+	// +immutable
+	Engine string `json:"engine"`
+
+	// The DB engine mode of the DB cluster, either provisioned, serverless,
+	// parallelquery, global, or multimaster.
+	// +immutable
+	// +optional
+	EngineMode *string `json:"engineMode,omitempty"`
+
+	// The version number of the database engine to use.
+	// +optional
+	EngineVersion *string `json:"engineVersion,omitempty"`
+
+	// The global cluster ID of an Aurora cluster that becomes the primary
+	// cluster in the new global database cluster.
+	// +immutable
+	// +optional
+	GlobalClusterIdentifier *string `json:"globalClusterIdentifier,omitempty"`
+
+	// The AWS KMS key identifier for an encrypted DB cluster.
+	// +immutable
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// The name of the master user for the DB cluster.
+	// +immutable
+	// +optional
+	MasterUsername *string `json:"masterUsername,omitempty"`
+
+	// MasterPasswordSecretRef references the secret that contains the password
+	// used in the creation of this DB cluster. If a reference is not given, a
+	// password will be auto-generated.
+	// +optional
+	MasterPasswordSecretRef *runtimev1alpha1.SecretKeySelector `json:"masterPasswordSecretRef,omitempty"`
+
+	// The port number on which the instances in the DB cluster accept
+	// connections.
+	// +optional
+	Port *int `json:"port,omitempty"`
+
+	// The daily time range during which automated backups are created if
+	// automated backups are enabled using the BackupRetentionPeriod parameter.
+	// +optional
+	PreferredBackupWindow *string `json:"preferredBackupWindow,omitempty"`
+
+	// The weekly time range during which system maintenance can occur, in
+	// Universal Coordinated Time (UTC).
+	// +optional
+	PreferredMaintenanceWindow *string `json:"preferredMaintenanceWindow,omitempty"`
+
+	// For DB clusters in serverless DB engine mode, the scaling properties of
+	// the DB cluster.
+	// +optional
+	ScalingConfiguration *ScalingConfiguration `json:"scalingConfiguration,omitempty"`
+
+	// A value that indicates whether the DB cluster is encrypted.
+	// +immutable
+	// +optional
+	StorageEncrypted *bool `json:"storageEncrypted,omitempty"`
+
+	// Tags to assign to the DB cluster.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// A list of EC2 VPC security groups to associate with this DB cluster.
+	// +optional
+	VPCSecurityGroupIDs []string `json:"vpcSecurityGroupIds,omitempty"`
+
+	// VPCSecurityGroupIDRefs are references to VPCSecurityGroups used to set
+	// the VPCSecurityGroupIDs.
+	// +optional
+	VPCSecurityGroupIDRefs []runtimev1alpha1.Reference `json:"vpcSecurityGroupIDRefs,omitempty"`
+
+	// VPCSecurityGroupIDSelector selects references to VPCSecurityGroups used
+	// to set the VPCSecurityGroupIDs.
+	// +optional
+	VPCSecurityGroupIDSelector *runtimev1alpha1.Selector `json:"vpcSecurityGroupIDSelector,omitempty"`
+
+	// ApplyModificationsImmediately specifies whether the modifications in this
+	// request and any pending modifications are asynchronously applied as soon
+	// as possible, regardless of the PreferredMaintenanceWindow setting for the
+	// DB cluster.
+	// +optional
+	ApplyModificationsImmediately *bool `json:"applyModificationsImmediately,omitempty"`
+
+	// SkipFinalSnapshotBeforeDeletion indicates whether a final DB cluster
+	// snapshot is created before the DB cluster is deleted.
+	// +optional
+	SkipFinalSnapshotBeforeDeletion *bool `json:"skipFinalSnapshotBeforeDeletion,omitempty"`
+
+	// FinalDBSnapshotIdentifier is the DB cluster snapshot identifier of the
+	// new DB cluster snapshot created when SkipFinalSnapshot is set to false.
+	// +optional
+	FinalDBSnapshotIdentifier *string `json:"finalDBSnapshotIdentifier,omitempty"`
+}
+
+// A DBClusterSpec defines the desired state of a DBCluster.
+type DBClusterSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DBClusterParameters `json:"forProvider"`
+}
+
+// DBClusterMember contains information about an instance that is part of a
+// DB cluster.
+type DBClusterMember struct {
+	// Specifies the instance identifier for this member of the DB cluster.
+	DBInstanceIdentifier string `json:"dbInstanceIdentifier,omitempty"`
+
+	// Specifies the status of the DB cluster parameter group for this member
+	// of the DB cluster.
+	DBClusterParameterGroupStatus string `json:"dbClusterParameterGroupStatus,omitempty"`
+
+	// Value that is true if the cluster member is the primary instance for
+	// the DB cluster and false otherwise.
+	IsClusterWriter bool `json:"isClusterWriter,omitempty"`
+
+	// A value that specifies the order in which an Aurora Replica is promoted
+	// to the primary instance after a failure of the existing primary instance.
+	PromotionTier int `json:"promotionTier,omitempty"`
+}
+
+// DBClusterObservation is the representation of the current state that is
+// observed.
+type DBClusterObservation struct {
+	// Specifies the current state of this DB cluster.
+	Status string `json:"status,omitempty"`
+
+	// The Amazon Resource Name (ARN) for the DB cluster.
+	DBClusterARN string `json:"dbClusterArn,omitempty"`
+
+	// The AWS Region-unique, immutable identifier for the DB cluster.
+	DBClusterResourceID string `json:"dbClusterResourceId,omitempty"`
+
+	// Specifies the connection endpoint for the primary instance of the DB
+	// cluster.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// The reader endpoint for the DB cluster. The reader endpoint load-balances
+	// connections across the Aurora Replicas that are available in the DB
+	// cluster.
+	ReaderEndpoint string `json:"readerEndpoint,omitempty"`
+
+	// Specifies the ID that Amazon Route 53 assigns when you create a hosted
+	// zone.
+	HostedZoneID string `json:"hostedZoneId,omitempty"`
+
+	// The current capacity of an Aurora Serverless DB cluster.
+	Capacity int `json:"capacity,omitempty"`
+
+	// The number of change records stored for Backtrack.
+	BacktrackConsumedChangeRecords int `json:"backtrackConsumedChangeRecords,omitempty"`
+
+	// Provides the list of instances that make up the DB cluster.
+	DBClusterMembers []DBClusterMember `json:"dbClusterMembers,omitempty"`
+
+	// Specifies the time when the DB cluster was created.
+	ClusterCreateTime *metav1.Time `json:"clusterCreateTime,omitempty"`
+
+	// Specifies the latest time to which a database can be restored with
+	// point-in-time restore.
+	LatestRestorableTime *metav1.Time `json:"latestRestorableTime,omitempty"`
+
+	// Provides a list of VPC security groups that the DB cluster belongs to.
+	VPCSecurityGroups []VPCSecurityGroupMembership `json:"vpcSecurityGroups,omitempty"`
+}
+
+// A DBClusterStatus represents the observed state of a DBCluster.
+type DBClusterStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DBClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBCluster is a managed resource that represents an AWS Aurora DB cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="ENGINE",type="string",JSONPath=".spec.forProvider.engine"
+// +kubebuilder:printcolumn:name="MODE",type="string",JSONPath=".spec.forProvider.engineMode"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBClusterSpec   `json:"spec"`
+	Status DBClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBClusterList contains a list of DBClusters
+type DBClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBCluster `json:"items"`
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// DBClusterParameterGroupParameters define the desired state of an AWS RDS
+// DB cluster parameter group.
+type DBClusterParameterGroupParameters struct {
+	// DBParameterGroupFamily is the DB cluster parameter group family name,
+	// e.g. aurora-mysql5.7 or aurora-postgresql11.
+	// +immutable
+	DBParameterGroupFamily string `json:"dbParameterGroupFamily"`
+
+	// Description for the DB cluster parameter group.
+	// +immutable
+	Description string `json:"description"`
+
+	// Parameters to set in the DB cluster parameter group. Parameters that
+	// are removed from this list are reset to their engine default.
+	// +optional
+	Parameters []Parameter `json:"parameters,omitempty"`
+
+	// Tags to assign to the DB cluster parameter group.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A DBClusterParameterGroupSpec defines the desired state of a
+// DBClusterParameterGroup.
+type DBClusterParameterGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DBClusterParameterGroupParameters `json:"forProvider"`
+}
+
+// DBClusterParameterGroupObservation is the representation of the current
+// state that is observed.
+type DBClusterParameterGroupObservation struct {
+	// DBClusterParameterGroupARN is the Amazon Resource Name (ARN) for the DB
+	// cluster parameter group.
+	DBClusterParameterGroupARN string `json:"dbClusterParameterGroupArn,omitempty"`
+
+	// Parameters that are modified from their engine default in the group.
+	Parameters []ParameterObservation `json:"parameters,omitempty"`
+}
+
+// A DBClusterParameterGroupStatus represents the observed state of a
+// DBClusterParameterGroup.
+type DBClusterParameterGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DBClusterParameterGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBClusterParameterGroup is a managed resource that represents an AWS RDS
+// DB cluster parameter group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="FAMILY",type="string",JSONPath=".spec.forProvider.dbParameterGroupFamily"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBClusterParameterGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBClusterParameterGroupSpec   `json:"spec"`
+	Status DBClusterParameterGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBClusterParameterGroupList contains a list of DBClusterParameterGroups
+type DBClusterParameterGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBClusterParameterGroup `json:"items"`
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Apply methods of a parameter.
+const (
+	ParameterApplyMethodImmediate     = "immediate"
+	ParameterApplyMethodPendingReboot = "pending-reboot"
+)
+
+// Parameter is a single parameter of a DB or DB cluster parameter group.
+type Parameter struct {
+	// ParameterName is the name of the parameter.
+	ParameterName string `json:"parameterName"`
+
+	// ParameterValue is the value of the parameter.
+	ParameterValue string `json:"parameterValue"`
+
+	// ApplyMethod indicates when to apply the parameter. Dynamic parameters
+	// can be applied immediately, static parameters require a reboot of the
+	// DB instances using the group. Defaults to pending-reboot, which is
+	// accepted for both.
+	// +kubebuilder:validation:Enum=immediate;pending-reboot
+	// +optional
+	ApplyMethod *string `json:"applyMethod,omitempty"`
+}
+
+// ParameterObservation is the observed state of a parameter that was
+// modified from its engine default.
+type ParameterObservation struct {
+	// ParameterName is the name of the parameter.
+	ParameterName string `json:"parameterName"`
+
+	// ParameterValue is the value of the parameter.
+	ParameterValue string `json:"parameterValue,omitempty"`
+
+	// ApplyMethod indicates when the parameter is applied.
+	ApplyMethod string `json:"applyMethod,omitempty"`
+
+	// ApplyType is the engine specific type of the parameter: static
+	// parameters are applied only after a reboot, dynamic ones are applied
+	// while the instance is running.
+	ApplyType string `json:"applyType,omitempty"`
+
+	// PendingReboot is true if the parameter was applied with the
+	// pending-reboot method, i.e. DB instances using this group have to be
+	// rebooted for the value to take effect.
+	PendingReboot bool `json:"pendingReboot,omitempty"`
+}
+
+// DBParameterGroupParameters define the desired state of an AWS RDS DB
+// parameter group.
+type DBParameterGroupParameters struct {
+	// DBParameterGroupFamily is the DB parameter group family name, e.g.
+	// mysql5.7 or postgres11. A DB parameter group can be associated with
+	// one and only one DB parameter group family.
+	// +immutable
+	DBParameterGroupFamily string `json:"dbParameterGroupFamily"`
+
+	// Description for the DB parameter group.
+	// +immutable
+	Description string `json:"description"`
+
+	// Parameters to set in the DB parameter group. Parameters that are
+	// removed from this list are reset to their engine default.
+	// +optional
+	Parameters []Parameter `json:"parameters,omitempty"`
+
+	// Tags to assign to the DB parameter group.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A DBParameterGroupSpec defines the desired state of a DBParameterGroup.
+type DBParameterGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DBParameterGroupParameters `json:"forProvider"`
+}
+
+// DBParameterGroupObservation is the representation of the current state that
+// is observed.
+type DBParameterGroupObservation struct {
+	// DBParameterGroupARN is the Amazon Resource Name (ARN) for the DB
+	// parameter group.
+	DBParameterGroupARN string `json:"dbParameterGroupArn,omitempty"`
+
+	// Parameters that are modified from their engine default in the group.
+	Parameters []ParameterObservation `json:"parameters,omitempty"`
+}
+
+// A DBParameterGroupResourceStatus represents the observed state of a
+// DBParameterGroup. DBParameterGroupStatus is already used for the parameter
+// group status of an RDSInstance.
+type DBParameterGroupResourceStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DBParameterGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBParameterGroup is a managed resource that represents an AWS RDS DB
+// parameter group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="FAMILY",type="string",JSONPath=".spec.forProvider.dbParameterGroupFamily"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBParameterGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBParameterGroupSpec           `json:"spec"`
+	Status DBParameterGroupResourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBParameterGroupList contains a list of DBParameterGroups
+type DBParameterGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBParameterGroup `json:"items"`
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// States of a DB proxy.
+const (
+	DBProxyStateAvailable = "available"
+	DBProxyStateCreating  = "creating"
+	DBProxyStateModifying = "modifying"
+	DBProxyStateDeleting  = "deleting"
+)
+
+// Engine families of a DB proxy.
+const (
+	DBProxyEngineFamilyMySQL      = "MYSQL"
+	DBProxyEngineFamilyPostgreSQL = "POSTGRESQL"
+)
+
+// UserAuthConfig specifies the details of authentication used by a proxy to
+// log in as a specific database user.
+type UserAuthConfig struct {
+	// AuthScheme is the type of authentication that the proxy uses for
+	// connections from the proxy to the underlying database.
+	// +kubebuilder:validation:Enum=SECRETS
+	// +optional
+	AuthScheme *string `json:"authScheme,omitempty"`
+
+	// Description of the authentication configuration.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// IAMAuth specifies whether to require or disallow IAM authentication for
+	// connections to the proxy.
+	// +kubebuilder:validation:Enum=DISABLED;REQUIRED
+	// +optional
+	IAMAuth *string `json:"iamAuth,omitempty"`
+
+	// SecretARN is the ARN of the Secrets Manager secret that holds the
+	// credentials the proxy uses to connect to the database.
+	SecretARN string `json:"secretArn"`
+
+	// Username is the name of the database user the proxy connects as.
+	// +optional
+	Username *string `json:"username,omitempty"`
+}
+
+// DBProxyParameters define the desired state of an AWS RDS DB proxy.
+type DBProxyParameters struct {
+	// EngineFamily is the kind of database engine that the proxy connects to.
+	// +kubebuilder:validation:Enum=MYSQL;POSTGRESQL
+	// +immutable
+	EngineFamily string `json:"engineFamily"`
+
+	// Auth is the authorization mechanism that the proxy uses.
+	Auth []UserAuthConfig `json:"auth"`
+
+	// RoleARN is the ARN of the IAM role that the proxy uses to access the
+	// secrets in Secrets Manager.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef references an IAMRole to retrieve its ARN.
+	// +immutable
+	// +optional
+	RoleARNRef *runtimev1alpha1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAMRole to retrieve its ARN.
+	// +immutable
+	// +optional
+	RoleARNSelector *runtimev1alpha1.Selector `json:"roleArnSelector,omitempty"`
+
+	// VPCSubnetIDs are the IDs of the VPC subnets that the proxy can use.
+	// +immutable
+	// +optional
+	VPCSubnetIDs []string `json:"vpcSubnetIds,omitempty"`
+
+	// VPCSubnetIDRefs are references to Subnets used to set the VPCSubnetIDs.
+	// +immutable
+	// +optional
+	VPCSubnetIDRefs []runtimev1alpha1.Reference `json:"vpcSubnetIdRefs,omitempty"`
+
+	// VPCSubnetIDSelector selects references to Subnets used to set the
+	// VPCSubnetIDs.
+	// +immutable
+	// +optional
+	VPCSubnetIDSelector *runtimev1alpha1.Selector `json:"vpcSubnetIdSelector,omitempty"`
+
+	// VPCSecurityGroupIDs are the IDs of the VPC security groups that the
+	// proxy can use.
+	// +optional
+	VPCSecurityGroupIDs []string `json:"vpcSecurityGroupIds,omitempty"`
+
+	// VPCSecurityGroupIDRefs are references to SecurityGroups used to set the
+	// VPCSecurityGroupIDs.
+	// +immutable
+	// +optional
+	VPCSecurityGroupIDRefs []runtimev1alpha1.Reference `json:"vpcSecurityGroupIdRefs,omitempty"`
+
+	// VPCSecurityGroupIDSelector selects references to SecurityGroups used to
+	// set the VPCSecurityGroupIDs.
+	// +immutable
+	// +optional
+	VPCSecurityGroupIDSelector *runtimev1alpha1.Selector `json:"vpcSecurityGroupIdSelector,omitempty"`
+
+	// IdleClientTimeout is the number of seconds that a connection to the
+	// proxy can be inactive before the proxy disconnects it.
+	// +optional
+	IdleClientTimeout *int `json:"idleClientTimeout,omitempty"`
+
+	// RequireTLS indicates whether connections to the proxy must use TLS.
+	// +optional
+	RequireTLS *bool `json:"requireTLS,omitempty"`
+
+	// DebugLogging indicates whether the proxy logs detailed information
+	// about the SQL statements it processes.
+	// +optional
+	DebugLogging *bool `json:"debugLogging,omitempty"`
+
+	// Tags to assign to the DB proxy.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A DBProxySpec defines the desired state of a DBProxy.
+type DBProxySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DBProxyParameters `json:"forProvider"`
+}
+
+// DBProxyObservation is the representation of the current state that is
+// observed.
+type DBProxyObservation struct {
+	// DBProxyARN is the Amazon Resource Name (ARN) for the DB proxy.
+	DBProxyARN string `json:"dbProxyArn,omitempty"`
+
+	// Status of the DB proxy.
+	Status string `json:"status,omitempty"`
+
+	// Endpoint is the address that applications connect to instead of the
+	// address of the database.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// VPCSubnetIDs are the IDs of the VPC subnets that the proxy uses.
+	VPCSubnetIDs []string `json:"vpcSubnetIds,omitempty"`
+
+	// CreatedDate is the time when the DB proxy was created.
+	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
+}
+
+// A DBProxyStatus represents the observed state of a DBProxy.
+type DBProxyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DBProxyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBProxy is a managed resource that represents an AWS RDS DB proxy.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.atProvider.endpoint"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBProxy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBProxySpec   `json:"spec"`
+	Status DBProxyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBProxyList contains a list of DBProxies
+type DBProxyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBProxy `json:"items"`
+}
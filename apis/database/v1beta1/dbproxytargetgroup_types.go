@@ -0,0 +1,209 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// DBProxyDefaultTargetGroupName is the name of the target group that is
+// created along with a DB proxy. It is currently the only target group a DB
+// proxy can have.
+const DBProxyDefaultTargetGroupName = "default"
+
+// Health states of a DB proxy target.
+const (
+	DBProxyTargetStateRegistering = "REGISTERING"
+	DBProxyTargetStateAvailable   = "AVAILABLE"
+	DBProxyTargetStateUnavailable = "UNAVAILABLE"
+)
+
+// ConnectionPoolConfiguration specifies the settings that control the size
+// and behavior of the connection pool of a target group.
+type ConnectionPoolConfiguration struct {
+	// ConnectionBorrowTimeout is the number of seconds for a proxy to wait for
+	// a connection to become available in the connection pool.
+	// +optional
+	ConnectionBorrowTimeout *int `json:"connectionBorrowTimeout,omitempty"`
+
+	// InitQuery is one or more SQL statements for the proxy to run when
+	// opening each new database connection.
+	// +optional
+	InitQuery *string `json:"initQuery,omitempty"`
+
+	// MaxConnectionsPercent is the maximum size of the connection pool as a
+	// percentage of the max_connections setting of the target.
+	// +optional
+	MaxConnectionsPercent *int `json:"maxConnectionsPercent,omitempty"`
+
+	// MaxIdleConnectionsPercent controls how actively the proxy closes idle
+	// database connections in the connection pool, as a percentage of the
+	// max_connections setting of the target.
+	// +optional
+	MaxIdleConnectionsPercent *int `json:"maxIdleConnectionsPercent,omitempty"`
+
+	// SessionPinningFilters are the situations where the proxy does not pin
+	// a client connection to a specific database connection, e.g.
+	// EXCLUDE_VARIABLE_SETS.
+	// +optional
+	SessionPinningFilters []string `json:"sessionPinningFilters,omitempty"`
+}
+
+// DBProxyTargetGroupParameters define the desired state of an AWS RDS DB
+// proxy target group. Either DBInstanceIdentifier or DBClusterIdentifier must
+// be given.
+type DBProxyTargetGroupParameters struct {
+	// DBProxyName is the name of the DB proxy the target group belongs to.
+	// +immutable
+	// +optional
+	DBProxyName *string `json:"dbProxyName,omitempty"`
+
+	// DBProxyNameRef references a DBProxy to retrieve its name.
+	// +immutable
+	// +optional
+	DBProxyNameRef *runtimev1alpha1.Reference `json:"dbProxyNameRef,omitempty"`
+
+	// DBProxyNameSelector selects a reference to a DBProxy to retrieve its
+	// name.
+	// +immutable
+	// +optional
+	DBProxyNameSelector *runtimev1alpha1.Selector `json:"dbProxyNameSelector,omitempty"`
+
+	// TargetGroupName is the name of the target group. Defaults to "default",
+	// which is currently the only target group a DB proxy can have.
+	// +immutable
+	// +optional
+	TargetGroupName *string `json:"targetGroupName,omitempty"`
+
+	// DBInstanceIdentifier is the identifier of the DB instance that the
+	// proxy connects to.
+	// +immutable
+	// +optional
+	DBInstanceIdentifier *string `json:"dbInstanceIdentifier,omitempty"`
+
+	// DBInstanceIdentifierRef references an RDSInstance to retrieve its
+	// identifier.
+	// +immutable
+	// +optional
+	DBInstanceIdentifierRef *runtimev1alpha1.Reference `json:"dbInstanceIdentifierRef,omitempty"`
+
+	// DBInstanceIdentifierSelector selects a reference to an RDSInstance to
+	// retrieve its identifier.
+	// +immutable
+	// +optional
+	DBInstanceIdentifierSelector *runtimev1alpha1.Selector `json:"dbInstanceIdentifierSelector,omitempty"`
+
+	// DBClusterIdentifier is the identifier of the DB cluster that the proxy
+	// connects to.
+	// +immutable
+	// +optional
+	DBClusterIdentifier *string `json:"dbClusterIdentifier,omitempty"`
+
+	// DBClusterIdentifierRef references a DBCluster to retrieve its
+	// identifier.
+	// +immutable
+	// +optional
+	DBClusterIdentifierRef *runtimev1alpha1.Reference `json:"dbClusterIdentifierRef,omitempty"`
+
+	// DBClusterIdentifierSelector selects a reference to a DBCluster to
+	// retrieve its identifier.
+	// +immutable
+	// +optional
+	DBClusterIdentifierSelector *runtimev1alpha1.Selector `json:"dbClusterIdentifierSelector,omitempty"`
+
+	// ConnectionPoolConfig is the settings of the connection pool of the
+	// target group.
+	// +optional
+	ConnectionPoolConfig *ConnectionPoolConfiguration `json:"connectionPoolConfig,omitempty"`
+}
+
+// A DBProxyTargetGroupSpec defines the desired state of a DBProxyTargetGroup.
+type DBProxyTargetGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DBProxyTargetGroupParameters `json:"forProvider"`
+}
+
+// DBProxyTargetObservation is the observed state of a database that a DB
+// proxy connects to.
+type DBProxyTargetObservation struct {
+	// Type of the target, e.g. RDS_INSTANCE or TRACKED_CLUSTER.
+	Type string `json:"type,omitempty"`
+
+	// RDSResourceID is the identifier of the DB instance or DB cluster.
+	RDSResourceID string `json:"rdsResourceId,omitempty"`
+
+	// Endpoint is the address of the target.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Port of the target.
+	Port int `json:"port,omitempty"`
+
+	// State is the health of the target, e.g. AVAILABLE.
+	State string `json:"state,omitempty"`
+
+	// Reason explains why the target is not available.
+	Reason string `json:"reason,omitempty"`
+}
+
+// DBProxyTargetGroupObservation is the representation of the current state
+// that is observed.
+type DBProxyTargetGroupObservation struct {
+	// TargetGroupARN is the Amazon Resource Name (ARN) for the target group.
+	TargetGroupARN string `json:"targetGroupArn,omitempty"`
+
+	// Status of the target group.
+	Status string `json:"status,omitempty"`
+
+	// Targets are the databases the target group connects to.
+	Targets []DBProxyTargetObservation `json:"targets,omitempty"`
+}
+
+// A DBProxyTargetGroupStatus represents the observed state of a
+// DBProxyTargetGroup.
+type DBProxyTargetGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DBProxyTargetGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBProxyTargetGroup is a managed resource that represents the databases an
+// AWS RDS DB proxy connects to.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROXY",type="string",JSONPath=".spec.forProvider.dbProxyName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBProxyTargetGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBProxyTargetGroupSpec   `json:"spec"`
+	Status DBProxyTargetGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBProxyTargetGroupList contains a list of DBProxyTargetGroups
+type DBProxyTargetGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBProxyTargetGroup `json:"items"`
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// States of a DB snapshot.
+const (
+	DBSnapshotStateAvailable = "available"
+	DBSnapshotStateCreating  = "creating"
+	DBSnapshotStateCopying   = "copying"
+	DBSnapshotStateDeleting  = "deleting"
+)
+
+// DBSnapshotAttributeRestore is the DB snapshot attribute that holds the
+// accounts that are allowed to restore the snapshot.
+const DBSnapshotAttributeRestore = "restore"
+
+// DBSnapshotParameters define the desired state of an AWS RDS DB snapshot.
+// Either DBInstanceIdentifier or SourceDBSnapshotIdentifier must be given.
+type DBSnapshotParameters struct {
+	// DBInstanceIdentifier is the identifier of the DB instance to take the
+	// snapshot of.
+	// +immutable
+	// +optional
+	DBInstanceIdentifier *string `json:"dbInstanceIdentifier,omitempty"`
+
+	// DBInstanceIdentifierRef references an RDSInstance to retrieve its
+	// identifier.
+	// +immutable
+	// +optional
+	DBInstanceIdentifierRef *runtimev1alpha1.Reference `json:"dbInstanceIdentifierRef,omitempty"`
+
+	// DBInstanceIdentifierSelector selects a reference to an RDSInstance to
+	// retrieve its identifier.
+	// +immutable
+	// +optional
+	DBInstanceIdentifierSelector *runtimev1alpha1.Selector `json:"dbInstanceIdentifierSelector,omitempty"`
+
+	// SourceDBSnapshotIdentifier is the identifier of the DB snapshot to copy
+	// instead of taking a new snapshot. The ARN of the source must be given if
+	// it is in another region or shared from another account.
+	// +immutable
+	// +optional
+	SourceDBSnapshotIdentifier *string `json:"sourceDBSnapshotIdentifier,omitempty"`
+
+	// SourceDBSnapshotIdentifierRef references a DBSnapshot to retrieve its
+	// ARN and copy it.
+	// +immutable
+	// +optional
+	SourceDBSnapshotIdentifierRef *runtimev1alpha1.Reference `json:"sourceDBSnapshotIdentifierRef,omitempty"`
+
+	// SourceDBSnapshotIdentifierSelector selects a reference to a DBSnapshot
+	// to retrieve its ARN and copy it.
+	// +immutable
+	// +optional
+	SourceDBSnapshotIdentifierSelector *runtimev1alpha1.Selector `json:"sourceDBSnapshotIdentifierSelector,omitempty"`
+
+	// KMSKeyID is the identifier of the KMS key to encrypt the copy of the
+	// source DB snapshot with. It must be given when an encrypted snapshot is
+	// copied to another region.
+	// +immutable
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// CopyTags indicates whether the tags of the source DB snapshot are
+	// copied along with it.
+	// +immutable
+	// +optional
+	CopyTags *bool `json:"copyTags,omitempty"`
+
+	// OptionGroupName is the option group to associate with the copy of the
+	// source DB snapshot. It is required when a snapshot that uses a
+	// persistent or permanent option is copied to another region.
+	// +immutable
+	// +optional
+	OptionGroupName *string `json:"optionGroupName,omitempty"`
+
+	// SharedAccounts are the IDs of the AWS accounts that are allowed to copy
+	// or restore the DB snapshot. Use "all" to make the snapshot public.
+	// +optional
+	SharedAccounts []string `json:"sharedAccounts,omitempty"`
+
+	// Tags to assign to the DB snapshot.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A DBSnapshotSpec defines the desired state of a DBSnapshot.
+type DBSnapshotSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DBSnapshotParameters `json:"forProvider"`
+}
+
+// DBSnapshotObservation is the representation of the current state that is
+// observed.
+type DBSnapshotObservation struct {
+	// DBSnapshotARN is the Amazon Resource Name (ARN) for the DB snapshot.
+	DBSnapshotARN string `json:"dbSnapshotArn,omitempty"`
+
+	// Status of the DB snapshot.
+	Status string `json:"status,omitempty"`
+
+	// PercentProgress is the estimated percentage of the data that has been
+	// transferred.
+	PercentProgress int `json:"percentProgress,omitempty"`
+
+	// SnapshotCreateTime is the time when the snapshot was taken.
+	SnapshotCreateTime *metav1.Time `json:"snapshotCreateTime,omitempty"`
+
+	// SnapshotType is the type of the DB snapshot.
+	SnapshotType string `json:"snapshotType,omitempty"`
+
+	// Engine is the name of the database engine of the DB snapshot.
+	Engine string `json:"engine,omitempty"`
+
+	// EngineVersion is the version of the database engine of the DB snapshot.
+	EngineVersion string `json:"engineVersion,omitempty"`
+
+	// AllocatedStorage is the allocated storage size in gibibytes.
+	AllocatedStorage int `json:"allocatedStorage,omitempty"`
+
+	// Encrypted indicates whether the DB snapshot is encrypted.
+	Encrypted bool `json:"encrypted,omitempty"`
+
+	// KMSKeyID is the identifier of the KMS key the DB snapshot is encrypted
+	// with.
+	KMSKeyID string `json:"kmsKeyId,omitempty"`
+
+	// SourceDBSnapshotIdentifier is the ARN of the DB snapshot this one was
+	// copied from.
+	SourceDBSnapshotIdentifier string `json:"sourceDBSnapshotIdentifier,omitempty"`
+
+	// SourceRegion is the region the DB snapshot was copied from.
+	SourceRegion string `json:"sourceRegion,omitempty"`
+
+	// SharedAccounts are the IDs of the AWS accounts that are allowed to copy
+	// or restore the DB snapshot.
+	SharedAccounts []string `json:"sharedAccounts,omitempty"`
+}
+
+// A DBSnapshotStatus represents the observed state of a DBSnapshot.
+type DBSnapshotStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DBSnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBSnapshot is a managed resource that represents an AWS RDS DB snapshot.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="PROGRESS",type="integer",JSONPath=".status.atProvider.percentProgress"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBSnapshotSpec   `json:"spec"`
+	Status DBSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBSnapshotList contains a list of DBSnapshots
+type DBSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBSnapshot `json:"items"`
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// OptionSetting is a setting of an option in an option group.
+type OptionSetting struct {
+	// Name of the option setting.
+	Name string `json:"name"`
+
+	// Value of the option setting.
+	Value string `json:"value"`
+}
+
+// OptionConfiguration is the desired configuration of an option in an
+// option group.
+type OptionConfiguration struct {
+	// OptionName is the name of the option, e.g. SQLSERVER_BACKUP_RESTORE or
+	// TDE.
+	OptionName string `json:"optionName"`
+
+	// OptionSettings are the settings of the option.
+	// +optional
+	OptionSettings []OptionSetting `json:"optionSettings,omitempty"`
+
+	// OptionVersion is the version of the option.
+	// +optional
+	OptionVersion *string `json:"optionVersion,omitempty"`
+
+	// Port is the port that the option uses, if it is configurable.
+	// +optional
+	Port *int `json:"port,omitempty"`
+
+	// DBSecurityGroupMemberships is a list of DB security groups used for
+	// this option.
+	// +optional
+	DBSecurityGroupMemberships []string `json:"dbSecurityGroupMemberships,omitempty"`
+
+	// VPCSecurityGroupMemberships is a list of VPC security group IDs used
+	// for this option.
+	// +optional
+	VPCSecurityGroupMemberships []string `json:"vpcSecurityGroupMemberships,omitempty"`
+
+	// VPCSecurityGroupMembershipRefs are references to SecurityGroups used to
+	// set VPCSecurityGroupMemberships.
+	// +optional
+	VPCSecurityGroupMembershipRefs []runtimev1alpha1.Reference `json:"vpcSecurityGroupMembershipRefs,omitempty"`
+
+	// VPCSecurityGroupMembershipSelector selects references to SecurityGroups
+	// used to set VPCSecurityGroupMemberships.
+	// +optional
+	VPCSecurityGroupMembershipSelector *runtimev1alpha1.Selector `json:"vpcSecurityGroupMembershipSelector,omitempty"`
+}
+
+// OptionGroupParameters define the desired state of an AWS RDS option group.
+type OptionGroupParameters struct {
+	// EngineName is the name of the engine that this option group can be
+	// applied to, e.g. sqlserver-se or oracle-ee.
+	// +immutable
+	EngineName string `json:"engineName"`
+
+	// MajorEngineVersion is the major version of the engine that this option
+	// group can be applied to.
+	// +immutable
+	MajorEngineVersion string `json:"majorEngineVersion"`
+
+	// Description of the option group.
+	// +immutable
+	Description string `json:"description"`
+
+	// Options to include in the option group. Options that are removed from
+	// this list are removed from the option group unless they are permanent.
+	// +optional
+	Options []OptionConfiguration `json:"options,omitempty"`
+
+	// ApplyImmediately indicates whether the changes should be applied to the
+	// DB instances using this option group immediately or during their next
+	// maintenance window.
+	// +optional
+	ApplyImmediately *bool `json:"applyImmediately,omitempty"`
+
+	// Tags to assign to the option group.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// An OptionGroupSpec defines the desired state of an OptionGroup.
+type OptionGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  OptionGroupParameters `json:"forProvider"`
+}
+
+// OptionObservation is the observed state of an option in an option group.
+type OptionObservation struct {
+	// OptionName is the name of the option.
+	OptionName string `json:"optionName"`
+
+	// OptionVersion is the version of the option.
+	OptionVersion string `json:"optionVersion,omitempty"`
+
+	// Port is the port that the option uses.
+	Port int `json:"port,omitempty"`
+
+	// Permanent indicates that the option can never be removed from the
+	// option group.
+	Permanent bool `json:"permanent,omitempty"`
+
+	// Persistent indicates that the option can't be removed from the option
+	// group while DB instances are associated with it.
+	Persistent bool `json:"persistent,omitempty"`
+
+	// OptionSettings are the settings of the option.
+	OptionSettings []OptionSetting `json:"optionSettings,omitempty"`
+
+	// DBSecurityGroupMemberships are the DB security groups used for this
+	// option.
+	DBSecurityGroupMemberships []DBSecurityGroupMembership `json:"dbSecurityGroupMemberships,omitempty"`
+
+	// VPCSecurityGroupMemberships are the VPC security groups used for this
+	// option.
+	VPCSecurityGroupMemberships []VPCSecurityGroupMembership `json:"vpcSecurityGroupMemberships,omitempty"`
+}
+
+// OptionGroupObservation is the representation of the current state that is
+// observed.
+type OptionGroupObservation struct {
+	// OptionGroupARN is the Amazon Resource Name (ARN) for the option group.
+	OptionGroupARN string `json:"optionGroupArn,omitempty"`
+
+	// VPCID is the ID of the VPC that the option group can be applied to. It
+	// is empty if the option group can be applied to both VPC and non-VPC
+	// instances.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// Options that are included in the option group.
+	Options []OptionObservation `json:"options,omitempty"`
+}
+
+// An OptionGroupStatus represents the observed state of an OptionGroup.
+type OptionGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     OptionGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OptionGroup is a managed resource that represents an AWS RDS option
+// group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ENGINE",type="string",JSONPath=".spec.forProvider.engineName"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.majorEngineVersion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type OptionGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OptionGroupSpec   `json:"spec"`
+	Status OptionGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OptionGroupList contains a list of OptionGroups
+type OptionGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OptionGroup `json:"items"`
+}
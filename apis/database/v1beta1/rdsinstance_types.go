@@ -106,6 +106,63 @@ type ScalingConfiguration struct {
 	SecondsUntilAutoPause *int `json:"secondsUntilAutoPause,omitempty"`
 }
 
+// PasswordRotationPolicy specifies how often a password is rotated.
+type PasswordRotationPolicy struct {
+	// Interval is the time between two rotations of the password, e.g. 720h.
+	Interval metav1.Duration `json:"interval"`
+}
+
+// IAMAuthTokenConfiguration specifies the database user that authenticates
+// with IAM and the secret its authentication tokens are published to.
+type IAMAuthTokenConfiguration struct {
+	// Username is the name of the database user that authenticates with IAM.
+	// The user has to exist in the database and be set up for IAM
+	// authentication, e.g. granted the rds_iam role in PostgreSQL or
+	// identified with AWSAuthenticationPlugin in MySQL.
+	Username string `json:"username"`
+
+	// RefreshInterval is the time between two refreshes of the token. Tokens
+	// expire after 15 minutes, so intervals longer than 14m are capped to
+	// 14m. Defaults to 10m.
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+
+	// WriteConnectionSecretToReference specifies the secret that the endpoint,
+	// port, username and token are written to. The token is written as the
+	// password.
+	WriteConnectionSecretToReference runtimev1alpha1.SecretReference `json:"writeConnectionSecretToRef"`
+}
+
+// RestoreFromParameters specifies the source an RDSInstance is restored from
+// instead of being created empty. Either SnapshotIdentifier or
+// SourceDBInstanceIdentifier must be specified.
+type RestoreFromParameters struct {
+	// SnapshotIdentifier is the identifier or ARN of the DB snapshot to
+	// restore the DB instance from.
+	// +immutable
+	// +optional
+	SnapshotIdentifier *string `json:"snapshotIdentifier,omitempty"`
+
+	// SourceDBInstanceIdentifier is the identifier of the DB instance whose
+	// automated backups are used for a point-in-time restore.
+	// +immutable
+	// +optional
+	SourceDBInstanceIdentifier *string `json:"sourceDBInstanceIdentifier,omitempty"`
+
+	// RestoreTime is the date and time to restore the source DB instance to.
+	// It must be before the latest restorable time of the source and cannot
+	// be specified together with UseLatestRestorableTime.
+	// +immutable
+	// +optional
+	RestoreTime *metav1.Time `json:"restoreTime,omitempty"`
+
+	// UseLatestRestorableTime specifies whether the source DB instance is
+	// restored to its latest restorable time.
+	// +immutable
+	// +optional
+	UseLatestRestorableTime *bool `json:"useLatestRestorableTime,omitempty"`
+}
+
 // RDSInstanceParameters define the desired state of an AWS Relational Database
 // Service instance.
 type RDSInstanceParameters struct {
@@ -207,6 +264,18 @@ type RDSInstanceParameters struct {
 	// +optional
 	DBClusterIdentifier *string `json:"dbClusterIdentifier,omitempty"`
 
+	// DBClusterIdentifierRef is a reference to a DBCluster used to set
+	// DBClusterIdentifier.
+	// +immutable
+	// +optional
+	DBClusterIdentifierRef *runtimev1alpha1.Reference `json:"dbClusterIdentifierRef,omitempty"`
+
+	// DBClusterIdentifierSelector selects a reference to a DBCluster used to
+	// set DBClusterIdentifier.
+	// +immutable
+	// +optional
+	DBClusterIdentifierSelector *runtimev1alpha1.Selector `json:"dbClusterIdentifierSelector,omitempty"`
+
 	// DBInstanceClass is the compute and memory capacity of the DB instance, for example, db.m4.large.
 	// Not all DB instance classes are available in all AWS Regions, or for all
 	// database engines. For the full list of DB instance classes, and availability
@@ -307,6 +376,12 @@ type RDSInstanceParameters struct {
 	// +optional
 	EnableIAMDatabaseAuthentication *bool `json:"enableIAMDatabaseAuthentication,omitempty"`
 
+	// IAMAuthToken configures the publishing of short-lived IAM database
+	// authentication tokens, which applications can use instead of a static
+	// password. It requires EnableIAMDatabaseAuthentication to be true.
+	// +optional
+	IAMAuthToken *IAMAuthTokenConfiguration `json:"iamAuthToken,omitempty"`
+
 	// EnablePerformanceInsights should be true to enable Performance Insights for the DB instance, and otherwise false.
 	// For more information, see Using Amazon Performance Insights (http://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_PerfInsights.html)
 	// in the Amazon Relational Database Service User Guide.
@@ -432,11 +507,19 @@ type RDSInstanceParameters struct {
 
 	// MasterPasswordSecretRef references the secret that contains the password used
 	// in the creation of this RDS instance. If no reference is given, a password
-	// will be auto-generated.
+	// will be auto-generated. Changes to the password in the secret are applied
+	// to the RDS instance.
 	// +optional
-	// +immutable
 	MasterPasswordSecretRef *runtimev1alpha1.SecretKeySelector `json:"masterPasswordSecretRef,omitempty"`
 
+	// MasterPasswordRotation enables the periodic rotation of the auto-generated
+	// master password. It cannot be used together with MasterPasswordSecretRef.
+	// Rotation requires WriteConnectionSecretToReference; the new password is
+	// kept in a secret named after the connection secret with a
+	// -pending-master-password suffix until it has been published.
+	// +optional
+	MasterPasswordRotation *PasswordRotationPolicy `json:"masterPasswordRotation,omitempty"`
+
 	// MonitoringInterval is the interval, in seconds, between points when Enhanced Monitoring metrics
 	// are collected for the DB instance. To disable collecting Enhanced Monitoring
 	// metrics, specify 0. The default is 0.
@@ -684,6 +767,16 @@ type RDSInstanceParameters struct {
 	// +optional
 	DBParameterGroupName *string `json:"dbParameterGroupName,omitempty"`
 
+	// DBParameterGroupNameRef is a reference to a DBParameterGroup used to
+	// set DBParameterGroupName.
+	// +optional
+	DBParameterGroupNameRef *runtimev1alpha1.Reference `json:"dbParameterGroupNameRef,omitempty"`
+
+	// DBParameterGroupNameSelector selects a reference to a DBParameterGroup
+	// used to set DBParameterGroupName.
+	// +optional
+	DBParameterGroupNameSelector *runtimev1alpha1.Selector `json:"dbParameterGroupNameSelector,omitempty"`
+
 	// Domain specifies the Active Directory Domain to create the instance in.
 	// +optional
 	Domain *string `json:"domain,omitempty"`
@@ -713,10 +806,63 @@ type RDSInstanceParameters struct {
 	// +optional
 	OptionGroupName *string `json:"optionGroupName,omitempty"`
 
+	// OptionGroupNameRef is a reference to an OptionGroup used to set
+	// OptionGroupName.
+	// +optional
+	OptionGroupNameRef *runtimev1alpha1.Reference `json:"optionGroupNameRef,omitempty"`
+
+	// OptionGroupNameSelector selects a reference to an OptionGroup used to
+	// set OptionGroupName.
+	// +optional
+	OptionGroupNameSelector *runtimev1alpha1.Selector `json:"optionGroupNameSelector,omitempty"`
+
 	// A value that specifies that the DB instance class of the DB instance uses
 	// its default processor features.
 	UseDefaultProcessorFeatures *bool `json:"useDefaultProcessorFeatures,omitempty"`
 
+	// RebootOnParameterChange indicates whether the DB instance should be
+	// rebooted automatically when its DB parameter group has changes that
+	// are pending a reboot.
+	// Default: false
+	// +optional
+	RebootOnParameterChange *bool `json:"rebootOnParameterChange,omitempty"`
+
+	// RestoreFrom specifies a DB snapshot or a point in time of another DB
+	// instance to restore the DB instance from. Settings that cannot be given
+	// during the restore are applied with a modification once the restored
+	// DB instance is available. MasterPasswordSecretRef is required, since
+	// the master password is set that way once the restored DB instance is
+	// available.
+	// +immutable
+	// +optional
+	RestoreFrom *RestoreFromParameters `json:"restoreFrom,omitempty"`
+
+	// SourceDBInstanceIdentifier is the identifier of the DB instance this DB
+	// instance is created as a read replica of. The ARN of the source must be
+	// given if it is in another region.
+	// +immutable
+	// +optional
+	SourceDBInstanceIdentifier *string `json:"sourceDBInstanceIdentifier,omitempty"`
+
+	// SourceDBInstanceIdentifierRef references an RDSInstance to retrieve its
+	// identifier and create this DB instance as its read replica.
+	// +immutable
+	// +optional
+	SourceDBInstanceIdentifierRef *runtimev1alpha1.Reference `json:"sourceDBInstanceIdentifierRef,omitempty"`
+
+	// SourceDBInstanceIdentifierSelector selects a reference to an RDSInstance
+	// to retrieve its identifier and create this DB instance as its read
+	// replica.
+	// +immutable
+	// +optional
+	SourceDBInstanceIdentifierSelector *runtimev1alpha1.Selector `json:"sourceDBInstanceIdentifierSelector,omitempty"`
+
+	// PromoteReadReplica promotes this read replica to a standalone DB
+	// instance, detaching it from its source. A promotion cannot be undone.
+	// It has no effect if the DB instance is not a read replica.
+	// +optional
+	PromoteReadReplica *bool `json:"promoteReadReplica,omitempty"`
+
 	// Determines whether a final DB snapshot is created before the DB instance
 	// is deleted. If true is specified, no DBSnapshot is created. If false is specified,
 	// a DB snapshot is created before the DB instance is deleted.
@@ -762,6 +908,8 @@ const (
 	RDSInstanceStateModifying = "modifying"
 	// The instance has failed and Amazon RDS can't recover it. Perform a point-in-time restore to the latest restorable time of the instance to recover the data.
 	RDSInstanceStateFailed = "failed"
+	// The instance is being rebooted.
+	RDSInstanceStateRebooting = "rebooting"
 )
 
 // DBParameterGroupStatus is the status of the DB parameter group.
@@ -1059,6 +1207,24 @@ type RDSInstanceObservation struct {
 	// a Read Replica.
 	ReadReplicaSourceDBInstanceIdentifier string `json:"readReplicaSourceDBInstanceIdentifier,omitempty"`
 
+	// MasterPasswordRotateTime is the last time the master password was
+	// rotated by its rotation policy.
+	MasterPasswordRotateTime *metav1.Time `json:"masterPasswordRotateTime,omitempty"`
+
+	// MasterPasswordRotationStartTime is the time the ongoing rotation of the
+	// master password was started. It is unset once the new password is
+	// published.
+	MasterPasswordRotationStartTime *metav1.Time `json:"masterPasswordRotationStartTime,omitempty"`
+
+	// IAMAuthTokenRefreshTime is the last time an IAM database authentication
+	// token was published.
+	IAMAuthTokenRefreshTime *metav1.Time `json:"iamAuthTokenRefreshTime,omitempty"`
+
+	// ReplicaLag is the number of seconds this read replica lags behind its
+	// source, as last reported to CloudWatch. It is empty once the read
+	// replica is promoted.
+	ReplicaLag *int `json:"replicaLag,omitempty"`
+
 	// SecondaryAvailabilityZone specifies the name of the secondary Availability Zone for a DB
 	// instance with multi-AZ support when it is present.
 	SecondaryAvailabilityZone string `json:"secondaryAvailabilityZone,omitempty"`
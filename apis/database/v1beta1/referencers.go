@@ -22,11 +22,23 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	network "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
 )
 
+// DBSnapshotARN returns the status.atProvider.dbSnapshotArn of a DBSnapshot.
+func DBSnapshotARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		s, ok := mg.(*DBSnapshot)
+		if !ok {
+			return ""
+		}
+		return s.Status.AtProvider.DBSnapshotARN
+	}
+}
+
 // ResolveReferences of this DBSubnetGroup
 func (mg *DBSubnetGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -66,6 +78,34 @@ func (mg *RDSInstance) ResolveReferences(ctx context.Context, c client.Reader) e
 	mg.Spec.ForProvider.DBSubnetGroupName = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.DBSubnetGroupNameRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.dbClusterIdentifier
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DBClusterIdentifier),
+		Reference:    mg.Spec.ForProvider.DBClusterIdentifierRef,
+		Selector:     mg.Spec.ForProvider.DBClusterIdentifierSelector,
+		To:           reference.To{Managed: &DBCluster{}, List: &DBClusterList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.DBClusterIdentifier = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DBClusterIdentifierRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.dbParameterGroupName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DBParameterGroupName),
+		Reference:    mg.Spec.ForProvider.DBParameterGroupNameRef,
+		Selector:     mg.Spec.ForProvider.DBParameterGroupNameSelector,
+		To:           reference.To{Managed: &DBParameterGroup{}, List: &DBParameterGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.DBParameterGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DBParameterGroupNameRef = rsp.ResolvedReference
+
 	// Resolve spec.forProvider.domainIAMRoleName
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DomainIAMRoleName),
@@ -94,6 +134,83 @@ func (mg *RDSInstance) ResolveReferences(ctx context.Context, c client.Reader) e
 	mg.Spec.ForProvider.MonitoringRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.MonitoringRoleARNRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.optionGroupName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.OptionGroupName),
+		Reference:    mg.Spec.ForProvider.OptionGroupNameRef,
+		Selector:     mg.Spec.ForProvider.OptionGroupNameSelector,
+		To:           reference.To{Managed: &OptionGroup{}, List: &OptionGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.OptionGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.OptionGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.sourceDBInstanceIdentifier
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SourceDBInstanceIdentifier),
+		Reference:    mg.Spec.ForProvider.SourceDBInstanceIdentifierRef,
+		Selector:     mg.Spec.ForProvider.SourceDBInstanceIdentifierSelector,
+		To:           reference.To{Managed: &RDSInstance{}, List: &RDSInstanceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SourceDBInstanceIdentifier = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SourceDBInstanceIdentifierRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.vpcSecurityGroupIDs
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.VPCSecurityGroupIDs,
+		References:    mg.Spec.ForProvider.VPCSecurityGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.VPCSecurityGroupIDSelector,
+		To:            reference.To{Managed: &network.SecurityGroup{}, List: &network.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.VPCSecurityGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.VPCSecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this DBCluster
+func (mg *DBCluster) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.dbSubnetGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DBSubnetGroupName),
+		Reference:    mg.Spec.ForProvider.DBSubnetGroupNameRef,
+		Selector:     mg.Spec.ForProvider.DBSubnetGroupNameSelector,
+		To:           reference.To{Managed: &DBSubnetGroup{}, List: &DBSubnetGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.DBSubnetGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DBSubnetGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.dbClusterParameterGroupName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DBClusterParameterGroupName),
+		Reference:    mg.Spec.ForProvider.DBClusterParameterGroupNameRef,
+		Selector:     mg.Spec.ForProvider.DBClusterParameterGroupNameSelector,
+		To:           reference.To{Managed: &DBClusterParameterGroup{}, List: &DBClusterParameterGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.DBClusterParameterGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DBClusterParameterGroupNameRef = rsp.ResolvedReference
+
 	// Resolve spec.forProvider.vpcSecurityGroupIDs
 	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
 		CurrentValues: mg.Spec.ForProvider.VPCSecurityGroupIDs,
@@ -110,3 +227,159 @@ func (mg *RDSInstance) ResolveReferences(ctx context.Context, c client.Reader) e
 
 	return nil
 }
+
+// ResolveReferences of this OptionGroup
+func (mg *OptionGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.options[*].vpcSecurityGroupMemberships
+	for i := range mg.Spec.ForProvider.Options {
+		mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+			CurrentValues: mg.Spec.ForProvider.Options[i].VPCSecurityGroupMemberships,
+			References:    mg.Spec.ForProvider.Options[i].VPCSecurityGroupMembershipRefs,
+			Selector:      mg.Spec.ForProvider.Options[i].VPCSecurityGroupMembershipSelector,
+			To:            reference.To{Managed: &network.SecurityGroup{}, List: &network.SecurityGroupList{}},
+			Extract:       reference.ExternalName(),
+		})
+		if err != nil {
+			return err
+		}
+		mg.Spec.ForProvider.Options[i].VPCSecurityGroupMemberships = mrsp.ResolvedValues
+		mg.Spec.ForProvider.Options[i].VPCSecurityGroupMembershipRefs = mrsp.ResolvedReferences
+	}
+
+	return nil
+}
+
+// ResolveReferences of this DBSnapshot
+func (mg *DBSnapshot) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.dbInstanceIdentifier
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DBInstanceIdentifier),
+		Reference:    mg.Spec.ForProvider.DBInstanceIdentifierRef,
+		Selector:     mg.Spec.ForProvider.DBInstanceIdentifierSelector,
+		To:           reference.To{Managed: &RDSInstance{}, List: &RDSInstanceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.DBInstanceIdentifier = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DBInstanceIdentifierRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.sourceDBSnapshotIdentifier
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SourceDBSnapshotIdentifier),
+		Reference:    mg.Spec.ForProvider.SourceDBSnapshotIdentifierRef,
+		Selector:     mg.Spec.ForProvider.SourceDBSnapshotIdentifierSelector,
+		To:           reference.To{Managed: &DBSnapshot{}, List: &DBSnapshotList{}},
+		Extract:      DBSnapshotARN(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SourceDBSnapshotIdentifier = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SourceDBSnapshotIdentifierRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this DBProxy
+func (mg *DBProxy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.roleArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RoleARN),
+		Reference:    mg.Spec.ForProvider.RoleARNRef,
+		Selector:     mg.Spec.ForProvider.RoleARNSelector,
+		To:           reference.To{Managed: &v1beta1.IAMRole{}, List: &v1beta1.IAMRoleList{}},
+		Extract:      v1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.RoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RoleARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.vpcSubnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.VPCSubnetIDs,
+		References:    mg.Spec.ForProvider.VPCSubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.VPCSubnetIDSelector,
+		To:            reference.To{Managed: &network.Subnet{}, List: &network.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.VPCSubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.VPCSubnetIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.vpcSecurityGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.VPCSecurityGroupIDs,
+		References:    mg.Spec.ForProvider.VPCSecurityGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.VPCSecurityGroupIDSelector,
+		To:            reference.To{Managed: &network.SecurityGroup{}, List: &network.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.VPCSecurityGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.VPCSecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this DBProxyTargetGroup
+func (mg *DBProxyTargetGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.dbProxyName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DBProxyName),
+		Reference:    mg.Spec.ForProvider.DBProxyNameRef,
+		Selector:     mg.Spec.ForProvider.DBProxyNameSelector,
+		To:           reference.To{Managed: &DBProxy{}, List: &DBProxyList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.DBProxyName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DBProxyNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.dbInstanceIdentifier
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DBInstanceIdentifier),
+		Reference:    mg.Spec.ForProvider.DBInstanceIdentifierRef,
+		Selector:     mg.Spec.ForProvider.DBInstanceIdentifierSelector,
+		To:           reference.To{Managed: &RDSInstance{}, List: &RDSInstanceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.DBInstanceIdentifier = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DBInstanceIdentifierRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.dbClusterIdentifier
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DBClusterIdentifier),
+		Reference:    mg.Spec.ForProvider.DBClusterIdentifierRef,
+		Selector:     mg.Spec.ForProvider.DBClusterIdentifierSelector,
+		To:           reference.To{Managed: &DBCluster{}, List: &DBClusterList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.DBClusterIdentifier = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DBClusterIdentifierRef = rsp.ResolvedReference
+
+	return nil
+}
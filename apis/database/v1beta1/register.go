@@ -61,8 +61,71 @@ var (
 	DBSubnetGroupGroupVersionKind = SchemeGroupVersion.WithKind(DBSubnetGroupKind)
 )
 
+// DBCluster type metadata.
+var (
+	DBClusterKind             = reflect.TypeOf(DBCluster{}).Name()
+	DBClusterGroupKind        = schema.GroupKind{Group: Group, Kind: DBClusterKind}.String()
+	DBClusterKindAPIVersion   = DBClusterKind + "." + SchemeGroupVersion.String()
+	DBClusterGroupVersionKind = SchemeGroupVersion.WithKind(DBClusterKind)
+)
+
+// DBParameterGroup type metadata.
+var (
+	DBParameterGroupKind             = reflect.TypeOf(DBParameterGroup{}).Name()
+	DBParameterGroupGroupKind        = schema.GroupKind{Group: Group, Kind: DBParameterGroupKind}.String()
+	DBParameterGroupKindAPIVersion   = DBParameterGroupKind + "." + SchemeGroupVersion.String()
+	DBParameterGroupGroupVersionKind = SchemeGroupVersion.WithKind(DBParameterGroupKind)
+)
+
+// DBClusterParameterGroup type metadata.
+var (
+	DBClusterParameterGroupKind             = reflect.TypeOf(DBClusterParameterGroup{}).Name()
+	DBClusterParameterGroupGroupKind        = schema.GroupKind{Group: Group, Kind: DBClusterParameterGroupKind}.String()
+	DBClusterParameterGroupKindAPIVersion   = DBClusterParameterGroupKind + "." + SchemeGroupVersion.String()
+	DBClusterParameterGroupGroupVersionKind = SchemeGroupVersion.WithKind(DBClusterParameterGroupKind)
+)
+
+// OptionGroup type metadata.
+var (
+	OptionGroupKind             = reflect.TypeOf(OptionGroup{}).Name()
+	OptionGroupGroupKind        = schema.GroupKind{Group: Group, Kind: OptionGroupKind}.String()
+	OptionGroupKindAPIVersion   = OptionGroupKind + "." + SchemeGroupVersion.String()
+	OptionGroupGroupVersionKind = SchemeGroupVersion.WithKind(OptionGroupKind)
+)
+
+// DBSnapshot type metadata.
+var (
+	DBSnapshotKind             = reflect.TypeOf(DBSnapshot{}).Name()
+	DBSnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: DBSnapshotKind}.String()
+	DBSnapshotKindAPIVersion   = DBSnapshotKind + "." + SchemeGroupVersion.String()
+	DBSnapshotGroupVersionKind = SchemeGroupVersion.WithKind(DBSnapshotKind)
+)
+
+// DBProxy type metadata.
+var (
+	DBProxyKind             = reflect.TypeOf(DBProxy{}).Name()
+	DBProxyGroupKind        = schema.GroupKind{Group: Group, Kind: DBProxyKind}.String()
+	DBProxyKindAPIVersion   = DBProxyKind + "." + SchemeGroupVersion.String()
+	DBProxyGroupVersionKind = SchemeGroupVersion.WithKind(DBProxyKind)
+)
+
+// DBProxyTargetGroup type metadata.
+var (
+	DBProxyTargetGroupKind             = reflect.TypeOf(DBProxyTargetGroup{}).Name()
+	DBProxyTargetGroupGroupKind        = schema.GroupKind{Group: Group, Kind: DBProxyTargetGroupKind}.String()
+	DBProxyTargetGroupKindAPIVersion   = DBProxyTargetGroupKind + "." + SchemeGroupVersion.String()
+	DBProxyTargetGroupGroupVersionKind = SchemeGroupVersion.WithKind(DBProxyTargetGroupKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&RDSInstance{}, &RDSInstanceList{})
 	SchemeBuilder.Register(&RDSInstanceClass{}, &RDSInstanceClassList{})
 	SchemeBuilder.Register(&DBSubnetGroup{}, &DBSubnetGroupList{})
+	SchemeBuilder.Register(&DBCluster{}, &DBClusterList{})
+	SchemeBuilder.Register(&DBParameterGroup{}, &DBParameterGroupList{})
+	SchemeBuilder.Register(&DBClusterParameterGroup{}, &DBClusterParameterGroupList{})
+	SchemeBuilder.Register(&OptionGroup{}, &OptionGroupList{})
+	SchemeBuilder.Register(&DBSnapshot{}, &DBSnapshotList{})
+	SchemeBuilder.Register(&DBProxy{}, &DBProxyList{})
+	SchemeBuilder.Register(&DBProxyTargetGroup{}, &DBProxyTargetGroupList{})
 }
@@ -22,6 +22,7 @@ package v1beta1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -65,53 +66,1557 @@ func (in *CloudwatchLogsExportConfiguration) DeepCopy() *CloudwatchLogsExportCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionPoolConfiguration) DeepCopyInto(out *ConnectionPoolConfiguration) {
+	*out = *in
+	if in.ConnectionBorrowTimeout != nil {
+		in, out := &in.ConnectionBorrowTimeout, &out.ConnectionBorrowTimeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.InitQuery != nil {
+		in, out := &in.InitQuery, &out.InitQuery
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxConnectionsPercent != nil {
+		in, out := &in.MaxConnectionsPercent, &out.MaxConnectionsPercent
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxIdleConnectionsPercent != nil {
+		in, out := &in.MaxIdleConnectionsPercent, &out.MaxIdleConnectionsPercent
+		*out = new(int)
+		**out = **in
+	}
+	if in.SessionPinningFilters != nil {
+		in, out := &in.SessionPinningFilters, &out.SessionPinningFilters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolConfiguration.
+func (in *ConnectionPoolConfiguration) DeepCopy() *ConnectionPoolConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionPoolConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBCluster) DeepCopyInto(out *DBCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBCluster.
+func (in *DBCluster) DeepCopy() *DBCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(DBCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterList) DeepCopyInto(out *DBClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterList.
+func (in *DBClusterList) DeepCopy() *DBClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterMember) DeepCopyInto(out *DBClusterMember) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterMember.
+func (in *DBClusterMember) DeepCopy() *DBClusterMember {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterObservation) DeepCopyInto(out *DBClusterObservation) {
+	*out = *in
+	if in.DBClusterMembers != nil {
+		in, out := &in.DBClusterMembers, &out.DBClusterMembers
+		*out = make([]DBClusterMember, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterCreateTime != nil {
+		in, out := &in.ClusterCreateTime, &out.ClusterCreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LatestRestorableTime != nil {
+		in, out := &in.LatestRestorableTime, &out.LatestRestorableTime
+		*out = (*in).DeepCopy()
+	}
+	if in.VPCSecurityGroups != nil {
+		in, out := &in.VPCSecurityGroups, &out.VPCSecurityGroups
+		*out = make([]VPCSecurityGroupMembership, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterObservation.
+func (in *DBClusterObservation) DeepCopy() *DBClusterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterParameterGroup) DeepCopyInto(out *DBClusterParameterGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameterGroup.
+func (in *DBClusterParameterGroup) DeepCopy() *DBClusterParameterGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterParameterGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBClusterParameterGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterParameterGroupList) DeepCopyInto(out *DBClusterParameterGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBClusterParameterGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameterGroupList.
+func (in *DBClusterParameterGroupList) DeepCopy() *DBClusterParameterGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterParameterGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBClusterParameterGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterParameterGroupObservation) DeepCopyInto(out *DBClusterParameterGroupObservation) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]ParameterObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameterGroupObservation.
+func (in *DBClusterParameterGroupObservation) DeepCopy() *DBClusterParameterGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterParameterGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterParameterGroupParameters) DeepCopyInto(out *DBClusterParameterGroupParameters) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameterGroupParameters.
+func (in *DBClusterParameterGroupParameters) DeepCopy() *DBClusterParameterGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterParameterGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterParameterGroupSpec) DeepCopyInto(out *DBClusterParameterGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameterGroupSpec.
+func (in *DBClusterParameterGroupSpec) DeepCopy() *DBClusterParameterGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterParameterGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterParameterGroupStatus) DeepCopyInto(out *DBClusterParameterGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameterGroupStatus.
+func (in *DBClusterParameterGroupStatus) DeepCopy() *DBClusterParameterGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterParameterGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterParameters) DeepCopyInto(out *DBClusterParameters) {
+	*out = *in
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BacktrackWindow != nil {
+		in, out := &in.BacktrackWindow, &out.BacktrackWindow
+		*out = new(int)
+		**out = **in
+	}
+	if in.BackupRetentionPeriod != nil {
+		in, out := &in.BackupRetentionPeriod, &out.BackupRetentionPeriod
+		*out = new(int)
+		**out = **in
+	}
+	if in.CharacterSetName != nil {
+		in, out := &in.CharacterSetName, &out.CharacterSetName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CopyTagsToSnapshot != nil {
+		in, out := &in.CopyTagsToSnapshot, &out.CopyTagsToSnapshot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DatabaseName != nil {
+		in, out := &in.DatabaseName, &out.DatabaseName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterParameterGroupName != nil {
+		in, out := &in.DBClusterParameterGroupName, &out.DBClusterParameterGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterParameterGroupNameRef != nil {
+		in, out := &in.DBClusterParameterGroupNameRef, &out.DBClusterParameterGroupNameRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.DBClusterParameterGroupNameSelector != nil {
+		in, out := &in.DBClusterParameterGroupNameSelector, &out.DBClusterParameterGroupNameSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DBSubnetGroupName != nil {
+		in, out := &in.DBSubnetGroupName, &out.DBSubnetGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBSubnetGroupNameRef != nil {
+		in, out := &in.DBSubnetGroupNameRef, &out.DBSubnetGroupNameRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.DBSubnetGroupNameSelector != nil {
+		in, out := &in.DBSubnetGroupNameSelector, &out.DBSubnetGroupNameSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableCloudwatchLogsExports != nil {
+		in, out := &in.EnableCloudwatchLogsExports, &out.EnableCloudwatchLogsExports
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EnableHTTPEndpoint != nil {
+		in, out := &in.EnableHTTPEndpoint, &out.EnableHTTPEndpoint
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableIAMDatabaseAuthentication != nil {
+		in, out := &in.EnableIAMDatabaseAuthentication, &out.EnableIAMDatabaseAuthentication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EngineMode != nil {
+		in, out := &in.EngineMode, &out.EngineMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalClusterIdentifier != nil {
+		in, out := &in.GlobalClusterIdentifier, &out.GlobalClusterIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterUsername != nil {
+		in, out := &in.MasterUsername, &out.MasterUsername
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterPasswordSecretRef != nil {
+		in, out := &in.MasterPasswordSecretRef, &out.MasterPasswordSecretRef
+		*out = new(v1alpha1.SecretKeySelector)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
+		**out = **in
+	}
+	if in.PreferredBackupWindow != nil {
+		in, out := &in.PreferredBackupWindow, &out.PreferredBackupWindow
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreferredMaintenanceWindow != nil {
+		in, out := &in.PreferredMaintenanceWindow, &out.PreferredMaintenanceWindow
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScalingConfiguration != nil {
+		in, out := &in.ScalingConfiguration, &out.ScalingConfiguration
+		*out = new(ScalingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageEncrypted != nil {
+		in, out := &in.StorageEncrypted, &out.StorageEncrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCSecurityGroupIDs != nil {
+		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCSecurityGroupIDRefs != nil {
+		in, out := &in.VPCSecurityGroupIDRefs, &out.VPCSecurityGroupIDRefs
+		*out = make([]v1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCSecurityGroupIDSelector != nil {
+		in, out := &in.VPCSecurityGroupIDSelector, &out.VPCSecurityGroupIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplyModificationsImmediately != nil {
+		in, out := &in.ApplyModificationsImmediately, &out.ApplyModificationsImmediately
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipFinalSnapshotBeforeDeletion != nil {
+		in, out := &in.SkipFinalSnapshotBeforeDeletion, &out.SkipFinalSnapshotBeforeDeletion
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FinalDBSnapshotIdentifier != nil {
+		in, out := &in.FinalDBSnapshotIdentifier, &out.FinalDBSnapshotIdentifier
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameters.
+func (in *DBClusterParameters) DeepCopy() *DBClusterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterSpec.
+func (in *DBClusterSpec) DeepCopy() *DBClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterStatus) DeepCopyInto(out *DBClusterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterStatus.
+func (in *DBClusterStatus) DeepCopy() *DBClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DBInstanceStatusInfo) DeepCopyInto(out *DBInstanceStatusInfo) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceStatusInfo.
-func (in *DBInstanceStatusInfo) DeepCopy() *DBInstanceStatusInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceStatusInfo.
+func (in *DBInstanceStatusInfo) DeepCopy() *DBInstanceStatusInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(DBInstanceStatusInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroup) DeepCopyInto(out *DBParameterGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroup.
+func (in *DBParameterGroup) DeepCopy() *DBParameterGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(DBParameterGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBParameterGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroupList) DeepCopyInto(out *DBParameterGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBParameterGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupList.
+func (in *DBParameterGroupList) DeepCopy() *DBParameterGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBParameterGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBParameterGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroupObservation) DeepCopyInto(out *DBParameterGroupObservation) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]ParameterObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupObservation.
+func (in *DBParameterGroupObservation) DeepCopy() *DBParameterGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DBParameterGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroupParameters) DeepCopyInto(out *DBParameterGroupParameters) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupParameters.
+func (in *DBParameterGroupParameters) DeepCopy() *DBParameterGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DBParameterGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroupResourceStatus) DeepCopyInto(out *DBParameterGroupResourceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupResourceStatus.
+func (in *DBParameterGroupResourceStatus) DeepCopy() *DBParameterGroupResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBParameterGroupResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroupSpec) DeepCopyInto(out *DBParameterGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupSpec.
+func (in *DBParameterGroupSpec) DeepCopy() *DBParameterGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBParameterGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroupStatus) DeepCopyInto(out *DBParameterGroupStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupStatus.
+func (in *DBParameterGroupStatus) DeepCopy() *DBParameterGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBParameterGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxy) DeepCopyInto(out *DBProxy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxy.
+func (in *DBProxy) DeepCopy() *DBProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBProxy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyList) DeepCopyInto(out *DBProxyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBProxy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyList.
+func (in *DBProxyList) DeepCopy() *DBProxyList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBProxyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyObservation) DeepCopyInto(out *DBProxyObservation) {
+	*out = *in
+	if in.VPCSubnetIDs != nil {
+		in, out := &in.VPCSubnetIDs, &out.VPCSubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CreatedDate != nil {
+		in, out := &in.CreatedDate, &out.CreatedDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyObservation.
+func (in *DBProxyObservation) DeepCopy() *DBProxyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyParameters) DeepCopyInto(out *DBProxyParameters) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = make([]UserAuthConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleARNRef != nil {
+		in, out := &in.RoleARNRef, &out.RoleARNRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.RoleARNSelector != nil {
+		in, out := &in.RoleARNSelector, &out.RoleARNSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCSubnetIDs != nil {
+		in, out := &in.VPCSubnetIDs, &out.VPCSubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCSubnetIDRefs != nil {
+		in, out := &in.VPCSubnetIDRefs, &out.VPCSubnetIDRefs
+		*out = make([]v1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCSubnetIDSelector != nil {
+		in, out := &in.VPCSubnetIDSelector, &out.VPCSubnetIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCSecurityGroupIDs != nil {
+		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCSecurityGroupIDRefs != nil {
+		in, out := &in.VPCSecurityGroupIDRefs, &out.VPCSecurityGroupIDRefs
+		*out = make([]v1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCSecurityGroupIDSelector != nil {
+		in, out := &in.VPCSecurityGroupIDSelector, &out.VPCSecurityGroupIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdleClientTimeout != nil {
+		in, out := &in.IdleClientTimeout, &out.IdleClientTimeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.RequireTLS != nil {
+		in, out := &in.RequireTLS, &out.RequireTLS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DebugLogging != nil {
+		in, out := &in.DebugLogging, &out.DebugLogging
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyParameters.
+func (in *DBProxyParameters) DeepCopy() *DBProxyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxySpec) DeepCopyInto(out *DBProxySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxySpec.
+func (in *DBProxySpec) DeepCopy() *DBProxySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyStatus) DeepCopyInto(out *DBProxyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyStatus.
+func (in *DBProxyStatus) DeepCopy() *DBProxyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyTargetGroup) DeepCopyInto(out *DBProxyTargetGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetGroup.
+func (in *DBProxyTargetGroup) DeepCopy() *DBProxyTargetGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyTargetGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBProxyTargetGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyTargetGroupList) DeepCopyInto(out *DBProxyTargetGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBProxyTargetGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetGroupList.
+func (in *DBProxyTargetGroupList) DeepCopy() *DBProxyTargetGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyTargetGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBProxyTargetGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyTargetGroupObservation) DeepCopyInto(out *DBProxyTargetGroupObservation) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]DBProxyTargetObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetGroupObservation.
+func (in *DBProxyTargetGroupObservation) DeepCopy() *DBProxyTargetGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyTargetGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyTargetGroupParameters) DeepCopyInto(out *DBProxyTargetGroupParameters) {
+	*out = *in
+	if in.DBProxyName != nil {
+		in, out := &in.DBProxyName, &out.DBProxyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBProxyNameRef != nil {
+		in, out := &in.DBProxyNameRef, &out.DBProxyNameRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.DBProxyNameSelector != nil {
+		in, out := &in.DBProxyNameSelector, &out.DBProxyNameSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetGroupName != nil {
+		in, out := &in.TargetGroupName, &out.TargetGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBInstanceIdentifier != nil {
+		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBInstanceIdentifierRef != nil {
+		in, out := &in.DBInstanceIdentifierRef, &out.DBInstanceIdentifierRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.DBInstanceIdentifierSelector != nil {
+		in, out := &in.DBInstanceIdentifierSelector, &out.DBInstanceIdentifierSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DBClusterIdentifier != nil {
+		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterIdentifierRef != nil {
+		in, out := &in.DBClusterIdentifierRef, &out.DBClusterIdentifierRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.DBClusterIdentifierSelector != nil {
+		in, out := &in.DBClusterIdentifierSelector, &out.DBClusterIdentifierSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionPoolConfig != nil {
+		in, out := &in.ConnectionPoolConfig, &out.ConnectionPoolConfig
+		*out = new(ConnectionPoolConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetGroupParameters.
+func (in *DBProxyTargetGroupParameters) DeepCopy() *DBProxyTargetGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyTargetGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyTargetGroupSpec) DeepCopyInto(out *DBProxyTargetGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetGroupSpec.
+func (in *DBProxyTargetGroupSpec) DeepCopy() *DBProxyTargetGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyTargetGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyTargetGroupStatus) DeepCopyInto(out *DBProxyTargetGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetGroupStatus.
+func (in *DBProxyTargetGroupStatus) DeepCopy() *DBProxyTargetGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyTargetGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyTargetObservation) DeepCopyInto(out *DBProxyTargetObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetObservation.
+func (in *DBProxyTargetObservation) DeepCopy() *DBProxyTargetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyTargetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSecurityGroupMembership) DeepCopyInto(out *DBSecurityGroupMembership) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSecurityGroupMembership.
+func (in *DBSecurityGroupMembership) DeepCopy() *DBSecurityGroupMembership {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSecurityGroupMembership)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSnapshot) DeepCopyInto(out *DBSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshot.
+func (in *DBSnapshot) DeepCopy() *DBSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSnapshotList) DeepCopyInto(out *DBSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshotList.
+func (in *DBSnapshotList) DeepCopy() *DBSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSnapshotObservation) DeepCopyInto(out *DBSnapshotObservation) {
+	*out = *in
+	if in.SnapshotCreateTime != nil {
+		in, out := &in.SnapshotCreateTime, &out.SnapshotCreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SharedAccounts != nil {
+		in, out := &in.SharedAccounts, &out.SharedAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshotObservation.
+func (in *DBSnapshotObservation) DeepCopy() *DBSnapshotObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSnapshotObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSnapshotParameters) DeepCopyInto(out *DBSnapshotParameters) {
+	*out = *in
+	if in.DBInstanceIdentifier != nil {
+		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBInstanceIdentifierRef != nil {
+		in, out := &in.DBInstanceIdentifierRef, &out.DBInstanceIdentifierRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.DBInstanceIdentifierSelector != nil {
+		in, out := &in.DBInstanceIdentifierSelector, &out.DBInstanceIdentifierSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceDBSnapshotIdentifier != nil {
+		in, out := &in.SourceDBSnapshotIdentifier, &out.SourceDBSnapshotIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceDBSnapshotIdentifierRef != nil {
+		in, out := &in.SourceDBSnapshotIdentifierRef, &out.SourceDBSnapshotIdentifierRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.SourceDBSnapshotIdentifierSelector != nil {
+		in, out := &in.SourceDBSnapshotIdentifierSelector, &out.SourceDBSnapshotIdentifierSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CopyTags != nil {
+		in, out := &in.CopyTags, &out.CopyTags
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OptionGroupName != nil {
+		in, out := &in.OptionGroupName, &out.OptionGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SharedAccounts != nil {
+		in, out := &in.SharedAccounts, &out.SharedAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshotParameters.
+func (in *DBSnapshotParameters) DeepCopy() *DBSnapshotParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSnapshotParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSnapshotSpec) DeepCopyInto(out *DBSnapshotSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshotSpec.
+func (in *DBSnapshotSpec) DeepCopy() *DBSnapshotSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DBInstanceStatusInfo)
+	out := new(DBSnapshotSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBParameterGroupStatus) DeepCopyInto(out *DBParameterGroupStatus) {
+func (in *DBSnapshotStatus) DeepCopyInto(out *DBSnapshotStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshotStatus.
+func (in *DBSnapshotStatus) DeepCopy() *DBSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSubnetGroup) DeepCopyInto(out *DBSubnetGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroup.
+func (in *DBSubnetGroup) DeepCopy() *DBSubnetGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSubnetGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBSubnetGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSubnetGroupInRDS) DeepCopyInto(out *DBSubnetGroupInRDS) {
+	*out = *in
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]SubnetInRDS, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupInRDS.
+func (in *DBSubnetGroupInRDS) DeepCopy() *DBSubnetGroupInRDS {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSubnetGroupInRDS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSubnetGroupList) DeepCopyInto(out *DBSubnetGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBSubnetGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupList.
+func (in *DBSubnetGroupList) DeepCopy() *DBSubnetGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSubnetGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBSubnetGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSubnetGroupObservation) DeepCopyInto(out *DBSubnetGroupObservation) {
+	*out = *in
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]Subnet, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupObservation.
+func (in *DBSubnetGroupObservation) DeepCopy() *DBSubnetGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSubnetGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSubnetGroupParameters) DeepCopyInto(out *DBSubnetGroupParameters) {
+	*out = *in
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDRefs != nil {
+		in, out := &in.SubnetIDRefs, &out.SubnetIDRefs
+		*out = make([]v1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupParameters.
+func (in *DBSubnetGroupParameters) DeepCopy() *DBSubnetGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSubnetGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSubnetGroupSpec) DeepCopyInto(out *DBSubnetGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupSpec.
+func (in *DBSubnetGroupSpec) DeepCopy() *DBSubnetGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSubnetGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSubnetGroupStatus) DeepCopyInto(out *DBSubnetGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupStatus.
+func (in *DBSubnetGroupStatus) DeepCopy() *DBSubnetGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSubnetGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainMembership) DeepCopyInto(out *DomainMembership) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainMembership.
+func (in *DomainMembership) DeepCopy() *DomainMembership {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMembership)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Endpoint) DeepCopyInto(out *Endpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoint.
+func (in *Endpoint) DeepCopy() *Endpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(Endpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMAuthTokenConfiguration) DeepCopyInto(out *IAMAuthTokenConfiguration) {
 	*out = *in
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	out.WriteConnectionSecretToReference = in.WriteConnectionSecretToReference
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupStatus.
-func (in *DBParameterGroupStatus) DeepCopy() *DBParameterGroupStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMAuthTokenConfiguration.
+func (in *IAMAuthTokenConfiguration) DeepCopy() *IAMAuthTokenConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(DBParameterGroupStatus)
+	out := new(IAMAuthTokenConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSecurityGroupMembership) DeepCopyInto(out *DBSecurityGroupMembership) {
+func (in *OptionConfiguration) DeepCopyInto(out *OptionConfiguration) {
 	*out = *in
+	if in.OptionSettings != nil {
+		in, out := &in.OptionSettings, &out.OptionSettings
+		*out = make([]OptionSetting, len(*in))
+		copy(*out, *in)
+	}
+	if in.OptionVersion != nil {
+		in, out := &in.OptionVersion, &out.OptionVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
+		**out = **in
+	}
+	if in.DBSecurityGroupMemberships != nil {
+		in, out := &in.DBSecurityGroupMemberships, &out.DBSecurityGroupMemberships
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCSecurityGroupMemberships != nil {
+		in, out := &in.VPCSecurityGroupMemberships, &out.VPCSecurityGroupMemberships
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCSecurityGroupMembershipRefs != nil {
+		in, out := &in.VPCSecurityGroupMembershipRefs, &out.VPCSecurityGroupMembershipRefs
+		*out = make([]v1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCSecurityGroupMembershipSelector != nil {
+		in, out := &in.VPCSecurityGroupMembershipSelector, &out.VPCSecurityGroupMembershipSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSecurityGroupMembership.
-func (in *DBSecurityGroupMembership) DeepCopy() *DBSecurityGroupMembership {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionConfiguration.
+func (in *OptionConfiguration) DeepCopy() *OptionConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(DBSecurityGroupMembership)
+	out := new(OptionConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSubnetGroup) DeepCopyInto(out *DBSubnetGroup) {
+func (in *OptionGroup) DeepCopyInto(out *OptionGroup) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -119,18 +1624,18 @@ func (in *DBSubnetGroup) DeepCopyInto(out *DBSubnetGroup) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroup.
-func (in *DBSubnetGroup) DeepCopy() *DBSubnetGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroup.
+func (in *OptionGroup) DeepCopy() *OptionGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(DBSubnetGroup)
+	out := new(OptionGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DBSubnetGroup) DeepCopyObject() runtime.Object {
+func (in *OptionGroup) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -138,51 +1643,31 @@ func (in *DBSubnetGroup) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSubnetGroupInRDS) DeepCopyInto(out *DBSubnetGroupInRDS) {
-	*out = *in
-	if in.Subnets != nil {
-		in, out := &in.Subnets, &out.Subnets
-		*out = make([]SubnetInRDS, len(*in))
-		copy(*out, *in)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupInRDS.
-func (in *DBSubnetGroupInRDS) DeepCopy() *DBSubnetGroupInRDS {
-	if in == nil {
-		return nil
-	}
-	out := new(DBSubnetGroupInRDS)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSubnetGroupList) DeepCopyInto(out *DBSubnetGroupList) {
+func (in *OptionGroupList) DeepCopyInto(out *OptionGroupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DBSubnetGroup, len(*in))
+		*out = make([]OptionGroup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupList.
-func (in *DBSubnetGroupList) DeepCopy() *DBSubnetGroupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupList.
+func (in *OptionGroupList) DeepCopy() *OptionGroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(DBSubnetGroupList)
+	out := new(OptionGroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DBSubnetGroupList) DeepCopyObject() runtime.Object {
+func (in *OptionGroupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -190,42 +1675,56 @@ func (in *DBSubnetGroupList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSubnetGroupObservation) DeepCopyInto(out *DBSubnetGroupObservation) {
+func (in *OptionGroupMembership) DeepCopyInto(out *OptionGroupMembership) {
 	*out = *in
-	if in.Subnets != nil {
-		in, out := &in.Subnets, &out.Subnets
-		*out = make([]Subnet, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupObservation.
-func (in *DBSubnetGroupObservation) DeepCopy() *DBSubnetGroupObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupMembership.
+func (in *OptionGroupMembership) DeepCopy() *OptionGroupMembership {
 	if in == nil {
 		return nil
 	}
-	out := new(DBSubnetGroupObservation)
+	out := new(OptionGroupMembership)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSubnetGroupParameters) DeepCopyInto(out *DBSubnetGroupParameters) {
+func (in *OptionGroupObservation) DeepCopyInto(out *OptionGroupObservation) {
 	*out = *in
-	if in.SubnetIDs != nil {
-		in, out := &in.SubnetIDs, &out.SubnetIDs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]OptionObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.SubnetIDRefs != nil {
-		in, out := &in.SubnetIDRefs, &out.SubnetIDRefs
-		*out = make([]v1alpha1.Reference, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupObservation.
+func (in *OptionGroupObservation) DeepCopy() *OptionGroupObservation {
+	if in == nil {
+		return nil
 	}
-	if in.SubnetIDSelector != nil {
-		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
-		*out = new(v1alpha1.Selector)
-		(*in).DeepCopyInto(*out)
+	out := new(OptionGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptionGroupParameters) DeepCopyInto(out *OptionGroupParameters) {
+	*out = *in
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]OptionConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ApplyImmediately != nil {
+		in, out := &in.ApplyImmediately, &out.ApplyImmediately
+		*out = new(bool)
+		**out = **in
 	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
@@ -234,91 +1733,142 @@ func (in *DBSubnetGroupParameters) DeepCopyInto(out *DBSubnetGroupParameters) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupParameters.
-func (in *DBSubnetGroupParameters) DeepCopy() *DBSubnetGroupParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupParameters.
+func (in *OptionGroupParameters) DeepCopy() *OptionGroupParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(DBSubnetGroupParameters)
+	out := new(OptionGroupParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSubnetGroupSpec) DeepCopyInto(out *DBSubnetGroupSpec) {
+func (in *OptionGroupSpec) DeepCopyInto(out *OptionGroupSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupSpec.
-func (in *DBSubnetGroupSpec) DeepCopy() *DBSubnetGroupSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupSpec.
+func (in *OptionGroupSpec) DeepCopy() *OptionGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DBSubnetGroupSpec)
+	out := new(OptionGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSubnetGroupStatus) DeepCopyInto(out *DBSubnetGroupStatus) {
+func (in *OptionGroupStatus) DeepCopyInto(out *OptionGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupStatus.
-func (in *DBSubnetGroupStatus) DeepCopy() *DBSubnetGroupStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupStatus.
+func (in *OptionGroupStatus) DeepCopy() *OptionGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DBSubnetGroupStatus)
+	out := new(OptionGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainMembership) DeepCopyInto(out *DomainMembership) {
+func (in *OptionObservation) DeepCopyInto(out *OptionObservation) {
 	*out = *in
+	if in.OptionSettings != nil {
+		in, out := &in.OptionSettings, &out.OptionSettings
+		*out = make([]OptionSetting, len(*in))
+		copy(*out, *in)
+	}
+	if in.DBSecurityGroupMemberships != nil {
+		in, out := &in.DBSecurityGroupMemberships, &out.DBSecurityGroupMemberships
+		*out = make([]DBSecurityGroupMembership, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCSecurityGroupMemberships != nil {
+		in, out := &in.VPCSecurityGroupMemberships, &out.VPCSecurityGroupMemberships
+		*out = make([]VPCSecurityGroupMembership, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainMembership.
-func (in *DomainMembership) DeepCopy() *DomainMembership {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionObservation.
+func (in *OptionObservation) DeepCopy() *OptionObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainMembership)
+	out := new(OptionObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Endpoint) DeepCopyInto(out *Endpoint) {
+func (in *OptionSetting) DeepCopyInto(out *OptionSetting) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoint.
-func (in *Endpoint) DeepCopy() *Endpoint {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionSetting.
+func (in *OptionSetting) DeepCopy() *OptionSetting {
 	if in == nil {
 		return nil
 	}
-	out := new(Endpoint)
+	out := new(OptionSetting)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OptionGroupMembership) DeepCopyInto(out *OptionGroupMembership) {
+func (in *Parameter) DeepCopyInto(out *Parameter) {
 	*out = *in
+	if in.ApplyMethod != nil {
+		in, out := &in.ApplyMethod, &out.ApplyMethod
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupMembership.
-func (in *OptionGroupMembership) DeepCopy() *OptionGroupMembership {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Parameter.
+func (in *Parameter) DeepCopy() *Parameter {
 	if in == nil {
 		return nil
 	}
-	out := new(OptionGroupMembership)
+	out := new(Parameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterObservation) DeepCopyInto(out *ParameterObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterObservation.
+func (in *ParameterObservation) DeepCopy() *ParameterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordRotationPolicy) DeepCopyInto(out *PasswordRotationPolicy) {
+	*out = *in
+	out.Interval = in.Interval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordRotationPolicy.
+func (in *PasswordRotationPolicy) DeepCopy() *PasswordRotationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordRotationPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -562,6 +2112,23 @@ func (in *RDSInstanceObservation) DeepCopyInto(out *RDSInstanceObservation) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MasterPasswordRotateTime != nil {
+		in, out := &in.MasterPasswordRotateTime, &out.MasterPasswordRotateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.MasterPasswordRotationStartTime != nil {
+		in, out := &in.MasterPasswordRotationStartTime, &out.MasterPasswordRotationStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.IAMAuthTokenRefreshTime != nil {
+		in, out := &in.IAMAuthTokenRefreshTime, &out.IAMAuthTokenRefreshTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ReplicaLag != nil {
+		in, out := &in.ReplicaLag, &out.ReplicaLag
+		*out = new(int)
+		**out = **in
+	}
 	if in.StatusInfos != nil {
 		in, out := &in.StatusInfos, &out.StatusInfos
 		*out = make([]DBInstanceStatusInfo, len(*in))
@@ -627,6 +2194,16 @@ func (in *RDSInstanceParameters) DeepCopyInto(out *RDSInstanceParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DBClusterIdentifierRef != nil {
+		in, out := &in.DBClusterIdentifierRef, &out.DBClusterIdentifierRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.DBClusterIdentifierSelector != nil {
+		in, out := &in.DBClusterIdentifierSelector, &out.DBClusterIdentifierSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DBName != nil {
 		in, out := &in.DBName, &out.DBName
 		*out = new(string)
@@ -667,6 +2244,11 @@ func (in *RDSInstanceParameters) DeepCopyInto(out *RDSInstanceParameters) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.IAMAuthToken != nil {
+		in, out := &in.IAMAuthToken, &out.IAMAuthToken
+		*out = new(IAMAuthTokenConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EnablePerformanceInsights != nil {
 		in, out := &in.EnablePerformanceInsights, &out.EnablePerformanceInsights
 		*out = new(bool)
@@ -702,6 +2284,11 @@ func (in *RDSInstanceParameters) DeepCopyInto(out *RDSInstanceParameters) {
 		*out = new(v1alpha1.SecretKeySelector)
 		**out = **in
 	}
+	if in.MasterPasswordRotation != nil {
+		in, out := &in.MasterPasswordRotation, &out.MasterPasswordRotation
+		*out = new(PasswordRotationPolicy)
+		**out = **in
+	}
 	if in.MonitoringInterval != nil {
 		in, out := &in.MonitoringInterval, &out.MonitoringInterval
 		*out = new(int)
@@ -827,6 +2414,16 @@ func (in *RDSInstanceParameters) DeepCopyInto(out *RDSInstanceParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DBParameterGroupNameRef != nil {
+		in, out := &in.DBParameterGroupNameRef, &out.DBParameterGroupNameRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.DBParameterGroupNameSelector != nil {
+		in, out := &in.DBParameterGroupNameSelector, &out.DBParameterGroupNameSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Domain != nil {
 		in, out := &in.Domain, &out.Domain
 		*out = new(string)
@@ -852,11 +2449,51 @@ func (in *RDSInstanceParameters) DeepCopyInto(out *RDSInstanceParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.OptionGroupNameRef != nil {
+		in, out := &in.OptionGroupNameRef, &out.OptionGroupNameRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.OptionGroupNameSelector != nil {
+		in, out := &in.OptionGroupNameSelector, &out.OptionGroupNameSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.UseDefaultProcessorFeatures != nil {
 		in, out := &in.UseDefaultProcessorFeatures, &out.UseDefaultProcessorFeatures
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RebootOnParameterChange != nil {
+		in, out := &in.RebootOnParameterChange, &out.RebootOnParameterChange
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestoreFrom != nil {
+		in, out := &in.RestoreFrom, &out.RestoreFrom
+		*out = new(RestoreFromParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceDBInstanceIdentifier != nil {
+		in, out := &in.SourceDBInstanceIdentifier, &out.SourceDBInstanceIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceDBInstanceIdentifierRef != nil {
+		in, out := &in.SourceDBInstanceIdentifierRef, &out.SourceDBInstanceIdentifierRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.SourceDBInstanceIdentifierSelector != nil {
+		in, out := &in.SourceDBInstanceIdentifierSelector, &out.SourceDBInstanceIdentifierSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PromoteReadReplica != nil {
+		in, out := &in.PromoteReadReplica, &out.PromoteReadReplica
+		*out = new(bool)
+		**out = **in
+	}
 	if in.SkipFinalSnapshotBeforeDeletion != nil {
 		in, out := &in.SkipFinalSnapshotBeforeDeletion, &out.SkipFinalSnapshotBeforeDeletion
 		*out = new(bool)
@@ -913,6 +2550,40 @@ func (in *RDSInstanceStatus) DeepCopy() *RDSInstanceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreFromParameters) DeepCopyInto(out *RestoreFromParameters) {
+	*out = *in
+	if in.SnapshotIdentifier != nil {
+		in, out := &in.SnapshotIdentifier, &out.SnapshotIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceDBInstanceIdentifier != nil {
+		in, out := &in.SourceDBInstanceIdentifier, &out.SourceDBInstanceIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.RestoreTime != nil {
+		in, out := &in.RestoreTime, &out.RestoreTime
+		*out = (*in).DeepCopy()
+	}
+	if in.UseLatestRestorableTime != nil {
+		in, out := &in.UseLatestRestorableTime, &out.UseLatestRestorableTime
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreFromParameters.
+func (in *RestoreFromParameters) DeepCopy() *RestoreFromParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreFromParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalingConfiguration) DeepCopyInto(out *ScalingConfiguration) {
 	*out = *in
@@ -994,6 +2665,41 @@ func (in *Tag) DeepCopy() *Tag {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserAuthConfig) DeepCopyInto(out *UserAuthConfig) {
+	*out = *in
+	if in.AuthScheme != nil {
+		in, out := &in.AuthScheme, &out.AuthScheme
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.IAMAuth != nil {
+		in, out := &in.IAMAuth, &out.IAMAuth
+		*out = new(string)
+		**out = **in
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserAuthConfig.
+func (in *UserAuthConfig) DeepCopy() *UserAuthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(UserAuthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCSecurityGroupMembership) DeepCopyInto(out *VPCSecurityGroupMembership) {
 	*out = *in
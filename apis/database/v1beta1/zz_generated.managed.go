@@ -23,6 +23,426 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
+// GetBindingPhase of this DBCluster.
+func (mg *DBCluster) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this DBCluster.
+func (mg *DBCluster) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetClassReference of this DBCluster.
+func (mg *DBCluster) GetClassReference() *corev1.ObjectReference {
+	return mg.Spec.ClassReference
+}
+
+// GetCondition of this DBCluster.
+func (mg *DBCluster) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetProviderReference of this DBCluster.
+func (mg *DBCluster) GetProviderReference() runtimev1alpha1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetReclaimPolicy of this DBCluster.
+func (mg *DBCluster) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this DBCluster.
+func (mg *DBCluster) GetWriteConnectionSecretToReference() *runtimev1alpha1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this DBCluster.
+func (mg *DBCluster) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this DBCluster.
+func (mg *DBCluster) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetClassReference of this DBCluster.
+func (mg *DBCluster) SetClassReference(r *corev1.ObjectReference) {
+	mg.Spec.ClassReference = r
+}
+
+// SetConditions of this DBCluster.
+func (mg *DBCluster) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetProviderReference of this DBCluster.
+func (mg *DBCluster) SetProviderReference(r runtimev1alpha1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetReclaimPolicy of this DBCluster.
+func (mg *DBCluster) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this DBCluster.
+func (mg *DBCluster) SetWriteConnectionSecretToReference(r *runtimev1alpha1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetBindingPhase of this DBClusterParameterGroup.
+func (mg *DBClusterParameterGroup) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this DBClusterParameterGroup.
+func (mg *DBClusterParameterGroup) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetClassReference of this DBClusterParameterGroup.
+func (mg *DBClusterParameterGroup) GetClassReference() *corev1.ObjectReference {
+	return mg.Spec.ClassReference
+}
+
+// GetCondition of this DBClusterParameterGroup.
+func (mg *DBClusterParameterGroup) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetProviderReference of this DBClusterParameterGroup.
+func (mg *DBClusterParameterGroup) GetProviderReference() runtimev1alpha1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetReclaimPolicy of this DBClusterParameterGroup.
+func (mg *DBClusterParameterGroup) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this DBClusterParameterGroup.
+func (mg *DBClusterParameterGroup) GetWriteConnectionSecretToReference() *runtimev1alpha1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this DBClusterParameterGroup.
+func (mg *DBClusterParameterGroup) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this DBClusterParameterGroup.
+func (mg *DBClusterParameterGroup) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetClassReference of this DBClusterParameterGroup.
+func (mg *DBClusterParameterGroup) SetClassReference(r *corev1.ObjectReference) {
+	mg.Spec.ClassReference = r
+}
+
+// SetConditions of this DBClusterParameterGroup.
+func (mg *DBClusterParameterGroup) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetProviderReference of this DBClusterParameterGroup.
+func (mg *DBClusterParameterGroup) SetProviderReference(r runtimev1alpha1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetReclaimPolicy of this DBClusterParameterGroup.
+func (mg *DBClusterParameterGroup) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this DBClusterParameterGroup.
+func (mg *DBClusterParameterGroup) SetWriteConnectionSecretToReference(r *runtimev1alpha1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetBindingPhase of this DBParameterGroup.
+func (mg *DBParameterGroup) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this DBParameterGroup.
+func (mg *DBParameterGroup) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetClassReference of this DBParameterGroup.
+func (mg *DBParameterGroup) GetClassReference() *corev1.ObjectReference {
+	return mg.Spec.ClassReference
+}
+
+// GetCondition of this DBParameterGroup.
+func (mg *DBParameterGroup) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetProviderReference of this DBParameterGroup.
+func (mg *DBParameterGroup) GetProviderReference() runtimev1alpha1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetReclaimPolicy of this DBParameterGroup.
+func (mg *DBParameterGroup) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this DBParameterGroup.
+func (mg *DBParameterGroup) GetWriteConnectionSecretToReference() *runtimev1alpha1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this DBParameterGroup.
+func (mg *DBParameterGroup) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this DBParameterGroup.
+func (mg *DBParameterGroup) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetClassReference of this DBParameterGroup.
+func (mg *DBParameterGroup) SetClassReference(r *corev1.ObjectReference) {
+	mg.Spec.ClassReference = r
+}
+
+// SetConditions of this DBParameterGroup.
+func (mg *DBParameterGroup) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetProviderReference of this DBParameterGroup.
+func (mg *DBParameterGroup) SetProviderReference(r runtimev1alpha1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetReclaimPolicy of this DBParameterGroup.
+func (mg *DBParameterGroup) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this DBParameterGroup.
+func (mg *DBParameterGroup) SetWriteConnectionSecretToReference(r *runtimev1alpha1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetBindingPhase of this DBProxy.
+func (mg *DBProxy) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this DBProxy.
+func (mg *DBProxy) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetClassReference of this DBProxy.
+func (mg *DBProxy) GetClassReference() *corev1.ObjectReference {
+	return mg.Spec.ClassReference
+}
+
+// GetCondition of this DBProxy.
+func (mg *DBProxy) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetProviderReference of this DBProxy.
+func (mg *DBProxy) GetProviderReference() runtimev1alpha1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetReclaimPolicy of this DBProxy.
+func (mg *DBProxy) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this DBProxy.
+func (mg *DBProxy) GetWriteConnectionSecretToReference() *runtimev1alpha1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this DBProxy.
+func (mg *DBProxy) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this DBProxy.
+func (mg *DBProxy) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetClassReference of this DBProxy.
+func (mg *DBProxy) SetClassReference(r *corev1.ObjectReference) {
+	mg.Spec.ClassReference = r
+}
+
+// SetConditions of this DBProxy.
+func (mg *DBProxy) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetProviderReference of this DBProxy.
+func (mg *DBProxy) SetProviderReference(r runtimev1alpha1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetReclaimPolicy of this DBProxy.
+func (mg *DBProxy) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this DBProxy.
+func (mg *DBProxy) SetWriteConnectionSecretToReference(r *runtimev1alpha1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetBindingPhase of this DBProxyTargetGroup.
+func (mg *DBProxyTargetGroup) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this DBProxyTargetGroup.
+func (mg *DBProxyTargetGroup) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetClassReference of this DBProxyTargetGroup.
+func (mg *DBProxyTargetGroup) GetClassReference() *corev1.ObjectReference {
+	return mg.Spec.ClassReference
+}
+
+// GetCondition of this DBProxyTargetGroup.
+func (mg *DBProxyTargetGroup) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetProviderReference of this DBProxyTargetGroup.
+func (mg *DBProxyTargetGroup) GetProviderReference() runtimev1alpha1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetReclaimPolicy of this DBProxyTargetGroup.
+func (mg *DBProxyTargetGroup) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this DBProxyTargetGroup.
+func (mg *DBProxyTargetGroup) GetWriteConnectionSecretToReference() *runtimev1alpha1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this DBProxyTargetGroup.
+func (mg *DBProxyTargetGroup) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this DBProxyTargetGroup.
+func (mg *DBProxyTargetGroup) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetClassReference of this DBProxyTargetGroup.
+func (mg *DBProxyTargetGroup) SetClassReference(r *corev1.ObjectReference) {
+	mg.Spec.ClassReference = r
+}
+
+// SetConditions of this DBProxyTargetGroup.
+func (mg *DBProxyTargetGroup) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetProviderReference of this DBProxyTargetGroup.
+func (mg *DBProxyTargetGroup) SetProviderReference(r runtimev1alpha1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetReclaimPolicy of this DBProxyTargetGroup.
+func (mg *DBProxyTargetGroup) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this DBProxyTargetGroup.
+func (mg *DBProxyTargetGroup) SetWriteConnectionSecretToReference(r *runtimev1alpha1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetBindingPhase of this DBSnapshot.
+func (mg *DBSnapshot) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this DBSnapshot.
+func (mg *DBSnapshot) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetClassReference of this DBSnapshot.
+func (mg *DBSnapshot) GetClassReference() *corev1.ObjectReference {
+	return mg.Spec.ClassReference
+}
+
+// GetCondition of this DBSnapshot.
+func (mg *DBSnapshot) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetProviderReference of this DBSnapshot.
+func (mg *DBSnapshot) GetProviderReference() runtimev1alpha1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetReclaimPolicy of this DBSnapshot.
+func (mg *DBSnapshot) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this DBSnapshot.
+func (mg *DBSnapshot) GetWriteConnectionSecretToReference() *runtimev1alpha1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this DBSnapshot.
+func (mg *DBSnapshot) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this DBSnapshot.
+func (mg *DBSnapshot) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetClassReference of this DBSnapshot.
+func (mg *DBSnapshot) SetClassReference(r *corev1.ObjectReference) {
+	mg.Spec.ClassReference = r
+}
+
+// SetConditions of this DBSnapshot.
+func (mg *DBSnapshot) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetProviderReference of this DBSnapshot.
+func (mg *DBSnapshot) SetProviderReference(r runtimev1alpha1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetReclaimPolicy of this DBSnapshot.
+func (mg *DBSnapshot) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this DBSnapshot.
+func (mg *DBSnapshot) SetWriteConnectionSecretToReference(r *runtimev1alpha1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
 // GetBindingPhase of this DBSubnetGroup.
 func (mg *DBSubnetGroup) GetBindingPhase() runtimev1alpha1.BindingPhase {
 	return mg.Status.GetBindingPhase()
@@ -93,6 +513,76 @@ func (mg *DBSubnetGroup) SetWriteConnectionSecretToReference(r *runtimev1alpha1.
 	mg.Spec.WriteConnectionSecretToReference = r
 }
 
+// GetBindingPhase of this OptionGroup.
+func (mg *OptionGroup) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this OptionGroup.
+func (mg *OptionGroup) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetClassReference of this OptionGroup.
+func (mg *OptionGroup) GetClassReference() *corev1.ObjectReference {
+	return mg.Spec.ClassReference
+}
+
+// GetCondition of this OptionGroup.
+func (mg *OptionGroup) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetProviderReference of this OptionGroup.
+func (mg *OptionGroup) GetProviderReference() runtimev1alpha1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetReclaimPolicy of this OptionGroup.
+func (mg *OptionGroup) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this OptionGroup.
+func (mg *OptionGroup) GetWriteConnectionSecretToReference() *runtimev1alpha1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this OptionGroup.
+func (mg *OptionGroup) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this OptionGroup.
+func (mg *OptionGroup) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetClassReference of this OptionGroup.
+func (mg *OptionGroup) SetClassReference(r *corev1.ObjectReference) {
+	mg.Spec.ClassReference = r
+}
+
+// SetConditions of this OptionGroup.
+func (mg *OptionGroup) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetProviderReference of this OptionGroup.
+func (mg *OptionGroup) SetProviderReference(r runtimev1alpha1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetReclaimPolicy of this OptionGroup.
+func (mg *OptionGroup) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this OptionGroup.
+func (mg *OptionGroup) SetWriteConnectionSecretToReference(r *runtimev1alpha1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
 // GetBindingPhase of this RDSInstance.
 func (mg *RDSInstance) GetBindingPhase() runtimev1alpha1.BindingPhase {
 	return mg.Status.GetBindingPhase()
@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// DHCPConfiguration describes a DHCP configuration option.
+type DHCPConfiguration struct {
+	// The name of a DHCP option, e.g. domain-name-servers, domain-name,
+	// ntp-servers, netbios-name-servers or netbios-node-type.
+	Key string `json:"key"`
+
+	// One or more values for the DHCP option.
+	Values []string `json:"values"`
+}
+
+// DHCPOptionsParameters define the desired state of an AWS DHCP options set.
+type DHCPOptionsParameters struct {
+	// The DHCP configuration options of the set. A DHCP options set cannot
+	// be modified after it is created.
+	// +immutable
+	DHCPConfigurations []DHCPConfiguration `json:"dhcpConfigurations"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+
+	// VPCID is the ID of the VPC the DHCP options set is associated with.
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+}
+
+// A DHCPOptionsSpec defines the desired state of a DHCPOptions.
+type DHCPOptionsSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DHCPOptionsParameters `json:"forProvider"`
+}
+
+// DHCPOptionsObservation keeps the state for the external resource
+type DHCPOptionsObservation struct {
+	// DHCPOptionsID is the ID of the DHCP options set.
+	DHCPOptionsID string `json:"dhcpOptionsId,omitempty"`
+
+	// The ID of the AWS account that owns the DHCP options set.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// AssociatedVPCID is the ID of the VPC that currently uses the DHCP
+	// options set.
+	AssociatedVPCID string `json:"associatedVpcId,omitempty"`
+}
+
+// A DHCPOptionsStatus represents the observed state of a DHCPOptions.
+type DHCPOptionsStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DHCPOptionsObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DHCPOptions is a managed resource that represents an AWS DHCP options set.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DHCPOptions struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DHCPOptionsSpec   `json:"spec"`
+	Status DHCPOptionsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DHCPOptionsList contains a list of DHCPOptions
+type DHCPOptionsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DHCPOptions `json:"items"`
+}
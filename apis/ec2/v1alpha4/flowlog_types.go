@@ -0,0 +1,180 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// FlowLogParameters define the desired state of an AWS VPC Flow Log. Exactly
+// one of VPCID, SubnetID or NetworkInterfaceID has to be set.
+type FlowLogParameters struct {
+	// The type of traffic to log.
+	// +immutable
+	// +kubebuilder:validation:Enum=ACCEPT;REJECT;ALL
+	TrafficType string `json:"trafficType"`
+
+	// Specifies the type of destination to which the flow log data is to be
+	// published. Flow log data can be published to CloudWatch Logs or Amazon
+	// S3.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=cloud-watch-logs;s3
+	LogDestinationType *string `json:"logDestinationType,omitempty"`
+
+	// Specifies the destination to which the flow log data is to be
+	// published. The value is the ARN of a CloudWatch Logs log group or an
+	// Amazon S3 bucket, depending on LogDestinationType.
+	// +optional
+	// +immutable
+	LogDestination *string `json:"logDestination,omitempty"`
+
+	// The name of a new or existing CloudWatch Logs log group where Amazon
+	// EC2 publishes your flow logs. Only one of LogDestination and
+	// LogGroupName can be specified.
+	// +optional
+	// +immutable
+	LogGroupName *string `json:"logGroupName,omitempty"`
+
+	// The ARN for the IAM role that permits Amazon EC2 to publish flow logs
+	// to a CloudWatch Logs log group.
+	// +optional
+	// +immutable
+	DeliverLogsPermissionARN *string `json:"deliverLogsPermissionArn,omitempty"`
+
+	// DeliverLogsPermissionARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	// +immutable
+	DeliverLogsPermissionARNRef *runtimev1alpha1.Reference `json:"deliverLogsPermissionArnRef,omitempty"`
+
+	// DeliverLogsPermissionARNSelector selects a reference to an IAMRole to
+	// retrieve its ARN.
+	// +optional
+	DeliverLogsPermissionARNSelector *runtimev1alpha1.Selector `json:"deliverLogsPermissionArnSelector,omitempty"`
+
+	// The fields to include in the flow log record, in the order in which
+	// they should appear. If omitted, the default format is used.
+	// +optional
+	// +immutable
+	LogFormat *string `json:"logFormat,omitempty"`
+
+	// The maximum interval of time during which a flow of packets is
+	// captured and aggregated into a flow log record, in seconds.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=60;600
+	MaxAggregationInterval *int64 `json:"maxAggregationInterval,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+
+	// VPCID is the ID of the VPC to log.
+	// +optional
+	// +immutable
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	// +immutable
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// SubnetID is the ID of the subnet to log.
+	// +optional
+	// +immutable
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef references a subnet to retrieve its subnetId
+	// +optional
+	// +immutable
+	SubnetIDRef *runtimev1alpha1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a subnet to retrieve its
+	// subnetId
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// NetworkInterfaceID is the ID of the network interface to log.
+	// +optional
+	// +immutable
+	NetworkInterfaceID *string `json:"networkInterfaceId,omitempty"`
+}
+
+// A FlowLogSpec defines the desired state of a FlowLog.
+type FlowLogSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  FlowLogParameters `json:"forProvider"`
+}
+
+// FlowLogObservation keeps the state for the external resource
+type FlowLogObservation struct {
+	// FlowLogID is the ID of the flow log.
+	FlowLogID string `json:"flowLogId,omitempty"`
+
+	// The status of the flow log.
+	FlowLogStatus string `json:"flowLogStatus,omitempty"`
+
+	// The status of the logs delivery.
+	DeliverLogsStatus string `json:"deliverLogsStatus,omitempty"`
+
+	// Information about the error that occurred when delivering the logs.
+	DeliverLogsErrorMessage string `json:"deliverLogsErrorMessage,omitempty"`
+
+	// The ID of the resource on which the flow log was created.
+	ResourceID string `json:"resourceId,omitempty"`
+}
+
+// A FlowLogStatus represents the observed state of a FlowLog.
+type FlowLogStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     FlowLogObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FlowLog is a managed resource that represents an AWS VPC Flow Log.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="RESOURCE",type="string",JSONPath=".status.atProvider.resourceId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type FlowLog struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FlowLogSpec   `json:"spec"`
+	Status FlowLogStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FlowLogList contains a list of FlowLogs
+type FlowLogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FlowLog `json:"items"`
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// KeyPairParameters define the desired state of an AWS EC2 key pair. If
+// neither PublicKeyMaterial nor PublicKeySecretRef is set, AWS generates the
+// key pair and the private key is written to the connection secret.
+type KeyPairParameters struct {
+	// The public key to import, e.g. in OpenSSH format.
+	// +optional
+	// +immutable
+	PublicKeyMaterial *string `json:"publicKeyMaterial,omitempty"`
+
+	// PublicKeySecretRef references a secret key that contains the public
+	// key to import.
+	// +optional
+	// +immutable
+	PublicKeySecretRef *runtimev1alpha1.SecretKeySelector `json:"publicKeySecretRef,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// A KeyPairSpec defines the desired state of a KeyPair.
+type KeyPairSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  KeyPairParameters `json:"forProvider,omitempty"`
+}
+
+// KeyPairObservation keeps the state for the external resource
+type KeyPairObservation struct {
+	// KeyPairID is the ID of the key pair.
+	KeyPairID string `json:"keyPairId,omitempty"`
+
+	// The fingerprint of the key pair. For generated keys this is the SHA-1
+	// digest of the DER encoded private key, for imported keys the MD5
+	// public key fingerprint.
+	KeyFingerprint string `json:"keyFingerprint,omitempty"`
+}
+
+// A KeyPairStatus represents the observed state of a KeyPair.
+type KeyPairStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     KeyPairObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KeyPair is a managed resource that represents an AWS EC2 key pair. The
+// external name of the resource is the name of the key pair.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="FINGERPRINT",type="string",JSONPath=".status.atProvider.keyFingerprint"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type KeyPair struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeyPairSpec   `json:"spec"`
+	Status KeyPairStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KeyPairList contains a list of KeyPairs
+type KeyPairList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KeyPair `json:"items"`
+}
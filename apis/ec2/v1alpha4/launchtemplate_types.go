@@ -0,0 +1,271 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// LaunchTemplateEBSBlockDevice describes a block device for an EBS volume.
+type LaunchTemplateEBSBlockDevice struct {
+	// Indicates whether the EBS volume is deleted on instance termination.
+	// +optional
+	DeleteOnTermination *bool `json:"deleteOnTermination,omitempty"`
+
+	// Indicates whether the EBS volume is encrypted.
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+
+	// The number of I/O operations per second (IOPS) that the volume supports.
+	// Only valid for io1 volumes.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty"`
+
+	// The ARN of the symmetric AWS KMS CMK used for encryption.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// The ID of the snapshot.
+	// +optional
+	SnapshotID *string `json:"snapshotId,omitempty"`
+
+	// The size of the volume, in GiB.
+	// +optional
+	VolumeSize *int64 `json:"volumeSize,omitempty"`
+
+	// The volume type.
+	// +optional
+	// +kubebuilder:validation:Enum=standard;io1;gp2;sc1;st1
+	VolumeType *string `json:"volumeType,omitempty"`
+}
+
+// LaunchTemplateBlockDeviceMapping describes a block device mapping.
+type LaunchTemplateBlockDeviceMapping struct {
+	// The device name (for example, /dev/sdh or xvdh).
+	DeviceName string `json:"deviceName"`
+
+	// Parameters used to automatically set up EBS volumes when the instance is
+	// launched.
+	// +optional
+	EBS *LaunchTemplateEBSBlockDevice `json:"ebs,omitempty"`
+
+	// Suppresses the specified device included in the block device mapping of
+	// the AMI.
+	// +optional
+	NoDevice *string `json:"noDevice,omitempty"`
+
+	// The virtual device name (ephemeralN).
+	// +optional
+	VirtualName *string `json:"virtualName,omitempty"`
+}
+
+// LaunchTemplateIAMInstanceProfile describes an IAM instance profile.
+type LaunchTemplateIAMInstanceProfile struct {
+	// The Amazon Resource Name (ARN) of the instance profile.
+	// +optional
+	ARN *string `json:"arn,omitempty"`
+
+	// The name of the instance profile.
+	// +optional
+	Name *string `json:"name,omitempty"`
+}
+
+// LaunchTemplateMetadataOptions describes the metadata options for the
+// instance.
+type LaunchTemplateMetadataOptions struct {
+	// This parameter enables or disables the HTTP metadata endpoint on your
+	// instances.
+	// +optional
+	// +kubebuilder:validation:Enum=enabled;disabled
+	HTTPEndpoint *string `json:"httpEndpoint,omitempty"`
+
+	// The desired HTTP PUT response hop limit for instance metadata requests.
+	// +optional
+	HTTPPutResponseHopLimit *int64 `json:"httpPutResponseHopLimit,omitempty"`
+
+	// The state of token usage for your instance metadata requests.
+	// +optional
+	// +kubebuilder:validation:Enum=optional;required
+	HTTPTokens *string `json:"httpTokens,omitempty"`
+}
+
+// LaunchTemplateTagSpecification specifies the tags to apply to a resource
+// when it is launched from the template.
+type LaunchTemplateTagSpecification struct {
+	// The type of resource to tag. Currently, the resource types that support
+	// tagging on creation are instance and volume.
+	// +kubebuilder:validation:Enum=instance;volume
+	ResourceType string `json:"resourceType"`
+
+	// The tags to apply to the resource.
+	Tags []ec2v1beta1.Tag `json:"tags"`
+}
+
+// LaunchTemplateData is the information for a launch template version.
+type LaunchTemplateData struct {
+	// The block device mapping.
+	// +optional
+	BlockDeviceMappings []LaunchTemplateBlockDeviceMapping `json:"blockDeviceMappings,omitempty"`
+
+	// If you set this parameter to true, you can't terminate the instance using
+	// the Amazon EC2 console, CLI, or API.
+	// +optional
+	DisableAPITermination *bool `json:"disableApiTermination,omitempty"`
+
+	// Indicates whether the instance is optimized for Amazon EBS I/O.
+	// +optional
+	EBSOptimized *bool `json:"ebsOptimized,omitempty"`
+
+	// The IAM instance profile.
+	// +optional
+	IAMInstanceProfile *LaunchTemplateIAMInstanceProfile `json:"iamInstanceProfile,omitempty"`
+
+	// The ID of the AMI.
+	// +optional
+	ImageID *string `json:"imageId,omitempty"`
+
+	// Indicates whether an instance stops or terminates when you initiate shutdown
+	// from the instance (using the operating system command for system shutdown).
+	// +optional
+	// +kubebuilder:validation:Enum=stop;terminate
+	InstanceInitiatedShutdownBehavior *string `json:"instanceInitiatedShutdownBehavior,omitempty"`
+
+	// The instance type.
+	// +optional
+	InstanceType *string `json:"instanceType,omitempty"`
+
+	// The name of the key pair.
+	// +optional
+	KeyName *string `json:"keyName,omitempty"`
+
+	// The metadata options for the instance.
+	// +optional
+	MetadataOptions *LaunchTemplateMetadataOptions `json:"metadataOptions,omitempty"`
+
+	// Specify true to enable detailed monitoring. Otherwise, basic monitoring
+	// is enabled.
+	// +optional
+	MonitoringEnabled *bool `json:"monitoringEnabled,omitempty"`
+
+	// One or more security group IDs.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs are references to SecurityGroups used to set
+	// the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used
+	// to set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// The tags to apply to the resources during launch.
+	// +optional
+	TagSpecifications []LaunchTemplateTagSpecification `json:"tagSpecifications,omitempty"`
+
+	// The Base64-encoded user data to make available to the instance.
+	// +optional
+	UserData *string `json:"userData,omitempty"`
+}
+
+// LaunchTemplateParameters define the desired state of an AWS EC2 Launch
+// Template.
+type LaunchTemplateParameters struct {
+	// The name of the launch template.
+	// +immutable
+	LaunchTemplateName string `json:"launchTemplateName"`
+
+	// The information for the launch template. A change to this data
+	// results in a new version of the launch template.
+	LaunchTemplateData LaunchTemplateData `json:"launchTemplateData"`
+
+	// A description for the launch template versions created by this
+	// resource.
+	// +optional
+	VersionDescription *string `json:"versionDescription,omitempty"`
+
+	// SetDefaultVersion indicates whether every new version of the launch
+	// template should be made the default version.
+	// +optional
+	SetDefaultVersion *bool `json:"setDefaultVersion,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// A LaunchTemplateSpec defines the desired state of a LaunchTemplate.
+type LaunchTemplateSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  LaunchTemplateParameters `json:"forProvider"`
+}
+
+// LaunchTemplateObservation keeps the state for the external resource
+type LaunchTemplateObservation struct {
+	// LaunchTemplateID is the ID of the LaunchTemplate.
+	LaunchTemplateID string `json:"launchTemplateId,omitempty"`
+
+	// The principal that created the launch template.
+	CreatedBy string `json:"createdBy,omitempty"`
+
+	// The version number of the default version of the launch template.
+	DefaultVersionNumber int64 `json:"defaultVersionNumber,omitempty"`
+
+	// The version number of the latest version of the launch template.
+	LatestVersionNumber int64 `json:"latestVersionNumber,omitempty"`
+}
+
+// A LaunchTemplateStatus represents the observed state of a LaunchTemplate.
+type LaunchTemplateStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     LaunchTemplateObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LaunchTemplate is a managed resource that represents an AWS EC2 Launch
+// Template.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="LATEST",type="integer",JSONPath=".status.atProvider.latestVersionNumber"
+// +kubebuilder:printcolumn:name="DEFAULT",type="integer",JSONPath=".status.atProvider.defaultVersionNumber"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LaunchTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LaunchTemplateSpec   `json:"spec"`
+	Status LaunchTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LaunchTemplateList contains a list of LaunchTemplates
+type LaunchTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LaunchTemplate `json:"items"`
+}
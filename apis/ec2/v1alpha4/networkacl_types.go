@@ -0,0 +1,205 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// ICMPTypeCode describes the ICMP type and code.
+type ICMPTypeCode struct {
+	// The ICMP code. A value of -1 means all codes for the specified ICMP type.
+	// +optional
+	Code *int64 `json:"code,omitempty"`
+
+	// The ICMP type. A value of -1 means all types.
+	// +optional
+	Type *int64 `json:"type,omitempty"`
+}
+
+// PortRange describes a range of ports.
+type PortRange struct {
+	// The first port in the range.
+	// +optional
+	From *int64 `json:"from,omitempty"`
+
+	// The last port in the range.
+	// +optional
+	To *int64 `json:"to,omitempty"`
+}
+
+// NetworkACLEntry describes an entry in a network ACL.
+type NetworkACLEntry struct {
+	// The rule number for the entry. ACL entries are processed in ascending
+	// order by rule number.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32766
+	RuleNumber int64 `json:"ruleNumber"`
+
+	// The protocol number. A value of "-1" means all protocols. If you specify
+	// "-1" or a protocol number other than "6" (TCP), "17" (UDP), or "1" (ICMP),
+	// traffic on all ports is allowed, regardless of any ports or ICMP types
+	// or codes that you specify.
+	Protocol string `json:"protocol"`
+
+	// Indicates whether to allow or deny the traffic that matches the rule.
+	// +kubebuilder:validation:Enum=allow;deny
+	RuleAction string `json:"ruleAction"`
+
+	// The IPv4 network range to allow or deny, in CIDR notation.
+	// +optional
+	CIDRBlock *string `json:"cidrBlock,omitempty"`
+
+	// The IPv6 network range to allow or deny, in CIDR notation.
+	// +optional
+	IPv6CIDRBlock *string `json:"ipv6CidrBlock,omitempty"`
+
+	// ICMP protocol: The ICMP or ICMPv6 type and code. Required if specifying
+	// protocol 1 (ICMP) or protocol 58 (ICMPv6) with an IPv6 CIDR block.
+	// +optional
+	ICMPTypeCode *ICMPTypeCode `json:"icmpTypeCode,omitempty"`
+
+	// TCP or UDP protocols: The range of ports the rule applies to. Required
+	// if specifying protocol 6 (TCP) or 17 (UDP).
+	// +optional
+	PortRange *PortRange `json:"portRange,omitempty"`
+}
+
+// NetworkACLAssociation describes an association between a network ACL and a
+// subnet.
+type NetworkACLAssociation struct {
+	// The ID of the subnet.
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// A referencer to retrieve the ID of a subnet
+	// +optional
+	SubnetIDRef *runtimev1alpha1.Reference `json:"subnetIdRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the ID of a subnet
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+}
+
+// NetworkACLAssociationState describes an observed association between a
+// network ACL and a subnet.
+type NetworkACLAssociationState struct {
+	// The ID of the association between a network ACL and a subnet.
+	AssociationID string `json:"associationId,omitempty"`
+
+	// The ID of the subnet.
+	SubnetID string `json:"subnetId,omitempty"`
+}
+
+// NetworkACLParameters define the desired state of an AWS VPC Network ACL.
+type NetworkACLParameters struct {
+	// Default indicates that this resource manages the default network ACL
+	// of the VPC instead of creating a new one. The default network ACL is
+	// not deleted when this resource is deleted.
+	// +optional
+	// +immutable
+	Default *bool `json:"default,omitempty"`
+
+	// The associations between the network ACL and one or more subnets. A
+	// subnet is moved from its current network ACL to this one.
+	// +optional
+	Associations []NetworkACLAssociation `json:"associations,omitempty"`
+
+	// The inbound entries of the network ACL.
+	// +optional
+	Ingress []NetworkACLEntry `json:"ingress,omitempty"`
+
+	// The outbound entries of the network ACL.
+	// +optional
+	Egress []NetworkACLEntry `json:"egress,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+
+	// VPCID is the ID of the VPC.
+	// +optional
+	// +immutable
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	// +immutable
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+}
+
+// A NetworkACLSpec defines the desired state of a NetworkACL.
+type NetworkACLSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  NetworkACLParameters `json:"forProvider"`
+}
+
+// NetworkACLObservation keeps the state for the external resource
+type NetworkACLObservation struct {
+	// NetworkACLID is the ID of the NetworkACL.
+	NetworkACLID string `json:"networkAclId,omitempty"`
+
+	// Indicates whether this is the default network ACL for the VPC.
+	IsDefault bool `json:"isDefault,omitempty"`
+
+	// The ID of the AWS account that owns the network ACL.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The actual associations of the network ACL.
+	Associations []NetworkACLAssociationState `json:"associations,omitempty"`
+}
+
+// A NetworkACLStatus represents the observed state of a NetworkACL.
+type NetworkACLStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     NetworkACLObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkACL is a managed resource that represents an AWS VPC Network ACL.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type NetworkACL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkACLSpec   `json:"spec"`
+	Status NetworkACLStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkACLList contains a list of NetworkACLs
+type NetworkACLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkACL `json:"items"`
+}
@@ -48,6 +48,87 @@ var (
 	RouteTableGroupVersionKind = SchemeGroupVersion.WithKind(RouteTableKind)
 )
 
+// LaunchTemplate type metadata.
+var (
+	LaunchTemplateKind             = reflect.TypeOf(LaunchTemplate{}).Name()
+	LaunchTemplateGroupKind        = schema.GroupKind{Group: Group, Kind: LaunchTemplateKind}.String()
+	LaunchTemplateKindAPIVersion   = LaunchTemplateKind + "." + SchemeGroupVersion.String()
+	LaunchTemplateGroupVersionKind = SchemeGroupVersion.WithKind(LaunchTemplateKind)
+)
+
+// NetworkACL type metadata.
+var (
+	NetworkACLKind             = reflect.TypeOf(NetworkACL{}).Name()
+	NetworkACLGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkACLKind}.String()
+	NetworkACLKindAPIVersion   = NetworkACLKind + "." + SchemeGroupVersion.String()
+	NetworkACLGroupVersionKind = SchemeGroupVersion.WithKind(NetworkACLKind)
+)
+
+// VPCEndpoint type metadata.
+var (
+	VPCEndpointKind             = reflect.TypeOf(VPCEndpoint{}).Name()
+	VPCEndpointGroupKind        = schema.GroupKind{Group: Group, Kind: VPCEndpointKind}.String()
+	VPCEndpointKindAPIVersion   = VPCEndpointKind + "." + SchemeGroupVersion.String()
+	VPCEndpointGroupVersionKind = SchemeGroupVersion.WithKind(VPCEndpointKind)
+)
+
+// FlowLog type metadata.
+var (
+	FlowLogKind             = reflect.TypeOf(FlowLog{}).Name()
+	FlowLogGroupKind        = schema.GroupKind{Group: Group, Kind: FlowLogKind}.String()
+	FlowLogKindAPIVersion   = FlowLogKind + "." + SchemeGroupVersion.String()
+	FlowLogGroupVersionKind = SchemeGroupVersion.WithKind(FlowLogKind)
+)
+
+// DHCPOptions type metadata.
+var (
+	DHCPOptionsKind             = reflect.TypeOf(DHCPOptions{}).Name()
+	DHCPOptionsGroupKind        = schema.GroupKind{Group: Group, Kind: DHCPOptionsKind}.String()
+	DHCPOptionsKindAPIVersion   = DHCPOptionsKind + "." + SchemeGroupVersion.String()
+	DHCPOptionsGroupVersionKind = SchemeGroupVersion.WithKind(DHCPOptionsKind)
+)
+
+// Volume type metadata.
+var (
+	VolumeKind             = reflect.TypeOf(Volume{}).Name()
+	VolumeGroupKind        = schema.GroupKind{Group: Group, Kind: VolumeKind}.String()
+	VolumeKindAPIVersion   = VolumeKind + "." + SchemeGroupVersion.String()
+	VolumeGroupVersionKind = SchemeGroupVersion.WithKind(VolumeKind)
+)
+
+// VolumeAttachment type metadata.
+var (
+	VolumeAttachmentKind             = reflect.TypeOf(VolumeAttachment{}).Name()
+	VolumeAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: VolumeAttachmentKind}.String()
+	VolumeAttachmentKindAPIVersion   = VolumeAttachmentKind + "." + SchemeGroupVersion.String()
+	VolumeAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(VolumeAttachmentKind)
+)
+
+// Snapshot type metadata.
+var (
+	SnapshotKind             = reflect.TypeOf(Snapshot{}).Name()
+	SnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: SnapshotKind}.String()
+	SnapshotKindAPIVersion   = SnapshotKind + "." + SchemeGroupVersion.String()
+	SnapshotGroupVersionKind = SchemeGroupVersion.WithKind(SnapshotKind)
+)
+
+// KeyPair type metadata.
+var (
+	KeyPairKind             = reflect.TypeOf(KeyPair{}).Name()
+	KeyPairGroupKind        = schema.GroupKind{Group: Group, Kind: KeyPairKind}.String()
+	KeyPairKindAPIVersion   = KeyPairKind + "." + SchemeGroupVersion.String()
+	KeyPairGroupVersionKind = SchemeGroupVersion.WithKind(KeyPairKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&RouteTable{}, &RouteTableList{})
+	SchemeBuilder.Register(&LaunchTemplate{}, &LaunchTemplateList{})
+	SchemeBuilder.Register(&NetworkACL{}, &NetworkACLList{})
+	SchemeBuilder.Register(&VPCEndpoint{}, &VPCEndpointList{})
+	SchemeBuilder.Register(&FlowLog{}, &FlowLogList{})
+	SchemeBuilder.Register(&DHCPOptions{}, &DHCPOptionsList{})
+	SchemeBuilder.Register(&Volume{}, &VolumeList{})
+	SchemeBuilder.Register(&VolumeAttachment{}, &VolumeAttachmentList{})
+	SchemeBuilder.Register(&Snapshot{}, &SnapshotList{})
+	SchemeBuilder.Register(&KeyPair{}, &KeyPairList{})
 }
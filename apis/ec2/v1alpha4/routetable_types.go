@@ -31,6 +31,11 @@ type Route struct {
 	// +optional
 	DestinationCIDRBlock *string `json:"destinationCidrBlock,omitempty"`
 
+	// The IPv6 CIDR block used for the destination match. Routing decisions
+	// are based on the most specific match.
+	// +optional
+	DestinationIPv6CIDRBlock *string `json:"destinationIpv6CidrBlock,omitempty"`
+
 	// The ID of an internet gateway or virtual private gateway attached to your
 	// VPC.
 	// +optional
@@ -54,6 +59,9 @@ type RouteState struct {
 	// decisions are based on the most specific match.
 	DestinationCIDRBlock string `json:"destinationCidrBlock,omitempty"`
 
+	// The IPv6 CIDR block used for the destination match.
+	DestinationIPv6CIDRBlock string `json:"destinationIpv6CidrBlock,omitempty"`
+
 	// The ID of an internet gateway or virtual private gateway attached to your
 	// VPC.
 	GatewayID string `json:"gatewayId,omitempty"`
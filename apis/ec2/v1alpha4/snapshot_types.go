@@ -0,0 +1,125 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// SnapshotParameters define the desired state of an AWS EBS Snapshot.
+type SnapshotParameters struct {
+	// A description for the snapshot.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// The ID of the EBS volume to take a snapshot of.
+	// +optional
+	// +immutable
+	VolumeID *string `json:"volumeId,omitempty"`
+
+	// VolumeIDRef references a Volume to retrieve its volumeId
+	// +optional
+	// +immutable
+	VolumeIDRef *runtimev1alpha1.Reference `json:"volumeIdRef,omitempty"`
+
+	// VolumeIDSelector selects a reference to a Volume to retrieve its
+	// volumeId
+	// +optional
+	VolumeIDSelector *runtimev1alpha1.Selector `json:"volumeIdSelector,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// A SnapshotSpec defines the desired state of a Snapshot.
+type SnapshotSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  SnapshotParameters `json:"forProvider"`
+}
+
+// SnapshotObservation keeps the state for the external resource
+type SnapshotObservation struct {
+	// SnapshotID is the ID of the snapshot.
+	SnapshotID string `json:"snapshotId,omitempty"`
+
+	// The state of the snapshot.
+	State string `json:"state,omitempty"`
+
+	// The progress of the snapshot, as a percentage.
+	Progress string `json:"progress,omitempty"`
+
+	// A message about the state of the snapshot, e.g. the reason why it
+	// failed.
+	StateMessage string `json:"stateMessage,omitempty"`
+
+	// The size of the volume, in GiB.
+	VolumeSize int64 `json:"volumeSize,omitempty"`
+
+	// Indicates whether the snapshot is encrypted.
+	Encrypted bool `json:"encrypted,omitempty"`
+
+	// The ID of the AWS KMS customer master key that was used to protect the
+	// volume encryption key of the snapshot.
+	KMSKeyID string `json:"kmsKeyId,omitempty"`
+
+	// The ID of the AWS account that owns the snapshot.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The time stamp when the snapshot was initiated.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+}
+
+// A SnapshotStatus represents the observed state of a Snapshot.
+type SnapshotStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     SnapshotObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Snapshot is a managed resource that represents a point-in-time AWS EBS
+// Snapshot of a Volume.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VOLUME",type="string",JSONPath=".spec.forProvider.volumeId"
+// +kubebuilder:printcolumn:name="PROGRESS",type="string",JSONPath=".status.atProvider.progress"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Snapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SnapshotSpec   `json:"spec"`
+	Status SnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SnapshotList contains a list of Snapshots
+type SnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Snapshot `json:"items"`
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// VolumeParameters define the desired state of an AWS EBS Volume.
+type VolumeParameters struct {
+	// The Availability Zone in which to create the volume.
+	// +immutable
+	AvailabilityZone string `json:"availabilityZone"`
+
+	// The size of the volume, in GiBs. The size can be increased after
+	// creation but cannot be decreased. Either Size or SnapshotID is
+	// required.
+	// +optional
+	Size *int64 `json:"size,omitempty"`
+
+	// The volume type.
+	// +optional
+	// +kubebuilder:validation:Enum=standard;io1;gp2;sc1;st1
+	VolumeType *string `json:"volumeType,omitempty"`
+
+	// The number of I/O operations per second (IOPS) to provision for the
+	// volume. Only valid for io1 volumes.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty"`
+
+	// Specifies whether the volume should be encrypted.
+	// +optional
+	// +immutable
+	Encrypted *bool `json:"encrypted,omitempty"`
+
+	// The identifier of the AWS KMS customer master key to use for
+	// encryption. If omitted, the default CMK for EBS is used.
+	// +optional
+	// +immutable
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// The snapshot from which to create the volume.
+	// +optional
+	// +immutable
+	SnapshotID *string `json:"snapshotId,omitempty"`
+
+	// Specifies whether to enable Amazon EBS Multi-Attach. Only valid for io1
+	// volumes.
+	// +optional
+	// +immutable
+	MultiAttachEnabled *bool `json:"multiAttachEnabled,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// A VolumeSpec defines the desired state of a Volume.
+type VolumeSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  VolumeParameters `json:"forProvider"`
+}
+
+// VolumeModification describes the latest modification of a volume.
+type VolumeModification struct {
+	// The current modification state.
+	ModificationState string `json:"modificationState,omitempty"`
+
+	// The modification progress, from 0 to 100 percent complete.
+	Progress int64 `json:"progress,omitempty"`
+
+	// A status message about the modification progress or failure.
+	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// The target size of the volume, in GiB.
+	TargetSize int64 `json:"targetSize,omitempty"`
+
+	// The target IOPS rate of the volume.
+	TargetIOPS int64 `json:"targetIops,omitempty"`
+
+	// The target EBS volume type of the volume.
+	TargetVolumeType string `json:"targetVolumeType,omitempty"`
+}
+
+// VolumeObservation keeps the state for the external resource
+type VolumeObservation struct {
+	// VolumeID is the ID of the volume.
+	VolumeID string `json:"volumeId,omitempty"`
+
+	// The state of the volume.
+	State string `json:"state,omitempty"`
+
+	// Modification is the latest modification of the volume, if any.
+	Modification *VolumeModification `json:"modification,omitempty"`
+}
+
+// A VolumeStatus represents the observed state of a Volume.
+type VolumeStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     VolumeObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Volume is a managed resource that represents an AWS EBS Volume.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="SIZE",type="integer",JSONPath=".spec.forProvider.size"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Volume struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VolumeSpec   `json:"spec"`
+	Status VolumeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VolumeList contains a list of Volumes
+type VolumeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Volume `json:"items"`
+}
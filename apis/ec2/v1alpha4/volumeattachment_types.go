@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// VolumeAttachmentParameters define the desired state of an attachment of an
+// AWS EBS Volume to an EC2 instance.
+type VolumeAttachmentParameters struct {
+	// The device name, e.g. /dev/sdh or xvdh.
+	// +immutable
+	Device string `json:"device"`
+
+	// The ID of the instance.
+	// +immutable
+	InstanceID string `json:"instanceId"`
+
+	// The ID of the EBS volume.
+	// +optional
+	// +immutable
+	VolumeID *string `json:"volumeId,omitempty"`
+
+	// VolumeIDRef references a Volume to retrieve its volumeId
+	// +optional
+	// +immutable
+	VolumeIDRef *runtimev1alpha1.Reference `json:"volumeIdRef,omitempty"`
+
+	// VolumeIDSelector selects a reference to a Volume to retrieve its
+	// volumeId
+	// +optional
+	VolumeIDSelector *runtimev1alpha1.Selector `json:"volumeIdSelector,omitempty"`
+
+	// ForceDetach forces the detachment of the volume when the attachment is
+	// deleted. Data that has not been flushed to the volume may be lost.
+	// +optional
+	ForceDetach *bool `json:"forceDetach,omitempty"`
+}
+
+// A VolumeAttachmentSpec defines the desired state of a VolumeAttachment.
+type VolumeAttachmentSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  VolumeAttachmentParameters `json:"forProvider"`
+}
+
+// VolumeAttachmentObservation keeps the state for the external resource
+type VolumeAttachmentObservation struct {
+	// The attachment state of the volume.
+	State string `json:"state,omitempty"`
+
+	// Indicates whether the EBS volume is deleted on instance termination.
+	DeleteOnTermination bool `json:"deleteOnTermination,omitempty"`
+}
+
+// A VolumeAttachmentStatus represents the observed state of a
+// VolumeAttachment.
+type VolumeAttachmentStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     VolumeAttachmentObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VolumeAttachment is a managed resource that represents the attachment of
+// an AWS EBS Volume to an EC2 instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VOLUME",type="string",JSONPath=".spec.forProvider.volumeId"
+// +kubebuilder:printcolumn:name="INSTANCE",type="string",JSONPath=".spec.forProvider.instanceId"
+// +kubebuilder:printcolumn:name="DEVICE",type="string",JSONPath=".spec.forProvider.device"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VolumeAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VolumeAttachmentSpec   `json:"spec"`
+	Status VolumeAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VolumeAttachmentList contains a list of VolumeAttachments
+type VolumeAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeAttachment `json:"items"`
+}
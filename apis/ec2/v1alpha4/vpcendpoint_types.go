@@ -0,0 +1,178 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// VPCEndpointParameters define the desired state of an AWS VPC Endpoint.
+type VPCEndpointParameters struct {
+	// The service name, e.g. com.amazonaws.us-east-1.s3. To get a list of
+	// available services, use the DescribeVpcEndpointServices request.
+	// +immutable
+	ServiceName string `json:"serviceName"`
+
+	// The type of endpoint.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=Gateway;Interface
+	VPCEndpointType *string `json:"vpcEndpointType,omitempty"`
+
+	// A policy to attach to the endpoint that controls access to the service.
+	// The policy must be in valid JSON format. If this parameter is not
+	// specified, AWS attaches a default policy that allows full access to the
+	// service.
+	// +optional
+	PolicyDocument *string `json:"policyDocument,omitempty"`
+
+	// (Interface endpoint) Indicates whether to associate a private hosted
+	// zone with the VPC so that the default public DNS name of the service
+	// resolves to the endpoint.
+	// +optional
+	PrivateDNSEnabled *bool `json:"privateDnsEnabled,omitempty"`
+
+	// (Gateway endpoint) The IDs of the route tables to update with a route
+	// to the service.
+	// +optional
+	RouteTableIDs []string `json:"routeTableIds,omitempty"`
+
+	// RouteTableIDRefs references route tables to retrieve their IDs.
+	// +optional
+	RouteTableIDRefs []runtimev1alpha1.Reference `json:"routeTableIdRefs,omitempty"`
+
+	// RouteTableIDSelector selects references to route tables to retrieve
+	// their IDs.
+	// +optional
+	RouteTableIDSelector *runtimev1alpha1.Selector `json:"routeTableIdSelector,omitempty"`
+
+	// (Interface endpoint) The IDs of the subnets in which to create an
+	// endpoint network interface.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs references subnets to retrieve their IDs.
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to subnets to retrieve their IDs.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// (Interface endpoint) The IDs of the security groups to associate with
+	// the endpoint network interfaces.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references security groups to retrieve their IDs.
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to security groups to
+	// retrieve their IDs.
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+
+	// VPCID is the ID of the VPC.
+	// +optional
+	// +immutable
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	// +immutable
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+}
+
+// A VPCEndpointSpec defines the desired state of a VPCEndpoint.
+type VPCEndpointSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  VPCEndpointParameters `json:"forProvider"`
+}
+
+// DNSEntry describes a DNS entry of a VPC endpoint.
+type DNSEntry struct {
+	// The DNS name.
+	DNSName string `json:"dnsName,omitempty"`
+
+	// The ID of the private hosted zone.
+	HostedZoneID string `json:"hostedZoneId,omitempty"`
+}
+
+// VPCEndpointObservation keeps the state for the external resource
+type VPCEndpointObservation struct {
+	// VPCEndpointID is the ID of the VPC endpoint.
+	VPCEndpointID string `json:"vpcEndpointId,omitempty"`
+
+	// The state of the VPC endpoint.
+	State string `json:"state,omitempty"`
+
+	// The ID of the AWS account that owns the VPC endpoint.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// (Interface endpoint) The DNS entries for the endpoint.
+	DNSEntries []DNSEntry `json:"dnsEntries,omitempty"`
+
+	// (Interface endpoint) The network interfaces for the endpoint.
+	NetworkInterfaceIDs []string `json:"networkInterfaceIds,omitempty"`
+}
+
+// A VPCEndpointStatus represents the observed state of a VPCEndpoint.
+type VPCEndpointStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     VPCEndpointObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPCEndpoint is a managed resource that represents an AWS VPC Endpoint.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".spec.forProvider.serviceName"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.vpcEndpointType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPCEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCEndpointSpec   `json:"spec"`
+	Status VPCEndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCEndpointList contains a list of VPCEndpoints
+type VPCEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCEndpoint `json:"items"`
+}
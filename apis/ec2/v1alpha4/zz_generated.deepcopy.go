@@ -72,57 +72,192 @@ func (in *AssociationState) DeepCopy() *AssociationState {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Route) DeepCopyInto(out *Route) {
+func (in *DHCPConfiguration) DeepCopyInto(out *DHCPConfiguration) {
 	*out = *in
-	if in.DestinationCIDRBlock != nil {
-		in, out := &in.DestinationCIDRBlock, &out.DestinationCIDRBlock
-		*out = new(string)
-		**out = **in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.GatewayID != nil {
-		in, out := &in.GatewayID, &out.GatewayID
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPConfiguration.
+func (in *DHCPConfiguration) DeepCopy() *DHCPConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptions.
+func (in *DHCPOptions) DeepCopy() *DHCPOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DHCPOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptionsList) DeepCopyInto(out *DHCPOptionsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DHCPOptions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsList.
+func (in *DHCPOptionsList) DeepCopy() *DHCPOptionsList {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOptionsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DHCPOptionsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptionsObservation) DeepCopyInto(out *DHCPOptionsObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsObservation.
+func (in *DHCPOptionsObservation) DeepCopy() *DHCPOptionsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOptionsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptionsParameters) DeepCopyInto(out *DHCPOptionsParameters) {
+	*out = *in
+	if in.DHCPConfigurations != nil {
+		in, out := &in.DHCPConfigurations, &out.DHCPConfigurations
+		*out = make([]DHCPConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]v1beta1.Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
 		*out = new(string)
 		**out = **in
 	}
-	if in.GatewayIDRef != nil {
-		in, out := &in.GatewayIDRef, &out.GatewayIDRef
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
 		*out = new(v1alpha1.Reference)
 		**out = **in
 	}
-	if in.GatewayIDSelector != nil {
-		in, out := &in.GatewayIDSelector, &out.GatewayIDSelector
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
 		*out = new(v1alpha1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
-func (in *Route) DeepCopy() *Route {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsParameters.
+func (in *DHCPOptionsParameters) DeepCopy() *DHCPOptionsParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(Route)
+	out := new(DHCPOptionsParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteState) DeepCopyInto(out *RouteState) {
+func (in *DHCPOptionsSpec) DeepCopyInto(out *DHCPOptionsSpec) {
 	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteState.
-func (in *RouteState) DeepCopy() *RouteState {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsSpec.
+func (in *DHCPOptionsSpec) DeepCopy() *DHCPOptionsSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteState)
+	out := new(DHCPOptionsSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTable) DeepCopyInto(out *RouteTable) {
+func (in *DHCPOptionsStatus) DeepCopyInto(out *DHCPOptionsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsStatus.
+func (in *DHCPOptionsStatus) DeepCopy() *DHCPOptionsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOptionsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSEntry) DeepCopyInto(out *DNSEntry) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSEntry.
+func (in *DNSEntry) DeepCopy() *DNSEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlowLog) DeepCopyInto(out *FlowLog) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -130,18 +265,18 @@ func (in *RouteTable) DeepCopyInto(out *RouteTable) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTable.
-func (in *RouteTable) DeepCopy() *RouteTable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLog.
+func (in *FlowLog) DeepCopy() *FlowLog {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTable)
+	out := new(FlowLog)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RouteTable) DeepCopyObject() runtime.Object {
+func (in *FlowLog) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -149,31 +284,31 @@ func (in *RouteTable) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTableList) DeepCopyInto(out *RouteTableList) {
+func (in *FlowLogList) DeepCopyInto(out *FlowLogList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]RouteTable, len(*in))
+		*out = make([]FlowLog, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableList.
-func (in *RouteTableList) DeepCopy() *RouteTableList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLogList.
+func (in *FlowLogList) DeepCopy() *FlowLogList {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTableList)
+	out := new(FlowLogList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RouteTableList) DeepCopyObject() runtime.Object {
+func (in *FlowLogList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -181,46 +316,62 @@ func (in *RouteTableList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTableObservation) DeepCopyInto(out *RouteTableObservation) {
+func (in *FlowLogObservation) DeepCopyInto(out *FlowLogObservation) {
 	*out = *in
-	if in.Routes != nil {
-		in, out := &in.Routes, &out.Routes
-		*out = make([]RouteState, len(*in))
-		copy(*out, *in)
-	}
-	if in.Associations != nil {
-		in, out := &in.Associations, &out.Associations
-		*out = make([]AssociationState, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableObservation.
-func (in *RouteTableObservation) DeepCopy() *RouteTableObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLogObservation.
+func (in *FlowLogObservation) DeepCopy() *FlowLogObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTableObservation)
+	out := new(FlowLogObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTableParameters) DeepCopyInto(out *RouteTableParameters) {
+func (in *FlowLogParameters) DeepCopyInto(out *FlowLogParameters) {
 	*out = *in
-	if in.Associations != nil {
-		in, out := &in.Associations, &out.Associations
-		*out = make([]Association, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.LogDestinationType != nil {
+		in, out := &in.LogDestinationType, &out.LogDestinationType
+		*out = new(string)
+		**out = **in
 	}
-	if in.Routes != nil {
-		in, out := &in.Routes, &out.Routes
-		*out = make([]Route, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.LogDestination != nil {
+		in, out := &in.LogDestination, &out.LogDestination
+		*out = new(string)
+		**out = **in
+	}
+	if in.LogGroupName != nil {
+		in, out := &in.LogGroupName, &out.LogGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeliverLogsPermissionARN != nil {
+		in, out := &in.DeliverLogsPermissionARN, &out.DeliverLogsPermissionARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeliverLogsPermissionARNRef != nil {
+		in, out := &in.DeliverLogsPermissionARNRef, &out.DeliverLogsPermissionARNRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.DeliverLogsPermissionARNSelector != nil {
+		in, out := &in.DeliverLogsPermissionARNSelector, &out.DeliverLogsPermissionARNSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LogFormat != nil {
+		in, out := &in.LogFormat, &out.LogFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxAggregationInterval != nil {
+		in, out := &in.MaxAggregationInterval, &out.MaxAggregationInterval
+		*out = new(int64)
+		**out = **in
 	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
@@ -242,48 +393,1811 @@ func (in *RouteTableParameters) DeepCopyInto(out *RouteTableParameters) {
 		*out = new(v1alpha1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetIDRef != nil {
+		in, out := &in.SubnetIDRef, &out.SubnetIDRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkInterfaceID != nil {
+		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableParameters.
-func (in *RouteTableParameters) DeepCopy() *RouteTableParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLogParameters.
+func (in *FlowLogParameters) DeepCopy() *FlowLogParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTableParameters)
+	out := new(FlowLogParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTableSpec) DeepCopyInto(out *RouteTableSpec) {
+func (in *FlowLogSpec) DeepCopyInto(out *FlowLogSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableSpec.
-func (in *RouteTableSpec) DeepCopy() *RouteTableSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLogSpec.
+func (in *FlowLogSpec) DeepCopy() *FlowLogSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTableSpec)
+	out := new(FlowLogSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTableStatus) DeepCopyInto(out *RouteTableStatus) {
+func (in *FlowLogStatus) DeepCopyInto(out *FlowLogStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableStatus.
-func (in *RouteTableStatus) DeepCopy() *RouteTableStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLogStatus.
+func (in *FlowLogStatus) DeepCopy() *FlowLogStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTableStatus)
+	out := new(FlowLogStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ICMPTypeCode) DeepCopyInto(out *ICMPTypeCode) {
+	*out = *in
+	if in.Code != nil {
+		in, out := &in.Code, &out.Code
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ICMPTypeCode.
+func (in *ICMPTypeCode) DeepCopy() *ICMPTypeCode {
+	if in == nil {
+		return nil
+	}
+	out := new(ICMPTypeCode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPair) DeepCopyInto(out *KeyPair) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPair.
+func (in *KeyPair) DeepCopy() *KeyPair {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPair)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyPair) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairList) DeepCopyInto(out *KeyPairList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeyPair, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairList.
+func (in *KeyPairList) DeepCopy() *KeyPairList {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyPairList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairObservation) DeepCopyInto(out *KeyPairObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairObservation.
+func (in *KeyPairObservation) DeepCopy() *KeyPairObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairParameters) DeepCopyInto(out *KeyPairParameters) {
+	*out = *in
+	if in.PublicKeyMaterial != nil {
+		in, out := &in.PublicKeyMaterial, &out.PublicKeyMaterial
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicKeySecretRef != nil {
+		in, out := &in.PublicKeySecretRef, &out.PublicKeySecretRef
+		*out = new(v1alpha1.SecretKeySelector)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]v1beta1.Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairParameters.
+func (in *KeyPairParameters) DeepCopy() *KeyPairParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairSpec) DeepCopyInto(out *KeyPairSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairSpec.
+func (in *KeyPairSpec) DeepCopy() *KeyPairSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairStatus) DeepCopyInto(out *KeyPairStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairStatus.
+func (in *KeyPairStatus) DeepCopy() *KeyPairStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplate) DeepCopyInto(out *LaunchTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplate.
+func (in *LaunchTemplate) DeepCopy() *LaunchTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LaunchTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateBlockDeviceMapping) DeepCopyInto(out *LaunchTemplateBlockDeviceMapping) {
+	*out = *in
+	if in.EBS != nil {
+		in, out := &in.EBS, &out.EBS
+		*out = new(LaunchTemplateEBSBlockDevice)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NoDevice != nil {
+		in, out := &in.NoDevice, &out.NoDevice
+		*out = new(string)
+		**out = **in
+	}
+	if in.VirtualName != nil {
+		in, out := &in.VirtualName, &out.VirtualName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateBlockDeviceMapping.
+func (in *LaunchTemplateBlockDeviceMapping) DeepCopy() *LaunchTemplateBlockDeviceMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateBlockDeviceMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateData) DeepCopyInto(out *LaunchTemplateData) {
+	*out = *in
+	if in.BlockDeviceMappings != nil {
+		in, out := &in.BlockDeviceMappings, &out.BlockDeviceMappings
+		*out = make([]LaunchTemplateBlockDeviceMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DisableAPITermination != nil {
+		in, out := &in.DisableAPITermination, &out.DisableAPITermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EBSOptimized != nil {
+		in, out := &in.EBSOptimized, &out.EBSOptimized
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IAMInstanceProfile != nil {
+		in, out := &in.IAMInstanceProfile, &out.IAMInstanceProfile
+		*out = new(LaunchTemplateIAMInstanceProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageID != nil {
+		in, out := &in.ImageID, &out.ImageID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceInitiatedShutdownBehavior != nil {
+		in, out := &in.InstanceInitiatedShutdownBehavior, &out.InstanceInitiatedShutdownBehavior
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceType != nil {
+		in, out := &in.InstanceType, &out.InstanceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyName != nil {
+		in, out := &in.KeyName, &out.KeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MetadataOptions != nil {
+		in, out := &in.MetadataOptions, &out.MetadataOptions
+		*out = new(LaunchTemplateMetadataOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MonitoringEnabled != nil {
+		in, out := &in.MonitoringEnabled, &out.MonitoringEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDRefs != nil {
+		in, out := &in.SecurityGroupIDRefs, &out.SecurityGroupIDRefs
+		*out = make([]v1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDSelector != nil {
+		in, out := &in.SecurityGroupIDSelector, &out.SecurityGroupIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TagSpecifications != nil {
+		in, out := &in.TagSpecifications, &out.TagSpecifications
+		*out = make([]LaunchTemplateTagSpecification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UserData != nil {
+		in, out := &in.UserData, &out.UserData
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateData.
+func (in *LaunchTemplateData) DeepCopy() *LaunchTemplateData {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateEBSBlockDevice) DeepCopyInto(out *LaunchTemplateEBSBlockDevice) {
+	*out = *in
+	if in.DeleteOnTermination != nil {
+		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SnapshotID != nil {
+		in, out := &in.SnapshotID, &out.SnapshotID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeSize != nil {
+		in, out := &in.VolumeSize, &out.VolumeSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.VolumeType != nil {
+		in, out := &in.VolumeType, &out.VolumeType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateEBSBlockDevice.
+func (in *LaunchTemplateEBSBlockDevice) DeepCopy() *LaunchTemplateEBSBlockDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateEBSBlockDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateIAMInstanceProfile) DeepCopyInto(out *LaunchTemplateIAMInstanceProfile) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateIAMInstanceProfile.
+func (in *LaunchTemplateIAMInstanceProfile) DeepCopy() *LaunchTemplateIAMInstanceProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateIAMInstanceProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateList) DeepCopyInto(out *LaunchTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LaunchTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateList.
+func (in *LaunchTemplateList) DeepCopy() *LaunchTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LaunchTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateMetadataOptions) DeepCopyInto(out *LaunchTemplateMetadataOptions) {
+	*out = *in
+	if in.HTTPEndpoint != nil {
+		in, out := &in.HTTPEndpoint, &out.HTTPEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTTPPutResponseHopLimit != nil {
+		in, out := &in.HTTPPutResponseHopLimit, &out.HTTPPutResponseHopLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.HTTPTokens != nil {
+		in, out := &in.HTTPTokens, &out.HTTPTokens
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateMetadataOptions.
+func (in *LaunchTemplateMetadataOptions) DeepCopy() *LaunchTemplateMetadataOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateMetadataOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateObservation) DeepCopyInto(out *LaunchTemplateObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateObservation.
+func (in *LaunchTemplateObservation) DeepCopy() *LaunchTemplateObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateParameters) DeepCopyInto(out *LaunchTemplateParameters) {
+	*out = *in
+	in.LaunchTemplateData.DeepCopyInto(&out.LaunchTemplateData)
+	if in.VersionDescription != nil {
+		in, out := &in.VersionDescription, &out.VersionDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.SetDefaultVersion != nil {
+		in, out := &in.SetDefaultVersion, &out.SetDefaultVersion
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]v1beta1.Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateParameters.
+func (in *LaunchTemplateParameters) DeepCopy() *LaunchTemplateParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateSpec) DeepCopyInto(out *LaunchTemplateSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateSpec.
+func (in *LaunchTemplateSpec) DeepCopy() *LaunchTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateStatus) DeepCopyInto(out *LaunchTemplateStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateStatus.
+func (in *LaunchTemplateStatus) DeepCopy() *LaunchTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateTagSpecification) DeepCopyInto(out *LaunchTemplateTagSpecification) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]v1beta1.Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateTagSpecification.
+func (in *LaunchTemplateTagSpecification) DeepCopy() *LaunchTemplateTagSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateTagSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACL) DeepCopyInto(out *NetworkACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACL.
+func (in *NetworkACL) DeepCopy() *NetworkACL {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLAssociation) DeepCopyInto(out *NetworkACLAssociation) {
+	*out = *in
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetIDRef != nil {
+		in, out := &in.SubnetIDRef, &out.SubnetIDRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLAssociation.
+func (in *NetworkACLAssociation) DeepCopy() *NetworkACLAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLAssociationState) DeepCopyInto(out *NetworkACLAssociationState) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLAssociationState.
+func (in *NetworkACLAssociationState) DeepCopy() *NetworkACLAssociationState {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLAssociationState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntry) DeepCopyInto(out *NetworkACLEntry) {
+	*out = *in
+	if in.CIDRBlock != nil {
+		in, out := &in.CIDRBlock, &out.CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6CIDRBlock != nil {
+		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.ICMPTypeCode != nil {
+		in, out := &in.ICMPTypeCode, &out.ICMPTypeCode
+		*out = new(ICMPTypeCode)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PortRange != nil {
+		in, out := &in.PortRange, &out.PortRange
+		*out = new(PortRange)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntry.
+func (in *NetworkACLEntry) DeepCopy() *NetworkACLEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLList) DeepCopyInto(out *NetworkACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLList.
+func (in *NetworkACLList) DeepCopy() *NetworkACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLObservation) DeepCopyInto(out *NetworkACLObservation) {
+	*out = *in
+	if in.Associations != nil {
+		in, out := &in.Associations, &out.Associations
+		*out = make([]NetworkACLAssociationState, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLObservation.
+func (in *NetworkACLObservation) DeepCopy() *NetworkACLObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLParameters) DeepCopyInto(out *NetworkACLParameters) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Associations != nil {
+		in, out := &in.Associations, &out.Associations
+		*out = make([]NetworkACLAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]NetworkACLEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]NetworkACLEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]v1beta1.Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLParameters.
+func (in *NetworkACLParameters) DeepCopy() *NetworkACLParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLSpec) DeepCopyInto(out *NetworkACLSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLSpec.
+func (in *NetworkACLSpec) DeepCopy() *NetworkACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLStatus) DeepCopyInto(out *NetworkACLStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLStatus.
+func (in *NetworkACLStatus) DeepCopy() *NetworkACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortRange) DeepCopyInto(out *PortRange) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = new(int64)
+		**out = **in
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortRange.
+func (in *PortRange) DeepCopy() *PortRange {
+	if in == nil {
+		return nil
+	}
+	out := new(PortRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route) DeepCopyInto(out *Route) {
+	*out = *in
+	if in.DestinationCIDRBlock != nil {
+		in, out := &in.DestinationCIDRBlock, &out.DestinationCIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationIPv6CIDRBlock != nil {
+		in, out := &in.DestinationIPv6CIDRBlock, &out.DestinationIPv6CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.GatewayID != nil {
+		in, out := &in.GatewayID, &out.GatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GatewayIDRef != nil {
+		in, out := &in.GatewayIDRef, &out.GatewayIDRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.GatewayIDSelector != nil {
+		in, out := &in.GatewayIDSelector, &out.GatewayIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
+func (in *Route) DeepCopy() *Route {
+	if in == nil {
+		return nil
+	}
+	out := new(Route)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteState) DeepCopyInto(out *RouteState) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteState.
+func (in *RouteState) DeepCopy() *RouteState {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTable) DeepCopyInto(out *RouteTable) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTable.
+func (in *RouteTable) DeepCopy() *RouteTable {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteTable) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTableList) DeepCopyInto(out *RouteTableList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RouteTable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableList.
+func (in *RouteTableList) DeepCopy() *RouteTableList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTableList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteTableList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTableObservation) DeepCopyInto(out *RouteTableObservation) {
+	*out = *in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]RouteState, len(*in))
+		copy(*out, *in)
+	}
+	if in.Associations != nil {
+		in, out := &in.Associations, &out.Associations
+		*out = make([]AssociationState, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableObservation.
+func (in *RouteTableObservation) DeepCopy() *RouteTableObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTableObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTableParameters) DeepCopyInto(out *RouteTableParameters) {
+	*out = *in
+	if in.Associations != nil {
+		in, out := &in.Associations, &out.Associations
+		*out = make([]Association, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]Route, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]v1beta1.Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableParameters.
+func (in *RouteTableParameters) DeepCopy() *RouteTableParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTableParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTableSpec) DeepCopyInto(out *RouteTableSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableSpec.
+func (in *RouteTableSpec) DeepCopy() *RouteTableSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTableSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTableStatus) DeepCopyInto(out *RouteTableStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableStatus.
+func (in *RouteTableStatus) DeepCopy() *RouteTableStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTableStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Snapshot) DeepCopyInto(out *Snapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Snapshot.
+func (in *Snapshot) DeepCopy() *Snapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(Snapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Snapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotList) DeepCopyInto(out *SnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Snapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotList.
+func (in *SnapshotList) DeepCopy() *SnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotObservation) DeepCopyInto(out *SnapshotObservation) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotObservation.
+func (in *SnapshotObservation) DeepCopy() *SnapshotObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotParameters) DeepCopyInto(out *SnapshotParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeID != nil {
+		in, out := &in.VolumeID, &out.VolumeID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeIDRef != nil {
+		in, out := &in.VolumeIDRef, &out.VolumeIDRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.VolumeIDSelector != nil {
+		in, out := &in.VolumeIDSelector, &out.VolumeIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]v1beta1.Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotParameters.
+func (in *SnapshotParameters) DeepCopy() *SnapshotParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotSpec) DeepCopyInto(out *SnapshotSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotSpec.
+func (in *SnapshotSpec) DeepCopy() *SnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotStatus) DeepCopyInto(out *SnapshotStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotStatus.
+func (in *SnapshotStatus) DeepCopy() *SnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpoint) DeepCopyInto(out *VPCEndpoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpoint.
+func (in *VPCEndpoint) DeepCopy() *VPCEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCEndpoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointList) DeepCopyInto(out *VPCEndpointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VPCEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointList.
+func (in *VPCEndpointList) DeepCopy() *VPCEndpointList {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCEndpointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointObservation) DeepCopyInto(out *VPCEndpointObservation) {
+	*out = *in
+	if in.DNSEntries != nil {
+		in, out := &in.DNSEntries, &out.DNSEntries
+		*out = make([]DNSEntry, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkInterfaceIDs != nil {
+		in, out := &in.NetworkInterfaceIDs, &out.NetworkInterfaceIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointObservation.
+func (in *VPCEndpointObservation) DeepCopy() *VPCEndpointObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointParameters) DeepCopyInto(out *VPCEndpointParameters) {
+	*out = *in
+	if in.VPCEndpointType != nil {
+		in, out := &in.VPCEndpointType, &out.VPCEndpointType
+		*out = new(string)
+		**out = **in
+	}
+	if in.PolicyDocument != nil {
+		in, out := &in.PolicyDocument, &out.PolicyDocument
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateDNSEnabled != nil {
+		in, out := &in.PrivateDNSEnabled, &out.PrivateDNSEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RouteTableIDs != nil {
+		in, out := &in.RouteTableIDs, &out.RouteTableIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RouteTableIDRefs != nil {
+		in, out := &in.RouteTableIDRefs, &out.RouteTableIDRefs
+		*out = make([]v1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.RouteTableIDSelector != nil {
+		in, out := &in.RouteTableIDSelector, &out.RouteTableIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDRefs != nil {
+		in, out := &in.SubnetIDRefs, &out.SubnetIDRefs
+		*out = make([]v1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDRefs != nil {
+		in, out := &in.SecurityGroupIDRefs, &out.SecurityGroupIDRefs
+		*out = make([]v1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDSelector != nil {
+		in, out := &in.SecurityGroupIDSelector, &out.SecurityGroupIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]v1beta1.Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointParameters.
+func (in *VPCEndpointParameters) DeepCopy() *VPCEndpointParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointSpec) DeepCopyInto(out *VPCEndpointSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointSpec.
+func (in *VPCEndpointSpec) DeepCopy() *VPCEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointStatus) DeepCopyInto(out *VPCEndpointStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointStatus.
+func (in *VPCEndpointStatus) DeepCopy() *VPCEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Volume) DeepCopyInto(out *Volume) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Volume.
+func (in *Volume) DeepCopy() *Volume {
+	if in == nil {
+		return nil
+	}
+	out := new(Volume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Volume) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeAttachment) DeepCopyInto(out *VolumeAttachment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeAttachment.
+func (in *VolumeAttachment) DeepCopy() *VolumeAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeAttachmentList) DeepCopyInto(out *VolumeAttachmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VolumeAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeAttachmentList.
+func (in *VolumeAttachmentList) DeepCopy() *VolumeAttachmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeAttachmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeAttachmentObservation) DeepCopyInto(out *VolumeAttachmentObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeAttachmentObservation.
+func (in *VolumeAttachmentObservation) DeepCopy() *VolumeAttachmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeAttachmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeAttachmentParameters) DeepCopyInto(out *VolumeAttachmentParameters) {
+	*out = *in
+	if in.VolumeID != nil {
+		in, out := &in.VolumeID, &out.VolumeID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeIDRef != nil {
+		in, out := &in.VolumeIDRef, &out.VolumeIDRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.VolumeIDSelector != nil {
+		in, out := &in.VolumeIDSelector, &out.VolumeIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForceDetach != nil {
+		in, out := &in.ForceDetach, &out.ForceDetach
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeAttachmentParameters.
+func (in *VolumeAttachmentParameters) DeepCopy() *VolumeAttachmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeAttachmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeAttachmentSpec) DeepCopyInto(out *VolumeAttachmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeAttachmentSpec.
+func (in *VolumeAttachmentSpec) DeepCopy() *VolumeAttachmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeAttachmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeAttachmentStatus) DeepCopyInto(out *VolumeAttachmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeAttachmentStatus.
+func (in *VolumeAttachmentStatus) DeepCopy() *VolumeAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeList) DeepCopyInto(out *VolumeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeList.
+func (in *VolumeList) DeepCopy() *VolumeList {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeModification) DeepCopyInto(out *VolumeModification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeModification.
+func (in *VolumeModification) DeepCopy() *VolumeModification {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeModification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeObservation) DeepCopyInto(out *VolumeObservation) {
+	*out = *in
+	if in.Modification != nil {
+		in, out := &in.Modification, &out.Modification
+		*out = new(VolumeModification)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeObservation.
+func (in *VolumeObservation) DeepCopy() *VolumeObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeParameters) DeepCopyInto(out *VolumeParameters) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int64)
+		**out = **in
+	}
+	if in.VolumeType != nil {
+		in, out := &in.VolumeType, &out.VolumeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SnapshotID != nil {
+		in, out := &in.SnapshotID, &out.SnapshotID
+		*out = new(string)
+		**out = **in
+	}
+	if in.MultiAttachEnabled != nil {
+		in, out := &in.MultiAttachEnabled, &out.MultiAttachEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]v1beta1.Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeParameters.
+func (in *VolumeParameters) DeepCopy() *VolumeParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSpec) DeepCopyInto(out *VolumeSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSpec.
+func (in *VolumeSpec) DeepCopy() *VolumeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeStatus.
+func (in *VolumeStatus) DeepCopy() *VolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -22,6 +22,19 @@ import (
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 )
 
+// SubnetIPv6CIDRBlockAssociation represents the association of an IPv6 CIDR
+// block with the subnet.
+type SubnetIPv6CIDRBlockAssociation struct {
+	// The association ID for the IPv6 CIDR block.
+	AssociationID string `json:"associationId,omitempty"`
+
+	// The IPv6 CIDR block.
+	IPv6CIDRBlock string `json:"ipv6CidrBlock,omitempty"`
+
+	// Information about the state of the CIDR block.
+	IPv6CIDRBlockState VPCCIDRBlockState `json:"ipv6CidrBlockState,omitempty"`
+}
+
 // SubnetParameters define the desired state of an AWS VPC Subnet.
 type SubnetParameters struct {
 	// CIDRBlock is the IPv4 network range for the Subnet, in CIDR notation. For example, 10.0.0.0/18.
@@ -48,7 +61,6 @@ type SubnetParameters struct {
 	// The IPv6 network range for the subnet, in CIDR notation. The subnet size
 	// must use a /64 prefix length.
 	// +optional
-	// +immutable
 	IPv6CIDRBlock *string `json:"ipv6CIDRBlock,omitempty"`
 
 	// Indicates whether instances launched in this subnet receive a public IPv4
@@ -95,6 +107,9 @@ type SubnetObservation struct {
 
 	// SubnetID is the ID of the Subnet.
 	SubnetID string `json:"subnetId,omitempty"`
+
+	// Information about the IPv6 CIDR blocks associated with the subnet.
+	IPv6CIDRBlockAssociationSet []SubnetIPv6CIDRBlockAssociation `json:"ipv6CidrBlockAssociationSet,omitempty"`
 }
 
 // A SubnetStatus represents the observed state of a Subnet.
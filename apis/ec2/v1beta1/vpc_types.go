@@ -72,6 +72,17 @@ type VPCParameters struct {
 	// +immutable
 	CIDRBlock string `json:"cidrBlock"`
 
+	// SecondaryCIDRBlocks are the additional IPv4 network ranges associated
+	// with the VPC, in CIDR notation.
+	// +optional
+	SecondaryCIDRBlocks []string `json:"secondaryCidrBlocks,omitempty"`
+
+	// Requests an Amazon-provided IPv6 CIDR block with a /56 prefix length
+	// for the VPC. You cannot specify the range of IP addresses, or the size
+	// of the CIDR block.
+	// +optional
+	AmazonProvidedIPv6CIDRBlock *bool `json:"amazonProvidedIpv6CidrBlock,omitempty"`
+
 	// A boolean flag to enable/disable DNS support in the VPC
 	// +optional
 	EnableDNSSupport *bool `json:"enableDnsSupport,omitempty"`
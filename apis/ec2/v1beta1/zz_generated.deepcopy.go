@@ -485,6 +485,22 @@ func (in *Subnet) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetIPv6CIDRBlockAssociation) DeepCopyInto(out *SubnetIPv6CIDRBlockAssociation) {
+	*out = *in
+	out.IPv6CIDRBlockState = in.IPv6CIDRBlockState
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetIPv6CIDRBlockAssociation.
+func (in *SubnetIPv6CIDRBlockAssociation) DeepCopy() *SubnetIPv6CIDRBlockAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetIPv6CIDRBlockAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetList) DeepCopyInto(out *SubnetList) {
 	*out = *in
@@ -520,6 +536,11 @@ func (in *SubnetList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetObservation) DeepCopyInto(out *SubnetObservation) {
 	*out = *in
+	if in.IPv6CIDRBlockAssociationSet != nil {
+		in, out := &in.IPv6CIDRBlockAssociationSet, &out.IPv6CIDRBlockAssociationSet
+		*out = make([]SubnetIPv6CIDRBlockAssociation, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetObservation.
@@ -613,7 +634,7 @@ func (in *SubnetSpec) DeepCopy() *SubnetSpec {
 func (in *SubnetStatus) DeepCopyInto(out *SubnetStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetStatus.
@@ -820,6 +841,16 @@ func (in *VPCObservation) DeepCopy() *VPCObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCParameters) DeepCopyInto(out *VPCParameters) {
 	*out = *in
+	if in.SecondaryCIDRBlocks != nil {
+		in, out := &in.SecondaryCIDRBlocks, &out.SecondaryCIDRBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AmazonProvidedIPv6CIDRBlock != nil {
+		in, out := &in.AmazonProvidedIPv6CIDRBlock, &out.AmazonProvidedIPv6CIDRBlock
+		*out = new(bool)
+		**out = **in
+	}
 	if in.EnableDNSSupport != nil {
 		in, out := &in.EnableDNSSupport, &out.EnableDNSSupport
 		*out = new(bool)
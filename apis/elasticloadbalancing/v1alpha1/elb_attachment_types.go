@@ -38,9 +38,15 @@ type ELBAttachmentParameters struct {
 	// +optional
 	ELBNameSelector *runtimev1alpha1.Selector `json:"elbNameSelector,omitempty"`
 
-	// List of identities of the instances to be attached.
-	// +immutable
-	InstanceID string `json:"instanceId"`
+	// Identity of a single instance to be attached. It is merged with
+	// InstanceIDs; prefer InstanceIDs for new resources.
+	// +optional
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// List of identities of the instances to be attached. Instances are
+	// registered and deregistered as a set.
+	// +optional
+	InstanceIDs []string `json:"instanceIds,omitempty"`
 }
 
 // An ELBAttachmentSpec defines the desired state of an ELBAttachment.
@@ -49,8 +55,25 @@ type ELBAttachmentSpec struct {
 	ForProvider                  ELBAttachmentParameters `json:"forProvider"`
 }
 
+// InstanceHealth is the health of an instance registered with the ELB.
+type InstanceHealth struct {
+	// The ID of the instance.
+	InstanceID string `json:"instanceId"`
+
+	// The current state of the instance: InService, OutOfService or Unknown.
+	State string `json:"state,omitempty"`
+
+	// Information about the cause of OutOfService instances: ELB or Instance.
+	ReasonCode string `json:"reasonCode,omitempty"`
+
+	// A description of the instance state.
+	Description string `json:"description,omitempty"`
+}
+
 // ELBAttachmentObservation keeps the state for the external resource
 type ELBAttachmentObservation struct {
+	// The health of the instances registered by this attachment.
+	Instances []InstanceHealth `json:"instances,omitempty"`
 }
 
 // An ELBAttachmentStatus represents the observed state of an ELBAttachmentAttachment.
@@ -64,7 +87,6 @@ type ELBAttachmentStatus struct {
 // An ELBAttachment is a managed resource that represents attachment of an
 // AWS Classic Load Balancer and an AWS EC2 instance.
 // +kubebuilder:printcolumn:name="ELBNAME",type="string",JSONPath=".spec.forProvider.elbName"
-// +kubebuilder:printcolumn:name="INSTANCEID",type="string",JSONPath=".spec.forProvider.instanceId"
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
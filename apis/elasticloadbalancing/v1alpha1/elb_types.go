@@ -89,18 +89,158 @@ type HealthCheck struct {
 	UnhealthyThreshold int64 `json:"unhealthyThreshold"`
 }
 
+// CrossZoneLoadBalancing defines whether cross-zone load balancing is enabled.
+type CrossZoneLoadBalancing struct {
+
+	// Specifies whether cross-zone load balancing is enabled for the load balancer.
+	Enabled bool `json:"enabled"`
+}
+
+// ConnectionDraining defines the connection draining settings of the ELB.
+type ConnectionDraining struct {
+
+	// Specifies whether connection draining is enabled for the load balancer.
+	Enabled bool `json:"enabled"`
+
+	// The maximum time, in seconds, to keep the existing connections open before
+	// deregistering the instances.
+	// +optional
+	Timeout *int64 `json:"timeout,omitempty"`
+}
+
+// ConnectionSettings defines the connection settings of the ELB.
+type ConnectionSettings struct {
+
+	// The time, in seconds, that the connection is allowed to be idle (no data
+	// has been sent over the connection) before it is closed by the load balancer.
+	IdleTimeout int64 `json:"idleTimeout"`
+}
+
+// AccessLog defines where and how often the ELB publishes access logs.
+type AccessLog struct {
+
+	// Specifies whether access logs are enabled for the load balancer.
+	Enabled bool `json:"enabled"`
+
+	// The interval for publishing the access logs. You can specify an interval
+	// of either 5 minutes or 60 minutes.
+	// +optional
+	EmitInterval *int64 `json:"emitInterval,omitempty"`
+
+	// The name of the Amazon S3 bucket where the access logs are stored.
+	// +optional
+	S3BucketName *string `json:"s3BucketName,omitempty"`
+
+	// The logical hierarchy you created for your Amazon S3 bucket, for example
+	// my-bucket-prefix/prod. If the prefix is not provided, the log is placed
+	// at the root level of the bucket.
+	// +optional
+	S3BucketPrefix *string `json:"s3BucketPrefix,omitempty"`
+}
+
+// LoadBalancerAttributes are the attributes of the ELB.
+type LoadBalancerAttributes struct {
+
+	// If enabled, the load balancer captures detailed information of all requests
+	// and delivers the information to the Amazon S3 bucket that you specify.
+	// +optional
+	AccessLog *AccessLog `json:"accessLog,omitempty"`
+
+	// If enabled, the load balancer allows existing requests to complete before
+	// the load balancer shifts traffic away from a deregistered or unhealthy instance.
+	// +optional
+	ConnectionDraining *ConnectionDraining `json:"connectionDraining,omitempty"`
+
+	// If enabled, the load balancer allows the connections to remain idle for
+	// the specified duration.
+	// +optional
+	ConnectionSettings *ConnectionSettings `json:"connectionSettings,omitempty"`
+
+	// If enabled, the load balancer routes the request traffic evenly across all
+	// instances regardless of the Availability Zones.
+	// +optional
+	CrossZoneLoadBalancing *CrossZoneLoadBalancing `json:"crossZoneLoadBalancing,omitempty"`
+}
+
+// PolicyAttribute is an attribute of an ELB policy.
+type PolicyAttribute struct {
+
+	// The name of the attribute.
+	AttributeName string `json:"attributeName"`
+
+	// The value of the attribute.
+	// +optional
+	AttributeValue *string `json:"attributeValue,omitempty"`
+}
+
+// Policy defines a policy that can be enabled for the listeners or back-end
+// servers of the ELB. Policies cannot be modified once created.
+type Policy struct {
+
+	// The name of the load balancer policy to be created.
+	PolicyName string `json:"policyName"`
+
+	// The name of the base policy type, e.g. SSLNegotiationPolicyType or
+	// ProxyProtocolPolicyType.
+	PolicyTypeName string `json:"policyTypeName"`
+
+	// The policy attributes.
+	// +optional
+	PolicyAttributes []PolicyAttribute `json:"policyAttributes,omitempty"`
+}
+
+// ListenerPolicy enables a set of policies for the listener on a given port.
+type ListenerPolicy struct {
+
+	// The external port of the load balancer.
+	LoadBalancerPort int64 `json:"loadBalancerPort"`
+
+	// The names of the policies to enable for the listener.
+	PolicyNames []string `json:"policyNames"`
+}
+
+// BackendServerPolicy enables a set of policies for a given instance port.
+type BackendServerPolicy struct {
+
+	// The port number associated with the EC2 instance.
+	InstancePort int64 `json:"instancePort"`
+
+	// The names of the policies to enable for the instance port.
+	PolicyNames []string `json:"policyNames"`
+}
+
 // ELBParameters define the desired state of an AWS ELB.
 type ELBParameters struct {
 	// One or more Availability Zones from the same region as the load balancer.
 	// +optional
 	AvailabilityZones []string `json:"availabilityZones,omitempty"`
 
+	// The attributes of the load balancer.
+	// +optional
+	Attributes *LoadBalancerAttributes `json:"attributes,omitempty"`
+
+	// The policies enabled for the back-end server ports of the load balancer.
+	// +optional
+	BackendServerPolicies []BackendServerPolicy `json:"backendServerPolicies,omitempty"`
+
 	// Information about the health checks conducted on the load balancer.
 	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
 
 	// The listeners for this ELB.
 	Listeners []Listener `json:"listeners"`
 
+	// The policies enabled for the listeners of the load balancer.
+	// +optional
+	ListenerPolicies []ListenerPolicy `json:"listenerPolicies,omitempty"`
+
+	// The policies to create for the load balancer. Policies are immutable and
+	// are identified by their name. Policies that were created by this
+	// resource and are removed from this list are deleted once they are not
+	// assigned to any listener or back-end server. Other policies of the load
+	// balancer are left untouched.
+	// +optional
+	Policies []Policy `json:"policies,omitempty"`
+
 	// The type of a load balancer. Valid only for load balancers in a VPC.
 	// +optional
 	// +immutable
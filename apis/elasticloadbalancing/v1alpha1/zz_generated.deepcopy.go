@@ -25,6 +25,36 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLog) DeepCopyInto(out *AccessLog) {
+	*out = *in
+	if in.EmitInterval != nil {
+		in, out := &in.EmitInterval, &out.EmitInterval
+		*out = new(int64)
+		**out = **in
+	}
+	if in.S3BucketName != nil {
+		in, out := &in.S3BucketName, &out.S3BucketName
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3BucketPrefix != nil {
+		in, out := &in.S3BucketPrefix, &out.S3BucketPrefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLog.
+func (in *AccessLog) DeepCopy() *AccessLog {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackendServerDescription) DeepCopyInto(out *BackendServerDescription) {
 	*out = *in
@@ -45,6 +75,76 @@ func (in *BackendServerDescription) DeepCopy() *BackendServerDescription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendServerPolicy) DeepCopyInto(out *BackendServerPolicy) {
+	*out = *in
+	if in.PolicyNames != nil {
+		in, out := &in.PolicyNames, &out.PolicyNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendServerPolicy.
+func (in *BackendServerPolicy) DeepCopy() *BackendServerPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendServerPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionDraining) DeepCopyInto(out *ConnectionDraining) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionDraining.
+func (in *ConnectionDraining) DeepCopy() *ConnectionDraining {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionDraining)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionSettings) DeepCopyInto(out *ConnectionSettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionSettings.
+func (in *ConnectionSettings) DeepCopy() *ConnectionSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrossZoneLoadBalancing) DeepCopyInto(out *CrossZoneLoadBalancing) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrossZoneLoadBalancing.
+func (in *CrossZoneLoadBalancing) DeepCopy() *CrossZoneLoadBalancing {
+	if in == nil {
+		return nil
+	}
+	out := new(CrossZoneLoadBalancing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ELB) DeepCopyInto(out *ELB) {
 	*out = *in
@@ -134,6 +234,11 @@ func (in *ELBAttachmentList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ELBAttachmentObservation) DeepCopyInto(out *ELBAttachmentObservation) {
 	*out = *in
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]InstanceHealth, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ELBAttachmentObservation.
@@ -159,6 +264,11 @@ func (in *ELBAttachmentParameters) DeepCopyInto(out *ELBAttachmentParameters) {
 		*out = new(corev1alpha1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.InstanceIDs != nil {
+		in, out := &in.InstanceIDs, &out.InstanceIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ELBAttachmentParameters.
@@ -192,7 +302,7 @@ func (in *ELBAttachmentSpec) DeepCopy() *ELBAttachmentSpec {
 func (in *ELBAttachmentStatus) DeepCopyInto(out *ELBAttachmentStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ELBAttachmentStatus.
@@ -267,6 +377,18 @@ func (in *ELBParameters) DeepCopyInto(out *ELBParameters) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = new(LoadBalancerAttributes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackendServerPolicies != nil {
+		in, out := &in.BackendServerPolicies, &out.BackendServerPolicies
+		*out = make([]BackendServerPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.HealthCheck != nil {
 		in, out := &in.HealthCheck, &out.HealthCheck
 		*out = new(HealthCheck)
@@ -279,6 +401,20 @@ func (in *ELBParameters) DeepCopyInto(out *ELBParameters) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ListenerPolicies != nil {
+		in, out := &in.ListenerPolicies, &out.ListenerPolicies
+		*out = make([]ListenerPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]Policy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Scheme != nil {
 		in, out := &in.Scheme, &out.Scheme
 		*out = new(string)
@@ -382,6 +518,21 @@ func (in *HealthCheck) DeepCopy() *HealthCheck {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceHealth) DeepCopyInto(out *InstanceHealth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceHealth.
+func (in *InstanceHealth) DeepCopy() *InstanceHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Listener) DeepCopyInto(out *Listener) {
 	*out = *in
@@ -407,6 +558,103 @@ func (in *Listener) DeepCopy() *Listener {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerPolicy) DeepCopyInto(out *ListenerPolicy) {
+	*out = *in
+	if in.PolicyNames != nil {
+		in, out := &in.PolicyNames, &out.PolicyNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerPolicy.
+func (in *ListenerPolicy) DeepCopy() *ListenerPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerAttributes) DeepCopyInto(out *LoadBalancerAttributes) {
+	*out = *in
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(AccessLog)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionDraining != nil {
+		in, out := &in.ConnectionDraining, &out.ConnectionDraining
+		*out = new(ConnectionDraining)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionSettings != nil {
+		in, out := &in.ConnectionSettings, &out.ConnectionSettings
+		*out = new(ConnectionSettings)
+		**out = **in
+	}
+	if in.CrossZoneLoadBalancing != nil {
+		in, out := &in.CrossZoneLoadBalancing, &out.CrossZoneLoadBalancing
+		*out = new(CrossZoneLoadBalancing)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerAttributes.
+func (in *LoadBalancerAttributes) DeepCopy() *LoadBalancerAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Policy) DeepCopyInto(out *Policy) {
+	*out = *in
+	if in.PolicyAttributes != nil {
+		in, out := &in.PolicyAttributes, &out.PolicyAttributes
+		*out = make([]PolicyAttribute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policy.
+func (in *Policy) DeepCopy() *Policy {
+	if in == nil {
+		return nil
+	}
+	out := new(Policy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyAttribute) DeepCopyInto(out *PolicyAttribute) {
+	*out = *in
+	if in.AttributeValue != nil {
+		in, out := &in.AttributeValue, &out.AttributeValue
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyAttribute.
+func (in *PolicyAttribute) DeepCopy() *PolicyAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
@@ -0,0 +1,200 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// RedirectActionConfig describes a redirect action. Each of the components
+// that are not specified keep their original value.
+type RedirectActionConfig struct {
+	// The hostname.
+	// +optional
+	Host *string `json:"host,omitempty"`
+
+	// The absolute path, starting with the leading "/".
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// The port.
+	// +optional
+	Port *string `json:"port,omitempty"`
+
+	// The protocol.
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+
+	// The query parameters, URL-encoded when necessary, but not
+	// percent-encoded.
+	// +optional
+	Query *string `json:"query,omitempty"`
+
+	// The HTTP redirect code.
+	// +kubebuilder:validation:Enum=HTTP_301;HTTP_302
+	StatusCode string `json:"statusCode"`
+}
+
+// FixedResponseActionConfig describes an action that returns a custom HTTP
+// response.
+type FixedResponseActionConfig struct {
+	// The content type.
+	// +optional
+	// +kubebuilder:validation:Enum=text/plain;text/css;text/html;application/javascript;application/json
+	ContentType *string `json:"contentType,omitempty"`
+
+	// The message.
+	// +optional
+	MessageBody *string `json:"messageBody,omitempty"`
+
+	// The HTTP response code (2XX, 4XX, or 5XX).
+	StatusCode string `json:"statusCode"`
+}
+
+// Action describes an action of a listener or a listener rule.
+type Action struct {
+	// The type of action.
+	// +kubebuilder:validation:Enum=forward;redirect;fixed-response
+	Type string `json:"type"`
+
+	// The order for the action. The action with the lowest value for order
+	// is performed first.
+	// +optional
+	Order *int64 `json:"order,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the target group to forward to.
+	// Specify only when Type is forward.
+	// +optional
+	TargetGroupARN *string `json:"targetGroupArn,omitempty"`
+
+	// TargetGroupARNRef references a TargetGroup to retrieve its ARN.
+	// +optional
+	TargetGroupARNRef *runtimev1alpha1.Reference `json:"targetGroupArnRef,omitempty"`
+
+	// TargetGroupARNSelector selects a reference to a TargetGroup to
+	// retrieve its ARN.
+	// +optional
+	TargetGroupARNSelector *runtimev1alpha1.Selector `json:"targetGroupArnSelector,omitempty"`
+
+	// Information for creating a redirect action. Specify only when Type is
+	// redirect.
+	// +optional
+	RedirectConfig *RedirectActionConfig `json:"redirectConfig,omitempty"`
+
+	// Information for creating an action that returns a custom HTTP
+	// response. Specify only when Type is fixed-response.
+	// +optional
+	FixedResponseConfig *FixedResponseActionConfig `json:"fixedResponseConfig,omitempty"`
+}
+
+// ListenerParameters define the desired state of an AWS Elastic Load
+// Balancing v2 Listener.
+type ListenerParameters struct {
+	// The Amazon Resource Name (ARN) of the load balancer.
+	// +optional
+	// +immutable
+	LoadBalancerARN *string `json:"loadBalancerArn,omitempty"`
+
+	// LoadBalancerARNRef references a LoadBalancer to retrieve its ARN.
+	// +optional
+	LoadBalancerARNRef *runtimev1alpha1.Reference `json:"loadBalancerArnRef,omitempty"`
+
+	// LoadBalancerARNSelector selects a reference to a LoadBalancer to
+	// retrieve its ARN.
+	// +optional
+	LoadBalancerARNSelector *runtimev1alpha1.Selector `json:"loadBalancerArnSelector,omitempty"`
+
+	// The port on which the load balancer is listening.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int64 `json:"port"`
+
+	// The protocol for connections from clients to the load balancer.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP;TLS;UDP;TCP_UDP
+	Protocol string `json:"protocol"`
+
+	// The security policy that defines which protocols and ciphers are
+	// supported. Only for HTTPS and TLS listeners.
+	// +optional
+	SSLPolicy *string `json:"sslPolicy,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the default certificate. Required
+	// for HTTPS and TLS listeners.
+	// +optional
+	CertificateARN *string `json:"certificateArn,omitempty"`
+
+	// CertificateARNRef references an ACM Certificate to retrieve its ARN.
+	// +optional
+	CertificateARNRef *runtimev1alpha1.Reference `json:"certificateArnRef,omitempty"`
+
+	// CertificateARNSelector selects a reference to an ACM Certificate to
+	// retrieve its ARN.
+	// +optional
+	CertificateARNSelector *runtimev1alpha1.Selector `json:"certificateArnSelector,omitempty"`
+
+	// The actions for the default rule.
+	// +kubebuilder:validation:MinItems=1
+	DefaultActions []Action `json:"defaultActions"`
+}
+
+// A ListenerSpec defines the desired state of a Listener.
+type ListenerSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ListenerParameters `json:"forProvider"`
+}
+
+// ListenerObservation keeps the state for the external resource
+type ListenerObservation struct {
+	// The Amazon Resource Name (ARN) of the listener.
+	ListenerARN string `json:"listenerArn,omitempty"`
+}
+
+// A ListenerStatus represents the observed state of a Listener.
+type ListenerStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ListenerObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Listener is a managed resource that represents an AWS Elastic Load
+// Balancing v2 Listener.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROTOCOL",type="string",JSONPath=".spec.forProvider.protocol"
+// +kubebuilder:printcolumn:name="PORT",type="integer",JSONPath=".spec.forProvider.port"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Listener struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ListenerSpec   `json:"spec"`
+	Status ListenerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ListenerList contains a list of Listeners
+type ListenerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Listener `json:"items"`
+}
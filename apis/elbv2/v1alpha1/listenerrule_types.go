@@ -0,0 +1,130 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// QueryStringKeyValuePair is a key/value pair of a query string condition.
+type QueryStringKeyValuePair struct {
+	// The key. You can omit the key.
+	// +optional
+	Key *string `json:"key,omitempty"`
+
+	// The value.
+	Value string `json:"value"`
+}
+
+// RuleCondition describes a condition of a listener rule.
+type RuleCondition struct {
+	// The field in the HTTP request.
+	// +kubebuilder:validation:Enum=host-header;path-pattern;http-header;http-request-method;query-string;source-ip
+	Field string `json:"field"`
+
+	// The values to match for the host-header, path-pattern, http-header,
+	// http-request-method and source-ip fields.
+	// +optional
+	Values []string `json:"values,omitempty"`
+
+	// The name of the HTTP header field for the http-header field.
+	// +optional
+	HTTPHeaderName *string `json:"httpHeaderName,omitempty"`
+
+	// The key/value pairs to match for the query-string field.
+	// +optional
+	QueryStringValues []QueryStringKeyValuePair `json:"queryStringValues,omitempty"`
+}
+
+// ListenerRuleParameters define the desired state of an AWS Elastic Load
+// Balancing v2 Listener Rule.
+type ListenerRuleParameters struct {
+	// The Amazon Resource Name (ARN) of the listener.
+	// +optional
+	// +immutable
+	ListenerARN *string `json:"listenerArn,omitempty"`
+
+	// ListenerARNRef references a Listener to retrieve its ARN.
+	// +optional
+	ListenerARNRef *runtimev1alpha1.Reference `json:"listenerArnRef,omitempty"`
+
+	// ListenerARNSelector selects a reference to a Listener to retrieve its
+	// ARN.
+	// +optional
+	ListenerARNSelector *runtimev1alpha1.Selector `json:"listenerArnSelector,omitempty"`
+
+	// The rule priority. A listener can't have multiple rules with the same
+	// priority.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=50000
+	Priority int64 `json:"priority"`
+
+	// The conditions of the rule.
+	// +kubebuilder:validation:MinItems=1
+	Conditions []RuleCondition `json:"conditions"`
+
+	// The actions of the rule.
+	// +kubebuilder:validation:MinItems=1
+	Actions []Action `json:"actions"`
+}
+
+// A ListenerRuleSpec defines the desired state of a ListenerRule.
+type ListenerRuleSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ListenerRuleParameters `json:"forProvider"`
+}
+
+// ListenerRuleObservation keeps the state for the external resource
+type ListenerRuleObservation struct {
+	// The Amazon Resource Name (ARN) of the rule.
+	RuleARN string `json:"ruleArn,omitempty"`
+}
+
+// A ListenerRuleStatus represents the observed state of a ListenerRule.
+type ListenerRuleStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ListenerRuleObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ListenerRule is a managed resource that represents an AWS Elastic Load
+// Balancing v2 Listener Rule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PRIORITY",type="integer",JSONPath=".spec.forProvider.priority"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ListenerRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ListenerRuleSpec   `json:"spec"`
+	Status ListenerRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ListenerRuleList contains a list of ListenerRules
+type ListenerRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ListenerRule `json:"items"`
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag defines a key value pair that can be attached to an Elastic Load
+// Balancing v2 resource.
+type Tag struct {
+	// The key of the tag.
+	Key string `json:"key"`
+
+	// The value of the tag.
+	// +optional
+	Value *string `json:"value,omitempty"`
+}
+
+// LoadBalancerParameters define the desired state of an AWS Application or
+// Network Load Balancer.
+type LoadBalancerParameters struct {
+	// The type of load balancer. The default is application.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=application;network
+	Type *string `json:"type,omitempty"`
+
+	// The nodes of an Internet-facing load balancer have public IP addresses.
+	// The nodes of an internal load balancer have only private IP addresses.
+	// The default is internet-facing.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=internet-facing;internal
+	Scheme *string `json:"scheme,omitempty"`
+
+	// The type of IP addresses used by the subnets for your load balancer.
+	// +optional
+	// +kubebuilder:validation:Enum=ipv4;dualstack
+	IPAddressType *string `json:"ipAddressType,omitempty"`
+
+	// The IDs of the public subnets. You must specify subnets from at least
+	// two Availability Zones for an application load balancer.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs references Subnets to retrieve their SubnetIDs.
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets to retrieve their
+	// SubnetIDs.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// The IDs of the security groups for an application load balancer.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references SecurityGroups to retrieve their
+	// SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups to
+	// retrieve their SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// One or more tags for the load balancer.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A LoadBalancerSpec defines the desired state of a LoadBalancer.
+type LoadBalancerSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  LoadBalancerParameters `json:"forProvider"`
+}
+
+// LoadBalancerObservation keeps the state for the external resource
+type LoadBalancerObservation struct {
+	// The Amazon Resource Name (ARN) of the load balancer.
+	LoadBalancerARN string `json:"loadBalancerArn,omitempty"`
+
+	// The public DNS name of the load balancer.
+	DNSName string `json:"dnsName,omitempty"`
+
+	// The ID of the Amazon Route 53 hosted zone associated with the load
+	// balancer.
+	CanonicalHostedZoneID string `json:"canonicalHostedZoneId,omitempty"`
+
+	// The state of the load balancer.
+	State string `json:"state,omitempty"`
+
+	// The ID of the VPC for the load balancer.
+	VPCID string `json:"vpcId,omitempty"`
+}
+
+// A LoadBalancerStatus represents the observed state of a LoadBalancer.
+type LoadBalancerStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     LoadBalancerObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LoadBalancer is a managed resource that represents an AWS Application or
+// Network Load Balancer.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DNSNAME",type="string",JSONPath=".status.atProvider.dnsName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LoadBalancer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoadBalancerSpec   `json:"spec"`
+	Status LoadBalancerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerList contains a list of LoadBalancers
+type LoadBalancerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancer `json:"items"`
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	acmv1alpha1 "github.com/crossplane/provider-aws/apis/acm/v1alpha1"
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// LoadBalancerARN returns the status.atProvider.loadBalancerArn of a
+// LoadBalancer.
+func LoadBalancerARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		lb, ok := mg.(*LoadBalancer)
+		if !ok {
+			return ""
+		}
+		return lb.Status.AtProvider.LoadBalancerARN
+	}
+}
+
+// LoadBalancerDNSName returns the status.atProvider.dnsName of a
+// LoadBalancer.
+func LoadBalancerDNSName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		lb, ok := mg.(*LoadBalancer)
+		if !ok {
+			return ""
+		}
+		return lb.Status.AtProvider.DNSName
+	}
+}
+
+// LoadBalancerCanonicalHostedZoneID returns the
+// status.atProvider.canonicalHostedZoneId of a LoadBalancer.
+func LoadBalancerCanonicalHostedZoneID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		lb, ok := mg.(*LoadBalancer)
+		if !ok {
+			return ""
+		}
+		return lb.Status.AtProvider.CanonicalHostedZoneID
+	}
+}
+
+// TargetGroupARN returns the status.atProvider.targetGroupArn of a
+// TargetGroup.
+func TargetGroupARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		tg, ok := mg.(*TargetGroup)
+		if !ok {
+			return ""
+		}
+		return tg.Status.AtProvider.TargetGroupARN
+	}
+}
+
+// ResolveReferences of this LoadBalancer
+func (mg *LoadBalancer) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.subnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SubnetIDs,
+		References:    mg.Spec.ForProvider.SubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.SubnetIDSelector,
+		To:            reference.To{Managed: &ec2v1beta1.Subnet{}, List: &ec2v1beta1.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SubnetIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.securityGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SecurityGroupIDs,
+		References:    mg.Spec.ForProvider.SecurityGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.SecurityGroupIDSelector,
+		To:            reference.To{Managed: &ec2v1beta1.SecurityGroup{}, List: &ec2v1beta1.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SecurityGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this TargetGroup
+func (mg *TargetGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.vpcId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VPCID),
+		Reference:    mg.Spec.ForProvider.VPCIDRef,
+		Selector:     mg.Spec.ForProvider.VPCIDSelector,
+		To:           reference.To{Managed: &ec2v1beta1.VPC{}, List: &ec2v1beta1.VPCList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VPCIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// resolveActions resolves the target group references of the given actions.
+func resolveActions(ctx context.Context, r *reference.APIResolver, actions []Action) error {
+	for i := range actions {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(actions[i].TargetGroupARN),
+			Reference:    actions[i].TargetGroupARNRef,
+			Selector:     actions[i].TargetGroupARNSelector,
+			To:           reference.To{Managed: &TargetGroup{}, List: &TargetGroupList{}},
+			Extract:      TargetGroupARN(),
+		})
+		if err != nil {
+			return err
+		}
+		actions[i].TargetGroupARN = reference.ToPtrValue(rsp.ResolvedValue)
+		actions[i].TargetGroupARNRef = rsp.ResolvedReference
+	}
+	return nil
+}
+
+// ResolveReferences of this Listener
+func (mg *Listener) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.loadBalancerArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.LoadBalancerARN),
+		Reference:    mg.Spec.ForProvider.LoadBalancerARNRef,
+		Selector:     mg.Spec.ForProvider.LoadBalancerARNSelector,
+		To:           reference.To{Managed: &LoadBalancer{}, List: &LoadBalancerList{}},
+		Extract:      LoadBalancerARN(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.LoadBalancerARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.LoadBalancerARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.certificateArn
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CertificateARN),
+		Reference:    mg.Spec.ForProvider.CertificateARNRef,
+		Selector:     mg.Spec.ForProvider.CertificateARNSelector,
+		To:           reference.To{Managed: &acmv1alpha1.Certificate{}, List: &acmv1alpha1.CertificateList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.CertificateARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CertificateARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.defaultActions[*].targetGroupArn
+	return resolveActions(ctx, r, mg.Spec.ForProvider.DefaultActions)
+}
+
+// ResolveReferences of this ListenerRule
+func (mg *ListenerRule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.listenerArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ListenerARN),
+		Reference:    mg.Spec.ForProvider.ListenerARNRef,
+		Selector:     mg.Spec.ForProvider.ListenerARNSelector,
+		To:           reference.To{Managed: &Listener{}, List: &ListenerList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.ListenerARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ListenerARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.actions[*].targetGroupArn
+	return resolveActions(ctx, r, mg.Spec.ForProvider.Actions)
+}
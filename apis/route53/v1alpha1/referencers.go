@@ -23,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	elbv2 "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
 )
 
 // ResolveReferences of this Zone
@@ -43,6 +44,38 @@ func (mg *ResourceRecordSet) ResolveReferences(ctx context.Context, c client.Rea
 	mg.Spec.ForProvider.ZoneID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.ZoneIDRef = rsp.ResolvedReference
 
+	if mg.Spec.ForProvider.AliasTarget == nil {
+		return nil
+	}
+
+	// Resolve spec.forProvider.aliasTarget.dnsName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.AliasTarget.DNSName,
+		Reference:    mg.Spec.ForProvider.AliasTarget.LoadBalancerRef,
+		Selector:     mg.Spec.ForProvider.AliasTarget.LoadBalancerSelector,
+		To:           reference.To{Managed: &elbv2.LoadBalancer{}, List: &elbv2.LoadBalancerList{}},
+		Extract:      elbv2.LoadBalancerDNSName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.AliasTarget.DNSName = rsp.ResolvedValue
+	mg.Spec.ForProvider.AliasTarget.LoadBalancerRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.aliasTarget.hostedZoneId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.AliasTarget.HostedZoneID,
+		Reference:    mg.Spec.ForProvider.AliasTarget.LoadBalancerRef,
+		Selector:     mg.Spec.ForProvider.AliasTarget.LoadBalancerSelector,
+		To:           reference.To{Managed: &elbv2.LoadBalancer{}, List: &elbv2.LoadBalancerList{}},
+		Extract:      elbv2.LoadBalancerCanonicalHostedZoneID(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.AliasTarget.HostedZoneID = rsp.ResolvedValue
+	mg.Spec.ForProvider.AliasTarget.LoadBalancerRef = rsp.ResolvedReference
+
 	return nil
 }
 
@@ -573,7 +573,8 @@ type AliasTarget struct {
 	// for which the value of Type is CNAME. This is because the alias record must
 	// have the same type as the record that you're routing traffic to, and creating
 	// a CNAME record for the zone apex isn't supported even for an alias record.
-	DNSName string `json:"dnsName"`
+	// +optional
+	DNSName string `json:"dnsName,omitempty"`
 
 	// Applies only to alias, failover alias, geolocation alias, latency alias,
 	// and weighted alias resource record sets: When EvaluateTargetHealth is true,
@@ -723,7 +724,18 @@ type AliasTarget struct {
 	//
 	// Specify the hosted zone ID of your hosted zone. (An alias resource record
 	// set can't reference a resource record set in a different hosted zone.)
-	HostedZoneID string `json:"hostedZoneId"`
+	// +optional
+	HostedZoneID string `json:"hostedZoneId,omitempty"`
+
+	// LoadBalancerRef references an Application or Network LoadBalancer to
+	// retrieve its DNSName and CanonicalHostedZoneID.
+	// +optional
+	LoadBalancerRef *runtimev1alpha1.Reference `json:"loadBalancerRef,omitempty"`
+
+	// LoadBalancerSelector selects a reference to an Application or Network
+	// LoadBalancer to retrieve its DNSName and CanonicalHostedZoneID.
+	// +optional
+	LoadBalancerSelector *runtimev1alpha1.Selector `json:"loadBalancerSelector,omitempty"`
 }
 
 // GeoLocation lets you control how Amazon Route 53 responds to DNS queries
@@ -28,6 +28,16 @@ import (
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AliasTarget) DeepCopyInto(out *AliasTarget) {
 	*out = *in
+	if in.LoadBalancerRef != nil {
+		in, out := &in.LoadBalancerRef, &out.LoadBalancerRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.LoadBalancerSelector != nil {
+		in, out := &in.LoadBalancerSelector, &out.LoadBalancerSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliasTarget.
@@ -371,7 +381,7 @@ func (in *ResourceRecordSetParameters) DeepCopyInto(out *ResourceRecordSetParame
 	if in.AliasTarget != nil {
 		in, out := &in.AliasTarget, &out.AliasTarget
 		*out = new(AliasTarget)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.GeoLocation != nil {
 		in, out := &in.GeoLocation, &out.GeoLocation
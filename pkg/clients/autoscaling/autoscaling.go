@@ -0,0 +1,579 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/autoscaling/v1alpha1"
+	clients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// ResourceTypeAutoScalingGroup is the resource type of tags that are
+	// attached to an Auto Scaling Group.
+	ResourceTypeAutoScalingGroup = "auto-scaling-group"
+
+	// StatusDeleteInProgress is the status of an Auto Scaling Group that is
+	// being deleted.
+	StatusDeleteInProgress = "Delete in progress"
+
+	// DefaultMinHealthyPercentage is the percentage of the desired capacity
+	// that is kept healthy during an instance refresh if none is specified.
+	DefaultMinHealthyPercentage = 90
+
+	// LaunchTemplateVersionLatest and LaunchTemplateVersionDefault are the
+	// symbolic launch template versions.
+	LaunchTemplateVersionLatest  = "$Latest"
+	LaunchTemplateVersionDefault = "$Default"
+
+	errCodeValidationError = "ValidationError"
+	errMessageNotFound     = "not found"
+)
+
+// A Client handles CRUD operations for Auto Scaling resources. It also
+// describes the launch templates of Auto Scaling Groups.
+type Client interface {
+	autoscalingiface.ClientAPI
+	DescribeLaunchTemplatesRequest(*ec2.DescribeLaunchTemplatesInput) ec2.DescribeLaunchTemplatesRequest
+}
+
+type client struct {
+	autoscalingiface.ClientAPI
+	ec2 *ec2.Client
+}
+
+// DescribeLaunchTemplatesRequest describes launch templates with the EC2 API.
+func (c *client) DescribeLaunchTemplatesRequest(in *ec2.DescribeLaunchTemplatesInput) ec2.DescribeLaunchTemplatesRequest {
+	return c.ec2.DescribeLaunchTemplatesRequest(in)
+}
+
+// NewClient returns a new Auto Scaling client. Credentials must be passed as
+// JSON encoded data.
+func NewClient(ctx context.Context, credentials []byte, region string, auth clients.AuthMethod) (Client, error) {
+	cfg, err := auth(ctx, credentials, clients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return &client{ClientAPI: autoscaling.New(*cfg), ec2: ec2.New(*cfg)}, err
+}
+
+// IsAutoScalingGroupNotFound returns true if the error is because the Auto
+// Scaling Group doesn't exist.
+func IsAutoScalingGroupNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == errCodeValidationError && strings.Contains(awsErr.Message(), errMessageNotFound)
+	}
+	return false
+}
+
+// GenerateLaunchTemplateSpecification returns the launch template
+// specification of the given parameters.
+func GenerateLaunchTemplateSpecification(p v1alpha1.LaunchTemplateSpecification) *autoscaling.LaunchTemplateSpecification {
+	return &autoscaling.LaunchTemplateSpecification{
+		LaunchTemplateId: p.LaunchTemplateID,
+		Version:          p.Version,
+	}
+}
+
+// GenerateTags returns the Auto Scaling tags of the given group.
+func GenerateTags(name string, tags []v1alpha1.Tag) []autoscaling.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]autoscaling.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = autoscaling.Tag{
+			Key:               aws.String(t.Key),
+			Value:             t.Value,
+			PropagateAtLaunch: t.PropagateAtLaunch,
+			ResourceId:        aws.String(name),
+			ResourceType:      aws.String(ResourceTypeAutoScalingGroup),
+		}
+	}
+	return res
+}
+
+// GenerateCreateAutoScalingGroupInput returns the input to create the Auto
+// Scaling Group described by the given parameters.
+func GenerateCreateAutoScalingGroupInput(name string, p v1alpha1.AutoScalingGroupParameters) *autoscaling.CreateAutoScalingGroupInput {
+	in := &autoscaling.CreateAutoScalingGroupInput{
+		AutoScalingGroupName:             aws.String(name),
+		LaunchTemplate:                   GenerateLaunchTemplateSpecification(p.LaunchTemplate),
+		MinSize:                          aws.Int64(p.MinSize),
+		MaxSize:                          aws.Int64(p.MaxSize),
+		DesiredCapacity:                  p.DesiredCapacity,
+		DefaultCooldown:                  p.DefaultCooldown,
+		HealthCheckType:                  p.HealthCheckType,
+		HealthCheckGracePeriod:           p.HealthCheckGracePeriod,
+		AvailabilityZones:                p.AvailabilityZones,
+		TargetGroupARNs:                  p.TargetGroupARNs,
+		TerminationPolicies:              p.TerminationPolicies,
+		MaxInstanceLifetime:              p.MaxInstanceLifetime,
+		NewInstancesProtectedFromScaleIn: p.NewInstancesProtectedFromScaleIn,
+		Tags:                             GenerateTags(name, p.Tags),
+	}
+	if len(p.SubnetIDs) != 0 {
+		in.VPCZoneIdentifier = aws.String(strings.Join(p.SubnetIDs, ","))
+	}
+	return in
+}
+
+// GenerateUpdateAutoScalingGroupInput returns the input to bring the Auto
+// Scaling Group to the state described by the given parameters.
+func GenerateUpdateAutoScalingGroupInput(name string, p v1alpha1.AutoScalingGroupParameters) *autoscaling.UpdateAutoScalingGroupInput {
+	in := &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName:             aws.String(name),
+		LaunchTemplate:                   GenerateLaunchTemplateSpecification(p.LaunchTemplate),
+		MinSize:                          aws.Int64(p.MinSize),
+		MaxSize:                          aws.Int64(p.MaxSize),
+		DesiredCapacity:                  p.DesiredCapacity,
+		DefaultCooldown:                  p.DefaultCooldown,
+		HealthCheckType:                  p.HealthCheckType,
+		HealthCheckGracePeriod:           p.HealthCheckGracePeriod,
+		AvailabilityZones:                p.AvailabilityZones,
+		TerminationPolicies:              p.TerminationPolicies,
+		MaxInstanceLifetime:              p.MaxInstanceLifetime,
+		NewInstancesProtectedFromScaleIn: p.NewInstancesProtectedFromScaleIn,
+	}
+	if len(p.SubnetIDs) != 0 {
+		in.VPCZoneIdentifier = aws.String(strings.Join(p.SubnetIDs, ","))
+	}
+	return in
+}
+
+// GetSubnetIDs returns the IDs of the subnets of the Auto Scaling Group.
+func GetSubnetIDs(g autoscaling.AutoScalingGroup) []string {
+	if aws.StringValue(g.VPCZoneIdentifier) == "" {
+		return nil
+	}
+	return strings.Split(aws.StringValue(g.VPCZoneIdentifier), ",")
+}
+
+// LateInitializeAutoScalingGroup fills the empty fields in
+// *v1alpha1.AutoScalingGroupParameters with the values seen in
+// autoscaling.AutoScalingGroup. The desired capacity is not late initialized
+// as it may be changed by scaling policies.
+func LateInitializeAutoScalingGroup(in *v1alpha1.AutoScalingGroupParameters, g *autoscaling.AutoScalingGroup) { // nolint:gocyclo
+	if g == nil {
+		return
+	}
+
+	if g.LaunchTemplate != nil {
+		in.LaunchTemplate.LaunchTemplateID = clients.LateInitializeStringPtr(in.LaunchTemplate.LaunchTemplateID, g.LaunchTemplate.LaunchTemplateId)
+		in.LaunchTemplate.Version = clients.LateInitializeStringPtr(in.LaunchTemplate.Version, g.LaunchTemplate.Version)
+	}
+	in.DefaultCooldown = clients.LateInitializeInt64Ptr(in.DefaultCooldown, g.DefaultCooldown)
+	in.HealthCheckType = clients.LateInitializeStringPtr(in.HealthCheckType, g.HealthCheckType)
+	in.HealthCheckGracePeriod = clients.LateInitializeInt64Ptr(in.HealthCheckGracePeriod, g.HealthCheckGracePeriod)
+	in.MaxInstanceLifetime = clients.LateInitializeInt64Ptr(in.MaxInstanceLifetime, g.MaxInstanceLifetime)
+	in.NewInstancesProtectedFromScaleIn = clients.LateInitializeBoolPtr(in.NewInstancesProtectedFromScaleIn, g.NewInstancesProtectedFromScaleIn)
+
+	if len(in.SubnetIDs) == 0 {
+		in.SubnetIDs = GetSubnetIDs(*g)
+	}
+	// Availability Zones are derived from the subnets if there are any.
+	if len(in.AvailabilityZones) == 0 && len(in.SubnetIDs) == 0 && len(g.AvailabilityZones) != 0 {
+		in.AvailabilityZones = g.AvailabilityZones
+	}
+	if len(in.TargetGroupARNs) == 0 && len(g.TargetGroupARNs) != 0 {
+		in.TargetGroupARNs = g.TargetGroupARNs
+	}
+	if len(in.TerminationPolicies) == 0 && len(g.TerminationPolicies) != 0 {
+		in.TerminationPolicies = g.TerminationPolicies
+	}
+	if len(in.Tags) == 0 && len(g.Tags) != 0 {
+		in.Tags = BuildFromTagDescriptions(g.Tags)
+	}
+}
+
+// BuildFromTagDescriptions returns the tags of an Auto Scaling Group.
+func BuildFromTagDescriptions(tags []autoscaling.TagDescription) []v1alpha1.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]v1alpha1.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = v1alpha1.Tag{
+			Key:               aws.StringValue(t.Key),
+			Value:             t.Value,
+			PropagateAtLaunch: t.PropagateAtLaunch,
+		}
+	}
+	return res
+}
+
+// DiffTags returns the tags that have to be created or updated and the tags
+// that have to be deleted to bring the observed tags to the desired state.
+func DiffTags(name string, desired []v1alpha1.Tag, observed []autoscaling.TagDescription) (update, remove []autoscaling.Tag) {
+	o := make(map[string]autoscaling.TagDescription, len(observed))
+	for _, t := range observed {
+		o[aws.StringValue(t.Key)] = t
+	}
+	d := make(map[string]bool, len(desired))
+	for _, t := range desired {
+		d[t.Key] = true
+		ot, ok := o[t.Key]
+		if ok && aws.StringValue(ot.Value) == aws.StringValue(t.Value) &&
+			aws.BoolValue(ot.PropagateAtLaunch) == aws.BoolValue(t.PropagateAtLaunch) {
+			continue
+		}
+		update = append(update, GenerateTags(name, []v1alpha1.Tag{t})...)
+	}
+	for _, t := range observed {
+		if d[aws.StringValue(t.Key)] {
+			continue
+		}
+		remove = append(remove, autoscaling.Tag{
+			Key:          t.Key,
+			ResourceId:   aws.String(name),
+			ResourceType: aws.String(ResourceTypeAutoScalingGroup),
+		})
+	}
+	return update, remove
+}
+
+// DiffStrings returns the elements that are only in desired and the elements
+// that are only in observed, both sorted.
+func DiffStrings(desired, observed []string) (add, remove []string) {
+	o := map[string]bool{}
+	for _, s := range observed {
+		o[s] = true
+	}
+	d := map[string]bool{}
+	for _, s := range desired {
+		d[s] = true
+		if !o[s] {
+			add = append(add, s)
+		}
+	}
+	for _, s := range observed {
+		if !d[s] {
+			remove = append(remove, s)
+		}
+	}
+	sort.Strings(add)
+	sort.Strings(remove)
+	return add, remove
+}
+
+// IsAutoScalingGroupConfigUpToDate returns true if the configuration that is
+// changed through UpdateAutoScalingGroup is up to date.
+func IsAutoScalingGroupConfigUpToDate(p v1alpha1.AutoScalingGroupParameters, g autoscaling.AutoScalingGroup) bool { // nolint:gocyclo
+	if g.LaunchTemplate == nil ||
+		aws.StringValue(p.LaunchTemplate.LaunchTemplateID) != aws.StringValue(g.LaunchTemplate.LaunchTemplateId) ||
+		(p.LaunchTemplate.Version != nil && aws.StringValue(p.LaunchTemplate.Version) != aws.StringValue(g.LaunchTemplate.Version)) {
+		return false
+	}
+	if p.MinSize != aws.Int64Value(g.MinSize) || p.MaxSize != aws.Int64Value(g.MaxSize) {
+		return false
+	}
+	if p.DesiredCapacity != nil && aws.Int64Value(p.DesiredCapacity) != aws.Int64Value(g.DesiredCapacity) {
+		return false
+	}
+	if p.DefaultCooldown != nil && aws.Int64Value(p.DefaultCooldown) != aws.Int64Value(g.DefaultCooldown) {
+		return false
+	}
+	if p.HealthCheckType != nil && aws.StringValue(p.HealthCheckType) != aws.StringValue(g.HealthCheckType) {
+		return false
+	}
+	if p.HealthCheckGracePeriod != nil && aws.Int64Value(p.HealthCheckGracePeriod) != aws.Int64Value(g.HealthCheckGracePeriod) {
+		return false
+	}
+	if p.MaxInstanceLifetime != nil && aws.Int64Value(p.MaxInstanceLifetime) != aws.Int64Value(g.MaxInstanceLifetime) {
+		return false
+	}
+	if p.NewInstancesProtectedFromScaleIn != nil && aws.BoolValue(p.NewInstancesProtectedFromScaleIn) != aws.BoolValue(g.NewInstancesProtectedFromScaleIn) {
+		return false
+	}
+	if len(p.TerminationPolicies) != 0 && !cmp.Equal(p.TerminationPolicies, g.TerminationPolicies) {
+		return false
+	}
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	if len(p.SubnetIDs) != 0 && !cmp.Equal(p.SubnetIDs, GetSubnetIDs(g), sortStrings) {
+		return false
+	}
+	if len(p.AvailabilityZones) != 0 && !cmp.Equal(p.AvailabilityZones, g.AvailabilityZones, sortStrings) {
+		return false
+	}
+	return true
+}
+
+// IsAutoScalingGroupUpToDate returns true if there is no update-able
+// difference between desired and observed state of the group, except for its
+// scaling policies and instances.
+func IsAutoScalingGroupUpToDate(p v1alpha1.AutoScalingGroupParameters, g autoscaling.AutoScalingGroup) bool {
+	if !IsAutoScalingGroupConfigUpToDate(p, g) {
+		return false
+	}
+	if attach, detach := DiffStrings(p.TargetGroupARNs, g.TargetGroupARNs); len(attach)+len(detach) != 0 {
+		return false
+	}
+	update, remove := DiffTags(aws.StringValue(g.AutoScalingGroupName), p.Tags, g.Tags)
+	return len(update)+len(remove) == 0
+}
+
+// GeneratePutScalingPolicyInput returns the input to create or update the
+// given scaling policy of an Auto Scaling Group.
+func GeneratePutScalingPolicyInput(name string, p v1alpha1.ScalingPolicy) *autoscaling.PutScalingPolicyInput {
+	in := &autoscaling.PutScalingPolicyInput{
+		AutoScalingGroupName:    aws.String(name),
+		PolicyName:              aws.String(p.PolicyName),
+		PolicyType:              aws.String(p.PolicyType),
+		AdjustmentType:          p.AdjustmentType,
+		ScalingAdjustment:       p.ScalingAdjustment,
+		Cooldown:                p.Cooldown,
+		MinAdjustmentMagnitude:  p.MinAdjustmentMagnitude,
+		MetricAggregationType:   p.MetricAggregationType,
+		EstimatedInstanceWarmup: p.EstimatedInstanceWarmup,
+	}
+	if len(p.StepAdjustments) != 0 {
+		in.StepAdjustments = make([]autoscaling.StepAdjustment, len(p.StepAdjustments))
+		for i, s := range p.StepAdjustments {
+			in.StepAdjustments[i] = autoscaling.StepAdjustment{
+				MetricIntervalLowerBound: int64ToFloat64Ptr(s.MetricIntervalLowerBound),
+				MetricIntervalUpperBound: int64ToFloat64Ptr(s.MetricIntervalUpperBound),
+				ScalingAdjustment:        aws.Int64(s.ScalingAdjustment),
+			}
+		}
+	}
+	if t := p.TargetTrackingConfiguration; t != nil {
+		in.TargetTrackingConfiguration = &autoscaling.TargetTrackingConfiguration{
+			PredefinedMetricSpecification: &autoscaling.PredefinedMetricSpecification{
+				PredefinedMetricType: autoscaling.MetricType(t.PredefinedMetricType),
+				ResourceLabel:        t.ResourceLabel,
+			},
+			TargetValue:    aws.Float64(float64(t.TargetValue)),
+			DisableScaleIn: t.DisableScaleIn,
+		}
+	}
+	return in
+}
+
+func int64ToFloat64Ptr(v *int64) *float64 {
+	if v == nil {
+		return nil
+	}
+	return aws.Float64(float64(*v))
+}
+
+// IsScalingPolicyUpToDate returns true if the observed scaling policy matches
+// the desired one.
+func IsScalingPolicyUpToDate(p v1alpha1.ScalingPolicy, o autoscaling.ScalingPolicy) bool { // nolint:gocyclo
+	if p.PolicyType != aws.StringValue(o.PolicyType) {
+		return false
+	}
+	if p.AdjustmentType != nil && aws.StringValue(p.AdjustmentType) != aws.StringValue(o.AdjustmentType) {
+		return false
+	}
+	if p.ScalingAdjustment != nil && aws.Int64Value(p.ScalingAdjustment) != aws.Int64Value(o.ScalingAdjustment) {
+		return false
+	}
+	if p.Cooldown != nil && aws.Int64Value(p.Cooldown) != aws.Int64Value(o.Cooldown) {
+		return false
+	}
+	if p.MinAdjustmentMagnitude != nil && aws.Int64Value(p.MinAdjustmentMagnitude) != aws.Int64Value(o.MinAdjustmentMagnitude) {
+		return false
+	}
+	if p.MetricAggregationType != nil && aws.StringValue(p.MetricAggregationType) != aws.StringValue(o.MetricAggregationType) {
+		return false
+	}
+	if p.EstimatedInstanceWarmup != nil && aws.Int64Value(p.EstimatedInstanceWarmup) != aws.Int64Value(o.EstimatedInstanceWarmup) {
+		return false
+	}
+	if len(p.StepAdjustments) != 0 && !areStepAdjustmentsUpToDate(p.StepAdjustments, o.StepAdjustments) {
+		return false
+	}
+	if p.TargetTrackingConfiguration != nil && !isTargetTrackingConfigurationUpToDate(*p.TargetTrackingConfiguration, o.TargetTrackingConfiguration) {
+		return false
+	}
+	return true
+}
+
+func areStepAdjustmentsUpToDate(p []v1alpha1.StepAdjustment, o []autoscaling.StepAdjustment) bool {
+	if len(p) != len(o) {
+		return false
+	}
+	for i := range p {
+		if !cmp.Equal(int64ToFloat64Ptr(p[i].MetricIntervalLowerBound), o[i].MetricIntervalLowerBound) ||
+			!cmp.Equal(int64ToFloat64Ptr(p[i].MetricIntervalUpperBound), o[i].MetricIntervalUpperBound) ||
+			p[i].ScalingAdjustment != aws.Int64Value(o[i].ScalingAdjustment) {
+			return false
+		}
+	}
+	return true
+}
+
+func isTargetTrackingConfigurationUpToDate(p v1alpha1.TargetTrackingConfiguration, o *autoscaling.TargetTrackingConfiguration) bool {
+	if o == nil || o.PredefinedMetricSpecification == nil {
+		return false
+	}
+	if p.PredefinedMetricType != string(o.PredefinedMetricSpecification.PredefinedMetricType) ||
+		aws.StringValue(p.ResourceLabel) != aws.StringValue(o.PredefinedMetricSpecification.ResourceLabel) {
+		return false
+	}
+	if float64(p.TargetValue) != aws.Float64Value(o.TargetValue) {
+		return false
+	}
+	return aws.BoolValue(p.DisableScaleIn) == aws.BoolValue(o.DisableScaleIn)
+}
+
+// DiffScalingPolicies returns the scaling policies that have to be created or
+// updated and the names of the policies that have to be deleted to bring the
+// observed policies to the desired state.
+func DiffScalingPolicies(desired []v1alpha1.ScalingPolicy, observed []autoscaling.ScalingPolicy) (put []v1alpha1.ScalingPolicy, remove []string) {
+	o := make(map[string]autoscaling.ScalingPolicy, len(observed))
+	for _, sp := range observed {
+		o[aws.StringValue(sp.PolicyName)] = sp
+	}
+	d := make(map[string]bool, len(desired))
+	for _, sp := range desired {
+		d[sp.PolicyName] = true
+		if osp, ok := o[sp.PolicyName]; ok && IsScalingPolicyUpToDate(sp, osp) {
+			continue
+		}
+		put = append(put, sp)
+	}
+	for _, sp := range observed {
+		if !d[aws.StringValue(sp.PolicyName)] {
+			remove = append(remove, aws.StringValue(sp.PolicyName))
+		}
+	}
+	return put, remove
+}
+
+// ResolveLaunchTemplateVersion returns the version number the given version
+// of the launch template refers to.
+func ResolveLaunchTemplateVersion(version string, lt *ec2.LaunchTemplate) string {
+	if lt == nil {
+		return version
+	}
+	switch version {
+	case LaunchTemplateVersionLatest:
+		return strconv.FormatInt(aws.Int64Value(lt.LatestVersionNumber), 10)
+	case LaunchTemplateVersionDefault:
+		return strconv.FormatInt(aws.Int64Value(lt.DefaultVersionNumber), 10)
+	}
+	return version
+}
+
+func isTerminating(i autoscaling.Instance) bool {
+	return strings.HasPrefix(string(i.LifecycleState), "Terminating") || i.LifecycleState == autoscaling.LifecycleStateTerminated
+}
+
+// GetOutdatedInstances returns the IDs of the instances of the Auto Scaling
+// Group that were not launched with the launch template version of the group.
+// Instances that are already terminating are ignored.
+func GetOutdatedInstances(g autoscaling.AutoScalingGroup, lt *ec2.LaunchTemplate) []string {
+	if g.LaunchTemplate == nil {
+		return nil
+	}
+	id := aws.StringValue(g.LaunchTemplate.LaunchTemplateId)
+	version := ResolveLaunchTemplateVersion(aws.StringValue(g.LaunchTemplate.Version), lt)
+
+	var outdated []string
+	for _, i := range g.Instances {
+		if isTerminating(i) {
+			continue
+		}
+		if i.LaunchTemplate == nil || aws.StringValue(i.LaunchTemplate.LaunchTemplateId) != id ||
+			ResolveLaunchTemplateVersion(aws.StringValue(i.LaunchTemplate.Version), lt) != version {
+			outdated = append(outdated, aws.StringValue(i.InstanceId))
+		}
+	}
+	return outdated
+}
+
+// GetInstancesToRefresh returns the outdated instances that can be replaced
+// now without the number of healthy instances in service dropping below the
+// minimum healthy percentage of the desired capacity. Nothing is replaced
+// while any instance of the group is not healthy and in service, so that
+// instances are replaced in batches.
+func GetInstancesToRefresh(g autoscaling.AutoScalingGroup, outdated []string, r v1alpha1.InstanceRefresh) []string {
+	if len(outdated) == 0 {
+		return nil
+	}
+	for _, i := range g.Instances {
+		if i.LifecycleState != autoscaling.LifecycleStateInService || aws.StringValue(i.HealthStatus) != "Healthy" {
+			return nil
+		}
+	}
+	desired := aws.Int64Value(g.DesiredCapacity)
+	if int64(len(g.Instances)) < desired {
+		return nil
+	}
+
+	minHealthy := int64(DefaultMinHealthyPercentage)
+	if r.MinHealthyPercentage != nil {
+		minHealthy = *r.MinHealthyPercentage
+	}
+	// Round up so that at least the minimum healthy percentage is kept.
+	n := int64(len(g.Instances)) - (desired*minHealthy+99)/100
+	if n < 1 {
+		n = 1
+	}
+	if n > int64(len(outdated)) {
+		n = int64(len(outdated))
+	}
+	return outdated[:n]
+}
+
+// GenerateAutoScalingGroupObservation is used to produce
+// v1alpha1.AutoScalingGroupObservation from autoscaling.AutoScalingGroup.
+func GenerateAutoScalingGroupObservation(g autoscaling.AutoScalingGroup, policies []autoscaling.ScalingPolicy, outdated int) v1alpha1.AutoScalingGroupObservation {
+	o := v1alpha1.AutoScalingGroupObservation{
+		AutoScalingGroupARN: aws.StringValue(g.AutoScalingGroupARN),
+		Status:              aws.StringValue(g.Status),
+		DesiredCapacity:     aws.Int64Value(g.DesiredCapacity),
+		OutdatedInstances:   int64(outdated),
+	}
+	if len(g.Instances) != 0 {
+		o.Instances = make([]v1alpha1.Instance, len(g.Instances))
+		for i, in := range g.Instances {
+			o.Instances[i] = v1alpha1.Instance{
+				InstanceID:       aws.StringValue(in.InstanceId),
+				AvailabilityZone: aws.StringValue(in.AvailabilityZone),
+				HealthStatus:     aws.StringValue(in.HealthStatus),
+				LifecycleState:   string(in.LifecycleState),
+			}
+			if in.LaunchTemplate != nil {
+				o.Instances[i].LaunchTemplateVersion = aws.StringValue(in.LaunchTemplate.Version)
+			}
+		}
+	}
+	if len(policies) != 0 {
+		o.ScalingPolicies = make([]v1alpha1.ScalingPolicyObservation, len(policies))
+		for i, sp := range policies {
+			o.ScalingPolicies[i] = v1alpha1.ScalingPolicyObservation{
+				PolicyName: aws.StringValue(sp.PolicyName),
+				PolicyARN:  aws.StringValue(sp.PolicyARN),
+			}
+		}
+	}
+	return o
+}
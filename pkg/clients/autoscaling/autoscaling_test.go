@@ -0,0 +1,271 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/autoscaling/v1alpha1"
+)
+
+var (
+	asgName    = "some-asg"
+	templateID = "lt-0123"
+)
+
+func instance(id, version string, state autoscaling.LifecycleState, health string) autoscaling.Instance {
+	return autoscaling.Instance{
+		InstanceId:     aws.String(id),
+		LifecycleState: state,
+		HealthStatus:   aws.String(health),
+		LaunchTemplate: &autoscaling.LaunchTemplateSpecification{
+			LaunchTemplateId: aws.String(templateID),
+			Version:          aws.String(version),
+		},
+	}
+}
+
+func TestGetOutdatedInstances(t *testing.T) {
+	type args struct {
+		g  autoscaling.AutoScalingGroup
+		lt *ec2.LaunchTemplate
+	}
+
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"NumericVersion": {
+			args: args{
+				g: autoscaling.AutoScalingGroup{
+					LaunchTemplate: &autoscaling.LaunchTemplateSpecification{LaunchTemplateId: aws.String(templateID), Version: aws.String("2")},
+					Instances: []autoscaling.Instance{
+						instance("i-1", "1", autoscaling.LifecycleStateInService, "Healthy"),
+						instance("i-2", "2", autoscaling.LifecycleStateInService, "Healthy"),
+						instance("i-3", "1", autoscaling.LifecycleStateTerminatingWait, "Healthy"),
+					},
+				},
+			},
+			want: []string{"i-1"},
+		},
+		"LatestVersion": {
+			args: args{
+				g: autoscaling.AutoScalingGroup{
+					LaunchTemplate: &autoscaling.LaunchTemplateSpecification{LaunchTemplateId: aws.String(templateID), Version: aws.String(LaunchTemplateVersionLatest)},
+					Instances: []autoscaling.Instance{
+						instance("i-1", "1", autoscaling.LifecycleStateInService, "Healthy"),
+						instance("i-2", "3", autoscaling.LifecycleStateInService, "Healthy"),
+						instance("i-3", LaunchTemplateVersionLatest, autoscaling.LifecycleStateInService, "Healthy"),
+					},
+				},
+				lt: &ec2.LaunchTemplate{LatestVersionNumber: aws.Int64(3), DefaultVersionNumber: aws.Int64(1)},
+			},
+			want: []string{"i-1"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetOutdatedInstances(tc.args.g, tc.args.lt)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetInstancesToRefresh(t *testing.T) {
+	type args struct {
+		g        autoscaling.AutoScalingGroup
+		outdated []string
+		r        v1alpha1.InstanceRefresh
+	}
+
+	healthy := func(ids ...string) []autoscaling.Instance {
+		res := make([]autoscaling.Instance, len(ids))
+		for i, id := range ids {
+			res[i] = instance(id, "1", autoscaling.LifecycleStateInService, "Healthy")
+		}
+		return res
+	}
+
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"AtLeastOne": {
+			args: args{
+				g:        autoscaling.AutoScalingGroup{DesiredCapacity: aws.Int64(3), Instances: healthy("i-1", "i-2", "i-3")},
+				outdated: []string{"i-1", "i-2", "i-3"},
+			},
+			want: []string{"i-1"},
+		},
+		"MinHealthyPercentage": {
+			args: args{
+				g:        autoscaling.AutoScalingGroup{DesiredCapacity: aws.Int64(4), Instances: healthy("i-1", "i-2", "i-3", "i-4")},
+				outdated: []string{"i-1", "i-2", "i-3", "i-4"},
+				r:        v1alpha1.InstanceRefresh{MinHealthyPercentage: aws.Int64(50)},
+			},
+			want: []string{"i-1", "i-2"},
+		},
+		"WaitForReplacement": {
+			args: args{
+				g: autoscaling.AutoScalingGroup{DesiredCapacity: aws.Int64(2), Instances: []autoscaling.Instance{
+					instance("i-1", "1", autoscaling.LifecycleStateInService, "Healthy"),
+					instance("i-2", "2", autoscaling.LifecycleStatePending, "Healthy"),
+				}},
+				outdated: []string{"i-1"},
+			},
+		},
+		"BelowDesiredCapacity": {
+			args: args{
+				g:        autoscaling.AutoScalingGroup{DesiredCapacity: aws.Int64(2), Instances: healthy("i-1")},
+				outdated: []string{"i-1"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetInstancesToRefresh(tc.args.g, tc.args.outdated, tc.args.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		update []autoscaling.Tag
+		remove []autoscaling.Tag
+	}
+
+	cases := map[string]struct {
+		desired  []v1alpha1.Tag
+		observed []autoscaling.TagDescription
+		want     want
+	}{
+		"UpToDate": {
+			desired:  []v1alpha1.Tag{{Key: "k", Value: aws.String("v"), PropagateAtLaunch: aws.Bool(true)}},
+			observed: []autoscaling.TagDescription{{Key: aws.String("k"), Value: aws.String("v"), PropagateAtLaunch: aws.Bool(true)}},
+		},
+		"PropagateAtLaunchChanged": {
+			desired: []v1alpha1.Tag{{Key: "k", Value: aws.String("v"), PropagateAtLaunch: aws.Bool(true)}},
+			observed: []autoscaling.TagDescription{
+				{Key: aws.String("k"), Value: aws.String("v"), PropagateAtLaunch: aws.Bool(false)},
+				{Key: aws.String("old"), Value: aws.String("v")},
+			},
+			want: want{
+				update: []autoscaling.Tag{{
+					Key:               aws.String("k"),
+					Value:             aws.String("v"),
+					PropagateAtLaunch: aws.Bool(true),
+					ResourceId:        aws.String(asgName),
+					ResourceType:      aws.String(ResourceTypeAutoScalingGroup),
+				}},
+				remove: []autoscaling.Tag{{
+					Key:          aws.String("old"),
+					ResourceId:   aws.String(asgName),
+					ResourceType: aws.String(ResourceTypeAutoScalingGroup),
+				}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			update, remove := DiffTags(asgName, tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.update, update); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffScalingPolicies(t *testing.T) {
+	target := v1alpha1.ScalingPolicy{
+		PolicyName: "cpu",
+		PolicyType: "TargetTrackingScaling",
+		TargetTrackingConfiguration: &v1alpha1.TargetTrackingConfiguration{
+			PredefinedMetricType: "ASGAverageCPUUtilization",
+			TargetValue:          50,
+		},
+	}
+	observedTarget := autoscaling.ScalingPolicy{
+		PolicyName: aws.String("cpu"),
+		PolicyType: aws.String("TargetTrackingScaling"),
+		TargetTrackingConfiguration: &autoscaling.TargetTrackingConfiguration{
+			PredefinedMetricSpecification: &autoscaling.PredefinedMetricSpecification{PredefinedMetricType: autoscaling.MetricTypeAsgaverageCpuutilization},
+			TargetValue:                   aws.Float64(50),
+			DisableScaleIn:                aws.Bool(false),
+		},
+	}
+	type want struct {
+		put    []v1alpha1.ScalingPolicy
+		remove []string
+	}
+
+	cases := map[string]struct {
+		desired  []v1alpha1.ScalingPolicy
+		observed []autoscaling.ScalingPolicy
+		want     want
+	}{
+		"UpToDate": {
+			desired:  []v1alpha1.ScalingPolicy{target},
+			observed: []autoscaling.ScalingPolicy{observedTarget},
+		},
+		"TargetChangedAndStale": {
+			desired: []v1alpha1.ScalingPolicy{target},
+			observed: []autoscaling.ScalingPolicy{
+				{
+					PolicyName: aws.String("cpu"),
+					PolicyType: aws.String("TargetTrackingScaling"),
+					TargetTrackingConfiguration: &autoscaling.TargetTrackingConfiguration{
+						PredefinedMetricSpecification: &autoscaling.PredefinedMetricSpecification{PredefinedMetricType: autoscaling.MetricTypeAsgaverageCpuutilization},
+						TargetValue:                   aws.Float64(70),
+					},
+				},
+				{PolicyName: aws.String("stale"), PolicyType: aws.String("SimpleScaling")},
+			},
+			want: want{
+				put:    []v1alpha1.ScalingPolicy{target},
+				remove: []string{"stale"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			put, remove := DiffScalingPolicies(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.put, put); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
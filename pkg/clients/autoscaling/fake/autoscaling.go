@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/autoscaling"
+)
+
+var _ clientset.Client = &MockClient{}
+
+// MockClient is a fake implementation of autoscaling.Client.
+type MockClient struct {
+	autoscalingiface.ClientAPI
+
+	MockDescribeAutoScalingGroupsRequest           func(*autoscaling.DescribeAutoScalingGroupsInput) autoscaling.DescribeAutoScalingGroupsRequest
+	MockCreateAutoScalingGroupRequest              func(*autoscaling.CreateAutoScalingGroupInput) autoscaling.CreateAutoScalingGroupRequest
+	MockUpdateAutoScalingGroupRequest              func(*autoscaling.UpdateAutoScalingGroupInput) autoscaling.UpdateAutoScalingGroupRequest
+	MockDeleteAutoScalingGroupRequest              func(*autoscaling.DeleteAutoScalingGroupInput) autoscaling.DeleteAutoScalingGroupRequest
+	MockAttachLoadBalancerTargetGroupsRequest      func(*autoscaling.AttachLoadBalancerTargetGroupsInput) autoscaling.AttachLoadBalancerTargetGroupsRequest
+	MockDetachLoadBalancerTargetGroupsRequest      func(*autoscaling.DetachLoadBalancerTargetGroupsInput) autoscaling.DetachLoadBalancerTargetGroupsRequest
+	MockCreateOrUpdateTagsRequest                  func(*autoscaling.CreateOrUpdateTagsInput) autoscaling.CreateOrUpdateTagsRequest
+	MockDeleteTagsRequest                          func(*autoscaling.DeleteTagsInput) autoscaling.DeleteTagsRequest
+	MockDescribePoliciesRequest                    func(*autoscaling.DescribePoliciesInput) autoscaling.DescribePoliciesRequest
+	MockPutScalingPolicyRequest                    func(*autoscaling.PutScalingPolicyInput) autoscaling.PutScalingPolicyRequest
+	MockDeletePolicyRequest                        func(*autoscaling.DeletePolicyInput) autoscaling.DeletePolicyRequest
+	MockTerminateInstanceInAutoScalingGroupRequest func(*autoscaling.TerminateInstanceInAutoScalingGroupInput) autoscaling.TerminateInstanceInAutoScalingGroupRequest
+	MockDescribeLaunchTemplatesRequest             func(*ec2.DescribeLaunchTemplatesInput) ec2.DescribeLaunchTemplatesRequest
+}
+
+// DescribeAutoScalingGroupsRequest calls the underlying
+// MockDescribeAutoScalingGroupsRequest method.
+func (c *MockClient) DescribeAutoScalingGroupsRequest(i *autoscaling.DescribeAutoScalingGroupsInput) autoscaling.DescribeAutoScalingGroupsRequest {
+	return c.MockDescribeAutoScalingGroupsRequest(i)
+}
+
+// CreateAutoScalingGroupRequest calls the underlying
+// MockCreateAutoScalingGroupRequest method.
+func (c *MockClient) CreateAutoScalingGroupRequest(i *autoscaling.CreateAutoScalingGroupInput) autoscaling.CreateAutoScalingGroupRequest {
+	return c.MockCreateAutoScalingGroupRequest(i)
+}
+
+// UpdateAutoScalingGroupRequest calls the underlying
+// MockUpdateAutoScalingGroupRequest method.
+func (c *MockClient) UpdateAutoScalingGroupRequest(i *autoscaling.UpdateAutoScalingGroupInput) autoscaling.UpdateAutoScalingGroupRequest {
+	return c.MockUpdateAutoScalingGroupRequest(i)
+}
+
+// DeleteAutoScalingGroupRequest calls the underlying
+// MockDeleteAutoScalingGroupRequest method.
+func (c *MockClient) DeleteAutoScalingGroupRequest(i *autoscaling.DeleteAutoScalingGroupInput) autoscaling.DeleteAutoScalingGroupRequest {
+	return c.MockDeleteAutoScalingGroupRequest(i)
+}
+
+// AttachLoadBalancerTargetGroupsRequest calls the underlying
+// MockAttachLoadBalancerTargetGroupsRequest method.
+func (c *MockClient) AttachLoadBalancerTargetGroupsRequest(i *autoscaling.AttachLoadBalancerTargetGroupsInput) autoscaling.AttachLoadBalancerTargetGroupsRequest {
+	return c.MockAttachLoadBalancerTargetGroupsRequest(i)
+}
+
+// DetachLoadBalancerTargetGroupsRequest calls the underlying
+// MockDetachLoadBalancerTargetGroupsRequest method.
+func (c *MockClient) DetachLoadBalancerTargetGroupsRequest(i *autoscaling.DetachLoadBalancerTargetGroupsInput) autoscaling.DetachLoadBalancerTargetGroupsRequest {
+	return c.MockDetachLoadBalancerTargetGroupsRequest(i)
+}
+
+// CreateOrUpdateTagsRequest calls the underlying
+// MockCreateOrUpdateTagsRequest method.
+func (c *MockClient) CreateOrUpdateTagsRequest(i *autoscaling.CreateOrUpdateTagsInput) autoscaling.CreateOrUpdateTagsRequest {
+	return c.MockCreateOrUpdateTagsRequest(i)
+}
+
+// DeleteTagsRequest calls the underlying
+// MockDeleteTagsRequest method.
+func (c *MockClient) DeleteTagsRequest(i *autoscaling.DeleteTagsInput) autoscaling.DeleteTagsRequest {
+	return c.MockDeleteTagsRequest(i)
+}
+
+// DescribePoliciesRequest calls the underlying
+// MockDescribePoliciesRequest method.
+func (c *MockClient) DescribePoliciesRequest(i *autoscaling.DescribePoliciesInput) autoscaling.DescribePoliciesRequest {
+	return c.MockDescribePoliciesRequest(i)
+}
+
+// PutScalingPolicyRequest calls the underlying
+// MockPutScalingPolicyRequest method.
+func (c *MockClient) PutScalingPolicyRequest(i *autoscaling.PutScalingPolicyInput) autoscaling.PutScalingPolicyRequest {
+	return c.MockPutScalingPolicyRequest(i)
+}
+
+// DeletePolicyRequest calls the underlying
+// MockDeletePolicyRequest method.
+func (c *MockClient) DeletePolicyRequest(i *autoscaling.DeletePolicyInput) autoscaling.DeletePolicyRequest {
+	return c.MockDeletePolicyRequest(i)
+}
+
+// TerminateInstanceInAutoScalingGroupRequest calls the underlying
+// MockTerminateInstanceInAutoScalingGroupRequest method.
+func (c *MockClient) TerminateInstanceInAutoScalingGroupRequest(i *autoscaling.TerminateInstanceInAutoScalingGroupInput) autoscaling.TerminateInstanceInAutoScalingGroupRequest {
+	return c.MockTerminateInstanceInAutoScalingGroupRequest(i)
+}
+
+// DescribeLaunchTemplatesRequest calls the underlying
+// MockDescribeLaunchTemplatesRequest method.
+func (c *MockClient) DescribeLaunchTemplatesRequest(i *ec2.DescribeLaunchTemplatesInput) ec2.DescribeLaunchTemplatesRequest {
+	return c.MockDescribeLaunchTemplatesRequest(i)
+}
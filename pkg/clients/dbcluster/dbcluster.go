@@ -0,0 +1,317 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbcluster
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ConnectionDetailsReaderEndpointKey is the key of the reader endpoint of the
+// DB cluster in the connection secret.
+const ConnectionDetailsReaderEndpointKey = "readerEndpoint"
+
+// Client is the external client used for DBCluster Custom Resource
+type Client interface {
+	CreateDBClusterRequest(*rds.CreateDBClusterInput) rds.CreateDBClusterRequest
+	DescribeDBClustersRequest(*rds.DescribeDBClustersInput) rds.DescribeDBClustersRequest
+	ModifyDBClusterRequest(*rds.ModifyDBClusterInput) rds.ModifyDBClusterRequest
+	DeleteDBClusterRequest(*rds.DeleteDBClusterInput) rds.DeleteDBClusterRequest
+	AddTagsToResourceRequest(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+}
+
+// NewClient returns a new client using AWS credentials as JSON encoded data.
+func NewClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (Client, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return rds.New(*cfg), nil
+}
+
+// IsNotFound returns true if the error is because the DB cluster doesn't exist.
+func IsNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), rds.ErrCodeDBClusterNotFoundFault)
+}
+
+// GenerateCreateDBClusterInput from DBClusterParameters
+func GenerateCreateDBClusterInput(name, password string, p *v1beta1.DBClusterParameters) *rds.CreateDBClusterInput {
+	c := &rds.CreateDBClusterInput{
+		DBClusterIdentifier:             aws.String(name),
+		AvailabilityZones:               p.AvailabilityZones,
+		BacktrackWindow:                 awsclients.Int64Address(p.BacktrackWindow),
+		BackupRetentionPeriod:           awsclients.Int64Address(p.BackupRetentionPeriod),
+		CharacterSetName:                p.CharacterSetName,
+		CopyTagsToSnapshot:              p.CopyTagsToSnapshot,
+		DatabaseName:                    p.DatabaseName,
+		DBClusterParameterGroupName:     p.DBClusterParameterGroupName,
+		DBSubnetGroupName:               p.DBSubnetGroupName,
+		DeletionProtection:              p.DeletionProtection,
+		EnableCloudwatchLogsExports:     p.EnableCloudwatchLogsExports,
+		EnableHttpEndpoint:              p.EnableHTTPEndpoint,
+		EnableIAMDatabaseAuthentication: p.EnableIAMDatabaseAuthentication,
+		Engine:                          aws.String(p.Engine),
+		EngineMode:                      p.EngineMode,
+		EngineVersion:                   p.EngineVersion,
+		GlobalClusterIdentifier:         p.GlobalClusterIdentifier,
+		KmsKeyId:                        p.KMSKeyID,
+		MasterUserPassword:              awsclients.String(password),
+		MasterUsername:                  p.MasterUsername,
+		Port:                            awsclients.Int64Address(p.Port),
+		PreferredBackupWindow:           p.PreferredBackupWindow,
+		PreferredMaintenanceWindow:      p.PreferredMaintenanceWindow,
+		ScalingConfiguration:            generateScalingConfiguration(p.ScalingConfiguration),
+		StorageEncrypted:                p.StorageEncrypted,
+		VpcSecurityGroupIds:             p.VPCSecurityGroupIDs,
+	}
+	if len(p.Tags) != 0 {
+		c.Tags = make([]rds.Tag, len(p.Tags))
+		for i, val := range p.Tags {
+			c.Tags[i] = rds.Tag{
+				Key:   aws.String(val.Key),
+				Value: aws.String(val.Value),
+			}
+		}
+	}
+	return c
+}
+
+// GenerateModifyDBClusterInput from the patch of DBClusterParameters and the
+// currently observed rds.DBCluster.
+func GenerateModifyDBClusterInput(name string, p *v1beta1.DBClusterParameters, observed rds.DBCluster) *rds.ModifyDBClusterInput {
+	// NOTE: MasterUserPassword is not set here, it is handled by the controller
+	// only when a password secret is given.
+	m := &rds.ModifyDBClusterInput{
+		DBClusterIdentifier:             aws.String(name),
+		ApplyImmediately:                p.ApplyModificationsImmediately,
+		BacktrackWindow:                 awsclients.Int64Address(p.BacktrackWindow),
+		BackupRetentionPeriod:           awsclients.Int64Address(p.BackupRetentionPeriod),
+		CopyTagsToSnapshot:              p.CopyTagsToSnapshot,
+		DBClusterParameterGroupName:     p.DBClusterParameterGroupName,
+		DeletionProtection:              p.DeletionProtection,
+		EnableHttpEndpoint:              p.EnableHTTPEndpoint,
+		EnableIAMDatabaseAuthentication: p.EnableIAMDatabaseAuthentication,
+		EngineVersion:                   p.EngineVersion,
+		Port:                            awsclients.Int64Address(p.Port),
+		PreferredBackupWindow:           p.PreferredBackupWindow,
+		PreferredMaintenanceWindow:      p.PreferredMaintenanceWindow,
+		ScalingConfiguration:            generateScalingConfiguration(p.ScalingConfiguration),
+		VpcSecurityGroupIds:             p.VPCSecurityGroupIDs,
+	}
+	if len(p.EnableCloudwatchLogsExports) != 0 {
+		m.CloudwatchLogsExportConfiguration = &rds.CloudwatchLogsExportConfiguration{
+			EnableLogTypes:  stringSliceDiff(p.EnableCloudwatchLogsExports, observed.EnabledCloudwatchLogsExports),
+			DisableLogTypes: stringSliceDiff(observed.EnabledCloudwatchLogsExports, p.EnableCloudwatchLogsExports),
+		}
+	}
+	return m
+}
+
+// CreatePatch creates a *v1beta1.DBClusterParameters that has only the changed
+// values between the target *v1beta1.DBClusterParameters and the current
+// *rds.DBCluster
+func CreatePatch(in *rds.DBCluster, target *v1beta1.DBClusterParameters) (*v1beta1.DBClusterParameters, error) {
+	currentParams := &v1beta1.DBClusterParameters{}
+	LateInitialize(currentParams, in)
+
+	jsonPatch, err := awsclients.CreateJSONPatch(currentParams, target)
+	if err != nil {
+		return nil, err
+	}
+	patch := &v1beta1.DBClusterParameters{}
+	if err := json.Unmarshal(jsonPatch, patch); err != nil {
+		return nil, err
+	}
+	return patch, nil
+}
+
+// IsUpToDate checks whether there is a change in any of the modifiable fields.
+func IsUpToDate(p v1beta1.DBClusterParameters, c rds.DBCluster) (bool, error) {
+	patch, err := CreatePatch(&c, &p)
+	if err != nil {
+		return false, err
+	}
+	return cmp.Equal(&v1beta1.DBClusterParameters{}, patch, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreTypes(&v1alpha1.Reference{}, &v1alpha1.Selector{}, []v1alpha1.Reference{}, &v1alpha1.SecretKeySelector{}),
+		cmpopts.IgnoreFields(v1beta1.DBClusterParameters{}, "Tags", "GlobalClusterIdentifier",
+			"ApplyModificationsImmediately", "SkipFinalSnapshotBeforeDeletion", "FinalDBSnapshotIdentifier"),
+	), nil
+}
+
+// LateInitialize fills the empty fields in *v1beta1.DBClusterParameters with
+// the values seen in rds.DBCluster.
+func LateInitialize(in *v1beta1.DBClusterParameters, c *rds.DBCluster) { // nolint:gocyclo
+	if c == nil {
+		return
+	}
+	in.Engine = awsclients.LateInitializeString(in.Engine, c.Engine)
+
+	in.BacktrackWindow = awsclients.LateInitializeIntPtr(in.BacktrackWindow, c.BacktrackWindow)
+	in.BackupRetentionPeriod = awsclients.LateInitializeIntPtr(in.BackupRetentionPeriod, c.BackupRetentionPeriod)
+	in.CharacterSetName = awsclients.LateInitializeStringPtr(in.CharacterSetName, c.CharacterSetName)
+	in.CopyTagsToSnapshot = awsclients.LateInitializeBoolPtr(in.CopyTagsToSnapshot, c.CopyTagsToSnapshot)
+	in.DatabaseName = awsclients.LateInitializeStringPtr(in.DatabaseName, c.DatabaseName)
+	in.DBClusterParameterGroupName = awsclients.LateInitializeStringPtr(in.DBClusterParameterGroupName, c.DBClusterParameterGroup)
+	in.DBSubnetGroupName = awsclients.LateInitializeStringPtr(in.DBSubnetGroupName, c.DBSubnetGroup)
+	in.DeletionProtection = awsclients.LateInitializeBoolPtr(in.DeletionProtection, c.DeletionProtection)
+	in.EnableHTTPEndpoint = awsclients.LateInitializeBoolPtr(in.EnableHTTPEndpoint, c.HttpEndpointEnabled)
+	in.EnableIAMDatabaseAuthentication = awsclients.LateInitializeBoolPtr(in.EnableIAMDatabaseAuthentication, c.IAMDatabaseAuthenticationEnabled)
+	in.EngineMode = awsclients.LateInitializeStringPtr(in.EngineMode, c.EngineMode)
+	in.KMSKeyID = awsclients.LateInitializeStringPtr(in.KMSKeyID, c.KmsKeyId)
+	in.MasterUsername = awsclients.LateInitializeStringPtr(in.MasterUsername, c.MasterUsername)
+	in.Port = awsclients.LateInitializeIntPtr(in.Port, c.Port)
+	in.PreferredBackupWindow = awsclients.LateInitializeStringPtr(in.PreferredBackupWindow, c.PreferredBackupWindow)
+	in.PreferredMaintenanceWindow = awsclients.LateInitializeStringPtr(in.PreferredMaintenanceWindow, c.PreferredMaintenanceWindow)
+	in.StorageEncrypted = awsclients.LateInitializeBoolPtr(in.StorageEncrypted, c.StorageEncrypted)
+
+	if len(in.AvailabilityZones) == 0 && len(c.AvailabilityZones) != 0 {
+		in.AvailabilityZones = c.AvailabilityZones
+	}
+	if len(in.EnableCloudwatchLogsExports) == 0 && len(c.EnabledCloudwatchLogsExports) != 0 {
+		in.EnableCloudwatchLogsExports = c.EnabledCloudwatchLogsExports
+	}
+	if len(in.VPCSecurityGroupIDs) == 0 && len(c.VpcSecurityGroups) != 0 {
+		in.VPCSecurityGroupIDs = make([]string, len(c.VpcSecurityGroups))
+		for i, val := range c.VpcSecurityGroups {
+			in.VPCSecurityGroupIDs[i] = aws.StringValue(val.VpcSecurityGroupId)
+		}
+	}
+	if c.ScalingConfigurationInfo != nil {
+		if in.ScalingConfiguration == nil {
+			in.ScalingConfiguration = &v1beta1.ScalingConfiguration{}
+		}
+		in.ScalingConfiguration.AutoPause = awsclients.LateInitializeBoolPtr(in.ScalingConfiguration.AutoPause, c.ScalingConfigurationInfo.AutoPause)
+		in.ScalingConfiguration.MaxCapacity = awsclients.LateInitializeIntPtr(in.ScalingConfiguration.MaxCapacity, c.ScalingConfigurationInfo.MaxCapacity)
+		in.ScalingConfiguration.MinCapacity = awsclients.LateInitializeIntPtr(in.ScalingConfiguration.MinCapacity, c.ScalingConfigurationInfo.MinCapacity)
+		in.ScalingConfiguration.SecondsUntilAutoPause = awsclients.LateInitializeIntPtr(in.ScalingConfiguration.SecondsUntilAutoPause, c.ScalingConfigurationInfo.SecondsUntilAutoPause)
+	}
+
+	in.EngineVersion = awsclients.LateInitializeStringPtr(in.EngineVersion, c.EngineVersion)
+	// AWS may create a more specific version than the one requested, e.g.
+	// 5.7.mysql_aurora.2.07.2 for 5.7. Assign the full version to the spec to
+	// avoid unnecessary update signals.
+	if strings.HasPrefix(aws.StringValue(c.EngineVersion), aws.StringValue(in.EngineVersion)) {
+		in.EngineVersion = c.EngineVersion
+	}
+}
+
+// GenerateObservation is used to produce v1beta1.DBClusterObservation from
+// rds.DBCluster.
+func GenerateObservation(c rds.DBCluster) v1beta1.DBClusterObservation {
+	o := v1beta1.DBClusterObservation{
+		Status:                         aws.StringValue(c.Status),
+		DBClusterARN:                   aws.StringValue(c.DBClusterArn),
+		DBClusterResourceID:            aws.StringValue(c.DbClusterResourceId),
+		Endpoint:                       aws.StringValue(c.Endpoint),
+		ReaderEndpoint:                 aws.StringValue(c.ReaderEndpoint),
+		HostedZoneID:                   aws.StringValue(c.HostedZoneId),
+		Capacity:                       int(aws.Int64Value(c.Capacity)),
+		BacktrackConsumedChangeRecords: int(aws.Int64Value(c.BacktrackConsumedChangeRecords)),
+	}
+	if c.ClusterCreateTime != nil {
+		t := metav1.NewTime(*c.ClusterCreateTime)
+		o.ClusterCreateTime = &t
+	}
+	if c.LatestRestorableTime != nil {
+		t := metav1.NewTime(*c.LatestRestorableTime)
+		o.LatestRestorableTime = &t
+	}
+	if len(c.DBClusterMembers) != 0 {
+		o.DBClusterMembers = make([]v1beta1.DBClusterMember, len(c.DBClusterMembers))
+		for i, val := range c.DBClusterMembers {
+			o.DBClusterMembers[i] = v1beta1.DBClusterMember{
+				DBInstanceIdentifier:          aws.StringValue(val.DBInstanceIdentifier),
+				DBClusterParameterGroupStatus: aws.StringValue(val.DBClusterParameterGroupStatus),
+				IsClusterWriter:               aws.BoolValue(val.IsClusterWriter),
+				PromotionTier:                 int(aws.Int64Value(val.PromotionTier)),
+			}
+		}
+	}
+	if len(c.VpcSecurityGroups) != 0 {
+		o.VPCSecurityGroups = make([]v1beta1.VPCSecurityGroupMembership, len(c.VpcSecurityGroups))
+		for i, val := range c.VpcSecurityGroups {
+			o.VPCSecurityGroups[i] = v1beta1.VPCSecurityGroupMembership{
+				Status:             aws.StringValue(val.Status),
+				VPCSecurityGroupID: aws.StringValue(val.VpcSecurityGroupId),
+			}
+		}
+	}
+	return o
+}
+
+// GetConnectionDetails extracts managed.ConnectionDetails out of
+// v1beta1.DBCluster. The writer endpoint is published as the endpoint and the
+// reader endpoint under its own key.
+func GetConnectionDetails(in v1beta1.DBCluster) managed.ConnectionDetails {
+	if in.Status.AtProvider.Endpoint == "" {
+		return nil
+	}
+	conn := managed.ConnectionDetails{
+		v1alpha1.ResourceCredentialsSecretEndpointKey: []byte(in.Status.AtProvider.Endpoint),
+	}
+	if in.Status.AtProvider.ReaderEndpoint != "" {
+		conn[ConnectionDetailsReaderEndpointKey] = []byte(in.Status.AtProvider.ReaderEndpoint)
+	}
+	if in.Spec.ForProvider.Port != nil {
+		conn[v1alpha1.ResourceCredentialsSecretPortKey] = []byte(strconv.Itoa(*in.Spec.ForProvider.Port))
+	}
+	return conn
+}
+
+func generateScalingConfiguration(s *v1beta1.ScalingConfiguration) *rds.ScalingConfiguration {
+	if s == nil {
+		return nil
+	}
+	return &rds.ScalingConfiguration{
+		AutoPause:             s.AutoPause,
+		MaxCapacity:           awsclients.Int64Address(s.MaxCapacity),
+		MinCapacity:           awsclients.Int64Address(s.MinCapacity),
+		SecondsUntilAutoPause: awsclients.Int64Address(s.SecondsUntilAutoPause),
+	}
+}
+
+// stringSliceDiff returns the elements of a that are not in b.
+func stringSliceDiff(a, b []string) []string {
+	mb := make(map[string]struct{}, len(b))
+	for _, x := range b {
+		mb[x] = struct{}{}
+	}
+	var diff []string
+	for _, x := range a {
+		if _, found := mb[x]; !found {
+			diff = append(diff, x)
+		}
+	}
+	return diff
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbcluster
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+)
+
+var (
+	engine        = "aurora-mysql"
+	engineMode    = "serverless"
+	engineVersion = "5.7"
+	fullVersion   = "5.7.mysql_aurora.2.07.2"
+	port          = 3306
+	retention     = 7
+	minCapacity   = 1
+	maxCapacity   = 4
+)
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		spec    v1beta1.DBClusterParameters
+		cluster rds.DBCluster
+		want    v1beta1.DBClusterParameters
+	}{
+		"AllEmpty": {
+			spec:    v1beta1.DBClusterParameters{},
+			cluster: rds.DBCluster{},
+			want:    v1beta1.DBClusterParameters{},
+		},
+		"FillsEmptyFields": {
+			spec: v1beta1.DBClusterParameters{Engine: engine, EngineVersion: aws.String(engineVersion)},
+			cluster: rds.DBCluster{
+				Engine:                aws.String(engine),
+				EngineMode:            aws.String(engineMode),
+				EngineVersion:         aws.String(fullVersion),
+				Port:                  aws.Int64(int64(port)),
+				BackupRetentionPeriod: aws.Int64(int64(retention)),
+				VpcSecurityGroups:     []rds.VpcSecurityGroupMembership{{VpcSecurityGroupId: aws.String("sg-1")}},
+				ScalingConfigurationInfo: &rds.ScalingConfigurationInfo{
+					MinCapacity: aws.Int64(int64(minCapacity)),
+					MaxCapacity: aws.Int64(int64(maxCapacity)),
+				},
+			},
+			want: v1beta1.DBClusterParameters{
+				Engine:                engine,
+				EngineMode:            aws.String(engineMode),
+				EngineVersion:         aws.String(fullVersion),
+				Port:                  &port,
+				BackupRetentionPeriod: &retention,
+				VPCSecurityGroupIDs:   []string{"sg-1"},
+				ScalingConfiguration: &v1beta1.ScalingConfiguration{
+					MinCapacity: &minCapacity,
+					MaxCapacity: &maxCapacity,
+				},
+			},
+		},
+		"KeepsSpecifiedFields": {
+			spec: v1beta1.DBClusterParameters{Engine: engine, Port: &port},
+			cluster: rds.DBCluster{
+				Engine: aws.String("aurora-postgresql"),
+				Port:   aws.Int64(5432),
+			},
+			want: v1beta1.DBClusterParameters{Engine: engine, Port: &port},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(&tc.spec, &tc.cluster)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		spec    v1beta1.DBClusterParameters
+		cluster rds.DBCluster
+		want    bool
+	}{
+		"SameFields": {
+			spec: v1beta1.DBClusterParameters{
+				Engine:                          engine,
+				Port:                            &port,
+				MasterPasswordSecretRef:         &v1alpha1.SecretKeySelector{Key: "pw"},
+				SkipFinalSnapshotBeforeDeletion: aws.Bool(true),
+			},
+			cluster: rds.DBCluster{Engine: aws.String(engine), Port: aws.Int64(int64(port))},
+			want:    true,
+		},
+		"DifferentFields": {
+			spec:    v1beta1.DBClusterParameters{Engine: engine, BackupRetentionPeriod: &retention},
+			cluster: rds.DBCluster{Engine: aws.String(engine), BackupRetentionPeriod: aws.Int64(1)},
+			want:    false,
+		},
+		"DifferentScalingConfiguration": {
+			spec: v1beta1.DBClusterParameters{
+				Engine:               engine,
+				ScalingConfiguration: &v1beta1.ScalingConfiguration{MaxCapacity: &maxCapacity},
+			},
+			cluster: rds.DBCluster{
+				Engine:                   aws.String(engine),
+				ScalingConfigurationInfo: &rds.ScalingConfigurationInfo{MaxCapacity: aws.Int64(2)},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _ := IsUpToDate(tc.spec, tc.cluster)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateModifyDBClusterInput(t *testing.T) {
+	cases := map[string]struct {
+		patch    v1beta1.DBClusterParameters
+		observed rds.DBCluster
+		want     *rds.ModifyDBClusterInput
+	}{
+		"LogExports": {
+			patch: v1beta1.DBClusterParameters{
+				ApplyModificationsImmediately: aws.Bool(true),
+				EnableCloudwatchLogsExports:   []string{"audit", "error"},
+			},
+			observed: rds.DBCluster{EnabledCloudwatchLogsExports: []string{"error", "slowquery"}},
+			want: &rds.ModifyDBClusterInput{
+				DBClusterIdentifier: aws.String("name"),
+				ApplyImmediately:    aws.Bool(true),
+				CloudwatchLogsExportConfiguration: &rds.CloudwatchLogsExportConfiguration{
+					EnableLogTypes:  []string{"audit"},
+					DisableLogTypes: []string{"slowquery"},
+				},
+			},
+		},
+		"ScalingConfiguration": {
+			patch: v1beta1.DBClusterParameters{
+				ScalingConfiguration: &v1beta1.ScalingConfiguration{MinCapacity: &minCapacity},
+			},
+			want: &rds.ModifyDBClusterInput{
+				DBClusterIdentifier:  aws.String("name"),
+				ScalingConfiguration: &rds.ScalingConfiguration{MinCapacity: aws.Int64(int64(minCapacity))},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyDBClusterInput("name", &tc.patch, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateModifyDBClusterInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		cluster v1beta1.DBCluster
+		want    managed.ConnectionDetails
+	}{
+		"NoEndpoint": {
+			cluster: v1beta1.DBCluster{},
+			want:    nil,
+		},
+		"WriterAndReader": {
+			cluster: v1beta1.DBCluster{
+				Spec: v1beta1.DBClusterSpec{ForProvider: v1beta1.DBClusterParameters{Port: &port}},
+				Status: v1beta1.DBClusterStatus{AtProvider: v1beta1.DBClusterObservation{
+					Endpoint:       "writer",
+					ReaderEndpoint: "reader",
+				}},
+			},
+			want: managed.ConnectionDetails{
+				v1alpha1.ResourceCredentialsSecretEndpointKey: []byte("writer"),
+				ConnectionDetailsReaderEndpointKey:            []byte("reader"),
+				v1alpha1.ResourceCredentialsSecretPortKey:     []byte("3306"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetConnectionDetails(tc.cluster)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/dbcluster"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockDBClusterClient)(nil)
+
+// MockDBClusterClient is a type that implements all the methods for DBCluster Client interface
+type MockDBClusterClient struct {
+	MockCreateDBClusterRequest    func(*rds.CreateDBClusterInput) rds.CreateDBClusterRequest
+	MockDescribeDBClustersRequest func(*rds.DescribeDBClustersInput) rds.DescribeDBClustersRequest
+	MockModifyDBClusterRequest    func(*rds.ModifyDBClusterInput) rds.ModifyDBClusterRequest
+	MockDeleteDBClusterRequest    func(*rds.DeleteDBClusterInput) rds.DeleteDBClusterRequest
+	MockAddTagsToResourceRequest  func(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+}
+
+// CreateDBClusterRequest mocks CreateDBClusterRequest method
+func (m *MockDBClusterClient) CreateDBClusterRequest(input *rds.CreateDBClusterInput) rds.CreateDBClusterRequest {
+	return m.MockCreateDBClusterRequest(input)
+}
+
+// DescribeDBClustersRequest mocks DescribeDBClustersRequest method
+func (m *MockDBClusterClient) DescribeDBClustersRequest(input *rds.DescribeDBClustersInput) rds.DescribeDBClustersRequest {
+	return m.MockDescribeDBClustersRequest(input)
+}
+
+// ModifyDBClusterRequest mocks ModifyDBClusterRequest method
+func (m *MockDBClusterClient) ModifyDBClusterRequest(input *rds.ModifyDBClusterInput) rds.ModifyDBClusterRequest {
+	return m.MockModifyDBClusterRequest(input)
+}
+
+// DeleteDBClusterRequest mocks DeleteDBClusterRequest method
+func (m *MockDBClusterClient) DeleteDBClusterRequest(input *rds.DeleteDBClusterInput) rds.DeleteDBClusterRequest {
+	return m.MockDeleteDBClusterRequest(input)
+}
+
+// AddTagsToResourceRequest mocks AddTagsToResourceRequest method
+func (m *MockDBClusterClient) AddTagsToResourceRequest(input *rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest {
+	return m.MockAddTagsToResourceRequest(input)
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbparametergroup
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// SourceUser is the source of the parameters that are modified from
+	// their engine default.
+	SourceUser = "user"
+
+	// applyTypeDynamic is the apply type of the parameters that can be
+	// applied without a reboot.
+	applyTypeDynamic = "dynamic"
+
+	// maxParametersPerRequest is the maximum number of parameters that can be
+	// modified or reset in a single request.
+	maxParametersPerRequest = 20
+)
+
+// Client is the external client used for DBParameterGroup and
+// DBClusterParameterGroup Custom Resources
+type Client interface {
+	CreateDBParameterGroupRequest(*rds.CreateDBParameterGroupInput) rds.CreateDBParameterGroupRequest
+	DescribeDBParameterGroupsRequest(*rds.DescribeDBParameterGroupsInput) rds.DescribeDBParameterGroupsRequest
+	DescribeDBParametersRequest(*rds.DescribeDBParametersInput) rds.DescribeDBParametersRequest
+	ModifyDBParameterGroupRequest(*rds.ModifyDBParameterGroupInput) rds.ModifyDBParameterGroupRequest
+	ResetDBParameterGroupRequest(*rds.ResetDBParameterGroupInput) rds.ResetDBParameterGroupRequest
+	DeleteDBParameterGroupRequest(*rds.DeleteDBParameterGroupInput) rds.DeleteDBParameterGroupRequest
+
+	CreateDBClusterParameterGroupRequest(*rds.CreateDBClusterParameterGroupInput) rds.CreateDBClusterParameterGroupRequest
+	DescribeDBClusterParameterGroupsRequest(*rds.DescribeDBClusterParameterGroupsInput) rds.DescribeDBClusterParameterGroupsRequest
+	DescribeDBClusterParametersRequest(*rds.DescribeDBClusterParametersInput) rds.DescribeDBClusterParametersRequest
+	ModifyDBClusterParameterGroupRequest(*rds.ModifyDBClusterParameterGroupInput) rds.ModifyDBClusterParameterGroupRequest
+	ResetDBClusterParameterGroupRequest(*rds.ResetDBClusterParameterGroupInput) rds.ResetDBClusterParameterGroupRequest
+	DeleteDBClusterParameterGroupRequest(*rds.DeleteDBClusterParameterGroupInput) rds.DeleteDBClusterParameterGroupRequest
+}
+
+// NewClient returns a new client using AWS credentials as JSON encoded data.
+func NewClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (Client, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return rds.New(*cfg), nil
+}
+
+// IsNotFound returns true if the error is because the DB parameter group or
+// the DB cluster parameter group doesn't exist.
+func IsNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), rds.ErrCodeDBParameterGroupNotFoundFault) ||
+		strings.Contains(err.Error(), rds.ErrCodeDBClusterParameterGroupNotFoundFault)
+}
+
+// GenerateTags converts the given v1beta1.Tags to rds.Tags.
+func GenerateTags(tags []v1beta1.Tag) []rds.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]rds.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = rds.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// GenerateParameterObservations produces the status of the given parameters
+// that were modified from their engine default.
+func GenerateParameterObservations(params []rds.Parameter) []v1beta1.ParameterObservation {
+	if len(params) == 0 {
+		return nil
+	}
+	res := make([]v1beta1.ParameterObservation, len(params))
+	for i, p := range params {
+		res[i] = v1beta1.ParameterObservation{
+			ParameterName:  aws.StringValue(p.ParameterName),
+			ParameterValue: aws.StringValue(p.ParameterValue),
+			ApplyMethod:    string(p.ApplyMethod),
+			ApplyType:      aws.StringValue(p.ApplyType),
+			PendingReboot:  p.ApplyMethod == rds.ApplyMethodPendingReboot,
+		}
+	}
+	return res
+}
+
+// GetOutdatedParameters returns the desired parameters whose value differs
+// from the observed one, in the form that the modify requests accept.
+func GetOutdatedParameters(desired []v1beta1.Parameter, observed []rds.Parameter) []rds.Parameter {
+	current := make(map[string]string, len(observed))
+	for _, p := range observed {
+		current[aws.StringValue(p.ParameterName)] = aws.StringValue(p.ParameterValue)
+	}
+	var res []rds.Parameter
+	for _, p := range desired {
+		if v, ok := current[p.ParameterName]; ok && v == p.ParameterValue {
+			continue
+		}
+		method := rds.ApplyMethodPendingReboot
+		if p.ApplyMethod != nil {
+			method = rds.ApplyMethod(*p.ApplyMethod)
+		}
+		res = append(res, rds.Parameter{
+			ParameterName:  aws.String(p.ParameterName),
+			ParameterValue: aws.String(p.ParameterValue),
+			ApplyMethod:    method,
+		})
+	}
+	return res
+}
+
+// GetParametersToReset returns the observed parameters that are not desired
+// anymore, in the form that the reset requests accept. Dynamic parameters are
+// reset immediately, static ones on the next reboot.
+func GetParametersToReset(desired []v1beta1.Parameter, observed []rds.Parameter) []rds.Parameter {
+	names := make(map[string]struct{}, len(desired))
+	for _, p := range desired {
+		names[p.ParameterName] = struct{}{}
+	}
+	var res []rds.Parameter
+	for _, p := range observed {
+		if _, ok := names[aws.StringValue(p.ParameterName)]; ok {
+			continue
+		}
+		method := rds.ApplyMethodPendingReboot
+		if aws.StringValue(p.ApplyType) == applyTypeDynamic {
+			method = rds.ApplyMethodImmediate
+		}
+		res = append(res, rds.Parameter{
+			ParameterName: p.ParameterName,
+			ApplyMethod:   method,
+		})
+	}
+	return res
+}
+
+// IsUpToDate checks whether the observed parameters match the desired ones.
+func IsUpToDate(desired []v1beta1.Parameter, observed []rds.Parameter) bool {
+	return len(GetOutdatedParameters(desired, observed)) == 0 && len(GetParametersToReset(desired, observed)) == 0
+}
+
+// ChunkParameters splits the given parameters into chunks that can be sent in
+// a single modify or reset request.
+func ChunkParameters(params []rds.Parameter) [][]rds.Parameter {
+	var res [][]rds.Parameter
+	for len(params) > maxParametersPerRequest {
+		res = append(res, params[:maxParametersPerRequest])
+		params = params[maxParametersPerRequest:]
+	}
+	if len(params) != 0 {
+		res = append(res, params)
+	}
+	return res
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbparametergroup
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+)
+
+func TestGetOutdatedParameters(t *testing.T) {
+	cases := map[string]struct {
+		desired  []v1beta1.Parameter
+		observed []rds.Parameter
+		want     []rds.Parameter
+	}{
+		"UpToDate": {
+			desired:  []v1beta1.Parameter{{ParameterName: "max_connections", ParameterValue: "100"}},
+			observed: []rds.Parameter{{ParameterName: aws.String("max_connections"), ParameterValue: aws.String("100")}},
+		},
+		"DifferentValue": {
+			desired:  []v1beta1.Parameter{{ParameterName: "max_connections", ParameterValue: "200", ApplyMethod: aws.String(v1beta1.ParameterApplyMethodImmediate)}},
+			observed: []rds.Parameter{{ParameterName: aws.String("max_connections"), ParameterValue: aws.String("100")}},
+			want: []rds.Parameter{{
+				ParameterName:  aws.String("max_connections"),
+				ParameterValue: aws.String("200"),
+				ApplyMethod:    rds.ApplyMethodImmediate,
+			}},
+		},
+		"MissingDefaultsToPendingReboot": {
+			desired: []v1beta1.Parameter{{ParameterName: "shared_buffers", ParameterValue: "1024"}},
+			want: []rds.Parameter{{
+				ParameterName:  aws.String("shared_buffers"),
+				ParameterValue: aws.String("1024"),
+				ApplyMethod:    rds.ApplyMethodPendingReboot,
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetOutdatedParameters(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetOutdatedParameters(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetParametersToReset(t *testing.T) {
+	cases := map[string]struct {
+		desired  []v1beta1.Parameter
+		observed []rds.Parameter
+		want     []rds.Parameter
+	}{
+		"NothingToReset": {
+			desired:  []v1beta1.Parameter{{ParameterName: "max_connections", ParameterValue: "100"}},
+			observed: []rds.Parameter{{ParameterName: aws.String("max_connections"), ParameterValue: aws.String("200")}},
+		},
+		"ResetRemoved": {
+			observed: []rds.Parameter{
+				{ParameterName: aws.String("max_connections"), ApplyType: aws.String("dynamic")},
+				{ParameterName: aws.String("shared_buffers"), ApplyType: aws.String("static")},
+			},
+			want: []rds.Parameter{
+				{ParameterName: aws.String("max_connections"), ApplyMethod: rds.ApplyMethodImmediate},
+				{ParameterName: aws.String("shared_buffers"), ApplyMethod: rds.ApplyMethodPendingReboot},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetParametersToReset(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetParametersToReset(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateParameterObservations(t *testing.T) {
+	params := []rds.Parameter{{
+		ParameterName:  aws.String("shared_buffers"),
+		ParameterValue: aws.String("1024"),
+		ApplyMethod:    rds.ApplyMethodPendingReboot,
+		ApplyType:      aws.String("static"),
+	}}
+	want := []v1beta1.ParameterObservation{{
+		ParameterName:  "shared_buffers",
+		ParameterValue: "1024",
+		ApplyMethod:    v1beta1.ParameterApplyMethodPendingReboot,
+		ApplyType:      "static",
+		PendingReboot:  true,
+	}}
+	if diff := cmp.Diff(want, GenerateParameterObservations(params)); diff != "" {
+		t.Errorf("GenerateParameterObservations(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestChunkParameters(t *testing.T) {
+	params := make([]rds.Parameter, 45)
+	for i := range params {
+		params[i] = rds.Parameter{ParameterName: aws.String(fmt.Sprintf("p%d", i))}
+	}
+	got := ChunkParameters(params)
+	sizes := make([]int, len(got))
+	for i, c := range got {
+		sizes[i] = len(c)
+	}
+	if diff := cmp.Diff([]int{20, 20, 5}, sizes); diff != "" {
+		t.Errorf("ChunkParameters(...): -want, +got:\n%s", diff)
+	}
+	if got := ChunkParameters(nil); len(got) != 0 {
+		t.Errorf("ChunkParameters(nil): want no chunks, got %d", len(got))
+	}
+}
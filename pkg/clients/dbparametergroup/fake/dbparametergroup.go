@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/dbparametergroup"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockDBParameterGroupClient)(nil)
+
+// MockDBParameterGroupClient is a type that implements all the methods for
+// DBParameterGroup Client interface
+type MockDBParameterGroupClient struct {
+	MockCreateDBParameterGroupRequest    func(*rds.CreateDBParameterGroupInput) rds.CreateDBParameterGroupRequest
+	MockDescribeDBParameterGroupsRequest func(*rds.DescribeDBParameterGroupsInput) rds.DescribeDBParameterGroupsRequest
+	MockDescribeDBParametersRequest      func(*rds.DescribeDBParametersInput) rds.DescribeDBParametersRequest
+	MockModifyDBParameterGroupRequest    func(*rds.ModifyDBParameterGroupInput) rds.ModifyDBParameterGroupRequest
+	MockResetDBParameterGroupRequest     func(*rds.ResetDBParameterGroupInput) rds.ResetDBParameterGroupRequest
+	MockDeleteDBParameterGroupRequest    func(*rds.DeleteDBParameterGroupInput) rds.DeleteDBParameterGroupRequest
+
+	MockCreateDBClusterParameterGroupRequest    func(*rds.CreateDBClusterParameterGroupInput) rds.CreateDBClusterParameterGroupRequest
+	MockDescribeDBClusterParameterGroupsRequest func(*rds.DescribeDBClusterParameterGroupsInput) rds.DescribeDBClusterParameterGroupsRequest
+	MockDescribeDBClusterParametersRequest      func(*rds.DescribeDBClusterParametersInput) rds.DescribeDBClusterParametersRequest
+	MockModifyDBClusterParameterGroupRequest    func(*rds.ModifyDBClusterParameterGroupInput) rds.ModifyDBClusterParameterGroupRequest
+	MockResetDBClusterParameterGroupRequest     func(*rds.ResetDBClusterParameterGroupInput) rds.ResetDBClusterParameterGroupRequest
+	MockDeleteDBClusterParameterGroupRequest    func(*rds.DeleteDBClusterParameterGroupInput) rds.DeleteDBClusterParameterGroupRequest
+}
+
+// CreateDBParameterGroupRequest mocks CreateDBParameterGroupRequest method
+func (m *MockDBParameterGroupClient) CreateDBParameterGroupRequest(input *rds.CreateDBParameterGroupInput) rds.CreateDBParameterGroupRequest {
+	return m.MockCreateDBParameterGroupRequest(input)
+}
+
+// DescribeDBParameterGroupsRequest mocks DescribeDBParameterGroupsRequest method
+func (m *MockDBParameterGroupClient) DescribeDBParameterGroupsRequest(input *rds.DescribeDBParameterGroupsInput) rds.DescribeDBParameterGroupsRequest {
+	return m.MockDescribeDBParameterGroupsRequest(input)
+}
+
+// DescribeDBParametersRequest mocks DescribeDBParametersRequest method
+func (m *MockDBParameterGroupClient) DescribeDBParametersRequest(input *rds.DescribeDBParametersInput) rds.DescribeDBParametersRequest {
+	return m.MockDescribeDBParametersRequest(input)
+}
+
+// ModifyDBParameterGroupRequest mocks ModifyDBParameterGroupRequest method
+func (m *MockDBParameterGroupClient) ModifyDBParameterGroupRequest(input *rds.ModifyDBParameterGroupInput) rds.ModifyDBParameterGroupRequest {
+	return m.MockModifyDBParameterGroupRequest(input)
+}
+
+// ResetDBParameterGroupRequest mocks ResetDBParameterGroupRequest method
+func (m *MockDBParameterGroupClient) ResetDBParameterGroupRequest(input *rds.ResetDBParameterGroupInput) rds.ResetDBParameterGroupRequest {
+	return m.MockResetDBParameterGroupRequest(input)
+}
+
+// DeleteDBParameterGroupRequest mocks DeleteDBParameterGroupRequest method
+func (m *MockDBParameterGroupClient) DeleteDBParameterGroupRequest(input *rds.DeleteDBParameterGroupInput) rds.DeleteDBParameterGroupRequest {
+	return m.MockDeleteDBParameterGroupRequest(input)
+}
+
+// CreateDBClusterParameterGroupRequest mocks CreateDBClusterParameterGroupRequest method
+func (m *MockDBParameterGroupClient) CreateDBClusterParameterGroupRequest(input *rds.CreateDBClusterParameterGroupInput) rds.CreateDBClusterParameterGroupRequest {
+	return m.MockCreateDBClusterParameterGroupRequest(input)
+}
+
+// DescribeDBClusterParameterGroupsRequest mocks DescribeDBClusterParameterGroupsRequest method
+func (m *MockDBParameterGroupClient) DescribeDBClusterParameterGroupsRequest(input *rds.DescribeDBClusterParameterGroupsInput) rds.DescribeDBClusterParameterGroupsRequest {
+	return m.MockDescribeDBClusterParameterGroupsRequest(input)
+}
+
+// DescribeDBClusterParametersRequest mocks DescribeDBClusterParametersRequest method
+func (m *MockDBParameterGroupClient) DescribeDBClusterParametersRequest(input *rds.DescribeDBClusterParametersInput) rds.DescribeDBClusterParametersRequest {
+	return m.MockDescribeDBClusterParametersRequest(input)
+}
+
+// ModifyDBClusterParameterGroupRequest mocks ModifyDBClusterParameterGroupRequest method
+func (m *MockDBParameterGroupClient) ModifyDBClusterParameterGroupRequest(input *rds.ModifyDBClusterParameterGroupInput) rds.ModifyDBClusterParameterGroupRequest {
+	return m.MockModifyDBClusterParameterGroupRequest(input)
+}
+
+// ResetDBClusterParameterGroupRequest mocks ResetDBClusterParameterGroupRequest method
+func (m *MockDBParameterGroupClient) ResetDBClusterParameterGroupRequest(input *rds.ResetDBClusterParameterGroupInput) rds.ResetDBClusterParameterGroupRequest {
+	return m.MockResetDBClusterParameterGroupRequest(input)
+}
+
+// DeleteDBClusterParameterGroupRequest mocks DeleteDBClusterParameterGroupRequest method
+func (m *MockDBParameterGroupClient) DeleteDBClusterParameterGroupRequest(input *rds.DeleteDBClusterParameterGroupInput) rds.DeleteDBClusterParameterGroupRequest {
+	return m.MockDeleteDBClusterParameterGroupRequest(input)
+}
@@ -0,0 +1,380 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbproxy
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// The ports a DB proxy listens on, which are the default ports of its engine
+// family.
+const (
+	portMySQL      = 3306
+	portPostgreSQL = 5432
+)
+
+// Client is the external client used for DBProxy and DBProxyTargetGroup
+// Custom Resources
+type Client interface {
+	CreateDBProxyRequest(*rds.CreateDBProxyInput) rds.CreateDBProxyRequest
+	DescribeDBProxiesRequest(*rds.DescribeDBProxiesInput) rds.DescribeDBProxiesRequest
+	ModifyDBProxyRequest(*rds.ModifyDBProxyInput) rds.ModifyDBProxyRequest
+	DeleteDBProxyRequest(*rds.DeleteDBProxyInput) rds.DeleteDBProxyRequest
+
+	DescribeDBProxyTargetGroupsRequest(*rds.DescribeDBProxyTargetGroupsInput) rds.DescribeDBProxyTargetGroupsRequest
+	ModifyDBProxyTargetGroupRequest(*rds.ModifyDBProxyTargetGroupInput) rds.ModifyDBProxyTargetGroupRequest
+	DescribeDBProxyTargetsRequest(*rds.DescribeDBProxyTargetsInput) rds.DescribeDBProxyTargetsRequest
+	RegisterDBProxyTargetsRequest(*rds.RegisterDBProxyTargetsInput) rds.RegisterDBProxyTargetsRequest
+	DeregisterDBProxyTargetsRequest(*rds.DeregisterDBProxyTargetsInput) rds.DeregisterDBProxyTargetsRequest
+}
+
+// NewClient returns a new client using AWS credentials as JSON encoded data.
+func NewClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (Client, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return rds.New(*cfg), nil
+}
+
+// IsNotFound returns true if the error is because the DB proxy doesn't
+// exist.
+func IsNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), rds.ErrCodeDBProxyNotFoundFault)
+}
+
+// IsTargetGroupNotFound returns true if the error is because the target group
+// or its DB proxy doesn't exist.
+func IsTargetGroupNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	return IsNotFound(err) || strings.Contains(err.Error(), rds.ErrCodeDBProxyTargetGroupNotFoundFault)
+}
+
+// IsTargetNotFound returns true if the error is because the target, its
+// target group or its DB proxy doesn't exist.
+func IsTargetNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	return IsTargetGroupNotFound(err) || strings.Contains(err.Error(), rds.ErrCodeDBProxyTargetNotFoundFault)
+}
+
+// GenerateCreateDBProxyInput from DBProxyParameters.
+func GenerateCreateDBProxyInput(name string, p v1beta1.DBProxyParameters) *rds.CreateDBProxyInput {
+	c := &rds.CreateDBProxyInput{
+		DBProxyName:         aws.String(name),
+		EngineFamily:        rds.EngineFamily(p.EngineFamily),
+		Auth:                generateUserAuthConfigs(p.Auth),
+		RoleArn:             p.RoleARN,
+		VpcSubnetIds:        p.VPCSubnetIDs,
+		VpcSecurityGroupIds: p.VPCSecurityGroupIDs,
+		IdleClientTimeout:   awsclients.Int64Address(p.IdleClientTimeout),
+		RequireTLS:          p.RequireTLS,
+		DebugLogging:        p.DebugLogging,
+	}
+	if len(p.Tags) != 0 {
+		c.Tags = make([]rds.Tag, len(p.Tags))
+		for i, t := range p.Tags {
+			c.Tags[i] = rds.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+		}
+	}
+	return c
+}
+
+// GenerateModifyDBProxyInput from DBProxyParameters. All the mutable fields
+// are sent since AWS accepts values that are the same as the current ones.
+func GenerateModifyDBProxyInput(name string, p v1beta1.DBProxyParameters) *rds.ModifyDBProxyInput {
+	return &rds.ModifyDBProxyInput{
+		DBProxyName:       aws.String(name),
+		Auth:              generateUserAuthConfigs(p.Auth),
+		RoleArn:           p.RoleARN,
+		SecurityGroups:    p.VPCSecurityGroupIDs,
+		IdleClientTimeout: awsclients.Int64Address(p.IdleClientTimeout),
+		RequireTLS:        p.RequireTLS,
+		DebugLogging:      p.DebugLogging,
+	}
+}
+
+// GenerateObservation is used to produce v1beta1.DBProxyObservation from
+// rds.DBProxy.
+func GenerateObservation(px rds.DBProxy) v1beta1.DBProxyObservation {
+	o := v1beta1.DBProxyObservation{
+		DBProxyARN:   aws.StringValue(px.DBProxyArn),
+		Status:       string(px.Status),
+		Endpoint:     aws.StringValue(px.Endpoint),
+		VPCSubnetIDs: px.VpcSubnetIds,
+	}
+	if px.CreatedDate != nil {
+		t := metav1.NewTime(*px.CreatedDate)
+		o.CreatedDate = &t
+	}
+	return o
+}
+
+// LateInitialize fills the empty fields in *v1beta1.DBProxyParameters with
+// the values seen in rds.DBProxy.
+func LateInitialize(in *v1beta1.DBProxyParameters, px *rds.DBProxy) {
+	if px == nil {
+		return
+	}
+	in.RoleARN = awsclients.LateInitializeStringPtr(in.RoleARN, px.RoleArn)
+	in.IdleClientTimeout = awsclients.LateInitializeIntPtr(in.IdleClientTimeout, px.IdleClientTimeout)
+	in.RequireTLS = awsclients.LateInitializeBoolPtr(in.RequireTLS, px.RequireTLS)
+	in.DebugLogging = awsclients.LateInitializeBoolPtr(in.DebugLogging, px.DebugLogging)
+	if len(in.VPCSecurityGroupIDs) == 0 {
+		in.VPCSecurityGroupIDs = px.VpcSecurityGroupIds
+	}
+}
+
+// IsUpToDate checks whether the observed DB proxy matches the desired one.
+func IsUpToDate(p v1beta1.DBProxyParameters, px rds.DBProxy) bool {
+	switch {
+	case p.RoleARN != nil && aws.StringValue(p.RoleARN) != aws.StringValue(px.RoleArn),
+		p.IdleClientTimeout != nil && int64(*p.IdleClientTimeout) != aws.Int64Value(px.IdleClientTimeout),
+		p.RequireTLS != nil && *p.RequireTLS != aws.BoolValue(px.RequireTLS),
+		p.DebugLogging != nil && *p.DebugLogging != aws.BoolValue(px.DebugLogging),
+		!areStringSetsEqual(p.VPCSecurityGroupIDs, px.VpcSecurityGroupIds):
+		return false
+	}
+	return isAuthUpToDate(p.Auth, px.Auth)
+}
+
+// GetConnectionDetails returns the endpoint and the port of the DB proxy.
+func GetConnectionDetails(in v1beta1.DBProxy) managed.ConnectionDetails {
+	if in.Status.AtProvider.Endpoint == "" {
+		return nil
+	}
+	conn := managed.ConnectionDetails{
+		v1alpha1.ResourceCredentialsSecretEndpointKey: []byte(in.Status.AtProvider.Endpoint),
+	}
+	switch in.Spec.ForProvider.EngineFamily {
+	case v1beta1.DBProxyEngineFamilyMySQL:
+		conn[v1alpha1.ResourceCredentialsSecretPortKey] = []byte(strconv.Itoa(portMySQL))
+	case v1beta1.DBProxyEngineFamilyPostgreSQL:
+		conn[v1alpha1.ResourceCredentialsSecretPortKey] = []byte(strconv.Itoa(portPostgreSQL))
+	}
+	return conn
+}
+
+// GetTargetGroupName returns the name of the desired target group.
+func GetTargetGroupName(p v1beta1.DBProxyTargetGroupParameters) string {
+	if p.TargetGroupName != nil {
+		return *p.TargetGroupName
+	}
+	return v1beta1.DBProxyDefaultTargetGroupName
+}
+
+// GenerateRegisterDBProxyTargetsInput from DBProxyTargetGroupParameters.
+func GenerateRegisterDBProxyTargetsInput(p v1beta1.DBProxyTargetGroupParameters) *rds.RegisterDBProxyTargetsInput {
+	i := &rds.RegisterDBProxyTargetsInput{
+		DBProxyName:     p.DBProxyName,
+		TargetGroupName: aws.String(GetTargetGroupName(p)),
+	}
+	if p.DBInstanceIdentifier != nil {
+		i.DBInstanceIdentifiers = []string{*p.DBInstanceIdentifier}
+	}
+	if p.DBClusterIdentifier != nil {
+		i.DBClusterIdentifiers = []string{*p.DBClusterIdentifier}
+	}
+	return i
+}
+
+// GenerateDeregisterDBProxyTargetsInput from DBProxyTargetGroupParameters.
+func GenerateDeregisterDBProxyTargetsInput(p v1beta1.DBProxyTargetGroupParameters) *rds.DeregisterDBProxyTargetsInput {
+	r := GenerateRegisterDBProxyTargetsInput(p)
+	return &rds.DeregisterDBProxyTargetsInput{
+		DBProxyName:           r.DBProxyName,
+		TargetGroupName:       r.TargetGroupName,
+		DBInstanceIdentifiers: r.DBInstanceIdentifiers,
+		DBClusterIdentifiers:  r.DBClusterIdentifiers,
+	}
+}
+
+// GenerateModifyDBProxyTargetGroupInput from DBProxyTargetGroupParameters.
+func GenerateModifyDBProxyTargetGroupInput(p v1beta1.DBProxyTargetGroupParameters) *rds.ModifyDBProxyTargetGroupInput {
+	i := &rds.ModifyDBProxyTargetGroupInput{
+		DBProxyName:     p.DBProxyName,
+		TargetGroupName: aws.String(GetTargetGroupName(p)),
+	}
+	if c := p.ConnectionPoolConfig; c != nil {
+		i.ConnectionPoolConfig = &rds.ConnectionPoolConfiguration{
+			ConnectionBorrowTimeout:   awsclients.Int64Address(c.ConnectionBorrowTimeout),
+			InitQuery:                 c.InitQuery,
+			MaxConnectionsPercent:     awsclients.Int64Address(c.MaxConnectionsPercent),
+			MaxIdleConnectionsPercent: awsclients.Int64Address(c.MaxIdleConnectionsPercent),
+			SessionPinningFilters:     c.SessionPinningFilters,
+		}
+	}
+	return i
+}
+
+// IsTargetRegistered returns true if the desired DB instance or DB cluster is
+// among the observed targets.
+func IsTargetRegistered(p v1beta1.DBProxyTargetGroupParameters, targets []rds.DBProxyTarget) bool {
+	for _, t := range targets {
+		id := aws.StringValue(t.RdsResourceId)
+		switch {
+		case t.Type == rds.TargetTypeRdsInstance && p.DBInstanceIdentifier != nil && id == *p.DBInstanceIdentifier,
+			t.Type == rds.TargetTypeTrackedCluster && p.DBClusterIdentifier != nil && id == *p.DBClusterIdentifier:
+			return true
+		}
+	}
+	return false
+}
+
+// IsTargetGroupUpToDate checks whether the observed connection pool settings
+// match the desired ones. Only the settings that are given are compared since
+// AWS fills the rest with their defaults.
+func IsTargetGroupUpToDate(p v1beta1.DBProxyTargetGroupParameters, tg rds.DBProxyTargetGroup) bool {
+	d := p.ConnectionPoolConfig
+	if d == nil {
+		return true
+	}
+	o := tg.ConnectionPoolConfig
+	if o == nil {
+		o = &rds.ConnectionPoolConfigurationInfo{}
+	}
+	switch {
+	case d.ConnectionBorrowTimeout != nil && int64(*d.ConnectionBorrowTimeout) != aws.Int64Value(o.ConnectionBorrowTimeout),
+		d.InitQuery != nil && *d.InitQuery != aws.StringValue(o.InitQuery),
+		d.MaxConnectionsPercent != nil && int64(*d.MaxConnectionsPercent) != aws.Int64Value(o.MaxConnectionsPercent),
+		d.MaxIdleConnectionsPercent != nil && int64(*d.MaxIdleConnectionsPercent) != aws.Int64Value(o.MaxIdleConnectionsPercent),
+		d.SessionPinningFilters != nil && !areStringSetsEqual(d.SessionPinningFilters, o.SessionPinningFilters):
+		return false
+	}
+	return true
+}
+
+// GenerateTargetGroupObservation is used to produce
+// v1beta1.DBProxyTargetGroupObservation from rds.DBProxyTargetGroup and its
+// targets.
+func GenerateTargetGroupObservation(tg rds.DBProxyTargetGroup, targets []rds.DBProxyTarget) v1beta1.DBProxyTargetGroupObservation {
+	o := v1beta1.DBProxyTargetGroupObservation{
+		TargetGroupARN: aws.StringValue(tg.TargetGroupArn),
+		Status:         aws.StringValue(tg.Status),
+	}
+	for _, t := range targets {
+		to := v1beta1.DBProxyTargetObservation{
+			Type:          string(t.Type),
+			RDSResourceID: aws.StringValue(t.RdsResourceId),
+			Endpoint:      aws.StringValue(t.Endpoint),
+			Port:          int(aws.Int64Value(t.Port)),
+		}
+		if t.TargetHealth != nil {
+			to.State = string(t.TargetHealth.State)
+			to.Reason = string(t.TargetHealth.Reason)
+		}
+		o.Targets = append(o.Targets, to)
+	}
+	return o
+}
+
+// GetTargetsState summarizes the health of the given targets. A target that
+// is unavailable makes all of them unavailable. It returns an empty string if
+// there is no target that reports its health.
+func GetTargetsState(targets []v1beta1.DBProxyTargetObservation) string {
+	state := ""
+	for _, t := range targets {
+		switch t.State {
+		case v1beta1.DBProxyTargetStateUnavailable:
+			return t.State
+		case v1beta1.DBProxyTargetStateRegistering:
+			state = t.State
+		case v1beta1.DBProxyTargetStateAvailable:
+			if state == "" {
+				state = t.State
+			}
+		}
+	}
+	return state
+}
+
+func generateUserAuthConfigs(in []v1beta1.UserAuthConfig) []rds.UserAuthConfig {
+	if len(in) == 0 {
+		return nil
+	}
+	res := make([]rds.UserAuthConfig, len(in))
+	for i, a := range in {
+		res[i] = rds.UserAuthConfig{
+			AuthScheme:  rds.AuthScheme(aws.StringValue(a.AuthScheme)),
+			Description: a.Description,
+			IAMAuth:     rds.IAMAuthMode(aws.StringValue(a.IAMAuth)),
+			SecretArn:   aws.String(a.SecretARN),
+			UserName:    a.Username,
+		}
+	}
+	return res
+}
+
+// isAuthUpToDate compares the desired and observed authentication
+// configurations by their secrets. Only the fields that are given are
+// compared since AWS fills the rest with their defaults.
+func isAuthUpToDate(desired []v1beta1.UserAuthConfig, observed []rds.UserAuthConfigInfo) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	current := make(map[string]rds.UserAuthConfigInfo, len(observed))
+	for _, o := range observed {
+		current[aws.StringValue(o.SecretArn)] = o
+	}
+	for _, d := range desired {
+		o, ok := current[d.SecretARN]
+		switch {
+		case !ok,
+			d.AuthScheme != nil && *d.AuthScheme != string(o.AuthScheme),
+			d.Description != nil && *d.Description != aws.StringValue(o.Description),
+			d.IAMAuth != nil && *d.IAMAuth != string(o.IAMAuth),
+			d.Username != nil && *d.Username != aws.StringValue(o.UserName):
+			return false
+		}
+	}
+	return true
+}
+
+func areStringSetsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sa := append([]string{}, a...)
+	sb := append([]string{}, b...)
+	sort.Strings(sa)
+	sort.Strings(sb)
+	for i := range sa {
+		if sa[i] != sb[i] {
+			return false
+		}
+	}
+	return true
+}
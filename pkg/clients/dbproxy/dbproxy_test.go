@@ -0,0 +1,176 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbproxy
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+)
+
+var (
+	secretARN = "arn:aws:secretsmanager:us-east-1:123456789012:secret:example"
+	roleARN   = "arn:aws:iam::123456789012:role/example"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	timeout := 1800
+	cases := map[string]struct {
+		p    v1beta1.DBProxyParameters
+		px   rds.DBProxy
+		want bool
+	}{
+		"UpToDate": {
+			p: v1beta1.DBProxyParameters{
+				RoleARN:             aws.String(roleARN),
+				IdleClientTimeout:   &timeout,
+				VPCSecurityGroupIDs: []string{"sg-2", "sg-1"},
+				Auth:                []v1beta1.UserAuthConfig{{SecretARN: secretARN}},
+			},
+			px: rds.DBProxy{
+				RoleArn:             aws.String(roleARN),
+				IdleClientTimeout:   aws.Int64(1800),
+				VpcSecurityGroupIds: []string{"sg-1", "sg-2"},
+				Auth: []rds.UserAuthConfigInfo{{
+					SecretArn:  aws.String(secretARN),
+					AuthScheme: rds.AuthSchemeSecrets,
+					IAMAuth:    rds.IAMAuthModeDisabled,
+				}},
+			},
+			want: true,
+		},
+		"IdleClientTimeoutChanged": {
+			p:  v1beta1.DBProxyParameters{IdleClientTimeout: &timeout},
+			px: rds.DBProxy{IdleClientTimeout: aws.Int64(600)},
+		},
+		"SecurityGroupsChanged": {
+			p:  v1beta1.DBProxyParameters{VPCSecurityGroupIDs: []string{"sg-1"}},
+			px: rds.DBProxy{VpcSecurityGroupIds: []string{"sg-2"}},
+		},
+		"AuthSecretChanged": {
+			p:  v1beta1.DBProxyParameters{Auth: []v1beta1.UserAuthConfig{{SecretARN: secretARN}}},
+			px: rds.DBProxy{Auth: []rds.UserAuthConfigInfo{{SecretArn: aws.String("other")}}},
+		},
+		"AuthIAMChanged": {
+			p: v1beta1.DBProxyParameters{Auth: []v1beta1.UserAuthConfig{{
+				SecretARN: secretARN,
+				IAMAuth:   aws.String(string(rds.IAMAuthModeRequired)),
+			}}},
+			px: rds.DBProxy{Auth: []rds.UserAuthConfigInfo{{
+				SecretArn: aws.String(secretARN),
+				IAMAuth:   rds.IAMAuthModeDisabled,
+			}}},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := IsUpToDate(tc.p, tc.px)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsTargetRegistered(t *testing.T) {
+	cases := map[string]struct {
+		p       v1beta1.DBProxyTargetGroupParameters
+		targets []rds.DBProxyTarget
+		want    bool
+	}{
+		"InstanceRegistered": {
+			p: v1beta1.DBProxyTargetGroupParameters{DBInstanceIdentifier: aws.String("db")},
+			targets: []rds.DBProxyTarget{
+				{Type: rds.TargetTypeRdsInstance, RdsResourceId: aws.String("db")},
+			},
+			want: true,
+		},
+		"ClusterRegistered": {
+			p: v1beta1.DBProxyTargetGroupParameters{DBClusterIdentifier: aws.String("cluster")},
+			targets: []rds.DBProxyTarget{
+				{Type: rds.TargetTypeRdsInstance, RdsResourceId: aws.String("cluster-instance-1")},
+				{Type: rds.TargetTypeTrackedCluster, RdsResourceId: aws.String("cluster")},
+			},
+			want: true,
+		},
+		"OtherInstanceRegistered": {
+			p: v1beta1.DBProxyTargetGroupParameters{DBInstanceIdentifier: aws.String("db")},
+			targets: []rds.DBProxyTarget{
+				{Type: rds.TargetTypeRdsInstance, RdsResourceId: aws.String("other")},
+			},
+		},
+		"NoTargets": {
+			p: v1beta1.DBProxyTargetGroupParameters{DBInstanceIdentifier: aws.String("db")},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := IsTargetRegistered(tc.p, tc.targets)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetTargetsState(t *testing.T) {
+	cases := map[string]struct {
+		targets []v1beta1.DBProxyTargetObservation
+		want    string
+	}{
+		"Available": {
+			targets: []v1beta1.DBProxyTargetObservation{
+				{State: v1beta1.DBProxyTargetStateAvailable},
+				{State: v1beta1.DBProxyTargetStateAvailable},
+			},
+			want: v1beta1.DBProxyTargetStateAvailable,
+		},
+		"Registering": {
+			targets: []v1beta1.DBProxyTargetObservation{
+				{State: v1beta1.DBProxyTargetStateAvailable},
+				{State: v1beta1.DBProxyTargetStateRegistering},
+			},
+			want: v1beta1.DBProxyTargetStateRegistering,
+		},
+		"Unavailable": {
+			targets: []v1beta1.DBProxyTargetObservation{
+				{State: v1beta1.DBProxyTargetStateRegistering},
+				{State: v1beta1.DBProxyTargetStateUnavailable},
+				{State: v1beta1.DBProxyTargetStateAvailable},
+			},
+			want: v1beta1.DBProxyTargetStateUnavailable,
+		},
+		"NoHealth": {
+			targets: []v1beta1.DBProxyTargetObservation{{}},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GetTargetsState(tc.targets)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
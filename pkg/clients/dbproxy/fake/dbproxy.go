@@ -0,0 +1,85 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/dbproxy"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockDBProxyClient)(nil)
+
+// MockDBProxyClient is a type that implements all the methods for DBProxy
+// Client interface
+type MockDBProxyClient struct {
+	MockCreateDBProxyRequest               func(*rds.CreateDBProxyInput) rds.CreateDBProxyRequest
+	MockDescribeDBProxiesRequest           func(*rds.DescribeDBProxiesInput) rds.DescribeDBProxiesRequest
+	MockModifyDBProxyRequest               func(*rds.ModifyDBProxyInput) rds.ModifyDBProxyRequest
+	MockDeleteDBProxyRequest               func(*rds.DeleteDBProxyInput) rds.DeleteDBProxyRequest
+	MockDescribeDBProxyTargetGroupsRequest func(*rds.DescribeDBProxyTargetGroupsInput) rds.DescribeDBProxyTargetGroupsRequest
+	MockModifyDBProxyTargetGroupRequest    func(*rds.ModifyDBProxyTargetGroupInput) rds.ModifyDBProxyTargetGroupRequest
+	MockDescribeDBProxyTargetsRequest      func(*rds.DescribeDBProxyTargetsInput) rds.DescribeDBProxyTargetsRequest
+	MockRegisterDBProxyTargetsRequest      func(*rds.RegisterDBProxyTargetsInput) rds.RegisterDBProxyTargetsRequest
+	MockDeregisterDBProxyTargetsRequest    func(*rds.DeregisterDBProxyTargetsInput) rds.DeregisterDBProxyTargetsRequest
+}
+
+// CreateDBProxyRequest mocks CreateDBProxyRequest method
+func (m *MockDBProxyClient) CreateDBProxyRequest(input *rds.CreateDBProxyInput) rds.CreateDBProxyRequest {
+	return m.MockCreateDBProxyRequest(input)
+}
+
+// DescribeDBProxiesRequest mocks DescribeDBProxiesRequest method
+func (m *MockDBProxyClient) DescribeDBProxiesRequest(input *rds.DescribeDBProxiesInput) rds.DescribeDBProxiesRequest {
+	return m.MockDescribeDBProxiesRequest(input)
+}
+
+// ModifyDBProxyRequest mocks ModifyDBProxyRequest method
+func (m *MockDBProxyClient) ModifyDBProxyRequest(input *rds.ModifyDBProxyInput) rds.ModifyDBProxyRequest {
+	return m.MockModifyDBProxyRequest(input)
+}
+
+// DeleteDBProxyRequest mocks DeleteDBProxyRequest method
+func (m *MockDBProxyClient) DeleteDBProxyRequest(input *rds.DeleteDBProxyInput) rds.DeleteDBProxyRequest {
+	return m.MockDeleteDBProxyRequest(input)
+}
+
+// DescribeDBProxyTargetGroupsRequest mocks DescribeDBProxyTargetGroupsRequest method
+func (m *MockDBProxyClient) DescribeDBProxyTargetGroupsRequest(input *rds.DescribeDBProxyTargetGroupsInput) rds.DescribeDBProxyTargetGroupsRequest {
+	return m.MockDescribeDBProxyTargetGroupsRequest(input)
+}
+
+// ModifyDBProxyTargetGroupRequest mocks ModifyDBProxyTargetGroupRequest method
+func (m *MockDBProxyClient) ModifyDBProxyTargetGroupRequest(input *rds.ModifyDBProxyTargetGroupInput) rds.ModifyDBProxyTargetGroupRequest {
+	return m.MockModifyDBProxyTargetGroupRequest(input)
+}
+
+// DescribeDBProxyTargetsRequest mocks DescribeDBProxyTargetsRequest method
+func (m *MockDBProxyClient) DescribeDBProxyTargetsRequest(input *rds.DescribeDBProxyTargetsInput) rds.DescribeDBProxyTargetsRequest {
+	return m.MockDescribeDBProxyTargetsRequest(input)
+}
+
+// RegisterDBProxyTargetsRequest mocks RegisterDBProxyTargetsRequest method
+func (m *MockDBProxyClient) RegisterDBProxyTargetsRequest(input *rds.RegisterDBProxyTargetsInput) rds.RegisterDBProxyTargetsRequest {
+	return m.MockRegisterDBProxyTargetsRequest(input)
+}
+
+// DeregisterDBProxyTargetsRequest mocks DeregisterDBProxyTargetsRequest method
+func (m *MockDBProxyClient) DeregisterDBProxyTargetsRequest(input *rds.DeregisterDBProxyTargetsInput) rds.DeregisterDBProxyTargetsRequest {
+	return m.MockDeregisterDBProxyTargetsRequest(input)
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbsnapshot
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsarn "github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client is the external client used for DBSnapshot Custom Resource
+type Client interface {
+	CreateDBSnapshotRequest(*rds.CreateDBSnapshotInput) rds.CreateDBSnapshotRequest
+	CopyDBSnapshotRequest(*rds.CopyDBSnapshotInput) rds.CopyDBSnapshotRequest
+	DescribeDBSnapshotsRequest(*rds.DescribeDBSnapshotsInput) rds.DescribeDBSnapshotsRequest
+	DescribeDBSnapshotAttributesRequest(*rds.DescribeDBSnapshotAttributesInput) rds.DescribeDBSnapshotAttributesRequest
+	ModifyDBSnapshotAttributeRequest(*rds.ModifyDBSnapshotAttributeInput) rds.ModifyDBSnapshotAttributeRequest
+	DeleteDBSnapshotRequest(*rds.DeleteDBSnapshotInput) rds.DeleteDBSnapshotRequest
+}
+
+// NewClient returns a new client using AWS credentials as JSON encoded data.
+func NewClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (Client, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return rds.New(*cfg), nil
+}
+
+// IsNotFound returns true if the error is because the DB snapshot doesn't
+// exist.
+func IsNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), rds.ErrCodeDBSnapshotNotFoundFault)
+}
+
+// GenerateCreateDBSnapshotInput from DBSnapshotParameters.
+func GenerateCreateDBSnapshotInput(name string, p v1beta1.DBSnapshotParameters) *rds.CreateDBSnapshotInput {
+	return &rds.CreateDBSnapshotInput{
+		DBSnapshotIdentifier: aws.String(name),
+		DBInstanceIdentifier: p.DBInstanceIdentifier,
+		Tags:                 generateTags(p.Tags),
+	}
+}
+
+// GenerateCopyDBSnapshotInput from DBSnapshotParameters. The region of the
+// source is taken from its ARN so that the request is presigned for
+// cross-region copies.
+func GenerateCopyDBSnapshotInput(name string, p v1beta1.DBSnapshotParameters) *rds.CopyDBSnapshotInput {
+	c := &rds.CopyDBSnapshotInput{
+		TargetDBSnapshotIdentifier: aws.String(name),
+		SourceDBSnapshotIdentifier: p.SourceDBSnapshotIdentifier,
+		KmsKeyId:                   p.KMSKeyID,
+		CopyTags:                   p.CopyTags,
+		OptionGroupName:            p.OptionGroupName,
+		Tags:                       generateTags(p.Tags),
+	}
+	if a, err := awsarn.Parse(aws.StringValue(p.SourceDBSnapshotIdentifier)); err == nil {
+		c.SourceRegion = aws.String(a.Region)
+	}
+	return c
+}
+
+// GenerateObservation is used to produce v1beta1.DBSnapshotObservation from
+// rds.DBSnapshot and the accounts it is shared with.
+func GenerateObservation(s rds.DBSnapshot, shared []string) v1beta1.DBSnapshotObservation {
+	o := v1beta1.DBSnapshotObservation{
+		DBSnapshotARN:              aws.StringValue(s.DBSnapshotArn),
+		Status:                     aws.StringValue(s.Status),
+		PercentProgress:            int(aws.Int64Value(s.PercentProgress)),
+		SnapshotType:               aws.StringValue(s.SnapshotType),
+		Engine:                     aws.StringValue(s.Engine),
+		EngineVersion:              aws.StringValue(s.EngineVersion),
+		AllocatedStorage:           int(aws.Int64Value(s.AllocatedStorage)),
+		Encrypted:                  aws.BoolValue(s.Encrypted),
+		KMSKeyID:                   aws.StringValue(s.KmsKeyId),
+		SourceDBSnapshotIdentifier: aws.StringValue(s.SourceDBSnapshotIdentifier),
+		SourceRegion:               aws.StringValue(s.SourceRegion),
+		SharedAccounts:             shared,
+	}
+	if s.SnapshotCreateTime != nil {
+		t := metav1.NewTime(*s.SnapshotCreateTime)
+		o.SnapshotCreateTime = &t
+	}
+	return o
+}
+
+// GetSharedAccounts returns the accounts that are allowed to restore the DB
+// snapshot.
+func GetSharedAccounts(r *rds.DBSnapshotAttributesResult) []string {
+	if r == nil {
+		return nil
+	}
+	for _, a := range r.DBSnapshotAttributes {
+		if aws.StringValue(a.AttributeName) == v1beta1.DBSnapshotAttributeRestore {
+			return a.AttributeValues
+		}
+	}
+	return nil
+}
+
+// GenerateModifyDBSnapshotAttributeInput returns the input to share the DB
+// snapshot with exactly the desired accounts. It returns nil if it is
+// already shared with them.
+func GenerateModifyDBSnapshotAttributeInput(name string, desired, observed []string) *rds.ModifyDBSnapshotAttributeInput {
+	add := stringSliceDiff(desired, observed)
+	remove := stringSliceDiff(observed, desired)
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+	return &rds.ModifyDBSnapshotAttributeInput{
+		DBSnapshotIdentifier: aws.String(name),
+		AttributeName:        aws.String(v1beta1.DBSnapshotAttributeRestore),
+		ValuesToAdd:          add,
+		ValuesToRemove:       remove,
+	}
+}
+
+// IsUpToDate checks whether the DB snapshot is shared with exactly the
+// desired accounts.
+func IsUpToDate(p v1beta1.DBSnapshotParameters, shared []string) bool {
+	return GenerateModifyDBSnapshotAttributeInput("", p.SharedAccounts, shared) == nil
+}
+
+func generateTags(tags []v1beta1.Tag) []rds.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]rds.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = rds.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// stringSliceDiff returns the elements of a that are not in b.
+func stringSliceDiff(a, b []string) []string {
+	m := make(map[string]struct{}, len(b))
+	for _, s := range b {
+		m[s] = struct{}{}
+	}
+	var res []string
+	for _, s := range a {
+		if _, ok := m[s]; !ok {
+			res = append(res, s)
+		}
+	}
+	return res
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbsnapshot
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+)
+
+var (
+	name    = "example"
+	kmsKey  = "arn:aws:kms:us-east-1:123456789012:key/example"
+	account = "123456789012"
+)
+
+func TestGenerateCopyDBSnapshotInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1beta1.DBSnapshotParameters
+		want *rds.CopyDBSnapshotInput
+	}{
+		"SameRegion": {
+			p: v1beta1.DBSnapshotParameters{SourceDBSnapshotIdentifier: aws.String("source")},
+			want: &rds.CopyDBSnapshotInput{
+				TargetDBSnapshotIdentifier: aws.String(name),
+				SourceDBSnapshotIdentifier: aws.String("source"),
+			},
+		},
+		"CrossRegionWithKMSKey": {
+			p: v1beta1.DBSnapshotParameters{
+				SourceDBSnapshotIdentifier: aws.String("arn:aws:rds:eu-west-1:123456789012:snapshot:source"),
+				KMSKeyID:                   aws.String(kmsKey),
+				CopyTags:                   aws.Bool(true),
+				Tags:                       []v1beta1.Tag{{Key: "k", Value: "v"}},
+			},
+			want: &rds.CopyDBSnapshotInput{
+				TargetDBSnapshotIdentifier: aws.String(name),
+				SourceDBSnapshotIdentifier: aws.String("arn:aws:rds:eu-west-1:123456789012:snapshot:source"),
+				SourceRegion:               aws.String("eu-west-1"),
+				KmsKeyId:                   aws.String(kmsKey),
+				CopyTags:                   aws.Bool(true),
+				Tags:                       []rds.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GenerateCopyDBSnapshotInput(name, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateModifyDBSnapshotAttributeInput(t *testing.T) {
+	cases := map[string]struct {
+		desired  []string
+		observed []string
+		want     *rds.ModifyDBSnapshotAttributeInput
+	}{
+		"UpToDate": {
+			desired:  []string{account},
+			observed: []string{account},
+		},
+		"ShareAndUnshare": {
+			desired:  []string{account},
+			observed: []string{"all"},
+			want: &rds.ModifyDBSnapshotAttributeInput{
+				DBSnapshotIdentifier: aws.String(name),
+				AttributeName:        aws.String(v1beta1.DBSnapshotAttributeRestore),
+				ValuesToAdd:          []string{account},
+				ValuesToRemove:       []string{"all"},
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GenerateModifyDBSnapshotAttributeInput(name, tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/dbsnapshot"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockDBSnapshotClient)(nil)
+
+// MockDBSnapshotClient is a type that implements all the methods for
+// DBSnapshot Client interface
+type MockDBSnapshotClient struct {
+	MockCreateDBSnapshotRequest             func(*rds.CreateDBSnapshotInput) rds.CreateDBSnapshotRequest
+	MockCopyDBSnapshotRequest               func(*rds.CopyDBSnapshotInput) rds.CopyDBSnapshotRequest
+	MockDescribeDBSnapshotsRequest          func(*rds.DescribeDBSnapshotsInput) rds.DescribeDBSnapshotsRequest
+	MockDescribeDBSnapshotAttributesRequest func(*rds.DescribeDBSnapshotAttributesInput) rds.DescribeDBSnapshotAttributesRequest
+	MockModifyDBSnapshotAttributeRequest    func(*rds.ModifyDBSnapshotAttributeInput) rds.ModifyDBSnapshotAttributeRequest
+	MockDeleteDBSnapshotRequest             func(*rds.DeleteDBSnapshotInput) rds.DeleteDBSnapshotRequest
+}
+
+// CreateDBSnapshotRequest mocks CreateDBSnapshotRequest method
+func (m *MockDBSnapshotClient) CreateDBSnapshotRequest(input *rds.CreateDBSnapshotInput) rds.CreateDBSnapshotRequest {
+	return m.MockCreateDBSnapshotRequest(input)
+}
+
+// CopyDBSnapshotRequest mocks CopyDBSnapshotRequest method
+func (m *MockDBSnapshotClient) CopyDBSnapshotRequest(input *rds.CopyDBSnapshotInput) rds.CopyDBSnapshotRequest {
+	return m.MockCopyDBSnapshotRequest(input)
+}
+
+// DescribeDBSnapshotsRequest mocks DescribeDBSnapshotsRequest method
+func (m *MockDBSnapshotClient) DescribeDBSnapshotsRequest(input *rds.DescribeDBSnapshotsInput) rds.DescribeDBSnapshotsRequest {
+	return m.MockDescribeDBSnapshotsRequest(input)
+}
+
+// DescribeDBSnapshotAttributesRequest mocks DescribeDBSnapshotAttributesRequest method
+func (m *MockDBSnapshotClient) DescribeDBSnapshotAttributesRequest(input *rds.DescribeDBSnapshotAttributesInput) rds.DescribeDBSnapshotAttributesRequest {
+	return m.MockDescribeDBSnapshotAttributesRequest(input)
+}
+
+// ModifyDBSnapshotAttributeRequest mocks ModifyDBSnapshotAttributeRequest method
+func (m *MockDBSnapshotClient) ModifyDBSnapshotAttributeRequest(input *rds.ModifyDBSnapshotAttributeInput) rds.ModifyDBSnapshotAttributeRequest {
+	return m.MockModifyDBSnapshotAttributeRequest(input)
+}
+
+// DeleteDBSnapshotRequest mocks DeleteDBSnapshotRequest method
+func (m *MockDBSnapshotClient) DeleteDBSnapshotRequest(input *rds.DeleteDBSnapshotInput) rds.DeleteDBSnapshotRequest {
+	return m.MockDeleteDBSnapshotRequest(input)
+}
@@ -0,0 +1,120 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// DHCPOptionsIDNotFound is the code that is returned by ec2 when the given DHCPOptionsID is not valid
+	DHCPOptionsIDNotFound = "InvalidDhcpOptionID.NotFound"
+
+	// DefaultDHCPOptionsID is the ID used to associate a VPC with the default
+	// DHCP options set.
+	DefaultDHCPOptionsID = "default"
+)
+
+// DHCPOptionsClient is the external client used for DHCPOptions Custom Resource
+type DHCPOptionsClient interface {
+	CreateDhcpOptionsRequest(*ec2.CreateDhcpOptionsInput) ec2.CreateDhcpOptionsRequest
+	DeleteDhcpOptionsRequest(*ec2.DeleteDhcpOptionsInput) ec2.DeleteDhcpOptionsRequest
+	DescribeDhcpOptionsRequest(*ec2.DescribeDhcpOptionsInput) ec2.DescribeDhcpOptionsRequest
+	AssociateDhcpOptionsRequest(*ec2.AssociateDhcpOptionsInput) ec2.AssociateDhcpOptionsRequest
+	DescribeVpcsRequest(*ec2.DescribeVpcsInput) ec2.DescribeVpcsRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// NewDHCPOptionsClient returns a new client using AWS credentials as JSON encoded data.
+func NewDHCPOptionsClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (DHCPOptionsClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ec2.New(*cfg), nil
+}
+
+// IsDHCPOptionsNotFoundErr returns true if the error is because the DHCP
+// options set doesn't exist
+func IsDHCPOptionsNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == DHCPOptionsIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateCreateDHCPOptionsInput returns the input to create the DHCP options
+// set described by the given parameters.
+func GenerateCreateDHCPOptionsInput(p v1alpha4.DHCPOptionsParameters) *ec2.CreateDhcpOptionsInput {
+	in := &ec2.CreateDhcpOptionsInput{
+		DhcpConfigurations: make([]ec2.NewDhcpConfiguration, len(p.DHCPConfigurations)),
+	}
+	for i, c := range p.DHCPConfigurations {
+		in.DhcpConfigurations[i] = ec2.NewDhcpConfiguration{
+			Key:    aws.String(c.Key),
+			Values: c.Values,
+		}
+	}
+	return in
+}
+
+// GenerateDHCPOptionsObservation is used to produce
+// v1alpha4.DHCPOptionsObservation from ec2.DhcpOptions and the VPC it is
+// associated with, if any.
+func GenerateDHCPOptionsObservation(o ec2.DhcpOptions, associatedVPCID string) v1alpha4.DHCPOptionsObservation {
+	return v1alpha4.DHCPOptionsObservation{
+		DHCPOptionsID:   aws.StringValue(o.DhcpOptionsId),
+		OwnerID:         aws.StringValue(o.OwnerId),
+		AssociatedVPCID: associatedVPCID,
+	}
+}
+
+// LateInitializeDHCPOptions fills the empty fields in
+// *v1alpha4.DHCPOptionsParameters with the values seen in ec2.DhcpOptions.
+func LateInitializeDHCPOptions(in *v1alpha4.DHCPOptionsParameters, o *ec2.DhcpOptions) {
+	if o == nil {
+		return
+	}
+
+	if len(in.DHCPConfigurations) == 0 && len(o.DhcpConfigurations) != 0 {
+		in.DHCPConfigurations = make([]v1alpha4.DHCPConfiguration, len(o.DhcpConfigurations))
+		for i, c := range o.DhcpConfigurations {
+			in.DHCPConfigurations[i] = v1alpha4.DHCPConfiguration{Key: aws.StringValue(c.Key)}
+			for _, v := range c.Values {
+				in.DHCPConfigurations[i].Values = append(in.DHCPConfigurations[i].Values, aws.StringValue(v.Value))
+			}
+		}
+	}
+
+	if len(in.Tags) == 0 && len(o.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(o.Tags)
+	}
+}
+
+// IsDHCPOptionsAssociationUpToDate returns true if the desired VPC uses the
+// DHCP options set with the given ID.
+func IsDHCPOptionsAssociationUpToDate(p v1alpha4.DHCPOptionsParameters, id string, vpc *ec2.Vpc) bool {
+	if aws.StringValue(p.VPCID) == "" {
+		return true
+	}
+	return vpc != nil && aws.StringValue(vpc.DhcpOptionsId) == id
+}
+
+// IsDHCPOptionsUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource. The DHCP configurations
+// of a set cannot be modified, so only the tags and the VPC association are
+// compared.
+func IsDHCPOptionsUpToDate(p v1alpha4.DHCPOptionsParameters, o ec2.DhcpOptions, vpc *ec2.Vpc) bool {
+	if !IsDHCPOptionsAssociationUpToDate(p, aws.StringValue(o.DhcpOptionsId), vpc) {
+		return false
+	}
+	return v1beta1.CompareTags(p.Tags, o.Tags)
+}
@@ -0,0 +1,125 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+var (
+	dhcpID    = "some dhcp options"
+	dhcpVPC   = "some vpc"
+	dhcpKey   = "domain-name-servers"
+	dhcpValue = "10.0.0.2"
+)
+
+func TestIsDHCPOptionsUpToDate(t *testing.T) {
+	type args struct {
+		p   v1alpha4.DHCPOptionsParameters
+		o   ec2.DhcpOptions
+		vpc *ec2.Vpc
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NoVPC": {
+			args: args{
+				p: v1alpha4.DHCPOptionsParameters{},
+				o: ec2.DhcpOptions{DhcpOptionsId: aws.String(dhcpID)},
+			},
+			want: true,
+		},
+		"Associated": {
+			args: args{
+				p:   v1alpha4.DHCPOptionsParameters{VPCID: aws.String(dhcpVPC)},
+				o:   ec2.DhcpOptions{DhcpOptionsId: aws.String(dhcpID)},
+				vpc: &ec2.Vpc{VpcId: aws.String(dhcpVPC), DhcpOptionsId: aws.String(dhcpID)},
+			},
+			want: true,
+		},
+		"NotAssociated": {
+			args: args{
+				p:   v1alpha4.DHCPOptionsParameters{VPCID: aws.String(dhcpVPC)},
+				o:   ec2.DhcpOptions{DhcpOptionsId: aws.String(dhcpID)},
+				vpc: &ec2.Vpc{VpcId: aws.String(dhcpVPC), DhcpOptionsId: aws.String("default")},
+			},
+			want: false,
+		},
+		"VPCMissing": {
+			args: args{
+				p: v1alpha4.DHCPOptionsParameters{VPCID: aws.String(dhcpVPC)},
+				o: ec2.DhcpOptions{DhcpOptionsId: aws.String(dhcpID)},
+			},
+			want: false,
+		},
+		"TagsDiffer": {
+			args: args{
+				p: v1alpha4.DHCPOptionsParameters{Tags: []v1beta1.Tag{{Key: "k", Value: "v"}}},
+				o: ec2.DhcpOptions{DhcpOptionsId: aws.String(dhcpID)},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDHCPOptionsUpToDate(tc.args.p, tc.args.o, tc.args.vpc)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeDHCPOptions(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha4.DHCPOptionsParameters
+		o    *ec2.DhcpOptions
+		want v1alpha4.DHCPOptionsParameters
+	}{
+		"AllFilled": {
+			p: v1alpha4.DHCPOptionsParameters{},
+			o: &ec2.DhcpOptions{
+				DhcpConfigurations: []ec2.DhcpConfiguration{{
+					Key:    aws.String(dhcpKey),
+					Values: []ec2.AttributeValue{{Value: aws.String(dhcpValue)}},
+				}},
+				Tags: []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			},
+			want: v1alpha4.DHCPOptionsParameters{
+				DHCPConfigurations: []v1alpha4.DHCPConfiguration{{Key: dhcpKey, Values: []string{dhcpValue}}},
+				Tags:               []v1beta1.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		"NoOverride": {
+			p: v1alpha4.DHCPOptionsParameters{
+				DHCPConfigurations: []v1alpha4.DHCPConfiguration{{Key: dhcpKey, Values: []string{"10.0.0.3"}}},
+			},
+			o: &ec2.DhcpOptions{
+				DhcpConfigurations: []ec2.DhcpConfiguration{{
+					Key:    aws.String(dhcpKey),
+					Values: []ec2.AttributeValue{{Value: aws.String(dhcpValue)}},
+				}},
+			},
+			want: v1alpha4.DHCPOptionsParameters{
+				DHCPConfigurations: []v1alpha4.DHCPConfiguration{{Key: dhcpKey, Values: []string{"10.0.0.3"}}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeDHCPOptions(&tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
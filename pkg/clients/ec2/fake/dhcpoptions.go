@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DHCPOptionsClient = (*MockDHCPOptionsClient)(nil)
+
+// MockDHCPOptionsClient is a type that implements all the methods for DHCPOptionsClient interface
+type MockDHCPOptionsClient struct {
+	MockCreate       func(*ec2.CreateDhcpOptionsInput) ec2.CreateDhcpOptionsRequest
+	MockDelete       func(*ec2.DeleteDhcpOptionsInput) ec2.DeleteDhcpOptionsRequest
+	MockDescribe     func(*ec2.DescribeDhcpOptionsInput) ec2.DescribeDhcpOptionsRequest
+	MockAssociate    func(*ec2.AssociateDhcpOptionsInput) ec2.AssociateDhcpOptionsRequest
+	MockDescribeVpcs func(*ec2.DescribeVpcsInput) ec2.DescribeVpcsRequest
+	MockCreateTags   func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// CreateDhcpOptionsRequest mocks CreateDhcpOptionsRequest method
+func (m *MockDHCPOptionsClient) CreateDhcpOptionsRequest(input *ec2.CreateDhcpOptionsInput) ec2.CreateDhcpOptionsRequest {
+	return m.MockCreate(input)
+}
+
+// DeleteDhcpOptionsRequest mocks DeleteDhcpOptionsRequest method
+func (m *MockDHCPOptionsClient) DeleteDhcpOptionsRequest(input *ec2.DeleteDhcpOptionsInput) ec2.DeleteDhcpOptionsRequest {
+	return m.MockDelete(input)
+}
+
+// DescribeDhcpOptionsRequest mocks DescribeDhcpOptionsRequest method
+func (m *MockDHCPOptionsClient) DescribeDhcpOptionsRequest(input *ec2.DescribeDhcpOptionsInput) ec2.DescribeDhcpOptionsRequest {
+	return m.MockDescribe(input)
+}
+
+// AssociateDhcpOptionsRequest mocks AssociateDhcpOptionsRequest method
+func (m *MockDHCPOptionsClient) AssociateDhcpOptionsRequest(input *ec2.AssociateDhcpOptionsInput) ec2.AssociateDhcpOptionsRequest {
+	return m.MockAssociate(input)
+}
+
+// DescribeVpcsRequest mocks DescribeVpcsRequest method
+func (m *MockDHCPOptionsClient) DescribeVpcsRequest(input *ec2.DescribeVpcsInput) ec2.DescribeVpcsRequest {
+	return m.MockDescribeVpcs(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockDHCPOptionsClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
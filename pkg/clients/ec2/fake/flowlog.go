@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.FlowLogClient = (*MockFlowLogClient)(nil)
+
+// MockFlowLogClient is a type that implements all the methods for FlowLogClient interface
+type MockFlowLogClient struct {
+	MockCreate     func(*ec2.CreateFlowLogsInput) ec2.CreateFlowLogsRequest
+	MockDelete     func(*ec2.DeleteFlowLogsInput) ec2.DeleteFlowLogsRequest
+	MockDescribe   func(*ec2.DescribeFlowLogsInput) ec2.DescribeFlowLogsRequest
+	MockCreateTags func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// CreateFlowLogsRequest mocks CreateFlowLogsRequest method
+func (m *MockFlowLogClient) CreateFlowLogsRequest(input *ec2.CreateFlowLogsInput) ec2.CreateFlowLogsRequest {
+	return m.MockCreate(input)
+}
+
+// DeleteFlowLogsRequest mocks DeleteFlowLogsRequest method
+func (m *MockFlowLogClient) DeleteFlowLogsRequest(input *ec2.DeleteFlowLogsInput) ec2.DeleteFlowLogsRequest {
+	return m.MockDelete(input)
+}
+
+// DescribeFlowLogsRequest mocks DescribeFlowLogsRequest method
+func (m *MockFlowLogClient) DescribeFlowLogsRequest(input *ec2.DescribeFlowLogsInput) ec2.DescribeFlowLogsRequest {
+	return m.MockDescribe(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockFlowLogClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
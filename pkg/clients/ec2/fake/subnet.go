@@ -32,6 +32,9 @@ type MockSubnetClient struct {
 	MockDescribe   func(*ec2.DescribeSubnetsInput) ec2.DescribeSubnetsRequest
 	MockModify     func(*ec2.ModifySubnetAttributeInput) ec2.ModifySubnetAttributeRequest
 	MockCreateTags func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+
+	MockAssociateCIDRBlock    func(*ec2.AssociateSubnetCidrBlockInput) ec2.AssociateSubnetCidrBlockRequest
+	MockDisassociateCIDRBlock func(*ec2.DisassociateSubnetCidrBlockInput) ec2.DisassociateSubnetCidrBlockRequest
 }
 
 // CreateSubnetRequest mocks CreateSubnetRequest method
@@ -58,3 +61,13 @@ func (m *MockSubnetClient) ModifySubnetAttributeRequest(input *ec2.ModifySubnetA
 func (m *MockSubnetClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
 	return m.MockCreateTags(input)
 }
+
+// AssociateSubnetCidrBlockRequest mocks AssociateSubnetCidrBlockRequest method
+func (m *MockSubnetClient) AssociateSubnetCidrBlockRequest(input *ec2.AssociateSubnetCidrBlockInput) ec2.AssociateSubnetCidrBlockRequest {
+	return m.MockAssociateCIDRBlock(input)
+}
+
+// DisassociateSubnetCidrBlockRequest mocks DisassociateSubnetCidrBlockRequest method
+func (m *MockSubnetClient) DisassociateSubnetCidrBlockRequest(input *ec2.DisassociateSubnetCidrBlockInput) ec2.DisassociateSubnetCidrBlockRequest {
+	return m.MockDisassociateCIDRBlock(input)
+}
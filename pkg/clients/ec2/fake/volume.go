@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.VolumeClient = (*MockVolumeClient)(nil)
+
+// MockVolumeClient is a type that implements all the methods for VolumeClient interface
+type MockVolumeClient struct {
+	MockCreate                func(*ec2.CreateVolumeInput) ec2.CreateVolumeRequest
+	MockDelete                func(*ec2.DeleteVolumeInput) ec2.DeleteVolumeRequest
+	MockDescribe              func(*ec2.DescribeVolumesInput) ec2.DescribeVolumesRequest
+	MockModify                func(*ec2.ModifyVolumeInput) ec2.ModifyVolumeRequest
+	MockDescribeModifications func(*ec2.DescribeVolumesModificationsInput) ec2.DescribeVolumesModificationsRequest
+	MockCreateTags            func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// CreateVolumeRequest mocks CreateVolumeRequest method
+func (m *MockVolumeClient) CreateVolumeRequest(input *ec2.CreateVolumeInput) ec2.CreateVolumeRequest {
+	return m.MockCreate(input)
+}
+
+// DeleteVolumeRequest mocks DeleteVolumeRequest method
+func (m *MockVolumeClient) DeleteVolumeRequest(input *ec2.DeleteVolumeInput) ec2.DeleteVolumeRequest {
+	return m.MockDelete(input)
+}
+
+// DescribeVolumesRequest mocks DescribeVolumesRequest method
+func (m *MockVolumeClient) DescribeVolumesRequest(input *ec2.DescribeVolumesInput) ec2.DescribeVolumesRequest {
+	return m.MockDescribe(input)
+}
+
+// ModifyVolumeRequest mocks ModifyVolumeRequest method
+func (m *MockVolumeClient) ModifyVolumeRequest(input *ec2.ModifyVolumeInput) ec2.ModifyVolumeRequest {
+	return m.MockModify(input)
+}
+
+// DescribeVolumesModificationsRequest mocks DescribeVolumesModificationsRequest method
+func (m *MockVolumeClient) DescribeVolumesModificationsRequest(input *ec2.DescribeVolumesModificationsInput) ec2.DescribeVolumesModificationsRequest {
+	return m.MockDescribeModifications(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockVolumeClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.VolumeAttachmentClient = (*MockVolumeAttachmentClient)(nil)
+
+// MockVolumeAttachmentClient is a type that implements all the methods for VolumeAttachmentClient interface
+type MockVolumeAttachmentClient struct {
+	MockAttach   func(*ec2.AttachVolumeInput) ec2.AttachVolumeRequest
+	MockDetach   func(*ec2.DetachVolumeInput) ec2.DetachVolumeRequest
+	MockDescribe func(*ec2.DescribeVolumesInput) ec2.DescribeVolumesRequest
+}
+
+// AttachVolumeRequest mocks AttachVolumeRequest method
+func (m *MockVolumeAttachmentClient) AttachVolumeRequest(input *ec2.AttachVolumeInput) ec2.AttachVolumeRequest {
+	return m.MockAttach(input)
+}
+
+// DetachVolumeRequest mocks DetachVolumeRequest method
+func (m *MockVolumeAttachmentClient) DetachVolumeRequest(input *ec2.DetachVolumeInput) ec2.DetachVolumeRequest {
+	return m.MockDetach(input)
+}
+
+// DescribeVolumesRequest mocks DescribeVolumesRequest method
+func (m *MockVolumeAttachmentClient) DescribeVolumesRequest(input *ec2.DescribeVolumesInput) ec2.DescribeVolumesRequest {
+	return m.MockDescribe(input)
+}
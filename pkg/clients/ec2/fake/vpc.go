@@ -34,6 +34,8 @@ type MockVPCClient struct {
 	MockModifyTenancy               func(*ec2.ModifyVpcTenancyInput) ec2.ModifyVpcTenancyRequest
 	MockCreateTagsRequest           func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
 	MockDescribeVpcAttributeRequest func(*ec2.DescribeVpcAttributeInput) ec2.DescribeVpcAttributeRequest
+	MockAssociateCIDRBlock          func(*ec2.AssociateVpcCidrBlockInput) ec2.AssociateVpcCidrBlockRequest
+	MockDisassociateCIDRBlock       func(*ec2.DisassociateVpcCidrBlockInput) ec2.DisassociateVpcCidrBlockRequest
 }
 
 // CreateVpcRequest mocks CreateVpcRequest method
@@ -70,3 +72,13 @@ func (m *MockVPCClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.Create
 func (m *MockVPCClient) DescribeVpcAttributeRequest(input *ec2.DescribeVpcAttributeInput) ec2.DescribeVpcAttributeRequest {
 	return m.MockDescribeVpcAttributeRequest(input)
 }
+
+// AssociateVpcCidrBlockRequest mocks AssociateVpcCidrBlockRequest method
+func (m *MockVPCClient) AssociateVpcCidrBlockRequest(input *ec2.AssociateVpcCidrBlockInput) ec2.AssociateVpcCidrBlockRequest {
+	return m.MockAssociateCIDRBlock(input)
+}
+
+// DisassociateVpcCidrBlockRequest mocks DisassociateVpcCidrBlockRequest method
+func (m *MockVPCClient) DisassociateVpcCidrBlockRequest(input *ec2.DisassociateVpcCidrBlockInput) ec2.DisassociateVpcCidrBlockRequest {
+	return m.MockDisassociateCIDRBlock(input)
+}
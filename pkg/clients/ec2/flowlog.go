@@ -0,0 +1,124 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// FlowLogIDNotFound is the code that is returned by ec2 when the given FlowLogID is not valid
+	FlowLogIDNotFound = "InvalidFlowLogId.NotFound"
+)
+
+// FlowLogClient is the external client used for FlowLog Custom Resource
+type FlowLogClient interface {
+	CreateFlowLogsRequest(*ec2.CreateFlowLogsInput) ec2.CreateFlowLogsRequest
+	DeleteFlowLogsRequest(*ec2.DeleteFlowLogsInput) ec2.DeleteFlowLogsRequest
+	DescribeFlowLogsRequest(*ec2.DescribeFlowLogsInput) ec2.DescribeFlowLogsRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// NewFlowLogClient returns a new client using AWS credentials as JSON encoded data.
+func NewFlowLogClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (FlowLogClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ec2.New(*cfg), nil
+}
+
+// IsFlowLogNotFoundErr returns true if the error is because the flow log doesn't exist
+func IsFlowLogNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == FlowLogIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GetFlowLogResource returns the type and the ID of the resource whose traffic
+// is logged by the flow log with the given parameters. An empty ID is returned
+// if no resource is set.
+func GetFlowLogResource(p v1alpha4.FlowLogParameters) (ec2.FlowLogsResourceType, string) {
+	switch {
+	case aws.StringValue(p.VPCID) != "":
+		return ec2.FlowLogsResourceTypeVpc, aws.StringValue(p.VPCID)
+	case aws.StringValue(p.SubnetID) != "":
+		return ec2.FlowLogsResourceTypeSubnet, aws.StringValue(p.SubnetID)
+	case aws.StringValue(p.NetworkInterfaceID) != "":
+		return ec2.FlowLogsResourceTypeNetworkInterface, aws.StringValue(p.NetworkInterfaceID)
+	}
+	return "", ""
+}
+
+// GenerateCreateFlowLogsInput returns the input to create the flow log
+// described by the given parameters.
+func GenerateCreateFlowLogsInput(p v1alpha4.FlowLogParameters) *ec2.CreateFlowLogsInput {
+	resourceType, resourceID := GetFlowLogResource(p)
+	in := &ec2.CreateFlowLogsInput{
+		DeliverLogsPermissionArn: p.DeliverLogsPermissionARN,
+		LogDestination:           p.LogDestination,
+		LogDestinationType:       ec2.LogDestinationType(aws.StringValue(p.LogDestinationType)),
+		LogFormat:                p.LogFormat,
+		LogGroupName:             p.LogGroupName,
+		MaxAggregationInterval:   p.MaxAggregationInterval,
+		ResourceIds:              []string{resourceID},
+		ResourceType:             resourceType,
+		TrafficType:              ec2.TrafficType(p.TrafficType),
+	}
+	if len(p.Tags) != 0 {
+		in.TagSpecifications = []ec2.TagSpecification{{
+			ResourceType: ec2.ResourceTypeVpcFlowLog,
+			Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return in
+}
+
+// GenerateFlowLogObservation is used to produce v1alpha4.FlowLogObservation
+// from ec2.FlowLog.
+func GenerateFlowLogObservation(fl ec2.FlowLog) v1alpha4.FlowLogObservation {
+	return v1alpha4.FlowLogObservation{
+		FlowLogID:               aws.StringValue(fl.FlowLogId),
+		FlowLogStatus:           aws.StringValue(fl.FlowLogStatus),
+		DeliverLogsStatus:       aws.StringValue(fl.DeliverLogsStatus),
+		DeliverLogsErrorMessage: aws.StringValue(fl.DeliverLogsErrorMessage),
+		ResourceID:              aws.StringValue(fl.ResourceId),
+	}
+}
+
+// LateInitializeFlowLog fills the empty fields in *v1alpha4.FlowLogParameters
+// with the values seen in ec2.FlowLog.
+func LateInitializeFlowLog(in *v1alpha4.FlowLogParameters, fl *ec2.FlowLog) {
+	if fl == nil {
+		return
+	}
+
+	in.DeliverLogsPermissionARN = awsclients.LateInitializeStringPtr(in.DeliverLogsPermissionARN, fl.DeliverLogsPermissionArn)
+	in.LogDestination = awsclients.LateInitializeStringPtr(in.LogDestination, fl.LogDestination)
+	if fl.LogDestinationType != "" {
+		in.LogDestinationType = awsclients.LateInitializeStringPtr(in.LogDestinationType, aws.String(string(fl.LogDestinationType)))
+	}
+	in.LogFormat = awsclients.LateInitializeStringPtr(in.LogFormat, fl.LogFormat)
+	in.LogGroupName = awsclients.LateInitializeStringPtr(in.LogGroupName, fl.LogGroupName)
+	in.MaxAggregationInterval = awsclients.LateInitializeInt64Ptr(in.MaxAggregationInterval, fl.MaxAggregationInterval)
+
+	if len(in.Tags) == 0 && len(fl.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(fl.Tags)
+	}
+}
+
+// IsFlowLogUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource. Only tags of a flow log
+// can be modified.
+func IsFlowLogUpToDate(p v1alpha4.FlowLogParameters, fl ec2.FlowLog) bool {
+	return v1beta1.CompareTags(p.Tags, fl.Tags)
+}
@@ -0,0 +1,117 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+var (
+	flVPC      = "some vpc"
+	flSubnet   = "some subnet"
+	flENI      = "some eni"
+	flRoleARN  = "arn:aws:iam::123456789012:role/flowlogs"
+	flLogGroup = "some log group"
+)
+
+func TestGetFlowLogResource(t *testing.T) {
+	type want struct {
+		resourceType ec2.FlowLogsResourceType
+		id           string
+	}
+
+	cases := map[string]struct {
+		p    v1alpha4.FlowLogParameters
+		want want
+	}{
+		"VPC": {
+			p:    v1alpha4.FlowLogParameters{VPCID: aws.String(flVPC)},
+			want: want{resourceType: ec2.FlowLogsResourceTypeVpc, id: flVPC},
+		},
+		"Subnet": {
+			p:    v1alpha4.FlowLogParameters{SubnetID: aws.String(flSubnet)},
+			want: want{resourceType: ec2.FlowLogsResourceTypeSubnet, id: flSubnet},
+		},
+		"NetworkInterface": {
+			p:    v1alpha4.FlowLogParameters{NetworkInterfaceID: aws.String(flENI)},
+			want: want{resourceType: ec2.FlowLogsResourceTypeNetworkInterface, id: flENI},
+		},
+		"EmptyResolvedReference": {
+			p:    v1alpha4.FlowLogParameters{VPCID: aws.String(""), SubnetID: aws.String(flSubnet)},
+			want: want{resourceType: ec2.FlowLogsResourceTypeSubnet, id: flSubnet},
+		},
+		"None": {
+			p: v1alpha4.FlowLogParameters{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rt, id := GetFlowLogResource(tc.p)
+			if diff := cmp.Diff(tc.want, want{resourceType: rt, id: id}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCreateFlowLogsInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha4.FlowLogParameters
+		want *ec2.CreateFlowLogsInput
+	}{
+		"CloudWatchLogs": {
+			p: v1alpha4.FlowLogParameters{
+				TrafficType:              "ALL",
+				LogDestinationType:       aws.String("cloud-watch-logs"),
+				LogGroupName:             aws.String(flLogGroup),
+				DeliverLogsPermissionARN: aws.String(flRoleARN),
+				VPCID:                    aws.String(flVPC),
+				Tags:                     []v1beta1.Tag{{Key: "k", Value: "v"}},
+			},
+			want: &ec2.CreateFlowLogsInput{
+				TrafficType:              ec2.TrafficTypeAll,
+				LogDestinationType:       ec2.LogDestinationTypeCloudWatchLogs,
+				LogGroupName:             aws.String(flLogGroup),
+				DeliverLogsPermissionArn: aws.String(flRoleARN),
+				ResourceType:             ec2.FlowLogsResourceTypeVpc,
+				ResourceIds:              []string{flVPC},
+				TagSpecifications: []ec2.TagSpecification{{
+					ResourceType: ec2.ResourceTypeVpcFlowLog,
+					Tags:         []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+				}},
+			},
+		},
+		"S3": {
+			p: v1alpha4.FlowLogParameters{
+				TrafficType:            "REJECT",
+				LogDestinationType:     aws.String("s3"),
+				LogDestination:         aws.String("arn:aws:s3:::bucket"),
+				MaxAggregationInterval: aws.Int64(60),
+				NetworkInterfaceID:     aws.String(flENI),
+			},
+			want: &ec2.CreateFlowLogsInput{
+				TrafficType:            ec2.TrafficTypeReject,
+				LogDestinationType:     ec2.LogDestinationTypeS3,
+				LogDestination:         aws.String("arn:aws:s3:::bucket"),
+				MaxAggregationInterval: aws.Int64(60),
+				ResourceType:           ec2.FlowLogsResourceTypeNetworkInterface,
+				ResourceIds:            []string{flENI},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateFlowLogsInput(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// KeyPairNotFound is the code that is returned by ec2 when the given key pair name is not valid
+	KeyPairNotFound = "InvalidKeyPair.NotFound"
+
+	// KeyPairPrivateKeyKey is the connection detail key under which the
+	// private key of a generated key pair is published.
+	KeyPairPrivateKeyKey = "privateKey"
+
+	// KeyPairFingerprintKey is the connection detail key under which the
+	// fingerprint of a key pair is published.
+	KeyPairFingerprintKey = "fingerprint"
+
+	// KeyPairNameKey is the connection detail key under which the name of a
+	// key pair is published.
+	KeyPairNameKey = "keyName"
+)
+
+// KeyPairClient is the external client used for KeyPair Custom Resource
+type KeyPairClient interface {
+	CreateKeyPairRequest(*ec2.CreateKeyPairInput) ec2.CreateKeyPairRequest
+	ImportKeyPairRequest(*ec2.ImportKeyPairInput) ec2.ImportKeyPairRequest
+	DeleteKeyPairRequest(*ec2.DeleteKeyPairInput) ec2.DeleteKeyPairRequest
+	DescribeKeyPairsRequest(*ec2.DescribeKeyPairsInput) ec2.DescribeKeyPairsRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// NewKeyPairClient returns a new client using AWS credentials as JSON encoded data.
+func NewKeyPairClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (KeyPairClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ec2.New(*cfg), nil
+}
+
+// IsKeyPairNotFoundErr returns true if the error is because the key pair doesn't exist
+func IsKeyPairNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == KeyPairNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// keyPairTagSpecifications returns the tag specifications for a new key pair.
+func keyPairTagSpecifications(p v1alpha4.KeyPairParameters) []ec2.TagSpecification {
+	if len(p.Tags) == 0 {
+		return nil
+	}
+	return []ec2.TagSpecification{{
+		ResourceType: ec2.ResourceTypeKeyPair,
+		Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+	}}
+}
+
+// GenerateCreateKeyPairInput returns the input to have AWS generate a key
+// pair with the given name.
+func GenerateCreateKeyPairInput(name string, p v1alpha4.KeyPairParameters) *ec2.CreateKeyPairInput {
+	return &ec2.CreateKeyPairInput{
+		KeyName:           aws.String(name),
+		TagSpecifications: keyPairTagSpecifications(p),
+	}
+}
+
+// GenerateImportKeyPairInput returns the input to import the given public key
+// as a key pair with the given name.
+func GenerateImportKeyPairInput(name string, publicKey []byte, p v1alpha4.KeyPairParameters) *ec2.ImportKeyPairInput {
+	return &ec2.ImportKeyPairInput{
+		KeyName:           aws.String(name),
+		PublicKeyMaterial: publicKey,
+		TagSpecifications: keyPairTagSpecifications(p),
+	}
+}
+
+// GenerateKeyPairObservation is used to produce v1alpha4.KeyPairObservation
+// from ec2.KeyPairInfo.
+func GenerateKeyPairObservation(k ec2.KeyPairInfo) v1alpha4.KeyPairObservation {
+	return v1alpha4.KeyPairObservation{
+		KeyPairID:      aws.StringValue(k.KeyPairId),
+		KeyFingerprint: aws.StringValue(k.KeyFingerprint),
+	}
+}
+
+// LateInitializeKeyPair fills the empty fields in *v1alpha4.KeyPairParameters
+// with the values seen in ec2.KeyPairInfo.
+func LateInitializeKeyPair(in *v1alpha4.KeyPairParameters, k *ec2.KeyPairInfo) {
+	if k == nil {
+		return
+	}
+
+	if len(in.Tags) == 0 && len(k.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(k.Tags)
+	}
+}
+
+// IsKeyPairUpToDate returns true if there is no update-able difference between
+// desired and observed state of the resource. Only tags of a key pair can be
+// modified.
+func IsKeyPairUpToDate(p v1alpha4.KeyPairParameters, k ec2.KeyPairInfo) bool {
+	return v1beta1.CompareTags(p.Tags, k.Tags)
+}
+
+// GetKeyPairConnectionDetails returns the name and the fingerprint of the key
+// pair as connection details.
+func GetKeyPairConnectionDetails(name, fingerprint string) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		KeyPairNameKey:        []byte(name),
+		KeyPairFingerprintKey: []byte(fingerprint),
+	}
+}
@@ -0,0 +1,261 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+var (
+	kpName        = "some key pair"
+	kpID          = "some id"
+	kpFingerprint = "some fingerprint"
+	kpPublicKey   = []byte("ssh-rsa AAAA")
+)
+
+func TestGenerateCreateKeyPairInput(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha4.KeyPairParameters
+		want *ec2.CreateKeyPairInput
+	}{
+		"NoTags": {
+			in:   v1alpha4.KeyPairParameters{},
+			want: &ec2.CreateKeyPairInput{KeyName: aws.String(kpName)},
+		},
+		"WithTags": {
+			in: v1alpha4.KeyPairParameters{
+				Tags: []v1beta1.Tag{{Key: "key", Value: "value"}},
+			},
+			want: &ec2.CreateKeyPairInput{
+				KeyName: aws.String(kpName),
+				TagSpecifications: []ec2.TagSpecification{{
+					ResourceType: ec2.ResourceTypeKeyPair,
+					Tags:         []ec2.Tag{{Key: aws.String("key"), Value: aws.String("value")}},
+				}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateKeyPairInput(kpName, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateImportKeyPairInput(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha4.KeyPairParameters
+		want *ec2.ImportKeyPairInput
+	}{
+		"NoTags": {
+			in: v1alpha4.KeyPairParameters{},
+			want: &ec2.ImportKeyPairInput{
+				KeyName:           aws.String(kpName),
+				PublicKeyMaterial: kpPublicKey,
+			},
+		},
+		"WithTags": {
+			in: v1alpha4.KeyPairParameters{
+				Tags: []v1beta1.Tag{{Key: "key", Value: "value"}},
+			},
+			want: &ec2.ImportKeyPairInput{
+				KeyName:           aws.String(kpName),
+				PublicKeyMaterial: kpPublicKey,
+				TagSpecifications: []ec2.TagSpecification{{
+					ResourceType: ec2.ResourceTypeKeyPair,
+					Tags:         []ec2.Tag{{Key: aws.String("key"), Value: aws.String("value")}},
+				}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateImportKeyPairInput(kpName, kpPublicKey, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateKeyPairObservation(t *testing.T) {
+	cases := map[string]struct {
+		in   ec2.KeyPairInfo
+		want v1alpha4.KeyPairObservation
+	}{
+		"AllFilled": {
+			in: ec2.KeyPairInfo{
+				KeyName:        aws.String(kpName),
+				KeyPairId:      aws.String(kpID),
+				KeyFingerprint: aws.String(kpFingerprint),
+			},
+			want: v1alpha4.KeyPairObservation{
+				KeyPairID:      kpID,
+				KeyFingerprint: kpFingerprint,
+			},
+		},
+		"Empty": {
+			in:   ec2.KeyPairInfo{},
+			want: v1alpha4.KeyPairObservation{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateKeyPairObservation(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeKeyPair(t *testing.T) {
+	type args struct {
+		p *v1alpha4.KeyPairParameters
+		k *ec2.KeyPairInfo
+	}
+
+	cases := map[string]struct {
+		args args
+		want *v1alpha4.KeyPairParameters
+	}{
+		"NilKeyPair": {
+			args: args{
+				p: &v1alpha4.KeyPairParameters{},
+			},
+			want: &v1alpha4.KeyPairParameters{},
+		},
+		"AllFilledNoDiff": {
+			args: args{
+				p: &v1alpha4.KeyPairParameters{
+					Tags: []v1beta1.Tag{{Key: "key", Value: "value"}},
+				},
+				k: &ec2.KeyPairInfo{
+					Tags: []ec2.Tag{{Key: aws.String("other"), Value: aws.String("tag")}},
+				},
+			},
+			want: &v1alpha4.KeyPairParameters{
+				Tags: []v1beta1.Tag{{Key: "key", Value: "value"}},
+			},
+		},
+		"TagsFilled": {
+			args: args{
+				p: &v1alpha4.KeyPairParameters{},
+				k: &ec2.KeyPairInfo{
+					Tags: []ec2.Tag{{Key: aws.String("key"), Value: aws.String("value")}},
+				},
+			},
+			want: &v1alpha4.KeyPairParameters{
+				Tags: []v1beta1.Tag{{Key: "key", Value: "value"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeKeyPair(tc.args.p, tc.args.k)
+			if diff := cmp.Diff(tc.want, tc.args.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsKeyPairUpToDate(t *testing.T) {
+	type args struct {
+		p v1alpha4.KeyPairParameters
+		k ec2.KeyPairInfo
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameTags": {
+			args: args{
+				p: v1alpha4.KeyPairParameters{
+					Tags: []v1beta1.Tag{{Key: "key", Value: "value"}},
+				},
+				k: ec2.KeyPairInfo{
+					Tags: []ec2.Tag{{Key: aws.String("key"), Value: aws.String("value")}},
+				},
+			},
+			want: true,
+		},
+		"DifferentTags": {
+			args: args{
+				p: v1alpha4.KeyPairParameters{
+					Tags: []v1beta1.Tag{{Key: "key", Value: "value"}},
+				},
+				k: ec2.KeyPairInfo{
+					Tags: []ec2.Tag{{Key: aws.String("key"), Value: aws.String("other")}},
+				},
+			},
+			want: false,
+		},
+		"NoTags": {
+			args: args{
+				p: v1alpha4.KeyPairParameters{},
+				k: ec2.KeyPairInfo{},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsKeyPairUpToDate(tc.args.p, tc.args.k)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetKeyPairConnectionDetails(t *testing.T) {
+	type args struct {
+		name        string
+		fingerprint string
+	}
+
+	cases := map[string]struct {
+		args args
+		want managed.ConnectionDetails
+	}{
+		"Filled": {
+			args: args{name: kpName, fingerprint: kpFingerprint},
+			want: managed.ConnectionDetails{
+				KeyPairNameKey:        []byte(kpName),
+				KeyPairFingerprintKey: []byte(kpFingerprint),
+			},
+		},
+		"Empty": {
+			want: managed.ConnectionDetails{
+				KeyPairNameKey:        []byte(""),
+				KeyPairFingerprintKey: []byte(""),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetKeyPairConnectionDetails(tc.args.name, tc.args.fingerprint)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package ec2
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// LaunchTemplateIDNotFound is the code that is returned by ec2 when the given LaunchTemplateID is not valid
+	LaunchTemplateIDNotFound = "InvalidLaunchTemplateId.NotFound"
+
+	// LaunchTemplateNameNotFound is the code that is returned by ec2 when the given LaunchTemplateName is not valid
+	LaunchTemplateNameNotFound = "InvalidLaunchTemplateName.NotFoundException"
+
+	// LaunchTemplateVersionLatest refers to the latest version of a launch template.
+	LaunchTemplateVersionLatest = "$Latest"
+)
+
+// LaunchTemplateClient is the external client used for LaunchTemplate Custom Resource
+type LaunchTemplateClient interface {
+	CreateLaunchTemplateRequest(*ec2.CreateLaunchTemplateInput) ec2.CreateLaunchTemplateRequest
+	DeleteLaunchTemplateRequest(*ec2.DeleteLaunchTemplateInput) ec2.DeleteLaunchTemplateRequest
+	DescribeLaunchTemplatesRequest(*ec2.DescribeLaunchTemplatesInput) ec2.DescribeLaunchTemplatesRequest
+	ModifyLaunchTemplateRequest(*ec2.ModifyLaunchTemplateInput) ec2.ModifyLaunchTemplateRequest
+	CreateLaunchTemplateVersionRequest(*ec2.CreateLaunchTemplateVersionInput) ec2.CreateLaunchTemplateVersionRequest
+	DescribeLaunchTemplateVersionsRequest(*ec2.DescribeLaunchTemplateVersionsInput) ec2.DescribeLaunchTemplateVersionsRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// NewLaunchTemplateClient returns a new client using AWS credentials as JSON encoded data.
+func NewLaunchTemplateClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (LaunchTemplateClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ec2.New(*cfg), nil
+}
+
+// IsLaunchTemplateNotFoundErr returns true if the error is because the launch template doesn't exist
+func IsLaunchTemplateNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == LaunchTemplateIDNotFound || awsErr.Code() == LaunchTemplateNameNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateLaunchTemplateData converts v1alpha4.LaunchTemplateData to the
+// format that the EC2 client expects.
+func GenerateLaunchTemplateData(p v1alpha4.LaunchTemplateData) *ec2.RequestLaunchTemplateData { // nolint:gocyclo
+	d := &ec2.RequestLaunchTemplateData{
+		DisableApiTermination: p.DisableAPITermination,
+		EbsOptimized:          p.EBSOptimized,
+		ImageId:               p.ImageID,
+		KeyName:               p.KeyName,
+		SecurityGroupIds:      p.SecurityGroupIDs,
+		UserData:              p.UserData,
+	}
+	if p.InstanceInitiatedShutdownBehavior != nil {
+		d.InstanceInitiatedShutdownBehavior = ec2.ShutdownBehavior(aws.StringValue(p.InstanceInitiatedShutdownBehavior))
+	}
+	if p.InstanceType != nil {
+		d.InstanceType = ec2.InstanceType(aws.StringValue(p.InstanceType))
+	}
+	if p.IAMInstanceProfile != nil {
+		d.IamInstanceProfile = &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{
+			Arn:  p.IAMInstanceProfile.ARN,
+			Name: p.IAMInstanceProfile.Name,
+		}
+	}
+	if p.MetadataOptions != nil {
+		d.MetadataOptions = &ec2.LaunchTemplateInstanceMetadataOptionsRequest{
+			HttpEndpoint:            ec2.LaunchTemplateInstanceMetadataEndpointState(aws.StringValue(p.MetadataOptions.HTTPEndpoint)),
+			HttpPutResponseHopLimit: p.MetadataOptions.HTTPPutResponseHopLimit,
+			HttpTokens:              ec2.LaunchTemplateHttpTokensState(aws.StringValue(p.MetadataOptions.HTTPTokens)),
+		}
+	}
+	if p.MonitoringEnabled != nil {
+		d.Monitoring = &ec2.LaunchTemplatesMonitoringRequest{Enabled: p.MonitoringEnabled}
+	}
+	for _, bdm := range p.BlockDeviceMappings {
+		m := ec2.LaunchTemplateBlockDeviceMappingRequest{
+			DeviceName:  aws.String(bdm.DeviceName),
+			NoDevice:    bdm.NoDevice,
+			VirtualName: bdm.VirtualName,
+		}
+		if bdm.EBS != nil {
+			m.Ebs = &ec2.LaunchTemplateEbsBlockDeviceRequest{
+				DeleteOnTermination: bdm.EBS.DeleteOnTermination,
+				Encrypted:           bdm.EBS.Encrypted,
+				Iops:                bdm.EBS.IOPS,
+				KmsKeyId:            bdm.EBS.KMSKeyID,
+				SnapshotId:          bdm.EBS.SnapshotID,
+				VolumeSize:          bdm.EBS.VolumeSize,
+				VolumeType:          ec2.VolumeType(aws.StringValue(bdm.EBS.VolumeType)),
+			}
+		}
+		d.BlockDeviceMappings = append(d.BlockDeviceMappings, m)
+	}
+	for _, ts := range p.TagSpecifications {
+		d.TagSpecifications = append(d.TagSpecifications, ec2.LaunchTemplateTagSpecificationRequest{
+			ResourceType: ec2.ResourceType(ts.ResourceType),
+			Tags:         v1beta1.GenerateEC2Tags(ts.Tags),
+		})
+	}
+	return d
+}
+
+// BuildLaunchTemplateData converts the data of a launch template version as
+// returned by the EC2 API to v1alpha4.LaunchTemplateData.
+func BuildLaunchTemplateData(in *ec2.ResponseLaunchTemplateData) v1alpha4.LaunchTemplateData { // nolint:gocyclo
+	if in == nil {
+		return v1alpha4.LaunchTemplateData{}
+	}
+	d := v1alpha4.LaunchTemplateData{
+		DisableAPITermination:             in.DisableApiTermination,
+		EBSOptimized:                      in.EbsOptimized,
+		ImageID:                           in.ImageId,
+		InstanceInitiatedShutdownBehavior: awsclients.String(string(in.InstanceInitiatedShutdownBehavior)),
+		InstanceType:                      awsclients.String(string(in.InstanceType)),
+		KeyName:                           in.KeyName,
+		SecurityGroupIDs:                  in.SecurityGroupIds,
+		UserData:                          in.UserData,
+	}
+	if in.IamInstanceProfile != nil {
+		d.IAMInstanceProfile = &v1alpha4.LaunchTemplateIAMInstanceProfile{
+			ARN:  in.IamInstanceProfile.Arn,
+			Name: in.IamInstanceProfile.Name,
+		}
+	}
+	if in.MetadataOptions != nil {
+		d.MetadataOptions = &v1alpha4.LaunchTemplateMetadataOptions{
+			HTTPEndpoint:            awsclients.String(string(in.MetadataOptions.HttpEndpoint)),
+			HTTPPutResponseHopLimit: in.MetadataOptions.HttpPutResponseHopLimit,
+			HTTPTokens:              awsclients.String(string(in.MetadataOptions.HttpTokens)),
+		}
+	}
+	if in.Monitoring != nil {
+		d.MonitoringEnabled = in.Monitoring.Enabled
+	}
+	for _, bdm := range in.BlockDeviceMappings {
+		m := v1alpha4.LaunchTemplateBlockDeviceMapping{
+			DeviceName:  aws.StringValue(bdm.DeviceName),
+			NoDevice:    bdm.NoDevice,
+			VirtualName: bdm.VirtualName,
+		}
+		if bdm.Ebs != nil {
+			m.EBS = &v1alpha4.LaunchTemplateEBSBlockDevice{
+				DeleteOnTermination: bdm.Ebs.DeleteOnTermination,
+				Encrypted:           bdm.Ebs.Encrypted,
+				IOPS:                bdm.Ebs.Iops,
+				KMSKeyID:            bdm.Ebs.KmsKeyId,
+				SnapshotID:          bdm.Ebs.SnapshotId,
+				VolumeSize:          bdm.Ebs.VolumeSize,
+				VolumeType:          awsclients.String(string(bdm.Ebs.VolumeType)),
+			}
+		}
+		d.BlockDeviceMappings = append(d.BlockDeviceMappings, m)
+	}
+	for _, ts := range in.TagSpecifications {
+		d.TagSpecifications = append(d.TagSpecifications, v1alpha4.LaunchTemplateTagSpecification{
+			ResourceType: string(ts.ResourceType),
+			Tags:         v1beta1.BuildFromEC2Tags(ts.Tags),
+		})
+	}
+	return d
+}
+
+// LateInitializeLaunchTemplate fills the empty fields in
+// *v1alpha4.LaunchTemplateParameters with the values seen in the given
+// ec2.LaunchTemplateVersion. Only the fields that AWS defaults once their
+// parent is given are late-initialized, since the rest of the launch template
+// data is expected to be fully specified by the user.
+func LateInitializeLaunchTemplate(in *v1alpha4.LaunchTemplateParameters, v *ec2.LaunchTemplateVersion) {
+	if v == nil || v.LaunchTemplateData == nil {
+		return
+	}
+	if in.LaunchTemplateData.MetadataOptions != nil && v.LaunchTemplateData.MetadataOptions != nil {
+		mo := in.LaunchTemplateData.MetadataOptions
+		observed := v.LaunchTemplateData.MetadataOptions
+		mo.HTTPEndpoint = awsclients.LateInitializeStringPtr(mo.HTTPEndpoint, awsclients.String(string(observed.HttpEndpoint)))
+		mo.HTTPPutResponseHopLimit = awsclients.LateInitializeInt64Ptr(mo.HTTPPutResponseHopLimit, observed.HttpPutResponseHopLimit)
+		mo.HTTPTokens = awsclients.LateInitializeStringPtr(mo.HTTPTokens, awsclients.String(string(observed.HttpTokens)))
+	}
+}
+
+// GenerateLaunchTemplateObservation is used to produce
+// v1alpha4.LaunchTemplateObservation from ec2.LaunchTemplate.
+func GenerateLaunchTemplateObservation(lt ec2.LaunchTemplate) v1alpha4.LaunchTemplateObservation {
+	return v1alpha4.LaunchTemplateObservation{
+		LaunchTemplateID:     aws.StringValue(lt.LaunchTemplateId),
+		CreatedBy:            aws.StringValue(lt.CreatedBy),
+		DefaultVersionNumber: aws.Int64Value(lt.DefaultVersionNumber),
+		LatestVersionNumber:  aws.Int64Value(lt.LatestVersionNumber),
+	}
+}
+
+// IsLaunchTemplateDataUpToDate returns true if the data of the supplied
+// launch template version matches the desired launch template data, i.e. no
+// new version needs to be created.
+func IsLaunchTemplateDataUpToDate(p v1alpha4.LaunchTemplateData, v ec2.LaunchTemplateVersion) bool {
+	desired := p.DeepCopy()
+	observed := BuildLaunchTemplateData(v.LaunchTemplateData)
+	sort.Strings(desired.SecurityGroupIDs)
+	sort.Strings(observed.SecurityGroupIDs)
+	return cmp.Equal(*desired, observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreTypes(&v1alpha1.Reference{}, &v1alpha1.Selector{}, []v1alpha1.Reference{}))
+}
+
+// IsLaunchTemplateUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsLaunchTemplateUpToDate(p v1alpha4.LaunchTemplateParameters, lt ec2.LaunchTemplate, v ec2.LaunchTemplateVersion) bool {
+	if !IsLaunchTemplateDataUpToDate(p.LaunchTemplateData, v) {
+		return false
+	}
+
+	if aws.BoolValue(p.SetDefaultVersion) && aws.Int64Value(lt.DefaultVersionNumber) != aws.Int64Value(lt.LatestVersionNumber) {
+		return false
+	}
+
+	return v1beta1.CompareTags(p.Tags, lt.Tags)
+}
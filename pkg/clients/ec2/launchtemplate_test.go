@@ -0,0 +1,184 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+var (
+	ltID           = "some lt"
+	ltImageID      = "some image"
+	ltInstanceType = "t3.medium"
+	ltSGID         = "some sg"
+	ltOtherSGID    = "some other sg"
+)
+
+func TestIsLaunchTemplateUpToDate(t *testing.T) {
+	type args struct {
+		p  v1alpha4.LaunchTemplateParameters
+		lt ec2.LaunchTemplate
+		v  ec2.LaunchTemplateVersion
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameFields": {
+			args: args{
+				p: v1alpha4.LaunchTemplateParameters{
+					LaunchTemplateData: v1alpha4.LaunchTemplateData{
+						ImageID:          aws.String(ltImageID),
+						InstanceType:     aws.String(ltInstanceType),
+						SecurityGroupIDs: []string{ltSGID, ltOtherSGID},
+					},
+				},
+				v: ec2.LaunchTemplateVersion{
+					LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+						ImageId:          aws.String(ltImageID),
+						InstanceType:     ec2.InstanceType(ltInstanceType),
+						SecurityGroupIds: []string{ltOtherSGID, ltSGID},
+					},
+				},
+			},
+			want: true,
+		},
+		"DifferentData": {
+			args: args{
+				p: v1alpha4.LaunchTemplateParameters{
+					LaunchTemplateData: v1alpha4.LaunchTemplateData{
+						ImageID:      aws.String(ltImageID),
+						InstanceType: aws.String("m5.large"),
+					},
+				},
+				v: ec2.LaunchTemplateVersion{
+					LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+						ImageId:      aws.String(ltImageID),
+						InstanceType: ec2.InstanceType(ltInstanceType),
+					},
+				},
+			},
+			want: false,
+		},
+		"DefaultVersionNotLatest": {
+			args: args{
+				p: v1alpha4.LaunchTemplateParameters{
+					SetDefaultVersion: aws.Bool(true),
+				},
+				lt: ec2.LaunchTemplate{
+					DefaultVersionNumber: aws.Int64(1),
+					LatestVersionNumber:  aws.Int64(2),
+				},
+				v: ec2.LaunchTemplateVersion{},
+			},
+			want: false,
+		},
+		"DifferentTags": {
+			args: args{
+				p: v1alpha4.LaunchTemplateParameters{
+					Tags: []v1beta1.Tag{{Key: "k", Value: "v"}},
+				},
+				v: ec2.LaunchTemplateVersion{},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsLaunchTemplateUpToDate(tc.args.p, tc.args.lt, tc.args.v)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateLaunchTemplateObservation(t *testing.T) {
+	cases := map[string]struct {
+		in  ec2.LaunchTemplate
+		out v1alpha4.LaunchTemplateObservation
+	}{
+		"AllFilled": {
+			in: ec2.LaunchTemplate{
+				LaunchTemplateId:     aws.String(ltID),
+				DefaultVersionNumber: aws.Int64(1),
+				LatestVersionNumber:  aws.Int64(3),
+			},
+			out: v1alpha4.LaunchTemplateObservation{
+				LaunchTemplateID:     ltID,
+				DefaultVersionNumber: 1,
+				LatestVersionNumber:  3,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateLaunchTemplateObservation(tc.in)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeLaunchTemplate(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha4.LaunchTemplateParameters
+		v    *ec2.LaunchTemplateVersion
+		want v1alpha4.LaunchTemplateParameters
+	}{
+		"MetadataOptionsDefaults": {
+			in: v1alpha4.LaunchTemplateParameters{
+				LaunchTemplateData: v1alpha4.LaunchTemplateData{
+					MetadataOptions: &v1alpha4.LaunchTemplateMetadataOptions{HTTPTokens: aws.String("required")},
+				},
+			},
+			v: &ec2.LaunchTemplateVersion{
+				LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+					MetadataOptions: &ec2.LaunchTemplateInstanceMetadataOptions{
+						HttpEndpoint:            ec2.LaunchTemplateInstanceMetadataEndpointStateEnabled,
+						HttpPutResponseHopLimit: aws.Int64(1),
+						HttpTokens:              ec2.LaunchTemplateHttpTokensStateRequired,
+					},
+				},
+			},
+			want: v1alpha4.LaunchTemplateParameters{
+				LaunchTemplateData: v1alpha4.LaunchTemplateData{
+					MetadataOptions: &v1alpha4.LaunchTemplateMetadataOptions{
+						HTTPEndpoint:            aws.String("enabled"),
+						HTTPPutResponseHopLimit: aws.Int64(1),
+						HTTPTokens:              aws.String("required"),
+					},
+				},
+			},
+		},
+		"NoMetadataOptionsInSpec": {
+			in: v1alpha4.LaunchTemplateParameters{},
+			v: &ec2.LaunchTemplateVersion{
+				LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+					MetadataOptions: &ec2.LaunchTemplateInstanceMetadataOptions{
+						HttpEndpoint: ec2.LaunchTemplateInstanceMetadataEndpointStateEnabled,
+					},
+				},
+			},
+			want: v1alpha4.LaunchTemplateParameters{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeLaunchTemplate(&tc.in, tc.v)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
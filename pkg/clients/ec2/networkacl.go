@@ -0,0 +1,241 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// NetworkACLIDNotFound is the code that is returned by ec2 when the given NetworkACLID is not valid
+	NetworkACLIDNotFound = "InvalidNetworkAclID.NotFound"
+
+	// NetworkACLEntryNotFound is the code that is returned when the given network ACL entry is not found
+	NetworkACLEntryNotFound = "InvalidNetworkAclEntry.NotFound"
+
+	// DefaultNetworkACLRuleNumber is the number of the rule that is part of
+	// every network ACL and denies all traffic that is not matched by another
+	// rule. It cannot be modified or removed.
+	DefaultNetworkACLRuleNumber = 32767
+
+	// DefaultIPv6NetworkACLRuleNumber is the number of the rule that is added
+	// to every network ACL of a VPC with an IPv6 CIDR block and denies all IPv6
+	// traffic that is not matched by another rule. It cannot be modified or
+	// removed.
+	DefaultIPv6NetworkACLRuleNumber = 32768
+)
+
+// NetworkACLClient is the external client used for NetworkACL Custom Resource
+type NetworkACLClient interface {
+	CreateNetworkAclRequest(*ec2.CreateNetworkAclInput) ec2.CreateNetworkAclRequest
+	DeleteNetworkAclRequest(*ec2.DeleteNetworkAclInput) ec2.DeleteNetworkAclRequest
+	DescribeNetworkAclsRequest(*ec2.DescribeNetworkAclsInput) ec2.DescribeNetworkAclsRequest
+	CreateNetworkAclEntryRequest(*ec2.CreateNetworkAclEntryInput) ec2.CreateNetworkAclEntryRequest
+	ReplaceNetworkAclEntryRequest(*ec2.ReplaceNetworkAclEntryInput) ec2.ReplaceNetworkAclEntryRequest
+	DeleteNetworkAclEntryRequest(*ec2.DeleteNetworkAclEntryInput) ec2.DeleteNetworkAclEntryRequest
+	ReplaceNetworkAclAssociationRequest(*ec2.ReplaceNetworkAclAssociationInput) ec2.ReplaceNetworkAclAssociationRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// NewNetworkACLClient returns a new client using AWS credentials as JSON encoded data.
+func NewNetworkACLClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (NetworkACLClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ec2.New(*cfg), nil
+}
+
+// IsNetworkACLNotFoundErr returns true if the error is because the network ACL doesn't exist
+func IsNetworkACLNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == NetworkACLIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNetworkACLEntryNotFoundErr returns true if the error is because the network ACL entry doesn't exist
+func IsNetworkACLEntryNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == NetworkACLEntryNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateNetworkACLObservation is used to produce
+// v1alpha4.NetworkACLObservation from ec2.NetworkAcl.
+func GenerateNetworkACLObservation(acl ec2.NetworkAcl) v1alpha4.NetworkACLObservation {
+	o := v1alpha4.NetworkACLObservation{
+		NetworkACLID: aws.StringValue(acl.NetworkAclId),
+		IsDefault:    aws.BoolValue(acl.IsDefault),
+		OwnerID:      aws.StringValue(acl.OwnerId),
+	}
+
+	if len(acl.Associations) > 0 {
+		o.Associations = make([]v1alpha4.NetworkACLAssociationState, len(acl.Associations))
+		for i, asc := range acl.Associations {
+			o.Associations[i] = v1alpha4.NetworkACLAssociationState{
+				AssociationID: aws.StringValue(asc.NetworkAclAssociationId),
+				SubnetID:      aws.StringValue(asc.SubnetId),
+			}
+		}
+	}
+
+	return o
+}
+
+// LateInitializeNetworkACL fills the empty fields in
+// *v1alpha4.NetworkACLParameters with the values seen in ec2.NetworkAcl.
+func LateInitializeNetworkACL(in *v1alpha4.NetworkACLParameters, acl *ec2.NetworkAcl) {
+	if acl == nil {
+		return
+	}
+	in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, acl.VpcId)
+
+	if len(in.Tags) == 0 && len(acl.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(acl.Tags)
+	}
+}
+
+// BuildNetworkACLEntry converts an ec2.NetworkAclEntry to
+// v1alpha4.NetworkACLEntry.
+func BuildNetworkACLEntry(e ec2.NetworkAclEntry) v1alpha4.NetworkACLEntry {
+	o := v1alpha4.NetworkACLEntry{
+		RuleNumber:    aws.Int64Value(e.RuleNumber),
+		Protocol:      aws.StringValue(e.Protocol),
+		RuleAction:    string(e.RuleAction),
+		CIDRBlock:     e.CidrBlock,
+		IPv6CIDRBlock: e.Ipv6CidrBlock,
+	}
+	if e.IcmpTypeCode != nil {
+		o.ICMPTypeCode = &v1alpha4.ICMPTypeCode{
+			Code: e.IcmpTypeCode.Code,
+			Type: e.IcmpTypeCode.Type,
+		}
+	}
+	if e.PortRange != nil {
+		o.PortRange = &v1alpha4.PortRange{
+			From: e.PortRange.From,
+			To:   e.PortRange.To,
+		}
+	}
+	return o
+}
+
+// GenerateCreateNetworkACLEntryInput returns the input to create the given
+// entry in the network ACL with the given ID.
+func GenerateCreateNetworkACLEntryInput(id string, egress bool, e v1alpha4.NetworkACLEntry) *ec2.CreateNetworkAclEntryInput {
+	in := &ec2.CreateNetworkAclEntryInput{
+		NetworkAclId:  aws.String(id),
+		Egress:        aws.Bool(egress),
+		RuleNumber:    aws.Int64(e.RuleNumber),
+		Protocol:      aws.String(e.Protocol),
+		RuleAction:    ec2.RuleAction(e.RuleAction),
+		CidrBlock:     e.CIDRBlock,
+		Ipv6CidrBlock: e.IPv6CIDRBlock,
+	}
+	if e.ICMPTypeCode != nil {
+		in.IcmpTypeCode = &ec2.IcmpTypeCode{Code: e.ICMPTypeCode.Code, Type: e.ICMPTypeCode.Type}
+	}
+	if e.PortRange != nil {
+		in.PortRange = &ec2.PortRange{From: e.PortRange.From, To: e.PortRange.To}
+	}
+	return in
+}
+
+// GenerateReplaceNetworkACLEntryInput returns the input to replace the entry
+// with the same rule number in the network ACL with the given ID.
+func GenerateReplaceNetworkACLEntryInput(id string, egress bool, e v1alpha4.NetworkACLEntry) *ec2.ReplaceNetworkAclEntryInput {
+	c := GenerateCreateNetworkACLEntryInput(id, egress, e)
+	return &ec2.ReplaceNetworkAclEntryInput{
+		NetworkAclId:  c.NetworkAclId,
+		Egress:        c.Egress,
+		RuleNumber:    c.RuleNumber,
+		Protocol:      c.Protocol,
+		RuleAction:    c.RuleAction,
+		CidrBlock:     c.CidrBlock,
+		Ipv6CidrBlock: c.Ipv6CidrBlock,
+		IcmpTypeCode:  c.IcmpTypeCode,
+		PortRange:     c.PortRange,
+	}
+}
+
+// DiffNetworkACLEntries compares the desired entries with the observed entries
+// of the given direction and returns the entries that need to be created and
+// replaced, and the rule numbers of the entries that need to be deleted.
+func DiffNetworkACLEntries(desired []v1alpha4.NetworkACLEntry, observed []ec2.NetworkAclEntry, egress bool) (create, replace []v1alpha4.NetworkACLEntry, remove []int64) {
+	current := map[int64]v1alpha4.NetworkACLEntry{}
+	for _, e := range observed {
+		if aws.BoolValue(e.Egress) != egress || isDefaultNetworkACLRule(aws.Int64Value(e.RuleNumber)) {
+			continue
+		}
+		current[aws.Int64Value(e.RuleNumber)] = BuildNetworkACLEntry(e)
+	}
+
+	wanted := map[int64]bool{}
+	for _, d := range desired {
+		wanted[d.RuleNumber] = true
+		c, ok := current[d.RuleNumber]
+		switch {
+		case !ok:
+			create = append(create, d)
+		case !cmp.Equal(d, c, cmpopts.EquateEmpty()):
+			replace = append(replace, d)
+		}
+	}
+
+	for _, e := range observed {
+		n := aws.Int64Value(e.RuleNumber)
+		if aws.BoolValue(e.Egress) != egress || isDefaultNetworkACLRule(n) || wanted[n] {
+			continue
+		}
+		remove = append(remove, n)
+	}
+	return create, replace, remove
+}
+
+func isDefaultNetworkACLRule(n int64) bool {
+	return n == DefaultNetworkACLRuleNumber || n == DefaultIPv6NetworkACLRuleNumber
+}
+
+// IsNetworkACLUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsNetworkACLUpToDate(p v1alpha4.NetworkACLParameters, acl ec2.NetworkAcl) bool {
+	for _, egress := range []bool{false, true} {
+		desired := p.Ingress
+		if egress {
+			desired = p.Egress
+		}
+		create, replace, remove := DiffNetworkACLEntries(desired, acl.Entries, egress)
+		if len(create)+len(replace)+len(remove) != 0 {
+			return false
+		}
+	}
+
+	for _, asc := range p.Associations {
+		found := false
+		for _, ob := range acl.Associations {
+			if aws.StringValue(ob.SubnetId) == aws.StringValue(asc.SubnetID) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return v1beta1.CompareTags(p.Tags, acl.Tags)
+}
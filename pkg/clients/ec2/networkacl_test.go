@@ -0,0 +1,193 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+var (
+	aclCIDR      = "10.0.0.0/16"
+	aclOtherCIDR = "10.1.0.0/16"
+	aclSubnetID  = "some subnet"
+)
+
+func aclEntry(n int64, cidr string) v1alpha4.NetworkACLEntry {
+	return v1alpha4.NetworkACLEntry{
+		RuleNumber: n,
+		Protocol:   "6",
+		RuleAction: "allow",
+		CIDRBlock:  aws.String(cidr),
+		PortRange:  &v1alpha4.PortRange{From: aws.Int64(443), To: aws.Int64(443)},
+	}
+}
+
+func observedACLEntry(n int64, cidr string, egress bool) ec2.NetworkAclEntry {
+	return ec2.NetworkAclEntry{
+		RuleNumber: aws.Int64(n),
+		Protocol:   aws.String("6"),
+		RuleAction: ec2.RuleActionAllow,
+		CidrBlock:  aws.String(cidr),
+		Egress:     aws.Bool(egress),
+		PortRange:  &ec2.PortRange{From: aws.Int64(443), To: aws.Int64(443)},
+	}
+}
+
+func defaultIPv6ACLEntry(egress bool) ec2.NetworkAclEntry {
+	return ec2.NetworkAclEntry{
+		RuleNumber:    aws.Int64(DefaultIPv6NetworkACLRuleNumber),
+		Protocol:      aws.String("-1"),
+		RuleAction:    ec2.RuleActionDeny,
+		Ipv6CidrBlock: aws.String("::/0"),
+		Egress:        aws.Bool(egress),
+	}
+}
+
+func defaultACLEntry(egress bool) ec2.NetworkAclEntry {
+	return ec2.NetworkAclEntry{
+		RuleNumber: aws.Int64(DefaultNetworkACLRuleNumber),
+		Protocol:   aws.String("-1"),
+		RuleAction: ec2.RuleActionDeny,
+		CidrBlock:  aws.String("0.0.0.0/0"),
+		Egress:     aws.Bool(egress),
+	}
+}
+
+func TestDiffNetworkACLEntries(t *testing.T) {
+	type args struct {
+		desired  []v1alpha4.NetworkACLEntry
+		observed []ec2.NetworkAclEntry
+		egress   bool
+	}
+	type want struct {
+		create  []v1alpha4.NetworkACLEntry
+		replace []v1alpha4.NetworkACLEntry
+		remove  []int64
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoChanges": {
+			args: args{
+				desired:  []v1alpha4.NetworkACLEntry{aclEntry(100, aclCIDR)},
+				observed: []ec2.NetworkAclEntry{observedACLEntry(100, aclCIDR, false), defaultACLEntry(false), defaultACLEntry(true)},
+			},
+		},
+		"IPv6DefaultRuleIgnored": {
+			args: args{
+				desired: []v1alpha4.NetworkACLEntry{aclEntry(100, aclCIDR)},
+				observed: []ec2.NetworkAclEntry{
+					observedACLEntry(100, aclCIDR, true),
+					defaultACLEntry(true),
+					defaultIPv6ACLEntry(true),
+				},
+				egress: true,
+			},
+		},
+		"CreateReplaceRemove": {
+			args: args{
+				desired:  []v1alpha4.NetworkACLEntry{aclEntry(100, aclOtherCIDR), aclEntry(200, aclCIDR)},
+				observed: []ec2.NetworkAclEntry{observedACLEntry(100, aclCIDR, false), observedACLEntry(300, aclCIDR, false), defaultACLEntry(false)},
+			},
+			want: want{
+				create:  []v1alpha4.NetworkACLEntry{aclEntry(200, aclCIDR)},
+				replace: []v1alpha4.NetworkACLEntry{aclEntry(100, aclOtherCIDR)},
+				remove:  []int64{300},
+			},
+		},
+		"OtherDirectionIgnored": {
+			args: args{
+				desired:  []v1alpha4.NetworkACLEntry{aclEntry(100, aclCIDR)},
+				observed: []ec2.NetworkAclEntry{observedACLEntry(100, aclCIDR, false), observedACLEntry(200, aclCIDR, false)},
+				egress:   true,
+			},
+			want: want{
+				create: []v1alpha4.NetworkACLEntry{aclEntry(100, aclCIDR)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			create, replace, remove := DiffNetworkACLEntries(tc.args.desired, tc.args.observed, tc.args.egress)
+			if diff := cmp.Diff(tc.want.create, create, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("create: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.replace, replace, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("replace: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsNetworkACLUpToDate(t *testing.T) {
+	type args struct {
+		p   v1alpha4.NetworkACLParameters
+		acl ec2.NetworkAcl
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameFields": {
+			args: args{
+				p: v1alpha4.NetworkACLParameters{
+					Ingress:      []v1alpha4.NetworkACLEntry{aclEntry(100, aclCIDR)},
+					Egress:       []v1alpha4.NetworkACLEntry{aclEntry(100, aclCIDR)},
+					Associations: []v1alpha4.NetworkACLAssociation{{SubnetID: aws.String(aclSubnetID)}},
+					Tags:         []v1beta1.Tag{{Key: "key", Value: "value"}},
+				},
+				acl: ec2.NetworkAcl{
+					Entries: []ec2.NetworkAclEntry{
+						observedACLEntry(100, aclCIDR, false), defaultACLEntry(false),
+						observedACLEntry(100, aclCIDR, true), defaultACLEntry(true),
+					},
+					Associations: []ec2.NetworkAclAssociation{{SubnetId: aws.String(aclSubnetID)}},
+					Tags:         []ec2.Tag{{Key: aws.String("key"), Value: aws.String("value")}},
+				},
+			},
+			want: true,
+		},
+		"DifferentEgress": {
+			args: args{
+				p: v1alpha4.NetworkACLParameters{
+					Egress: []v1alpha4.NetworkACLEntry{aclEntry(100, aclOtherCIDR)},
+				},
+				acl: ec2.NetworkAcl{
+					Entries: []ec2.NetworkAclEntry{observedACLEntry(100, aclCIDR, true)},
+				},
+			},
+			want: false,
+		},
+		"MissingAssociation": {
+			args: args{
+				p: v1alpha4.NetworkACLParameters{
+					Associations: []v1alpha4.NetworkACLAssociation{{SubnetID: aws.String(aclSubnetID)}},
+				},
+				acl: ec2.NetworkAcl{},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsNetworkACLUpToDate(tc.args.p, tc.args.acl)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -93,9 +93,10 @@ func GenerateRTObservation(rt ec2.RouteTable) v1alpha4.RouteTableObservation {
 		o.Routes = make([]v1alpha4.RouteState, len(rt.Routes))
 		for i, rt := range rt.Routes {
 			o.Routes[i] = v1alpha4.RouteState{
-				State:                string(rt.State),
-				DestinationCIDRBlock: aws.StringValue(rt.DestinationCidrBlock),
-				GatewayID:            aws.StringValue(rt.GatewayId),
+				State:                    string(rt.State),
+				DestinationCIDRBlock:     aws.StringValue(rt.DestinationCidrBlock),
+				DestinationIPv6CIDRBlock: aws.StringValue(rt.DestinationIpv6CidrBlock),
+				GatewayID:                aws.StringValue(rt.GatewayId),
 			}
 		}
 	}
@@ -127,8 +128,9 @@ func LateInitializeRT(in *v1alpha4.RouteTableParameters, rt *ec2.RouteTable) { /
 		in.Routes = make([]v1alpha4.Route, len(rt.Routes))
 		for i, val := range rt.Routes {
 			in.Routes[i] = v1alpha4.Route{
-				DestinationCIDRBlock: val.DestinationCidrBlock,
-				GatewayID:            val.GatewayId,
+				DestinationCIDRBlock:     val.DestinationCidrBlock,
+				DestinationIPv6CIDRBlock: val.DestinationIpv6CidrBlock,
+				GatewayID:                val.GatewayId,
 			}
 		}
 	}
@@ -155,15 +157,18 @@ func CreateRTPatch(in ec2.RouteTable, target v1alpha4.RouteTableParameters) (*v1
 
 	v1beta1.SortTags(target.Tags, in.Tags)
 
-	// Add the default route for fair comparison.
+	// Add the local routes in the observed order for fair comparison.
+	var local []v1alpha4.Route
 	for _, val := range in.Routes {
-		if *val.GatewayId == LocalGatewayID {
-			target.Routes = append([]v1alpha4.Route{{
-				GatewayID:            val.GatewayId,
-				DestinationCIDRBlock: val.DestinationCidrBlock,
-			}}, target.Routes...)
+		if aws.StringValue(val.GatewayId) == LocalGatewayID {
+			local = append(local, v1alpha4.Route{
+				GatewayID:                val.GatewayId,
+				DestinationCIDRBlock:     val.DestinationCidrBlock,
+				DestinationIPv6CIDRBlock: val.DestinationIpv6CidrBlock,
+			})
 		}
 	}
+	target.Routes = append(local, target.Routes...)
 
 	LateInitializeRT(currentParams, &in)
 
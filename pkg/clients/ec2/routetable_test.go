@@ -16,6 +16,7 @@ var (
 	rtID       = "some RT Id"
 	rtSubnetID = "some subnet"
 	rtOwner    = "some owner"
+	rtIGW      = "some igw"
 )
 
 func specAssociations() []v1alpha4.Association {
@@ -66,6 +67,25 @@ func TestIsRTUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"LocalRoutes": {
+			args: args{
+				rt: ec2.RouteTable{
+					VpcId: aws.String(rtVPC),
+					Routes: []ec2.Route{
+						{GatewayId: aws.String(LocalGatewayID), DestinationCidrBlock: aws.String("10.0.0.0/16")},
+						{GatewayId: aws.String(LocalGatewayID), DestinationIpv6CidrBlock: aws.String("2600:1f18::/56")},
+						{GatewayId: aws.String(rtIGW), DestinationCidrBlock: aws.String("0.0.0.0/0")},
+					},
+				},
+				p: v1alpha4.RouteTableParameters{
+					VPCID: aws.String(rtVPC),
+					Routes: []v1alpha4.Route{
+						{GatewayID: aws.String(rtIGW), DestinationCIDRBlock: aws.String("0.0.0.0/0")},
+					},
+				},
+			},
+			want: true,
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,104 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// SnapshotIDNotFound is the code that is returned by ec2 when the given SnapshotID is not valid
+	SnapshotIDNotFound = "InvalidSnapshot.NotFound"
+)
+
+// SnapshotClient is the external client used for Snapshot Custom Resource
+type SnapshotClient interface {
+	CreateSnapshotRequest(*ec2.CreateSnapshotInput) ec2.CreateSnapshotRequest
+	DeleteSnapshotRequest(*ec2.DeleteSnapshotInput) ec2.DeleteSnapshotRequest
+	DescribeSnapshotsRequest(*ec2.DescribeSnapshotsInput) ec2.DescribeSnapshotsRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// NewSnapshotClient returns a new client using AWS credentials as JSON encoded data.
+func NewSnapshotClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (SnapshotClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ec2.New(*cfg), nil
+}
+
+// IsSnapshotNotFoundErr returns true if the error is because the snapshot doesn't exist
+func IsSnapshotNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == SnapshotIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateCreateSnapshotInput returns the input to create the snapshot
+// described by the given parameters.
+func GenerateCreateSnapshotInput(p v1alpha4.SnapshotParameters) *ec2.CreateSnapshotInput {
+	in := &ec2.CreateSnapshotInput{
+		Description: p.Description,
+		VolumeId:    p.VolumeID,
+	}
+	if len(p.Tags) != 0 {
+		in.TagSpecifications = []ec2.TagSpecification{{
+			ResourceType: ec2.ResourceTypeSnapshot,
+			Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return in
+}
+
+// GenerateSnapshotObservation is used to produce v1alpha4.SnapshotObservation
+// from ec2.Snapshot.
+func GenerateSnapshotObservation(s ec2.Snapshot) v1alpha4.SnapshotObservation {
+	o := v1alpha4.SnapshotObservation{
+		SnapshotID:   aws.StringValue(s.SnapshotId),
+		State:        string(s.State),
+		Progress:     aws.StringValue(s.Progress),
+		StateMessage: aws.StringValue(s.StateMessage),
+		VolumeSize:   aws.Int64Value(s.VolumeSize),
+		Encrypted:    aws.BoolValue(s.Encrypted),
+		KMSKeyID:     aws.StringValue(s.KmsKeyId),
+		OwnerID:      aws.StringValue(s.OwnerId),
+	}
+	if s.StartTime != nil {
+		t := metav1.NewTime(*s.StartTime)
+		o.StartTime = &t
+	}
+	return o
+}
+
+// LateInitializeSnapshot fills the empty fields in
+// *v1alpha4.SnapshotParameters with the values seen in ec2.Snapshot.
+func LateInitializeSnapshot(in *v1alpha4.SnapshotParameters, s *ec2.Snapshot) {
+	if s == nil {
+		return
+	}
+
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, s.Description)
+	in.VolumeID = awsclients.LateInitializeStringPtr(in.VolumeID, s.VolumeId)
+
+	if len(in.Tags) == 0 && len(s.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(s.Tags)
+	}
+}
+
+// IsSnapshotUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource. Only tags of a snapshot
+// can be modified.
+func IsSnapshotUpToDate(p v1alpha4.SnapshotParameters, s ec2.Snapshot) bool {
+	return v1beta1.CompareTags(p.Tags, s.Tags)
+}
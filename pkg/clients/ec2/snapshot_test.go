@@ -0,0 +1,241 @@
+package ec2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+var (
+	snapID        = "some snapshot"
+	snapDesc      = "some description"
+	snapOtherDesc = "other description"
+	snapVolID     = "some volume"
+	snapOwner     = "some owner"
+	snapKMSKeyID  = "some key"
+	snapStartTime = time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestGenerateCreateSnapshotInput(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha4.SnapshotParameters
+		want *ec2.CreateSnapshotInput
+	}{
+		"NoTags": {
+			in: v1alpha4.SnapshotParameters{
+				Description: aws.String(snapDesc),
+				VolumeID:    aws.String(snapVolID),
+			},
+			want: &ec2.CreateSnapshotInput{
+				Description: aws.String(snapDesc),
+				VolumeId:    aws.String(snapVolID),
+			},
+		},
+		"WithTags": {
+			in: v1alpha4.SnapshotParameters{
+				VolumeID: aws.String(snapVolID),
+				Tags:     []v1beta1.Tag{{Key: "key", Value: "value"}},
+			},
+			want: &ec2.CreateSnapshotInput{
+				VolumeId: aws.String(snapVolID),
+				TagSpecifications: []ec2.TagSpecification{{
+					ResourceType: ec2.ResourceTypeSnapshot,
+					Tags:         []ec2.Tag{{Key: aws.String("key"), Value: aws.String("value")}},
+				}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateSnapshotInput(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateSnapshotObservation(t *testing.T) {
+	startTime := metav1.NewTime(snapStartTime)
+
+	cases := map[string]struct {
+		in   ec2.Snapshot
+		want v1alpha4.SnapshotObservation
+	}{
+		"AllFilled": {
+			in: ec2.Snapshot{
+				SnapshotId:   aws.String(snapID),
+				State:        ec2.SnapshotStateCompleted,
+				Progress:     aws.String("100%"),
+				StateMessage: aws.String("done"),
+				VolumeSize:   aws.Int64(10),
+				Encrypted:    aws.Bool(true),
+				KmsKeyId:     aws.String(snapKMSKeyID),
+				OwnerId:      aws.String(snapOwner),
+				StartTime:    &snapStartTime,
+			},
+			want: v1alpha4.SnapshotObservation{
+				SnapshotID:   snapID,
+				State:        string(ec2.SnapshotStateCompleted),
+				Progress:     "100%",
+				StateMessage: "done",
+				VolumeSize:   10,
+				Encrypted:    true,
+				KMSKeyID:     snapKMSKeyID,
+				OwnerID:      snapOwner,
+				StartTime:    &startTime,
+			},
+		},
+		"NoStartTime": {
+			in: ec2.Snapshot{
+				SnapshotId: aws.String(snapID),
+				State:      ec2.SnapshotStatePending,
+			},
+			want: v1alpha4.SnapshotObservation{
+				SnapshotID: snapID,
+				State:      string(ec2.SnapshotStatePending),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateSnapshotObservation(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSnapshot(t *testing.T) {
+	type args struct {
+		p *v1alpha4.SnapshotParameters
+		s *ec2.Snapshot
+	}
+
+	cases := map[string]struct {
+		args args
+		want *v1alpha4.SnapshotParameters
+	}{
+		"NilSnapshot": {
+			args: args{
+				p: &v1alpha4.SnapshotParameters{VolumeID: aws.String(snapVolID)},
+			},
+			want: &v1alpha4.SnapshotParameters{VolumeID: aws.String(snapVolID)},
+		},
+		"AllFilledNoDiff": {
+			args: args{
+				p: &v1alpha4.SnapshotParameters{
+					Description: aws.String(snapDesc),
+					VolumeID:    aws.String(snapVolID),
+					Tags:        []v1beta1.Tag{{Key: "key", Value: "value"}},
+				},
+				s: &ec2.Snapshot{
+					Description: aws.String(snapOtherDesc),
+					VolumeId:    aws.String("other volume"),
+					Tags:        []ec2.Tag{{Key: aws.String("other"), Value: aws.String("tag")}},
+				},
+			},
+			want: &v1alpha4.SnapshotParameters{
+				Description: aws.String(snapDesc),
+				VolumeID:    aws.String(snapVolID),
+				Tags:        []v1beta1.Tag{{Key: "key", Value: "value"}},
+			},
+		},
+		"PartialFilled": {
+			args: args{
+				p: &v1alpha4.SnapshotParameters{
+					VolumeID: aws.String(snapVolID),
+				},
+				s: &ec2.Snapshot{
+					Description: aws.String(snapDesc),
+					VolumeId:    aws.String(snapVolID),
+					Tags:        []ec2.Tag{{Key: aws.String("key"), Value: aws.String("value")}},
+				},
+			},
+			want: &v1alpha4.SnapshotParameters{
+				Description: aws.String(snapDesc),
+				VolumeID:    aws.String(snapVolID),
+				Tags:        []v1beta1.Tag{{Key: "key", Value: "value"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSnapshot(tc.args.p, tc.args.s)
+			if diff := cmp.Diff(tc.want, tc.args.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSnapshotUpToDate(t *testing.T) {
+	type args struct {
+		p v1alpha4.SnapshotParameters
+		s ec2.Snapshot
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameTags": {
+			args: args{
+				p: v1alpha4.SnapshotParameters{
+					Tags: []v1beta1.Tag{{Key: "key", Value: "value"}},
+				},
+				s: ec2.Snapshot{
+					Tags: []ec2.Tag{{Key: aws.String("key"), Value: aws.String("value")}},
+				},
+			},
+			want: true,
+		},
+		"DifferentTags": {
+			args: args{
+				p: v1alpha4.SnapshotParameters{
+					Tags: []v1beta1.Tag{{Key: "key", Value: "value"}},
+				},
+				s: ec2.Snapshot{
+					Tags: []ec2.Tag{{Key: aws.String("key"), Value: aws.String("other")}},
+				},
+			},
+			want: false,
+		},
+		"MissingTags": {
+			args: args{
+				p: v1alpha4.SnapshotParameters{
+					Tags: []v1beta1.Tag{{Key: "key", Value: "value"}},
+				},
+				s: ec2.Snapshot{},
+			},
+			want: false,
+		},
+		"DescriptionIgnored": {
+			args: args{
+				p: v1alpha4.SnapshotParameters{Description: aws.String(snapDesc)},
+				s: ec2.Snapshot{Description: aws.String(snapOtherDesc)},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSnapshotUpToDate(tc.args.p, tc.args.s)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
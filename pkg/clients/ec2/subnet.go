@@ -23,6 +23,8 @@ type SubnetClient interface {
 	DeleteSubnetRequest(input *ec2.DeleteSubnetInput) ec2.DeleteSubnetRequest
 	ModifySubnetAttributeRequest(input *ec2.ModifySubnetAttributeInput) ec2.ModifySubnetAttributeRequest
 	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	AssociateSubnetCidrBlockRequest(*ec2.AssociateSubnetCidrBlockInput) ec2.AssociateSubnetCidrBlockRequest
+	DisassociateSubnetCidrBlockRequest(*ec2.DisassociateSubnetCidrBlockInput) ec2.DisassociateSubnetCidrBlockRequest
 }
 
 // NewSubnetClient returns a new client using AWS credentials as JSON encoded data.
@@ -60,9 +62,38 @@ func GenerateSubnetObservation(subnet ec2.Subnet) v1beta1.SubnetObservation {
 		o.SubnetState = v
 	}
 
+	if len(subnet.Ipv6CidrBlockAssociationSet) > 0 {
+		o.IPv6CIDRBlockAssociationSet = make([]v1beta1.SubnetIPv6CIDRBlockAssociation, len(subnet.Ipv6CidrBlockAssociationSet))
+		for i, v := range subnet.Ipv6CidrBlockAssociationSet {
+			o.IPv6CIDRBlockAssociationSet[i] = v1beta1.SubnetIPv6CIDRBlockAssociation{
+				AssociationID: aws.StringValue(v.AssociationId),
+				IPv6CIDRBlock: aws.StringValue(v.Ipv6CidrBlock),
+			}
+			if v.Ipv6CidrBlockState != nil {
+				o.IPv6CIDRBlockAssociationSet[i].IPv6CIDRBlockState = v1beta1.VPCCIDRBlockState{
+					State:         string(v.Ipv6CidrBlockState.State),
+					StatusMessage: aws.StringValue(v.Ipv6CidrBlockState.StatusMessage),
+				}
+			}
+		}
+	}
+
 	return o
 }
 
+// GetActiveSubnetIPv6CIDRBlock returns the IPv6 CIDR block association of the
+// subnet that is associated or being associated, if any.
+func GetActiveSubnetIPv6CIDRBlock(s ec2.Subnet) *ec2.SubnetIpv6CidrBlockAssociation {
+	for i, b := range s.Ipv6CidrBlockAssociationSet {
+		if b.Ipv6CidrBlockState != nil &&
+			(b.Ipv6CidrBlockState.State == ec2.SubnetCidrBlockStateCodeAssociated ||
+				b.Ipv6CidrBlockState.State == ec2.SubnetCidrBlockStateCodeAssociating) {
+			return &s.Ipv6CidrBlockAssociationSet[i]
+		}
+	}
+	return nil
+}
+
 // LateInitializeSubnet fills the empty fields in *v1beta1.SubnetParameters with
 // the values seen in ec2.Subnet.
 func LateInitializeSubnet(in *v1beta1.SubnetParameters, s *ec2.Subnet) { // nolint:gocyclo
@@ -77,8 +108,8 @@ func LateInitializeSubnet(in *v1beta1.SubnetParameters, s *ec2.Subnet) { // noli
 	in.MapPublicIPOnLaunch = awsclients.LateInitializeBoolPtr(in.MapPublicIPOnLaunch, s.MapPublicIpOnLaunch)
 	in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, s.VpcId)
 
-	if s.Ipv6CidrBlockAssociationSet != nil {
-		in.IPv6CIDRBlock = awsclients.LateInitializeStringPtr(in.IPv6CIDRBlock, s.Ipv6CidrBlockAssociationSet[0].Ipv6CidrBlock)
+	if b := GetActiveSubnetIPv6CIDRBlock(*s); b != nil {
+		in.IPv6CIDRBlock = awsclients.LateInitializeStringPtr(in.IPv6CIDRBlock, b.Ipv6CidrBlock)
 	}
 
 	if len(in.Tags) == 0 && len(s.Tags) != 0 {
@@ -88,11 +119,19 @@ func LateInitializeSubnet(in *v1beta1.SubnetParameters, s *ec2.Subnet) { // noli
 
 // IsSubnetUpToDate checks whether there is a change in any of the modifiable fields.
 func IsSubnetUpToDate(p v1beta1.SubnetParameters, s ec2.Subnet) bool {
-	if p.MapPublicIPOnLaunch != nil && (*p.MapPublicIPOnLaunch != *s.MapPublicIpOnLaunch) {
+	if p.MapPublicIPOnLaunch != nil && (*p.MapPublicIPOnLaunch != aws.BoolValue(s.MapPublicIpOnLaunch)) {
 		return false
 	}
 
-	if p.AssignIPv6AddressOnCreation != nil && (*p.AssignIPv6AddressOnCreation != *s.AssignIpv6AddressOnCreation) {
+	if p.AssignIPv6AddressOnCreation != nil && (*p.AssignIPv6AddressOnCreation != aws.BoolValue(s.AssignIpv6AddressOnCreation)) {
+		return false
+	}
+
+	observedIPv6 := ""
+	if b := GetActiveSubnetIPv6CIDRBlock(s); b != nil {
+		observedIPv6 = aws.StringValue(b.Ipv6CidrBlock)
+	}
+	if aws.StringValue(p.IPv6CIDRBlock) != observedIPv6 {
 		return false
 	}
 
@@ -62,6 +62,22 @@ func TestIsSubnetUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"DifferentIPv6CIDRBlock": {
+			args: args{
+				subnet: ec2.Subnet{
+					CidrBlock: aws.String(cidr),
+					Ipv6CidrBlockAssociationSet: []ec2.SubnetIpv6CidrBlockAssociation{{
+						Ipv6CidrBlock:      aws.String("2600:1f16::/64"),
+						Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{State: ec2.SubnetCidrBlockStateCodeDisassociated},
+					}},
+				},
+				p: v1beta1.SubnetParameters{
+					CIDRBlock:     cidr,
+					IPv6CIDRBlock: aws.String("2600:1f16::/64"),
+				},
+			},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,185 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// VolumeIDNotFound is the code that is returned by ec2 when the given VolumeID is not valid
+	VolumeIDNotFound = "InvalidVolume.NotFound"
+
+	// VolumeModificationNotFound is the code that is returned by ec2 when the
+	// given volume has never been modified
+	VolumeModificationNotFound = "InvalidVolumeModification.NotFound"
+)
+
+// VolumeClient is the external client used for Volume Custom Resource
+type VolumeClient interface {
+	CreateVolumeRequest(*ec2.CreateVolumeInput) ec2.CreateVolumeRequest
+	DeleteVolumeRequest(*ec2.DeleteVolumeInput) ec2.DeleteVolumeRequest
+	DescribeVolumesRequest(*ec2.DescribeVolumesInput) ec2.DescribeVolumesRequest
+	ModifyVolumeRequest(*ec2.ModifyVolumeInput) ec2.ModifyVolumeRequest
+	DescribeVolumesModificationsRequest(*ec2.DescribeVolumesModificationsInput) ec2.DescribeVolumesModificationsRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// NewVolumeClient returns a new client using AWS credentials as JSON encoded data.
+func NewVolumeClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (VolumeClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ec2.New(*cfg), nil
+}
+
+// IsVolumeNotFoundErr returns true if the error is because the volume doesn't exist
+func IsVolumeNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == VolumeIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// IsVolumeModificationNotFoundErr returns true if the error is because the
+// volume has no modifications
+func IsVolumeModificationNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == VolumeModificationNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateCreateVolumeInput returns the input to create the volume described
+// by the given parameters.
+func GenerateCreateVolumeInput(p v1alpha4.VolumeParameters) *ec2.CreateVolumeInput {
+	in := &ec2.CreateVolumeInput{
+		AvailabilityZone:   aws.String(p.AvailabilityZone),
+		Encrypted:          p.Encrypted,
+		Iops:               p.IOPS,
+		KmsKeyId:           p.KMSKeyID,
+		MultiAttachEnabled: p.MultiAttachEnabled,
+		Size:               p.Size,
+		SnapshotId:         p.SnapshotID,
+		VolumeType:         ec2.VolumeType(aws.StringValue(p.VolumeType)),
+	}
+	if len(p.Tags) != 0 {
+		in.TagSpecifications = []ec2.TagSpecification{{
+			ResourceType: ec2.ResourceTypeVolume,
+			Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return in
+}
+
+// GenerateVolumeObservation is used to produce v1alpha4.VolumeObservation from
+// ec2.Volume and its latest modification, if any.
+func GenerateVolumeObservation(v ec2.Volume, m *ec2.VolumeModification) v1alpha4.VolumeObservation {
+	o := v1alpha4.VolumeObservation{
+		VolumeID: aws.StringValue(v.VolumeId),
+		State:    string(v.State),
+	}
+	if m != nil {
+		o.Modification = &v1alpha4.VolumeModification{
+			ModificationState: string(m.ModificationState),
+			Progress:          aws.Int64Value(m.Progress),
+			StatusMessage:     aws.StringValue(m.StatusMessage),
+			TargetSize:        aws.Int64Value(m.TargetSize),
+			TargetIOPS:        aws.Int64Value(m.TargetIops),
+			TargetVolumeType:  string(m.TargetVolumeType),
+		}
+	}
+	return o
+}
+
+// LatestVolumeModification returns the most recently started modification in
+// the given list, or nil if the list is empty.
+func LatestVolumeModification(ms []ec2.VolumeModification) *ec2.VolumeModification {
+	var latest *ec2.VolumeModification
+	for i := range ms {
+		if latest == nil || (ms[i].StartTime != nil && latest.StartTime != nil && ms[i].StartTime.After(*latest.StartTime)) {
+			latest = &ms[i]
+		}
+	}
+	return latest
+}
+
+// LateInitializeVolume fills the empty fields in *v1alpha4.VolumeParameters
+// with the values seen in ec2.Volume.
+func LateInitializeVolume(in *v1alpha4.VolumeParameters, v *ec2.Volume) {
+	if v == nil {
+		return
+	}
+
+	in.Size = awsclients.LateInitializeInt64Ptr(in.Size, v.Size)
+	if v.VolumeType != "" {
+		in.VolumeType = awsclients.LateInitializeStringPtr(in.VolumeType, aws.String(string(v.VolumeType)))
+	}
+	// EC2 reports the baseline IOPS of every volume type, but IOPS can only
+	// be requested for provisioned IOPS volumes.
+	if v.VolumeType == ec2.VolumeTypeIo1 {
+		in.IOPS = awsclients.LateInitializeInt64Ptr(in.IOPS, v.Iops)
+	}
+	in.Encrypted = awsclients.LateInitializeBoolPtr(in.Encrypted, v.Encrypted)
+	in.KMSKeyID = awsclients.LateInitializeStringPtr(in.KMSKeyID, v.KmsKeyId)
+	in.SnapshotID = awsclients.LateInitializeStringPtr(in.SnapshotID, v.SnapshotId)
+	in.MultiAttachEnabled = awsclients.LateInitializeBoolPtr(in.MultiAttachEnabled, v.MultiAttachEnabled)
+
+	if len(in.Tags) == 0 && len(v.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(v.Tags)
+	}
+}
+
+// GenerateModifyVolumeInput returns the input to bring the observed volume to
+// the desired size, type and IOPS, and whether there is anything to modify.
+func GenerateModifyVolumeInput(id string, p v1alpha4.VolumeParameters, v ec2.Volume) (*ec2.ModifyVolumeInput, bool) {
+	in := &ec2.ModifyVolumeInput{VolumeId: aws.String(id)}
+	changed := false
+
+	if p.Size != nil && aws.Int64Value(p.Size) != aws.Int64Value(v.Size) {
+		in.Size = p.Size
+		changed = true
+	}
+	if p.VolumeType != nil && aws.StringValue(p.VolumeType) != string(v.VolumeType) {
+		in.VolumeType = ec2.VolumeType(aws.StringValue(p.VolumeType))
+		changed = true
+	}
+	if p.IOPS != nil && aws.StringValue(p.VolumeType) == string(ec2.VolumeTypeIo1) && aws.Int64Value(p.IOPS) != aws.Int64Value(v.Iops) {
+		in.Iops = p.IOPS
+		changed = true
+	}
+
+	return in, changed
+}
+
+// IsVolumeModifying returns true if the given modification has not reached
+// the optimizing state yet, during which no further modification can be
+// requested.
+func IsVolumeModifying(m *ec2.VolumeModification) bool {
+	return m != nil && m.ModificationState == ec2.VolumeModificationStateModifying
+}
+
+// IsVolumeUpToDate returns true if there is no update-able difference between
+// desired and observed state of the resource. A volume that is being modified
+// is considered up to date until the modification is applied.
+func IsVolumeUpToDate(p v1alpha4.VolumeParameters, v ec2.Volume, m *ec2.VolumeModification) bool {
+	if !v1beta1.CompareTags(p.Tags, v.Tags) {
+		return false
+	}
+	if IsVolumeModifying(m) {
+		return true
+	}
+	_, changed := GenerateModifyVolumeInput(aws.StringValue(v.VolumeId), p, v)
+	return !changed
+}
@@ -0,0 +1,196 @@
+package ec2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+)
+
+var (
+	volID = "some volume"
+	volAZ = "us-east-1a"
+)
+
+func TestGenerateModifyVolumeInput(t *testing.T) {
+	type args struct {
+		p v1alpha4.VolumeParameters
+		v ec2.Volume
+	}
+	type want struct {
+		in      *ec2.ModifyVolumeInput
+		changed bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoChanges": {
+			args: args{
+				p: v1alpha4.VolumeParameters{Size: aws.Int64(10), VolumeType: aws.String("gp2")},
+				v: ec2.Volume{Size: aws.Int64(10), VolumeType: ec2.VolumeTypeGp2, Iops: aws.Int64(100)},
+			},
+			want: want{
+				in: &ec2.ModifyVolumeInput{VolumeId: aws.String(volID)},
+			},
+		},
+		"Resize": {
+			args: args{
+				p: v1alpha4.VolumeParameters{Size: aws.Int64(20), VolumeType: aws.String("gp2")},
+				v: ec2.Volume{Size: aws.Int64(10), VolumeType: ec2.VolumeTypeGp2},
+			},
+			want: want{
+				in:      &ec2.ModifyVolumeInput{VolumeId: aws.String(volID), Size: aws.Int64(20)},
+				changed: true,
+			},
+		},
+		"ChangeTypeAndIOPS": {
+			args: args{
+				p: v1alpha4.VolumeParameters{Size: aws.Int64(10), VolumeType: aws.String("io1"), IOPS: aws.Int64(500)},
+				v: ec2.Volume{Size: aws.Int64(10), VolumeType: ec2.VolumeTypeGp2, Iops: aws.Int64(100)},
+			},
+			want: want{
+				in:      &ec2.ModifyVolumeInput{VolumeId: aws.String(volID), VolumeType: ec2.VolumeTypeIo1, Iops: aws.Int64(500)},
+				changed: true,
+			},
+		},
+		"IgnoreIOPSForGP2": {
+			args: args{
+				p: v1alpha4.VolumeParameters{VolumeType: aws.String("gp2"), IOPS: aws.Int64(500)},
+				v: ec2.Volume{VolumeType: ec2.VolumeTypeGp2, Iops: aws.Int64(100)},
+			},
+			want: want{
+				in: &ec2.ModifyVolumeInput{VolumeId: aws.String(volID)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in, changed := GenerateModifyVolumeInput(volID, tc.args.p, tc.args.v)
+			if diff := cmp.Diff(tc.want.in, in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsVolumeUpToDate(t *testing.T) {
+	type args struct {
+		p v1alpha4.VolumeParameters
+		v ec2.Volume
+		m *ec2.VolumeModification
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: v1alpha4.VolumeParameters{Size: aws.Int64(10)},
+				v: ec2.Volume{Size: aws.Int64(10)},
+			},
+			want: true,
+		},
+		"SizeDiffers": {
+			args: args{
+				p: v1alpha4.VolumeParameters{Size: aws.Int64(20)},
+				v: ec2.Volume{Size: aws.Int64(10)},
+				m: &ec2.VolumeModification{ModificationState: ec2.VolumeModificationStateCompleted},
+			},
+			want: false,
+		},
+		"Modifying": {
+			args: args{
+				p: v1alpha4.VolumeParameters{Size: aws.Int64(20)},
+				v: ec2.Volume{Size: aws.Int64(10)},
+				m: &ec2.VolumeModification{ModificationState: ec2.VolumeModificationStateModifying},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsVolumeUpToDate(tc.args.p, tc.args.v, tc.args.m)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLatestVolumeModification(t *testing.T) {
+	older := time.Now().Add(-time.Hour)
+	newer := time.Now()
+
+	cases := map[string]struct {
+		ms   []ec2.VolumeModification
+		want *ec2.VolumeModification
+	}{
+		"Empty": {},
+		"Latest": {
+			ms: []ec2.VolumeModification{
+				{StartTime: &newer, TargetSize: aws.Int64(30)},
+				{StartTime: &older, TargetSize: aws.Int64(20)},
+			},
+			want: &ec2.VolumeModification{StartTime: &newer, TargetSize: aws.Int64(30)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := LatestVolumeModification(tc.ms)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeVolume(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha4.VolumeParameters
+		v    *ec2.Volume
+		want v1alpha4.VolumeParameters
+	}{
+		"GP2": {
+			p: v1alpha4.VolumeParameters{AvailabilityZone: volAZ},
+			v: &ec2.Volume{Size: aws.Int64(10), VolumeType: ec2.VolumeTypeGp2, Iops: aws.Int64(100), Encrypted: aws.Bool(false)},
+			want: v1alpha4.VolumeParameters{
+				AvailabilityZone: volAZ,
+				Size:             aws.Int64(10),
+				VolumeType:       aws.String("gp2"),
+				Encrypted:        aws.Bool(false),
+			},
+		},
+		"IO1": {
+			p: v1alpha4.VolumeParameters{AvailabilityZone: volAZ},
+			v: &ec2.Volume{Size: aws.Int64(10), VolumeType: ec2.VolumeTypeIo1, Iops: aws.Int64(500)},
+			want: v1alpha4.VolumeParameters{
+				AvailabilityZone: volAZ,
+				Size:             aws.Int64(10),
+				VolumeType:       aws.String("io1"),
+				IOPS:             aws.Int64(500),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeVolume(&tc.p, tc.v)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// VolumeAttachmentNotFound is the code that is returned by ec2 when the
+	// given volume is not attached to the given instance
+	VolumeAttachmentNotFound = "InvalidAttachment.NotFound"
+
+	// VolumeIncorrectState is the code that is returned by ec2 when the
+	// volume is not in a state that allows the requested operation, e.g.
+	// detaching a volume that is already detached
+	VolumeIncorrectState = "IncorrectState"
+)
+
+// VolumeAttachmentClient is the external client used for VolumeAttachment Custom Resource
+type VolumeAttachmentClient interface {
+	AttachVolumeRequest(*ec2.AttachVolumeInput) ec2.AttachVolumeRequest
+	DetachVolumeRequest(*ec2.DetachVolumeInput) ec2.DetachVolumeRequest
+	DescribeVolumesRequest(*ec2.DescribeVolumesInput) ec2.DescribeVolumesRequest
+}
+
+// NewVolumeAttachmentClient returns a new client using AWS credentials as JSON encoded data.
+func NewVolumeAttachmentClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (VolumeAttachmentClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ec2.New(*cfg), nil
+}
+
+// IsVolumeAttachmentNotFoundErr returns true if the error is because the
+// volume or the attachment doesn't exist
+func IsVolumeAttachmentNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch awsErr.Code() {
+		case VolumeAttachmentNotFound, VolumeIDNotFound, VolumeIncorrectState:
+			return true
+		}
+	}
+	return false
+}
+
+// FindVolumeAttachment returns the attachment of the given volume to the
+// given instance, or nil if the volume is not attached to it.
+func FindVolumeAttachment(v ec2.Volume, instanceID string) *ec2.VolumeAttachment {
+	for i, a := range v.Attachments {
+		if aws.StringValue(a.InstanceId) == instanceID {
+			return &v.Attachments[i]
+		}
+	}
+	return nil
+}
+
+// GenerateVolumeAttachmentObservation is used to produce
+// v1alpha4.VolumeAttachmentObservation from ec2.VolumeAttachment.
+func GenerateVolumeAttachmentObservation(a ec2.VolumeAttachment) v1alpha4.VolumeAttachmentObservation {
+	return v1alpha4.VolumeAttachmentObservation{
+		State:               string(a.State),
+		DeleteOnTermination: aws.BoolValue(a.DeleteOnTermination),
+	}
+}
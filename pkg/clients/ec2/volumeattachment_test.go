@@ -0,0 +1,143 @@
+package ec2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+)
+
+var (
+	attInstanceID      = "some instance"
+	attOtherInstanceID = "other instance"
+)
+
+func TestIsVolumeAttachmentNotFoundErr(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"AttachmentNotFound": {
+			err:  awserr.New(VolumeAttachmentNotFound, "", nil),
+			want: true,
+		},
+		"VolumeNotFound": {
+			err:  awserr.New(VolumeIDNotFound, "", nil),
+			want: true,
+		},
+		"IncorrectState": {
+			err:  awserr.New(VolumeIncorrectState, "", nil),
+			want: true,
+		},
+		"OtherAWSError": {
+			err:  awserr.New("SomethingElse", "", nil),
+			want: false,
+		},
+		"NotAWSError": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsVolumeAttachmentNotFoundErr(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindVolumeAttachment(t *testing.T) {
+	type args struct {
+		v          ec2.Volume
+		instanceID string
+	}
+
+	cases := map[string]struct {
+		args args
+		want *ec2.VolumeAttachment
+	}{
+		"Found": {
+			args: args{
+				v: ec2.Volume{
+					VolumeId: aws.String(volID),
+					Attachments: []ec2.VolumeAttachment{
+						{InstanceId: aws.String(attOtherInstanceID), State: ec2.VolumeAttachmentStateAttached},
+						{InstanceId: aws.String(attInstanceID), State: ec2.VolumeAttachmentStateAttaching},
+					},
+				},
+				instanceID: attInstanceID,
+			},
+			want: &ec2.VolumeAttachment{InstanceId: aws.String(attInstanceID), State: ec2.VolumeAttachmentStateAttaching},
+		},
+		"NotFound": {
+			args: args{
+				v: ec2.Volume{
+					VolumeId: aws.String(volID),
+					Attachments: []ec2.VolumeAttachment{
+						{InstanceId: aws.String(attOtherInstanceID), State: ec2.VolumeAttachmentStateAttached},
+					},
+				},
+				instanceID: attInstanceID,
+			},
+		},
+		"NoAttachments": {
+			args: args{
+				v:          ec2.Volume{VolumeId: aws.String(volID)},
+				instanceID: attInstanceID,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FindVolumeAttachment(tc.args.v, tc.args.instanceID)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateVolumeAttachmentObservation(t *testing.T) {
+	cases := map[string]struct {
+		in   ec2.VolumeAttachment
+		want v1alpha4.VolumeAttachmentObservation
+	}{
+		"AllFilled": {
+			in: ec2.VolumeAttachment{
+				InstanceId:          aws.String(attInstanceID),
+				State:               ec2.VolumeAttachmentStateAttached,
+				DeleteOnTermination: aws.Bool(true),
+			},
+			want: v1alpha4.VolumeAttachmentObservation{
+				State:               string(ec2.VolumeAttachmentStateAttached),
+				DeleteOnTermination: true,
+			},
+		},
+		"NoDeleteOnTermination": {
+			in: ec2.VolumeAttachment{
+				State: ec2.VolumeAttachmentStateDetaching,
+			},
+			want: v1alpha4.VolumeAttachmentObservation{
+				State: string(ec2.VolumeAttachmentStateDetaching),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateVolumeAttachmentObservation(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
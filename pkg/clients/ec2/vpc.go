@@ -25,6 +25,8 @@ type VPCClient interface {
 	ModifyVpcAttributeRequest(*ec2.ModifyVpcAttributeInput) ec2.ModifyVpcAttributeRequest
 	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
 	ModifyVpcTenancyRequest(*ec2.ModifyVpcTenancyInput) ec2.ModifyVpcTenancyRequest
+	AssociateVpcCidrBlockRequest(*ec2.AssociateVpcCidrBlockInput) ec2.AssociateVpcCidrBlockRequest
+	DisassociateVpcCidrBlockRequest(*ec2.DisassociateVpcCidrBlockInput) ec2.DisassociateVpcCidrBlockRequest
 }
 
 // NewVpcClient returns a new client using AWS credentials as JSON encoded data.
@@ -59,9 +61,77 @@ func IsVpcUpToDate(spec v1beta1.VPCParameters, vpc ec2.Vpc, attributes ec2.Descr
 		return false
 	}
 
+	associate, disassociate := DiffVPCCIDRBlocks(spec, vpc)
+	if len(associate)+len(disassociate) != 0 {
+		return false
+	}
+
+	associateIPv6, disassociateIPv6 := DiffVPCIPv6CIDRBlocks(spec, vpc)
+	if associateIPv6 || len(disassociateIPv6) != 0 {
+		return false
+	}
+
 	return v1beta1.CompareTags(spec.Tags, vpc.Tags)
 }
 
+// isVPCCIDRBlockActive returns true if the CIDR block is associated with the
+// VPC or on its way to be.
+func isVPCCIDRBlockActive(s *ec2.VpcCidrBlockState) bool {
+	return s != nil && (s.State == ec2.VpcCidrBlockStateCodeAssociated || s.State == ec2.VpcCidrBlockStateCodeAssociating)
+}
+
+// DiffVPCCIDRBlocks returns the secondary IPv4 CIDR blocks that need to be
+// associated with the VPC and the association IDs of the secondary IPv4 CIDR
+// blocks that need to be disassociated from it. The primary CIDR block of the
+// VPC is never reported.
+func DiffVPCCIDRBlocks(spec v1beta1.VPCParameters, vpc ec2.Vpc) (associate, disassociate []string) {
+	observed := map[string]bool{}
+	for _, b := range vpc.CidrBlockAssociationSet {
+		cidr := aws.StringValue(b.CidrBlock)
+		if cidr == aws.StringValue(vpc.CidrBlock) || !isVPCCIDRBlockActive(b.CidrBlockState) {
+			continue
+		}
+		observed[cidr] = true
+	}
+
+	desired := map[string]bool{}
+	for _, cidr := range spec.SecondaryCIDRBlocks {
+		desired[cidr] = true
+		if !observed[cidr] {
+			associate = append(associate, cidr)
+		}
+	}
+
+	for _, b := range vpc.CidrBlockAssociationSet {
+		cidr := aws.StringValue(b.CidrBlock)
+		if observed[cidr] && !desired[cidr] {
+			disassociate = append(disassociate, aws.StringValue(b.AssociationId))
+		}
+	}
+	return associate, disassociate
+}
+
+// DiffVPCIPv6CIDRBlocks returns whether an Amazon-provided IPv6 CIDR block
+// needs to be associated with the VPC and the association IDs of the IPv6 CIDR
+// blocks that need to be disassociated from it.
+func DiffVPCIPv6CIDRBlocks(spec v1beta1.VPCParameters, vpc ec2.Vpc) (associate bool, disassociate []string) {
+	if spec.AmazonProvidedIPv6CIDRBlock == nil {
+		return false, nil
+	}
+
+	var active []string
+	for _, b := range vpc.Ipv6CidrBlockAssociationSet {
+		if isVPCCIDRBlockActive(b.Ipv6CidrBlockState) {
+			active = append(active, aws.StringValue(b.AssociationId))
+		}
+	}
+
+	if aws.BoolValue(spec.AmazonProvidedIPv6CIDRBlock) {
+		return len(active) == 0, nil
+	}
+	return false, active
+}
+
 // GenerateVpcObservation is used to produce v1beta1.VPCObservation from
 // ec2.Vpc.
 func GenerateVpcObservation(vpc ec2.Vpc) v1beta1.VPCObservation {
@@ -114,4 +184,12 @@ func LateInitializeVPC(in *v1beta1.VPCParameters, v *ec2.Vpc) { // nolint:gocycl
 
 	in.CIDRBlock = awsclients.LateInitializeString(in.CIDRBlock, v.CidrBlock)
 	in.InstanceTenancy = awsclients.LateInitializeStringPtr(in.InstanceTenancy, aws.String(string(v.InstanceTenancy)))
+
+	hasIPv6 := false
+	for _, b := range v.Ipv6CidrBlockAssociationSet {
+		if isVPCCIDRBlockActive(b.Ipv6CidrBlockState) {
+			hasIPv6 = true
+		}
+	}
+	in.AmazonProvidedIPv6CIDRBlock = awsclients.LateInitializeBoolPtr(in.AmazonProvidedIPv6CIDRBlock, aws.Bool(hasIPv6))
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
@@ -14,6 +15,10 @@ var (
 	boolFalse         = false
 	vpcOwner          = "some owner"
 	vpcStateAvailable = "available"
+
+	vpcPrimaryCIDR   = "10.0.0.0/16"
+	vpcSecondaryCIDR = "10.1.0.0/16"
+	vpcOtherCIDR     = "10.2.0.0/16"
 )
 
 func TestGenerateVPCObservation(t *testing.T) {
@@ -56,3 +61,113 @@ func TestGenerateVPCObservation(t *testing.T) {
 		})
 	}
 }
+
+func vpcCIDRBlock(id, cidr string, state ec2.VpcCidrBlockStateCode) ec2.VpcCidrBlockAssociation {
+	return ec2.VpcCidrBlockAssociation{
+		AssociationId:  aws.String(id),
+		CidrBlock:      aws.String(cidr),
+		CidrBlockState: &ec2.VpcCidrBlockState{State: state},
+	}
+}
+
+func TestDiffVPCCIDRBlocks(t *testing.T) {
+	type want struct {
+		associate    []string
+		disassociate []string
+	}
+
+	cases := map[string]struct {
+		spec v1beta1.VPCParameters
+		vpc  ec2.Vpc
+		want want
+	}{
+		"NoChanges": {
+			spec: v1beta1.VPCParameters{CIDRBlock: vpcPrimaryCIDR, SecondaryCIDRBlocks: []string{vpcSecondaryCIDR}},
+			vpc: ec2.Vpc{
+				CidrBlock: aws.String(vpcPrimaryCIDR),
+				CidrBlockAssociationSet: []ec2.VpcCidrBlockAssociation{
+					vpcCIDRBlock("primary", vpcPrimaryCIDR, ec2.VpcCidrBlockStateCodeAssociated),
+					vpcCIDRBlock("secondary", vpcSecondaryCIDR, ec2.VpcCidrBlockStateCodeAssociating),
+				},
+			},
+		},
+		"AssociateAndDisassociate": {
+			spec: v1beta1.VPCParameters{CIDRBlock: vpcPrimaryCIDR, SecondaryCIDRBlocks: []string{vpcSecondaryCIDR}},
+			vpc: ec2.Vpc{
+				CidrBlock: aws.String(vpcPrimaryCIDR),
+				CidrBlockAssociationSet: []ec2.VpcCidrBlockAssociation{
+					vpcCIDRBlock("primary", vpcPrimaryCIDR, ec2.VpcCidrBlockStateCodeAssociated),
+					vpcCIDRBlock("old", vpcSecondaryCIDR, ec2.VpcCidrBlockStateCodeDisassociated),
+					vpcCIDRBlock("other", vpcOtherCIDR, ec2.VpcCidrBlockStateCodeAssociated),
+				},
+			},
+			want: want{
+				associate:    []string{vpcSecondaryCIDR},
+				disassociate: []string{"other"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			associate, disassociate := DiffVPCCIDRBlocks(tc.spec, tc.vpc)
+			if diff := cmp.Diff(tc.want.associate, associate, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("associate: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disassociate, disassociate, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("disassociate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffVPCIPv6CIDRBlocks(t *testing.T) {
+	type want struct {
+		associate    bool
+		disassociate []string
+	}
+
+	active := ec2.Vpc{
+		Ipv6CidrBlockAssociationSet: []ec2.VpcIpv6CidrBlockAssociation{{
+			AssociationId:      aws.String("ipv6"),
+			Ipv6CidrBlockState: &ec2.VpcCidrBlockState{State: ec2.VpcCidrBlockStateCodeAssociated},
+		}},
+	}
+
+	cases := map[string]struct {
+		spec v1beta1.VPCParameters
+		vpc  ec2.Vpc
+		want want
+	}{
+		"Unset": {
+			spec: v1beta1.VPCParameters{},
+			vpc:  active,
+		},
+		"Associate": {
+			spec: v1beta1.VPCParameters{AmazonProvidedIPv6CIDRBlock: aws.Bool(true)},
+			vpc:  ec2.Vpc{},
+			want: want{associate: true},
+		},
+		"AlreadyAssociated": {
+			spec: v1beta1.VPCParameters{AmazonProvidedIPv6CIDRBlock: aws.Bool(true)},
+			vpc:  active,
+		},
+		"Disassociate": {
+			spec: v1beta1.VPCParameters{AmazonProvidedIPv6CIDRBlock: aws.Bool(false, aws.FieldRequired)},
+			vpc:  active,
+			want: want{disassociate: []string{"ipv6"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			associate, disassociate := DiffVPCIPv6CIDRBlocks(tc.spec, tc.vpc)
+			if diff := cmp.Diff(tc.want.associate, associate); diff != "" {
+				t.Errorf("associate: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disassociate, disassociate, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("disassociate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
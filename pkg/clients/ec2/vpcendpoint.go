@@ -0,0 +1,227 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// VPCEndpointIDNotFound is the code that is returned by ec2 when the given VPCEndpointID is not valid
+	VPCEndpointIDNotFound = "InvalidVpcEndpointId.NotFound"
+
+	// VPCEndpointDNSNameKeyFormat is the format of the connection detail keys
+	// under which the DNS names of a VPC endpoint are published.
+	VPCEndpointDNSNameKeyFormat = "dnsName-%d"
+)
+
+// VPCEndpointClient is the external client used for VPCEndpoint Custom Resource
+type VPCEndpointClient interface {
+	CreateVpcEndpointRequest(*ec2.CreateVpcEndpointInput) ec2.CreateVpcEndpointRequest
+	DeleteVpcEndpointsRequest(*ec2.DeleteVpcEndpointsInput) ec2.DeleteVpcEndpointsRequest
+	DescribeVpcEndpointsRequest(*ec2.DescribeVpcEndpointsInput) ec2.DescribeVpcEndpointsRequest
+	ModifyVpcEndpointRequest(*ec2.ModifyVpcEndpointInput) ec2.ModifyVpcEndpointRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// NewVPCEndpointClient returns a new client using AWS credentials as JSON encoded data.
+func NewVPCEndpointClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (VPCEndpointClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ec2.New(*cfg), nil
+}
+
+// IsVPCEndpointNotFoundErr returns true if the error is because the VPC endpoint doesn't exist
+func IsVPCEndpointNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == VPCEndpointIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateCreateVPCEndpointInput returns the input to create the VPC endpoint
+// described by the given parameters.
+func GenerateCreateVPCEndpointInput(p v1alpha4.VPCEndpointParameters) *ec2.CreateVpcEndpointInput {
+	return &ec2.CreateVpcEndpointInput{
+		ServiceName:       aws.String(p.ServiceName),
+		VpcEndpointType:   ec2.VpcEndpointType(aws.StringValue(p.VPCEndpointType)),
+		VpcId:             p.VPCID,
+		PolicyDocument:    p.PolicyDocument,
+		PrivateDnsEnabled: p.PrivateDNSEnabled,
+		RouteTableIds:     p.RouteTableIDs,
+		SubnetIds:         p.SubnetIDs,
+		SecurityGroupIds:  p.SecurityGroupIDs,
+	}
+}
+
+// GenerateVPCEndpointObservation is used to produce
+// v1alpha4.VPCEndpointObservation from ec2.VpcEndpoint.
+func GenerateVPCEndpointObservation(ep ec2.VpcEndpoint) v1alpha4.VPCEndpointObservation {
+	o := v1alpha4.VPCEndpointObservation{
+		VPCEndpointID:       aws.StringValue(ep.VpcEndpointId),
+		State:               string(ep.State),
+		OwnerID:             aws.StringValue(ep.OwnerId),
+		NetworkInterfaceIDs: ep.NetworkInterfaceIds,
+	}
+
+	if len(ep.DnsEntries) > 0 {
+		o.DNSEntries = make([]v1alpha4.DNSEntry, len(ep.DnsEntries))
+		for i, e := range ep.DnsEntries {
+			o.DNSEntries[i] = v1alpha4.DNSEntry{
+				DNSName:      aws.StringValue(e.DnsName),
+				HostedZoneID: aws.StringValue(e.HostedZoneId),
+			}
+		}
+	}
+
+	return o
+}
+
+// LateInitializeVPCEndpoint fills the empty fields in
+// *v1alpha4.VPCEndpointParameters with the values seen in ec2.VpcEndpoint.
+func LateInitializeVPCEndpoint(in *v1alpha4.VPCEndpointParameters, ep *ec2.VpcEndpoint) {
+	if ep == nil {
+		return
+	}
+
+	in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, ep.VpcId)
+	in.VPCEndpointType = awsclients.LateInitializeStringPtr(in.VPCEndpointType, aws.String(string(ep.VpcEndpointType)))
+	in.PolicyDocument = awsclients.LateInitializeStringPtr(in.PolicyDocument, ep.PolicyDocument)
+	in.PrivateDNSEnabled = awsclients.LateInitializeBoolPtr(in.PrivateDNSEnabled, ep.PrivateDnsEnabled)
+
+	if len(in.RouteTableIDs) == 0 && len(ep.RouteTableIds) != 0 {
+		in.RouteTableIDs = ep.RouteTableIds
+	}
+	if len(in.SubnetIDs) == 0 && len(ep.SubnetIds) != 0 {
+		in.SubnetIDs = ep.SubnetIds
+	}
+	if len(in.SecurityGroupIDs) == 0 && len(ep.Groups) != 0 {
+		in.SecurityGroupIDs = make([]string, len(ep.Groups))
+		for i, g := range ep.Groups {
+			in.SecurityGroupIDs[i] = aws.StringValue(g.GroupId)
+		}
+	}
+
+	if len(in.Tags) == 0 && len(ep.Tags) != 0 {
+		in.Tags = v1beta1.BuildFromEC2Tags(ep.Tags)
+	}
+}
+
+// diffStrings returns the elements that are only in desired and the elements
+// that are only in observed, both sorted.
+func diffStrings(desired, observed []string) (add, remove []string) {
+	o := map[string]bool{}
+	for _, s := range observed {
+		o[s] = true
+	}
+	d := map[string]bool{}
+	for _, s := range desired {
+		d[s] = true
+		if !o[s] {
+			add = append(add, s)
+		}
+	}
+	for _, s := range observed {
+		if !d[s] {
+			remove = append(remove, s)
+		}
+	}
+	sort.Strings(add)
+	sort.Strings(remove)
+	return add, remove
+}
+
+// isPolicyDocumentUpToDate compares two policy documents regardless of their
+// formatting.
+func isPolicyDocumentUpToDate(desired, observed *string) (bool, error) {
+	if desired == nil {
+		return true, nil
+	}
+	d, err := awsclients.CompactAndEscapeJSON(aws.StringValue(desired))
+	if err != nil {
+		return false, err
+	}
+	o, err := awsclients.CompactAndEscapeJSON(aws.StringValue(observed))
+	if err != nil {
+		return false, err
+	}
+	return d == o, nil
+}
+
+// GenerateModifyVPCEndpointInput returns the input to bring the observed VPC
+// endpoint to the desired state, and whether there is anything to modify.
+func GenerateModifyVPCEndpointInput(id string, p v1alpha4.VPCEndpointParameters, ep ec2.VpcEndpoint) (*ec2.ModifyVpcEndpointInput, bool, error) {
+	in := &ec2.ModifyVpcEndpointInput{VpcEndpointId: aws.String(id)}
+
+	in.AddRouteTableIds, in.RemoveRouteTableIds = diffStrings(p.RouteTableIDs, ep.RouteTableIds)
+	in.AddSubnetIds, in.RemoveSubnetIds = diffStrings(p.SubnetIDs, ep.SubnetIds)
+
+	groups := make([]string, len(ep.Groups))
+	for i, g := range ep.Groups {
+		groups[i] = aws.StringValue(g.GroupId)
+	}
+	in.AddSecurityGroupIds, in.RemoveSecurityGroupIds = diffStrings(p.SecurityGroupIDs, groups)
+
+	changed := len(in.AddRouteTableIds)+len(in.RemoveRouteTableIds)+
+		len(in.AddSubnetIds)+len(in.RemoveSubnetIds)+
+		len(in.AddSecurityGroupIds)+len(in.RemoveSecurityGroupIds) != 0
+
+	upToDate, err := isPolicyDocumentUpToDate(p.PolicyDocument, ep.PolicyDocument)
+	if err != nil {
+		return nil, false, err
+	}
+	if !upToDate {
+		in.PolicyDocument = p.PolicyDocument
+		changed = true
+	}
+
+	if p.PrivateDNSEnabled != nil && aws.BoolValue(p.PrivateDNSEnabled) != aws.BoolValue(ep.PrivateDnsEnabled) {
+		in.PrivateDnsEnabled = p.PrivateDNSEnabled
+		changed = true
+	}
+
+	return in, changed, nil
+}
+
+// IsVPCEndpointUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsVPCEndpointUpToDate(p v1alpha4.VPCEndpointParameters, ep ec2.VpcEndpoint) (bool, error) {
+	_, changed, err := GenerateModifyVPCEndpointInput(aws.StringValue(ep.VpcEndpointId), p, ep)
+	if err != nil {
+		return false, err
+	}
+	if changed {
+		return false, nil
+	}
+	return v1beta1.CompareTags(p.Tags, ep.Tags), nil
+}
+
+// GetVPCEndpointConnectionDetails returns the DNS entries of the VPC endpoint
+// as connection details. The first DNS name is also published as the endpoint.
+func GetVPCEndpointConnectionDetails(ep ec2.VpcEndpoint) managed.ConnectionDetails {
+	if len(ep.DnsEntries) == 0 {
+		return nil
+	}
+	conn := managed.ConnectionDetails{
+		v1alpha1.ResourceCredentialsSecretEndpointKey: []byte(aws.StringValue(ep.DnsEntries[0].DnsName)),
+	}
+	for i, e := range ep.DnsEntries {
+		conn[fmt.Sprintf(VPCEndpointDNSNameKeyFormat, i)] = []byte(aws.StringValue(e.DnsName))
+	}
+	return conn
+}
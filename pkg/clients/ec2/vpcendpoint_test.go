@@ -0,0 +1,129 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+)
+
+var (
+	epID        = "some endpoint"
+	epRT        = "some rt"
+	epOtherRT   = "some other rt"
+	epSG        = "some sg"
+	epPolicy    = `{"Statement":[{"Action":"*","Effect":"Allow","Principal":"*","Resource":"*"}]}`
+	epPolicyFmt = `{
+  "Statement": [
+    {"Action": "*", "Effect": "Allow", "Principal": "*", "Resource": "*"}
+  ]
+}`
+	epDNSName      = "vpce-1.s3.us-east-1.vpce.amazonaws.com"
+	epOtherDNSName = "s3.us-east-1.amazonaws.com"
+)
+
+func TestGenerateModifyVPCEndpointInput(t *testing.T) {
+	type args struct {
+		p  v1alpha4.VPCEndpointParameters
+		ep ec2.VpcEndpoint
+	}
+	type want struct {
+		in      *ec2.ModifyVpcEndpointInput
+		changed bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoChanges": {
+			args: args{
+				p: v1alpha4.VPCEndpointParameters{
+					RouteTableIDs:    []string{epRT},
+					SecurityGroupIDs: []string{epSG},
+					PolicyDocument:   aws.String(epPolicyFmt),
+				},
+				ep: ec2.VpcEndpoint{
+					RouteTableIds:  []string{epRT},
+					Groups:         []ec2.SecurityGroupIdentifier{{GroupId: aws.String(epSG)}},
+					PolicyDocument: aws.String(epPolicy),
+				},
+			},
+			want: want{
+				in: &ec2.ModifyVpcEndpointInput{VpcEndpointId: aws.String(epID)},
+			},
+		},
+		"RouteTablesAndDNS": {
+			args: args{
+				p: v1alpha4.VPCEndpointParameters{
+					RouteTableIDs:     []string{epOtherRT},
+					PrivateDNSEnabled: aws.Bool(true),
+				},
+				ep: ec2.VpcEndpoint{
+					RouteTableIds:     []string{epRT},
+					PrivateDnsEnabled: aws.Bool(false),
+				},
+			},
+			want: want{
+				in: &ec2.ModifyVpcEndpointInput{
+					VpcEndpointId:       aws.String(epID),
+					AddRouteTableIds:    []string{epOtherRT},
+					RemoveRouteTableIds: []string{epRT},
+					PrivateDnsEnabled:   aws.Bool(true),
+				},
+				changed: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in, changed, err := GenerateModifyVPCEndpointInput(epID, tc.args.p, tc.args.ep)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.in, in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetVPCEndpointConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		ep   ec2.VpcEndpoint
+		want managed.ConnectionDetails
+	}{
+		"NoDNSEntries": {
+			ep: ec2.VpcEndpoint{},
+		},
+		"DNSEntries": {
+			ep: ec2.VpcEndpoint{
+				DnsEntries: []ec2.DnsEntry{{DnsName: aws.String(epDNSName)}, {DnsName: aws.String(epOtherDNSName)}},
+			},
+			want: managed.ConnectionDetails{
+				v1alpha1.ResourceCredentialsSecretEndpointKey: []byte(epDNSName),
+				"dnsName-0": []byte(epDNSName),
+				"dnsName-1": []byte(epOtherDNSName),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetVPCEndpointConnectionDetails(tc.ep)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticache
+
+import (
+	"net"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+
+	"github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	clients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// CacheNodeConnectionKeyPrefix is prepended to the ID of a cache node to form
+// the connection secret key that holds the endpoint of that node.
+const CacheNodeConnectionKeyPrefix = "node-"
+
+// NewCreateCacheClusterInput returns ElastiCache cache cluster creation input
+// suitable for use with the AWS API.
+func NewCreateCacheClusterInput(p cachev1alpha1.CacheClusterParameters, id string) *elasticache.CreateCacheClusterInput {
+	c := &elasticache.CreateCacheClusterInput{
+		CacheClusterId:             aws.String(id),
+		AZMode:                     elasticache.AZMode(clients.StringValue(p.AZMode)),
+		CacheNodeType:              aws.String(p.CacheNodeType),
+		CacheParameterGroupName:    p.CacheParameterGroupName,
+		CacheSecurityGroupNames:    p.CacheSecurityGroupNames,
+		CacheSubnetGroupName:       p.CacheSubnetGroupName,
+		Engine:                     aws.String(p.Engine),
+		EngineVersion:              p.EngineVersion,
+		NotificationTopicArn:       p.NotificationTopicARN,
+		NumCacheNodes:              aws.Int64(int64(p.NumCacheNodes)),
+		Port:                       clients.Int64Address(p.Port),
+		PreferredAvailabilityZone:  p.PreferredAvailabilityZone,
+		PreferredAvailabilityZones: p.PreferredAvailabilityZones,
+		PreferredMaintenanceWindow: p.PreferredMaintenanceWindow,
+		SecurityGroupIds:           p.SecurityGroupIDs,
+	}
+	if len(p.Tags) != 0 {
+		c.Tags = make([]elasticache.Tag, len(p.Tags))
+		for i, tag := range p.Tags {
+			c.Tags[i] = elasticache.Tag{
+				Key:   aws.String(tag.Key),
+				Value: aws.String(tag.Value),
+			}
+		}
+	}
+	return c
+}
+
+// NewModifyCacheClusterInput returns ElastiCache cache cluster modification
+// input suitable for use with the AWS API. The node type, engine version and
+// number of nodes are only included if they differ from the observed cluster,
+// including its pending modifications, and the AZ mode only applies to the
+// nodes that are added. Removing nodes requires their IDs so the nodes with
+// the highest IDs of the observed cluster are chosen when the desired number
+// of nodes is lower than the current one.
+func NewModifyCacheClusterInput(p cachev1alpha1.CacheClusterParameters, id string, cc elasticache.CacheCluster) *elasticache.ModifyCacheClusterInput {
+	m := &elasticache.ModifyCacheClusterInput{
+		CacheClusterId:             aws.String(id),
+		ApplyImmediately:           aws.Bool(p.ApplyModificationsImmediately),
+		CacheParameterGroupName:    p.CacheParameterGroupName,
+		CacheSecurityGroupNames:    p.CacheSecurityGroupNames,
+		NotificationTopicArn:       p.NotificationTopicARN,
+		PreferredMaintenanceWindow: p.PreferredMaintenanceWindow,
+		SecurityGroupIds:           p.SecurityGroupIDs,
+	}
+	numCacheNodes, nodeType, engineVersion := desiredCacheClusterValues(cc)
+	if p.CacheNodeType != aws.StringValue(nodeType) {
+		m.CacheNodeType = aws.String(p.CacheNodeType)
+	}
+	if p.EngineVersion != nil && *p.EngineVersion != aws.StringValue(engineVersion) {
+		m.EngineVersion = p.EngineVersion
+	}
+	if int64(p.NumCacheNodes) == aws.Int64Value(numCacheNodes) {
+		return m
+	}
+	m.NumCacheNodes = aws.Int64(int64(p.NumCacheNodes))
+	n := int(aws.Int64Value(cc.NumCacheNodes)) - p.NumCacheNodes
+	if n < 0 {
+		m.AZMode = elasticache.AZMode(clients.StringValue(p.AZMode))
+	}
+	if n > 0 {
+		ids := make([]string, 0, len(cc.CacheNodes))
+		for _, node := range cc.CacheNodes {
+			ids = append(ids, aws.StringValue(node.CacheNodeId))
+		}
+		sort.Sort(sort.Reverse(sort.StringSlice(ids)))
+		if n > len(ids) {
+			n = len(ids)
+		}
+		m.CacheNodeIdsToRemove = ids[:n]
+	}
+	return m
+}
+
+// NewDeleteCacheClusterInput returns ElastiCache cache cluster deletion input
+// suitable for use with the AWS API.
+func NewDeleteCacheClusterInput(id string) *elasticache.DeleteCacheClusterInput {
+	return &elasticache.DeleteCacheClusterInput{CacheClusterId: aws.String(id)}
+}
+
+// LateInitializeCacheCluster fills the empty fields in CacheClusterParameters
+// with the values seen in elasticache.CacheCluster.
+func LateInitializeCacheCluster(p *cachev1alpha1.CacheClusterParameters, cc elasticache.CacheCluster) {
+	if p == nil {
+		return
+	}
+	p.CacheSubnetGroupName = clients.LateInitializeStringPtr(p.CacheSubnetGroupName, cc.CacheSubnetGroupName)
+	p.EngineVersion = clients.LateInitializeStringPtr(p.EngineVersion, cc.EngineVersion)
+	p.PreferredMaintenanceWindow = clients.LateInitializeStringPtr(p.PreferredMaintenanceWindow, cc.PreferredMaintenanceWindow)
+	if cc.CacheParameterGroup != nil {
+		p.CacheParameterGroupName = clients.LateInitializeStringPtr(p.CacheParameterGroupName, cc.CacheParameterGroup.CacheParameterGroupName)
+	}
+	if cc.NotificationConfiguration != nil {
+		p.NotificationTopicARN = clients.LateInitializeStringPtr(p.NotificationTopicARN, cc.NotificationConfiguration.TopicArn)
+	}
+	if len(p.SecurityGroupIDs) == 0 && len(cc.SecurityGroups) != 0 {
+		p.SecurityGroupIDs = make([]string, len(cc.SecurityGroups))
+		for i, val := range cc.SecurityGroups {
+			p.SecurityGroupIDs[i] = aws.StringValue(val.SecurityGroupId)
+		}
+	}
+	if len(p.CacheSecurityGroupNames) == 0 && len(cc.CacheSecurityGroups) != 0 {
+		p.CacheSecurityGroupNames = make([]string, len(cc.CacheSecurityGroups))
+		for i, val := range cc.CacheSecurityGroups {
+			p.CacheSecurityGroupNames[i] = aws.StringValue(val.CacheSecurityGroupName)
+		}
+	}
+}
+
+// IsCacheClusterUpToDate checks whether the observed cache cluster matches the
+// desired state. Modifications that are pending are considered to be applied
+// already so that they are not requested repeatedly.
+func IsCacheClusterUpToDate(p cachev1alpha1.CacheClusterParameters, cc elasticache.CacheCluster) bool { // nolint:gocyclo
+	numCacheNodes, nodeType, engineVersion := desiredCacheClusterValues(cc)
+	switch {
+	case int64(p.NumCacheNodes) != aws.Int64Value(numCacheNodes),
+		p.CacheNodeType != aws.StringValue(nodeType),
+		p.EngineVersion != nil && *p.EngineVersion != aws.StringValue(engineVersion),
+		p.PreferredMaintenanceWindow != nil && *p.PreferredMaintenanceWindow != aws.StringValue(cc.PreferredMaintenanceWindow):
+		return false
+	}
+	if pg := cc.CacheParameterGroup; p.CacheParameterGroupName != nil && pg != nil && *p.CacheParameterGroupName != aws.StringValue(pg.CacheParameterGroupName) {
+		return false
+	}
+	if nc := cc.NotificationConfiguration; p.NotificationTopicARN != nil && (nc == nil || *p.NotificationTopicARN != aws.StringValue(nc.TopicArn)) {
+		return false
+	}
+	return !sgIDsNeedUpdate(p.SecurityGroupIDs, cc.SecurityGroups) && !sgNamesNeedUpdate(p.CacheSecurityGroupNames, cc.CacheSecurityGroups)
+}
+
+// desiredCacheClusterValues returns the number of nodes, node type and engine
+// version the cache cluster has once its pending modifications are applied.
+func desiredCacheClusterValues(cc elasticache.CacheCluster) (numCacheNodes *int64, nodeType, engineVersion *string) {
+	numCacheNodes, nodeType, engineVersion = cc.NumCacheNodes, cc.CacheNodeType, cc.EngineVersion
+	if pm := cc.PendingModifiedValues; pm != nil {
+		if pm.NumCacheNodes != nil {
+			numCacheNodes = pm.NumCacheNodes
+		}
+		if pm.CacheNodeType != nil {
+			nodeType = pm.CacheNodeType
+		}
+		if pm.EngineVersion != nil {
+			engineVersion = pm.EngineVersion
+		}
+	}
+	return numCacheNodes, nodeType, engineVersion
+}
+
+// GenerateCacheClusterObservation produces a CacheClusterObservation object
+// out of received elasticache.CacheCluster object.
+func GenerateCacheClusterObservation(cc elasticache.CacheCluster) cachev1alpha1.CacheClusterObservation {
+	o := cachev1alpha1.CacheClusterObservation{
+		ARN:                   aws.StringValue(cc.ARN),
+		CacheClusterStatus:    aws.StringValue(cc.CacheClusterStatus),
+		ConfigurationEndpoint: newCacheClusterEndpoint(cc.ConfigurationEndpoint),
+		NumCacheNodes:         int(aws.Int64Value(cc.NumCacheNodes)),
+	}
+	if len(cc.CacheNodes) != 0 {
+		o.CacheNodes = make([]cachev1alpha1.CacheNode, len(cc.CacheNodes))
+		for i, n := range cc.CacheNodes {
+			o.CacheNodes[i] = cachev1alpha1.CacheNode{
+				CacheNodeID:              aws.StringValue(n.CacheNodeId),
+				CacheNodeStatus:          aws.StringValue(n.CacheNodeStatus),
+				CustomerAvailabilityZone: aws.StringValue(n.CustomerAvailabilityZone),
+				Endpoint:                 newCacheClusterEndpoint(n.Endpoint),
+				ParameterGroupStatus:     aws.StringValue(n.ParameterGroupStatus),
+			}
+		}
+	}
+	if pm := cc.PendingModifiedValues; pm != nil {
+		o.PendingModifiedValues = cachev1alpha1.CacheClusterPendingModifiedValues{
+			CacheNodeIDsToRemove: pm.CacheNodeIdsToRemove,
+			CacheNodeType:        aws.StringValue(pm.CacheNodeType),
+			EngineVersion:        aws.StringValue(pm.EngineVersion),
+			NumCacheNodes:        int(aws.Int64Value(pm.NumCacheNodes)),
+		}
+	}
+	return o
+}
+
+// CacheClusterConnectionEndpoints returns the connection details of a cache
+// cluster. The configuration endpoint of a Memcached cluster, or the endpoint
+// of the first node if there is none, is published as the endpoint. Every
+// node's endpoint is also published in host:port form under a key made of
+// CacheNodeConnectionKeyPrefix and the node's ID.
+func CacheClusterConnectionEndpoints(cc elasticache.CacheCluster) managed.ConnectionDetails {
+	e := cc.ConfigurationEndpoint
+	if e == nil && len(cc.CacheNodes) > 0 {
+		e = cc.CacheNodes[0].Endpoint
+	}
+	if e == nil || e.Address == nil {
+		return nil
+	}
+	conn := managed.ConnectionDetails{
+		v1alpha1.ResourceCredentialsSecretEndpointKey: []byte(aws.StringValue(e.Address)),
+		v1alpha1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(int(aws.Int64Value(e.Port)))),
+	}
+	for _, n := range cc.CacheNodes {
+		if n.Endpoint == nil || n.Endpoint.Address == nil {
+			continue
+		}
+		hp := net.JoinHostPort(aws.StringValue(n.Endpoint.Address), strconv.Itoa(int(aws.Int64Value(n.Endpoint.Port))))
+		conn[CacheNodeConnectionKeyPrefix+aws.StringValue(n.CacheNodeId)] = []byte(hp)
+	}
+	return conn
+}
+
+// IsCacheClusterNotFound returns true if the supplied error indicates a Cache
+// Cluster was not found.
+func IsCacheClusterNotFound(err error) bool {
+	return isErrorCodeEqual(elasticache.ErrCodeCacheClusterNotFoundFault, err)
+}
+
+// IsCacheClusterAlreadyExists returns true if the supplied error indicates a
+// Cache Cluster already exists.
+func IsCacheClusterAlreadyExists(err error) bool {
+	return isErrorCodeEqual(elasticache.ErrCodeCacheClusterAlreadyExistsFault, err)
+}
+
+func newCacheClusterEndpoint(e *elasticache.Endpoint) cachev1alpha1.Endpoint {
+	if e == nil {
+		return cachev1alpha1.Endpoint{}
+	}
+	return cachev1alpha1.Endpoint{Address: aws.StringValue(e.Address), Port: int(aws.Int64Value(e.Port))}
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticache
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func cacheNodes(ids ...string) []elasticache.CacheNode {
+	nodes := make([]elasticache.CacheNode, len(ids))
+	for i, id := range ids {
+		nodes[i] = elasticache.CacheNode{
+			CacheNodeId: aws.String(id),
+			Endpoint:    &elasticache.Endpoint{Address: aws.String(id + "." + host), Port: aws.Int64(port)},
+		}
+	}
+	return nodes
+}
+
+func TestNewModifyCacheClusterInput(t *testing.T) {
+	p := cachev1alpha1.CacheClusterParameters{
+		ApplyModificationsImmediately: true,
+		AZMode:                        aws.String(string(elasticache.AZModeCrossAz)),
+		CacheNodeType:                 cacheNodeType,
+		EngineVersion:                 &engineVersion,
+	}
+	cases := map[string]struct {
+		numCacheNodes int
+		cc            elasticache.CacheCluster
+		want          *elasticache.ModifyCacheClusterInput
+	}{
+		"ScaleOut": {
+			numCacheNodes: 3,
+			cc: elasticache.CacheCluster{
+				NumCacheNodes: aws.Int64(1),
+				CacheNodeType: &cacheNodeType,
+				EngineVersion: &engineVersion,
+				CacheNodes:    cacheNodes("0001"),
+			},
+			want: &elasticache.ModifyCacheClusterInput{
+				CacheClusterId:   aws.String(name),
+				ApplyImmediately: aws.Bool(true),
+				AZMode:           elasticache.AZModeCrossAz,
+				NumCacheNodes:    aws.Int64(3),
+			},
+		},
+		"ScaleIn": {
+			numCacheNodes: 1,
+			cc: elasticache.CacheCluster{
+				NumCacheNodes: aws.Int64(3),
+				CacheNodeType: &cacheNodeType,
+				EngineVersion: &engineVersion,
+				CacheNodes:    cacheNodes("0002", "0001", "0003"),
+			},
+			want: &elasticache.ModifyCacheClusterInput{
+				CacheClusterId:       aws.String(name),
+				ApplyImmediately:     aws.Bool(true),
+				NumCacheNodes:        aws.Int64(1),
+				CacheNodeIdsToRemove: []string{"0003", "0002"},
+			},
+		},
+		"ChangedFieldsOnly": {
+			numCacheNodes: 1,
+			cc: elasticache.CacheCluster{
+				NumCacheNodes: aws.Int64(1),
+				CacheNodeType: aws.String("cache.t2.micro"),
+				EngineVersion: aws.String("1.4.0"),
+				CacheNodes:    cacheNodes("0001"),
+			},
+			want: &elasticache.ModifyCacheClusterInput{
+				CacheClusterId:   aws.String(name),
+				ApplyImmediately: aws.Bool(true),
+				CacheNodeType:    &cacheNodeType,
+				EngineVersion:    &engineVersion,
+			},
+		},
+		"PendingModifications": {
+			numCacheNodes: 3,
+			cc: elasticache.CacheCluster{
+				NumCacheNodes: aws.Int64(1),
+				CacheNodeType: aws.String("cache.t2.micro"),
+				EngineVersion: &engineVersion,
+				CacheNodes:    cacheNodes("0001"),
+				PendingModifiedValues: &elasticache.PendingModifiedValues{
+					NumCacheNodes: aws.Int64(3),
+					CacheNodeType: &cacheNodeType,
+				},
+			},
+			want: &elasticache.ModifyCacheClusterInput{
+				CacheClusterId:   aws.String(name),
+				ApplyImmediately: aws.Bool(true),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			in := p
+			in.NumCacheNodes = tc.numCacheNodes
+			got := NewModifyCacheClusterInput(in, name, tc.cc)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewModifyCacheClusterInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsCacheClusterUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    cachev1alpha1.CacheClusterParameters
+		cc   elasticache.CacheCluster
+		want bool
+	}{
+		"UpToDate": {
+			p: cachev1alpha1.CacheClusterParameters{
+				CacheNodeType:              cacheNodeType,
+				EngineVersion:              &engineVersion,
+				NumCacheNodes:              2,
+				PreferredMaintenanceWindow: &maintenanceWindow,
+				SecurityGroupIDs:           securityGroupIDs,
+			},
+			cc: elasticache.CacheCluster{
+				CacheNodeType:              &cacheNodeType,
+				EngineVersion:              &engineVersion,
+				NumCacheNodes:              aws.Int64(2),
+				PreferredMaintenanceWindow: &maintenanceWindow,
+				SecurityGroups: []elasticache.SecurityGroupMembership{
+					{SecurityGroupId: &securityGroupIDs[1]},
+					{SecurityGroupId: &securityGroupIDs[0]},
+				},
+			},
+			want: true,
+		},
+		"NumCacheNodesChanged": {
+			p:  cachev1alpha1.CacheClusterParameters{CacheNodeType: cacheNodeType, NumCacheNodes: 3},
+			cc: elasticache.CacheCluster{CacheNodeType: &cacheNodeType, NumCacheNodes: aws.Int64(2)},
+		},
+		"NumCacheNodesPending": {
+			p: cachev1alpha1.CacheClusterParameters{CacheNodeType: cacheNodeType, NumCacheNodes: 3},
+			cc: elasticache.CacheCluster{
+				CacheNodeType:         &cacheNodeType,
+				NumCacheNodes:         aws.Int64(2),
+				PendingModifiedValues: &elasticache.PendingModifiedValues{NumCacheNodes: aws.Int64(3)},
+			},
+			want: true,
+		},
+		"NotificationTopicRemoved": {
+			p:  cachev1alpha1.CacheClusterParameters{CacheNodeType: cacheNodeType, NumCacheNodes: 1, NotificationTopicARN: &notificationTopicARN},
+			cc: elasticache.CacheCluster{CacheNodeType: &cacheNodeType, NumCacheNodes: aws.Int64(1)},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := IsCacheClusterUpToDate(tc.p, tc.cc)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsCacheClusterUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCacheClusterConnectionEndpoints(t *testing.T) {
+	cases := map[string]struct {
+		cc   elasticache.CacheCluster
+		want managed.ConnectionDetails
+	}{
+		"Memcached": {
+			cc: elasticache.CacheCluster{
+				ConfigurationEndpoint: &elasticache.Endpoint{Address: aws.String(host), Port: aws.Int64(port)},
+				CacheNodes:            cacheNodes("0001", "0002"),
+			},
+			want: managed.ConnectionDetails{
+				v1alpha1.ResourceCredentialsSecretEndpointKey: []byte(host),
+				v1alpha1.ResourceCredentialsSecretPortKey:     []byte("6379"),
+				CacheNodeConnectionKeyPrefix + "0001":         []byte("0001." + host + ":6379"),
+				CacheNodeConnectionKeyPrefix + "0002":         []byte("0002." + host + ":6379"),
+			},
+		},
+		"NoConfigurationEndpoint": {
+			cc: elasticache.CacheCluster{CacheNodes: cacheNodes("0001")},
+			want: managed.ConnectionDetails{
+				v1alpha1.ResourceCredentialsSecretEndpointKey: []byte("0001." + host),
+				v1alpha1.ResourceCredentialsSecretPortKey:     []byte("6379"),
+				CacheNodeConnectionKeyPrefix + "0001":         []byte("0001." + host + ":6379"),
+			},
+		},
+		"NoEndpoints": {
+			cc: elasticache.CacheCluster{},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := CacheClusterConnectionEndpoints(tc.cc)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("CacheClusterConnectionEndpoints(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
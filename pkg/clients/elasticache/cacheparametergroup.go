@@ -0,0 +1,121 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticache
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+
+	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+)
+
+const (
+	// CacheParameterSourceUser is the source of the parameters that are
+	// modified from their engine default.
+	CacheParameterSourceUser = "user"
+
+	// maxCacheParametersPerRequest is the maximum number of parameters that
+	// can be modified or reset in a single request.
+	maxCacheParametersPerRequest = 20
+)
+
+// GenerateCacheParameterObservations produces the status of the given
+// parameters that were modified from their engine default.
+func GenerateCacheParameterObservations(params []elasticache.Parameter) []cachev1alpha1.CacheParameterObservation {
+	if len(params) == 0 {
+		return nil
+	}
+	res := make([]cachev1alpha1.CacheParameterObservation, len(params))
+	for i, p := range params {
+		res[i] = cachev1alpha1.CacheParameterObservation{
+			ParameterName:  aws.StringValue(p.ParameterName),
+			ParameterValue: aws.StringValue(p.ParameterValue),
+			ChangeType:     string(p.ChangeType),
+			RequiresReboot: p.ChangeType == elasticache.ChangeTypeRequiresReboot,
+		}
+	}
+	return res
+}
+
+// GetOutdatedCacheParameters returns the desired parameters whose value
+// differs from the observed one.
+func GetOutdatedCacheParameters(desired []cachev1alpha1.CacheParameter, observed []elasticache.Parameter) []elasticache.ParameterNameValue {
+	current := make(map[string]string, len(observed))
+	for _, p := range observed {
+		current[aws.StringValue(p.ParameterName)] = aws.StringValue(p.ParameterValue)
+	}
+	var res []elasticache.ParameterNameValue
+	for _, p := range desired {
+		if v, ok := current[p.ParameterName]; ok && v == p.ParameterValue {
+			continue
+		}
+		res = append(res, elasticache.ParameterNameValue{
+			ParameterName:  aws.String(p.ParameterName),
+			ParameterValue: aws.String(p.ParameterValue),
+		})
+	}
+	return res
+}
+
+// GetCacheParametersToReset returns the observed parameters that are not
+// desired anymore.
+func GetCacheParametersToReset(desired []cachev1alpha1.CacheParameter, observed []elasticache.Parameter) []elasticache.ParameterNameValue {
+	names := make(map[string]struct{}, len(desired))
+	for _, p := range desired {
+		names[p.ParameterName] = struct{}{}
+	}
+	var res []elasticache.ParameterNameValue
+	for _, p := range observed {
+		if _, ok := names[aws.StringValue(p.ParameterName)]; ok {
+			continue
+		}
+		res = append(res, elasticache.ParameterNameValue{ParameterName: p.ParameterName})
+	}
+	return res
+}
+
+// IsCacheParameterGroupUpToDate checks whether the observed parameters match
+// the desired ones.
+func IsCacheParameterGroupUpToDate(desired []cachev1alpha1.CacheParameter, observed []elasticache.Parameter) bool {
+	return len(GetOutdatedCacheParameters(desired, observed)) == 0 && len(GetCacheParametersToReset(desired, observed)) == 0
+}
+
+// ChunkCacheParameters splits the given parameters into chunks that can be
+// sent in a single modify or reset request.
+func ChunkCacheParameters(params []elasticache.ParameterNameValue) [][]elasticache.ParameterNameValue {
+	var res [][]elasticache.ParameterNameValue
+	for len(params) > maxCacheParametersPerRequest {
+		res = append(res, params[:maxCacheParametersPerRequest])
+		params = params[maxCacheParametersPerRequest:]
+	}
+	if len(params) != 0 {
+		res = append(res, params)
+	}
+	return res
+}
+
+// IsCacheParameterGroupNotFound returns true if the supplied error indicates
+// a Cache Parameter Group was not found.
+func IsCacheParameterGroupNotFound(err error) bool {
+	return isErrorCodeEqual(elasticache.ErrCodeCacheParameterGroupNotFoundFault, err)
+}
+
+// IsCacheParameterGroupAlreadyExists returns true if the supplied error
+// indicates a Cache Parameter Group already exists.
+func IsCacheParameterGroupAlreadyExists(err error) bool {
+	return isErrorCodeEqual(elasticache.ErrCodeCacheParameterGroupAlreadyExistsFault, err)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticache
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+
+	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestGetOutdatedCacheParameters(t *testing.T) {
+	cases := map[string]struct {
+		desired  []cachev1alpha1.CacheParameter
+		observed []elasticache.Parameter
+		want     []elasticache.ParameterNameValue
+	}{
+		"UpToDate": {
+			desired:  []cachev1alpha1.CacheParameter{{ParameterName: "timeout", ParameterValue: "300"}},
+			observed: []elasticache.Parameter{{ParameterName: aws.String("timeout"), ParameterValue: aws.String("300")}},
+		},
+		"DifferentValue": {
+			desired:  []cachev1alpha1.CacheParameter{{ParameterName: "timeout", ParameterValue: "600"}},
+			observed: []elasticache.Parameter{{ParameterName: aws.String("timeout"), ParameterValue: aws.String("300")}},
+			want:     []elasticache.ParameterNameValue{{ParameterName: aws.String("timeout"), ParameterValue: aws.String("600")}},
+		},
+		"NotModifiedYet": {
+			desired: []cachev1alpha1.CacheParameter{{ParameterName: "timeout", ParameterValue: "600"}},
+			want:    []elasticache.ParameterNameValue{{ParameterName: aws.String("timeout"), ParameterValue: aws.String("600")}},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GetOutdatedCacheParameters(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetOutdatedCacheParameters(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetCacheParametersToReset(t *testing.T) {
+	cases := map[string]struct {
+		desired  []cachev1alpha1.CacheParameter
+		observed []elasticache.Parameter
+		want     []elasticache.ParameterNameValue
+	}{
+		"StillDesired": {
+			desired:  []cachev1alpha1.CacheParameter{{ParameterName: "timeout", ParameterValue: "600"}},
+			observed: []elasticache.Parameter{{ParameterName: aws.String("timeout"), ParameterValue: aws.String("300")}},
+		},
+		"Removed": {
+			observed: []elasticache.Parameter{{ParameterName: aws.String("timeout"), ParameterValue: aws.String("300")}},
+			want:     []elasticache.ParameterNameValue{{ParameterName: aws.String("timeout")}},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GetCacheParametersToReset(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetCacheParametersToReset(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCacheParameterObservations(t *testing.T) {
+	params := []elasticache.Parameter{
+		{ParameterName: aws.String("timeout"), ParameterValue: aws.String("300"), ChangeType: elasticache.ChangeTypeImmediate},
+		{ParameterName: aws.String("databases"), ParameterValue: aws.String("32"), ChangeType: elasticache.ChangeTypeRequiresReboot},
+	}
+	want := []cachev1alpha1.CacheParameterObservation{
+		{ParameterName: "timeout", ParameterValue: "300", ChangeType: "immediate"},
+		{ParameterName: "databases", ParameterValue: "32", ChangeType: "requires-reboot", RequiresReboot: true},
+	}
+	if diff := cmp.Diff(want, GenerateCacheParameterObservations(params)); diff != "" {
+		t.Errorf("GenerateCacheParameterObservations(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestChunkCacheParameters(t *testing.T) {
+	params := make([]elasticache.ParameterNameValue, 45)
+	got := ChunkCacheParameters(params)
+	if diff := cmp.Diff([]int{20, 20, 5}, []int{len(got[0]), len(got[1]), len(got[2])}); diff != "" {
+		t.Errorf("ChunkCacheParameters(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticache
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	clients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// NewCreateSnapshotInput returns ElastiCache snapshot creation input suitable
+// for use with the AWS API.
+func NewCreateSnapshotInput(p cachev1alpha1.CacheSnapshotParameters, name string) *elasticache.CreateSnapshotInput {
+	return &elasticache.CreateSnapshotInput{
+		KmsKeyId:           p.KMSKeyID,
+		ReplicationGroupId: p.ReplicationGroupID,
+		SnapshotName:       aws.String(name),
+	}
+}
+
+// NewDescribeSnapshotsInput returns ElastiCache snapshot description input
+// suitable for use with the AWS API.
+func NewDescribeSnapshotsInput(name string) *elasticache.DescribeSnapshotsInput {
+	return &elasticache.DescribeSnapshotsInput{SnapshotName: aws.String(name)}
+}
+
+// NewCopySnapshotInput returns ElastiCache snapshot copy input that exports
+// the snapshot with the given name to the Amazon S3 bucket in the supplied
+// parameters.
+func NewCopySnapshotInput(p cachev1alpha1.CacheSnapshotParameters, name string) *elasticache.CopySnapshotInput {
+	c := &elasticache.CopySnapshotInput{
+		SourceSnapshotName: aws.String(name),
+		TargetSnapshotName: aws.String(name),
+	}
+	if p.Export != nil {
+		c.TargetBucket = aws.String(p.Export.TargetBucket)
+		if p.Export.TargetSnapshotName != nil {
+			c.TargetSnapshotName = p.Export.TargetSnapshotName
+		}
+	}
+	return c
+}
+
+// NewDeleteSnapshotInput returns ElastiCache snapshot deletion input suitable
+// for use with the AWS API.
+func NewDeleteSnapshotInput(name string) *elasticache.DeleteSnapshotInput {
+	return &elasticache.DeleteSnapshotInput{SnapshotName: aws.String(name)}
+}
+
+// SnapshotExportTarget returns the location in Amazon S3, in the form
+// bucket/name, the snapshot with the given name is exported to, or an empty
+// string if it is not exported.
+func SnapshotExportTarget(p cachev1alpha1.CacheSnapshotParameters, name string) string {
+	if p.Export == nil {
+		return ""
+	}
+	c := NewCopySnapshotInput(p, name)
+	return aws.StringValue(c.TargetBucket) + "/" + aws.StringValue(c.TargetSnapshotName)
+}
+
+// IsSnapshotUpToDate returns true if the snapshot with the given name has
+// been exported to the desired location, if any. All other parameters of a
+// snapshot are immutable.
+func IsSnapshotUpToDate(p cachev1alpha1.CacheSnapshotParameters, o cachev1alpha1.CacheSnapshotObservation, name string) bool {
+	t := SnapshotExportTarget(p, name)
+	return t == "" || t == o.ExportedTo
+}
+
+// GenerateSnapshotObservation produces a CacheSnapshotObservation object out
+// of received elasticache.Snapshot object.
+func GenerateSnapshotObservation(s elasticache.Snapshot) cachev1alpha1.CacheSnapshotObservation {
+	o := cachev1alpha1.CacheSnapshotObservation{
+		ARN:            clients.StringValue(s.ARN),
+		CacheNodeType:  clients.StringValue(s.CacheNodeType),
+		EngineVersion:  clients.StringValue(s.EngineVersion),
+		NumNodeGroups:  int(aws.Int64Value(s.NumNodeGroups)),
+		SnapshotSource: clients.StringValue(s.SnapshotSource),
+		SnapshotStatus: clients.StringValue(s.SnapshotStatus),
+	}
+	if len(s.NodeSnapshots) != 0 {
+		o.NodeSnapshots = make([]cachev1alpha1.NodeSnapshot, len(s.NodeSnapshots))
+		for i, ns := range s.NodeSnapshots {
+			o.NodeSnapshots[i] = cachev1alpha1.NodeSnapshot{
+				CacheClusterID: clients.StringValue(ns.CacheClusterId),
+				CacheNodeID:    clients.StringValue(ns.CacheNodeId),
+				CacheSize:      clients.StringValue(ns.CacheSize),
+				NodeGroupID:    clients.StringValue(ns.NodeGroupId),
+			}
+			if ns.SnapshotCreateTime != nil {
+				t := metav1.NewTime(*ns.SnapshotCreateTime)
+				o.NodeSnapshots[i].SnapshotCreateTime = &t
+			}
+		}
+	}
+	return o
+}
+
+// IsSnapshotNotFound returns true if the supplied error indicates a Snapshot
+// was not found.
+func IsSnapshotNotFound(err error) bool {
+	return isErrorCodeEqual(elasticache.ErrCodeSnapshotNotFoundFault, err)
+}
+
+// IsSnapshotAlreadyExists returns true if the supplied error indicates a
+// Snapshot already exists.
+func IsSnapshotAlreadyExists(err error) bool {
+	return isErrorCodeEqual(elasticache.ErrCodeSnapshotAlreadyExistsFault, err)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var bucket = "coolBucket"
+
+func TestNewCopySnapshotInput(t *testing.T) {
+	cases := map[string]struct {
+		p    cachev1alpha1.CacheSnapshotParameters
+		want *elasticache.CopySnapshotInput
+	}{
+		"DefaultTargetName": {
+			p: cachev1alpha1.CacheSnapshotParameters{Export: &cachev1alpha1.CacheSnapshotExport{TargetBucket: bucket}},
+			want: &elasticache.CopySnapshotInput{
+				SourceSnapshotName: aws.String(snapshotName),
+				TargetBucket:       aws.String(bucket),
+				TargetSnapshotName: aws.String(snapshotName),
+			},
+		},
+		"TargetName": {
+			p: cachev1alpha1.CacheSnapshotParameters{Export: &cachev1alpha1.CacheSnapshotExport{
+				TargetBucket:       bucket,
+				TargetSnapshotName: aws.String("exported"),
+			}},
+			want: &elasticache.CopySnapshotInput{
+				SourceSnapshotName: aws.String(snapshotName),
+				TargetBucket:       aws.String(bucket),
+				TargetSnapshotName: aws.String("exported"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewCopySnapshotInput(tc.p, snapshotName)
+
+			if err := got.Validate(); err != nil {
+				t.Errorf("NewCopySnapshotInput(...): invalid input: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewCopySnapshotInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSnapshotUpToDate(t *testing.T) {
+	export := &cachev1alpha1.CacheSnapshotExport{TargetBucket: bucket}
+	cases := map[string]struct {
+		p    cachev1alpha1.CacheSnapshotParameters
+		o    cachev1alpha1.CacheSnapshotObservation
+		want bool
+	}{
+		"NoExport": {
+			want: true,
+		},
+		"NotExported": {
+			p: cachev1alpha1.CacheSnapshotParameters{Export: export},
+		},
+		"ExportedElsewhere": {
+			p: cachev1alpha1.CacheSnapshotParameters{Export: export},
+			o: cachev1alpha1.CacheSnapshotObservation{ExportedTo: "otherBucket/" + snapshotName},
+		},
+		"Exported": {
+			p:    cachev1alpha1.CacheSnapshotParameters{Export: export},
+			o:    cachev1alpha1.CacheSnapshotObservation{ExportedTo: bucket + "/" + snapshotName},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSnapshotUpToDate(tc.p, tc.o, snapshotName)
+			if got != tc.want {
+				t.Errorf("IsSnapshotUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGenerateSnapshotObservation(t *testing.T) {
+	created := time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC)
+	createdTime := metav1.NewTime(created)
+	cases := map[string]struct {
+		s    elasticache.Snapshot
+		want cachev1alpha1.CacheSnapshotObservation
+	}{
+		"InProgress": {
+			s: elasticache.Snapshot{
+				ARN:            aws.String("arn"),
+				CacheNodeType:  aws.String(cacheNodeType),
+				EngineVersion:  aws.String(engineVersion),
+				NumNodeGroups:  aws.Int64(2),
+				SnapshotSource: aws.String("manual"),
+				SnapshotStatus: aws.String(cachev1alpha1.CacheSnapshotStatusCreating),
+				NodeSnapshots: []elasticache.NodeSnapshot{
+					{
+						CacheClusterId:     aws.String("cluster-0001-001"),
+						CacheNodeId:        aws.String("0001"),
+						CacheSize:          aws.String("6 MB"),
+						NodeGroupId:        aws.String("0001"),
+						SnapshotCreateTime: &created,
+					},
+					{
+						CacheClusterId: aws.String("cluster-0002-001"),
+						CacheNodeId:    aws.String("0001"),
+						NodeGroupId:    aws.String("0002"),
+					},
+				},
+			},
+			want: cachev1alpha1.CacheSnapshotObservation{
+				ARN:            "arn",
+				CacheNodeType:  cacheNodeType,
+				EngineVersion:  engineVersion,
+				NumNodeGroups:  2,
+				SnapshotSource: "manual",
+				SnapshotStatus: cachev1alpha1.CacheSnapshotStatusCreating,
+				NodeSnapshots: []cachev1alpha1.NodeSnapshot{
+					{
+						CacheClusterID:     "cluster-0001-001",
+						CacheNodeID:        "0001",
+						CacheSize:          "6 MB",
+						NodeGroupID:        "0001",
+						SnapshotCreateTime: &createdTime,
+					},
+					{
+						CacheClusterID: "cluster-0002-001",
+						CacheNodeID:    "0001",
+						NodeGroupID:    "0002",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateSnapshotObservation(tc.s)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateSnapshotObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,7 +19,9 @@ package elasticache
 import (
 	"context"
 	"reflect"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -27,12 +29,18 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache/elasticacheiface"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/cache/v1beta1"
 	clients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+// PendingAuthTokenKey is the connection secret key under which a generated
+// auth token is kept while it is being rotated, i.e. until it is set as the
+// only valid token of the replication group.
+const PendingAuthTokenKey = "pendingPassword"
+
 // A Client handles CRUD operations for ElastiCache resources. This interface is
 // compatible with the upstream AWS redis client.
 type Client elasticacheiface.ClientAPI
@@ -127,6 +135,32 @@ func NewModifyReplicationGroupInput(g v1beta1.ReplicationGroupParameters, id str
 	}
 }
 
+// NewUpdateAuthTokenInput returns ElastiCache replication group modification
+// input that updates the authentication token using the given strategy.
+func NewUpdateAuthTokenInput(id, token string, s elasticache.AuthTokenUpdateStrategyType) *elasticache.ModifyReplicationGroupInput {
+	return &elasticache.ModifyReplicationGroupInput{
+		ApplyImmediately:        aws.Bool(true),
+		AuthToken:               aws.String(token),
+		AuthTokenUpdateStrategy: s,
+		ReplicationGroupId:      aws.String(id),
+	}
+}
+
+// IsAuthTokenRotationDue returns true if the auto-generated authentication
+// token of the replication group is due to be rotated at the given time. The
+// time AWS last modified the token is used if it is known, otherwise the time
+// the token was last generated or rotated in by Crossplane.
+func IsAuthTokenRotationDue(p v1beta1.ReplicationGroupParameters, o v1beta1.ReplicationGroupObservation, now time.Time) bool {
+	last := o.AuthTokenLastModifiedDate
+	if last == nil {
+		last = o.AuthTokenRotateTime
+	}
+	if p.AuthTokenRotation == nil || !aws.BoolValue(p.AuthEnabled) || last == nil {
+		return false
+	}
+	return !now.Before(last.Add(p.AuthTokenRotation.Interval.Duration))
+}
+
 // NewDeleteReplicationGroupInput returns ElastiCache replication group deletion
 // input suitable for use with the AWS API.
 func NewDeleteReplicationGroupInput(id string) *elasticache.DeleteReplicationGroupInput {
@@ -201,6 +235,10 @@ func ReplicationGroupNeedsUpdate(kube v1beta1.ReplicationGroupParameters, rg ela
 	case !reflect.DeepEqual(kube.SnapshotWindow, rg.SnapshotWindow):
 		return true
 	}
+	o := GenerateObservation(rg)
+	if NodeGroupCountNeedsUpdate(kube, o) || ReplicaCountNeedsIncrease(kube, o) || ReplicaCountNeedsDecrease(kube, o) {
+		return true
+	}
 	for _, cc := range ccList {
 		if cacheClusterNeedsUpdate(kube, cc) {
 			return true
@@ -209,6 +247,88 @@ func ReplicationGroupNeedsUpdate(kube v1beta1.ReplicationGroupParameters, rg ela
 	return false
 }
 
+// NodeGroupCountNeedsUpdate returns true if the desired number of node groups
+// (shards) differs from the observed one.
+func NodeGroupCountNeedsUpdate(kube v1beta1.ReplicationGroupParameters, o v1beta1.ReplicationGroupObservation) bool {
+	// We cannot tell how many node groups there are until AWS reports them.
+	if kube.NumNodeGroups == nil || len(o.NodeGroups) == 0 {
+		return false
+	}
+	return *kube.NumNodeGroups != len(o.NodeGroups)
+}
+
+// ReplicaCountNeedsIncrease returns true if any of the observed node groups has
+// fewer replicas than desired.
+func ReplicaCountNeedsIncrease(kube v1beta1.ReplicationGroupParameters, o v1beta1.ReplicationGroupObservation) bool {
+	if kube.ReplicasPerNodeGroup == nil {
+		return false
+	}
+	for _, ng := range o.NodeGroups {
+		// One of the members of a node group is its primary.
+		if len(ng.NodeGroupMembers) != 0 && len(ng.NodeGroupMembers)-1 < *kube.ReplicasPerNodeGroup {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplicaCountNeedsDecrease returns true if any of the observed node groups has
+// more replicas than desired.
+func ReplicaCountNeedsDecrease(kube v1beta1.ReplicationGroupParameters, o v1beta1.ReplicationGroupObservation) bool {
+	if kube.ReplicasPerNodeGroup == nil {
+		return false
+	}
+	for _, ng := range o.NodeGroups {
+		if len(ng.NodeGroupMembers)-1 > *kube.ReplicasPerNodeGroup {
+			return true
+		}
+	}
+	return false
+}
+
+// NewModifyReplicationGroupShardConfigurationInput returns ElastiCache
+// replication group shard configuration modification input suitable for use
+// with the AWS API. When scaling in, the node groups with the highest IDs are
+// removed.
+func NewModifyReplicationGroupShardConfigurationInput(g v1beta1.ReplicationGroupParameters, id string, o v1beta1.ReplicationGroupObservation) *elasticache.ModifyReplicationGroupShardConfigurationInput {
+	c := &elasticache.ModifyReplicationGroupShardConfigurationInput{
+		// Online resharding can only be applied immediately.
+		ApplyImmediately:   aws.Bool(true),
+		NodeGroupCount:     clients.Int64Address(g.NumNodeGroups),
+		ReplicationGroupId: aws.String(id),
+	}
+	if g.NumNodeGroups == nil || *g.NumNodeGroups >= len(o.NodeGroups) {
+		return c
+	}
+	ids := make([]string, len(o.NodeGroups))
+	for i, ng := range o.NodeGroups {
+		ids[i] = ng.NodeGroupID
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(ids)))
+	c.NodeGroupsToRemove = ids[:len(ids)-*g.NumNodeGroups]
+	return c
+}
+
+// NewIncreaseReplicaCountInput returns ElastiCache replica count increase
+// input suitable for use with the AWS API.
+func NewIncreaseReplicaCountInput(g v1beta1.ReplicationGroupParameters, id string) *elasticache.IncreaseReplicaCountInput {
+	return &elasticache.IncreaseReplicaCountInput{
+		ApplyImmediately:   aws.Bool(true),
+		NewReplicaCount:    clients.Int64Address(g.ReplicasPerNodeGroup),
+		ReplicationGroupId: aws.String(id),
+	}
+}
+
+// NewDecreaseReplicaCountInput returns ElastiCache replica count decrease
+// input suitable for use with the AWS API.
+func NewDecreaseReplicaCountInput(g v1beta1.ReplicationGroupParameters, id string) *elasticache.DecreaseReplicaCountInput {
+	return &elasticache.DecreaseReplicaCountInput{
+		ApplyImmediately:   aws.Bool(true),
+		NewReplicaCount:    clients.Int64Address(g.ReplicasPerNodeGroup),
+		ReplicationGroupId: aws.String(id),
+	}
+}
+
 func automaticFailoverEnabled(af elasticache.AutomaticFailoverStatus) *bool {
 	if af == "" {
 		return nil
@@ -283,6 +403,10 @@ func GenerateObservation(rg elasticache.ReplicationGroup) v1beta1.ReplicationGro
 		MemberClusters:        rg.MemberClusters,
 		Status:                clients.StringValue(rg.Status),
 	}
+	if rg.AuthTokenLastModifiedDate != nil {
+		t := metav1.NewTime(*rg.AuthTokenLastModifiedDate)
+		o.AuthTokenLastModifiedDate = &t
+	}
 	if len(rg.NodeGroups) != 0 {
 		o.NodeGroups = make([]v1beta1.NodeGroup, len(rg.NodeGroups))
 		for i, ng := range rg.NodeGroups {
@@ -323,6 +447,7 @@ func generateNodeGroup(ng elasticache.NodeGroup) v1beta1.NodeGroup {
 
 func generateReplicationGroupPendingModifiedValues(in elasticache.ReplicationGroupPendingModifiedValues) v1beta1.ReplicationGroupPendingModifiedValues {
 	r := v1beta1.ReplicationGroupPendingModifiedValues{
+		AuthTokenStatus:         string(in.AuthTokenStatus),
 		AutomaticFailoverStatus: string(in.AutomaticFailoverStatus),
 		PrimaryClusterID:        clients.StringValue(in.PrimaryClusterId),
 	}
@@ -19,6 +19,7 @@ package elasticache
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -579,6 +580,62 @@ func TestGenerateObservation(t *testing.T) {
 	}
 }
 
+func TestIsAuthTokenRotationDue(t *testing.T) {
+	now := time.Now()
+	modified := metav1.NewTime(now.Add(-2 * time.Hour))
+	rotation := &v1beta1.AuthTokenRotationPolicy{Interval: metav1.Duration{Duration: time.Hour}}
+	cases := []struct {
+		name string
+		p    v1beta1.ReplicationGroupParameters
+		o    v1beta1.ReplicationGroupObservation
+		want bool
+	}{
+		{
+			name: "NoRotationPolicy",
+			p:    v1beta1.ReplicationGroupParameters{AuthEnabled: &authEnabled},
+			o:    v1beta1.ReplicationGroupObservation{AuthTokenLastModifiedDate: &modified},
+		},
+		{
+			name: "AuthNotEnabled",
+			p:    v1beta1.ReplicationGroupParameters{AuthTokenRotation: rotation},
+			o:    v1beta1.ReplicationGroupObservation{AuthTokenLastModifiedDate: &modified},
+		},
+		{
+			name: "NeverModified",
+			p:    v1beta1.ReplicationGroupParameters{AuthEnabled: &authEnabled, AuthTokenRotation: rotation},
+		},
+		{
+			name: "NotDueYet",
+			p: v1beta1.ReplicationGroupParameters{
+				AuthEnabled:       &authEnabled,
+				AuthTokenRotation: &v1beta1.AuthTokenRotationPolicy{Interval: metav1.Duration{Duration: 3 * time.Hour}},
+			},
+			o: v1beta1.ReplicationGroupObservation{AuthTokenLastModifiedDate: &modified},
+		},
+		{
+			name: "Due",
+			p:    v1beta1.ReplicationGroupParameters{AuthEnabled: &authEnabled, AuthTokenRotation: rotation},
+			o:    v1beta1.ReplicationGroupObservation{AuthTokenLastModifiedDate: &modified},
+			want: true,
+		},
+		{
+			name: "DueSinceRotated",
+			p:    v1beta1.ReplicationGroupParameters{AuthEnabled: &authEnabled, AuthTokenRotation: rotation},
+			o:    v1beta1.ReplicationGroupObservation{AuthTokenRotateTime: &modified},
+			want: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := IsAuthTokenRotationDue(tc.p, tc.o, now)
+			if got != tc.want {
+				t.Errorf("IsAuthTokenRotationDue(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
 func TestReplicationGroupNeedsUpdate(t *testing.T) {
 	cases := []struct {
 		name   string
@@ -623,6 +680,33 @@ func TestReplicationGroupNeedsUpdate(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "NeedsNewNodeGroupCount",
+			kube: replicationGroup.Spec.ForProvider,
+			rg: elasticache.ReplicationGroup{
+				AutomaticFailover:      elasticache.AutomaticFailoverStatusEnabling,
+				CacheNodeType:          aws.String(cacheNodeType),
+				SnapshotRetentionLimit: aws.Int64(snapshotRetentionLimit),
+				SnapshotWindow:         aws.String(snapshotWindow),
+				NodeGroups:             []elasticache.NodeGroup{newNodeGroup("0001", replicasPerNodeGroup)},
+			},
+			want: true,
+		},
+		{
+			name: "NeedsNewReplicaCount",
+			kube: replicationGroup.Spec.ForProvider,
+			rg: elasticache.ReplicationGroup{
+				AutomaticFailover:      elasticache.AutomaticFailoverStatusEnabling,
+				CacheNodeType:          aws.String(cacheNodeType),
+				SnapshotRetentionLimit: aws.Int64(snapshotRetentionLimit),
+				SnapshotWindow:         aws.String(snapshotWindow),
+				NodeGroups: []elasticache.NodeGroup{
+					newNodeGroup("0001", replicasPerNodeGroup),
+					newNodeGroup("0002", replicasPerNodeGroup-1),
+				},
+			},
+			want: true,
+		},
 		{
 			name: "CacheClusterNeedsUpdate",
 			kube: replicationGroup.Spec.ForProvider,
@@ -684,6 +768,129 @@ func TestReplicationGroupNeedsUpdate(t *testing.T) {
 	}
 }
 
+func newNodeGroup(id string, replicas int) elasticache.NodeGroup {
+	ng := elasticache.NodeGroup{NodeGroupId: aws.String(id)}
+	for i := 0; i <= replicas; i++ {
+		ng.NodeGroupMembers = append(ng.NodeGroupMembers, elasticache.NodeGroupMember{
+			CacheClusterId: aws.String(id + "-" + strconv.Itoa(i)),
+		})
+	}
+	return ng
+}
+
+func TestReplicaCountNeedsUpdate(t *testing.T) {
+	type want struct {
+		increase bool
+		decrease bool
+	}
+	cases := []struct {
+		name string
+		kube v1beta1.ReplicationGroupParameters
+		rg   elasticache.ReplicationGroup
+		want want
+	}{
+		{
+			name: "NotSpecified",
+			rg:   elasticache.ReplicationGroup{NodeGroups: []elasticache.NodeGroup{newNodeGroup("0001", 1)}},
+		},
+		{
+			name: "NotObservedYet",
+			kube: v1beta1.ReplicationGroupParameters{ReplicasPerNodeGroup: &replicasPerNodeGroup},
+		},
+		{
+			name: "NeedsIncrease",
+			kube: v1beta1.ReplicationGroupParameters{ReplicasPerNodeGroup: &replicasPerNodeGroup},
+			rg: elasticache.ReplicationGroup{NodeGroups: []elasticache.NodeGroup{
+				newNodeGroup("0001", replicasPerNodeGroup),
+				newNodeGroup("0002", replicasPerNodeGroup-1),
+			}},
+			want: want{increase: true},
+		},
+		{
+			name: "NeedsDecrease",
+			kube: v1beta1.ReplicationGroupParameters{ReplicasPerNodeGroup: &replicasPerNodeGroup},
+			rg: elasticache.ReplicationGroup{NodeGroups: []elasticache.NodeGroup{
+				newNodeGroup("0001", replicasPerNodeGroup+1),
+			}},
+			want: want{decrease: true},
+		},
+		{
+			name: "UpToDate",
+			kube: v1beta1.ReplicationGroupParameters{ReplicasPerNodeGroup: &replicasPerNodeGroup},
+			rg: elasticache.ReplicationGroup{NodeGroups: []elasticache.NodeGroup{
+				newNodeGroup("0001", replicasPerNodeGroup),
+				newNodeGroup("0002", replicasPerNodeGroup),
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := GenerateObservation(tc.rg)
+			got := want{
+				increase: ReplicaCountNeedsIncrease(tc.kube, o),
+				decrease: ReplicaCountNeedsDecrease(tc.kube, o),
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("ReplicaCountNeeds...(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewModifyReplicationGroupShardConfigurationInput(t *testing.T) {
+	three := 3
+	cases := []struct {
+		name string
+		kube v1beta1.ReplicationGroupParameters
+		rg   elasticache.ReplicationGroup
+		want *elasticache.ModifyReplicationGroupShardConfigurationInput
+	}{
+		{
+			name: "ScaleOut",
+			kube: v1beta1.ReplicationGroupParameters{NumNodeGroups: &three},
+			rg: elasticache.ReplicationGroup{NodeGroups: []elasticache.NodeGroup{
+				newNodeGroup("0001", 1),
+				newNodeGroup("0002", 1),
+			}},
+			want: &elasticache.ModifyReplicationGroupShardConfigurationInput{
+				ApplyImmediately:   aws.Bool(true),
+				NodeGroupCount:     aws.Int64(three),
+				ReplicationGroupId: aws.String(name),
+			},
+		},
+		{
+			name: "ScaleIn",
+			kube: v1beta1.ReplicationGroupParameters{NumNodeGroups: &numNodeGroups},
+			rg: elasticache.ReplicationGroup{NodeGroups: []elasticache.NodeGroup{
+				newNodeGroup("0003", 1),
+				newNodeGroup("0001", 1),
+				newNodeGroup("0004", 1),
+				newNodeGroup("0002", 1),
+			}},
+			want: &elasticache.ModifyReplicationGroupShardConfigurationInput{
+				ApplyImmediately:   aws.Bool(true),
+				NodeGroupCount:     aws.Int64(numNodeGroups),
+				NodeGroupsToRemove: []string{"0004", "0003"},
+				ReplicationGroupId: aws.String(name),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewModifyReplicationGroupShardConfigurationInput(tc.kube, name, GenerateObservation(tc.rg))
+
+			if err := got.Validate(); err != nil {
+				t.Errorf("NewModifyReplicationGroupShardConfigurationInput(...): invalid input: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewModifyReplicationGroupShardConfigurationInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestCacheClusterNeedsUpdate(t *testing.T) {
 	cases := []struct {
 		name string
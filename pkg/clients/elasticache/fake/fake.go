@@ -27,16 +27,34 @@ var _ elasticacheiface.ClientAPI = &MockClient{}
 type MockClient struct {
 	elasticacheiface.ClientAPI
 
-	MockDescribeReplicationGroupsRequest func(*elasticache.DescribeReplicationGroupsInput) elasticache.DescribeReplicationGroupsRequest
-	MockCreateReplicationGroupRequest    func(*elasticache.CreateReplicationGroupInput) elasticache.CreateReplicationGroupRequest
-	MockModifyReplicationGroupRequest    func(*elasticache.ModifyReplicationGroupInput) elasticache.ModifyReplicationGroupRequest
-	MockDeleteReplicationGroupRequest    func(*elasticache.DeleteReplicationGroupInput) elasticache.DeleteReplicationGroupRequest
-	MockDescribeCacheClustersRequest     func(*elasticache.DescribeCacheClustersInput) elasticache.DescribeCacheClustersRequest
+	MockDescribeReplicationGroupsRequest                func(*elasticache.DescribeReplicationGroupsInput) elasticache.DescribeReplicationGroupsRequest
+	MockCreateReplicationGroupRequest                   func(*elasticache.CreateReplicationGroupInput) elasticache.CreateReplicationGroupRequest
+	MockModifyReplicationGroupRequest                   func(*elasticache.ModifyReplicationGroupInput) elasticache.ModifyReplicationGroupRequest
+	MockModifyReplicationGroupShardConfigurationRequest func(*elasticache.ModifyReplicationGroupShardConfigurationInput) elasticache.ModifyReplicationGroupShardConfigurationRequest
+	MockIncreaseReplicaCountRequest                     func(*elasticache.IncreaseReplicaCountInput) elasticache.IncreaseReplicaCountRequest
+	MockDecreaseReplicaCountRequest                     func(*elasticache.DecreaseReplicaCountInput) elasticache.DecreaseReplicaCountRequest
+	MockDeleteReplicationGroupRequest                   func(*elasticache.DeleteReplicationGroupInput) elasticache.DeleteReplicationGroupRequest
+	MockDescribeCacheClustersRequest                    func(*elasticache.DescribeCacheClustersInput) elasticache.DescribeCacheClustersRequest
+	MockCreateCacheClusterRequest                       func(*elasticache.CreateCacheClusterInput) elasticache.CreateCacheClusterRequest
+	MockModifyCacheClusterRequest                       func(*elasticache.ModifyCacheClusterInput) elasticache.ModifyCacheClusterRequest
+	MockDeleteCacheClusterRequest                       func(*elasticache.DeleteCacheClusterInput) elasticache.DeleteCacheClusterRequest
 
 	MockDescribeCacheSubnetGroupsRequest func(*elasticache.DescribeCacheSubnetGroupsInput) elasticache.DescribeCacheSubnetGroupsRequest
 	MockCreateCacheSubnetGroupRequest    func(*elasticache.CreateCacheSubnetGroupInput) elasticache.CreateCacheSubnetGroupRequest
 	MockModifyCacheSubnetGroupRequest    func(*elasticache.ModifyCacheSubnetGroupInput) elasticache.ModifyCacheSubnetGroupRequest
 	MockDeleteCacheSubnetGroupRequest    func(*elasticache.DeleteCacheSubnetGroupInput) elasticache.DeleteCacheSubnetGroupRequest
+
+	MockCreateCacheParameterGroupRequest    func(*elasticache.CreateCacheParameterGroupInput) elasticache.CreateCacheParameterGroupRequest
+	MockDescribeCacheParameterGroupsRequest func(*elasticache.DescribeCacheParameterGroupsInput) elasticache.DescribeCacheParameterGroupsRequest
+	MockDescribeCacheParametersRequest      func(*elasticache.DescribeCacheParametersInput) elasticache.DescribeCacheParametersRequest
+	MockModifyCacheParameterGroupRequest    func(*elasticache.ModifyCacheParameterGroupInput) elasticache.ModifyCacheParameterGroupRequest
+	MockResetCacheParameterGroupRequest     func(*elasticache.ResetCacheParameterGroupInput) elasticache.ResetCacheParameterGroupRequest
+	MockDeleteCacheParameterGroupRequest    func(*elasticache.DeleteCacheParameterGroupInput) elasticache.DeleteCacheParameterGroupRequest
+
+	MockDescribeSnapshotsRequest func(*elasticache.DescribeSnapshotsInput) elasticache.DescribeSnapshotsRequest
+	MockCreateSnapshotRequest    func(*elasticache.CreateSnapshotInput) elasticache.CreateSnapshotRequest
+	MockCopySnapshotRequest      func(*elasticache.CopySnapshotInput) elasticache.CopySnapshotRequest
+	MockDeleteSnapshotRequest    func(*elasticache.DeleteSnapshotInput) elasticache.DeleteSnapshotRequest
 }
 
 // DescribeReplicationGroupsRequest calls the underlying
@@ -57,6 +75,24 @@ func (c *MockClient) ModifyReplicationGroupRequest(i *elasticache.ModifyReplicat
 	return c.MockModifyReplicationGroupRequest(i)
 }
 
+// ModifyReplicationGroupShardConfigurationRequest calls the underlying
+// MockModifyReplicationGroupShardConfigurationRequest method.
+func (c *MockClient) ModifyReplicationGroupShardConfigurationRequest(i *elasticache.ModifyReplicationGroupShardConfigurationInput) elasticache.ModifyReplicationGroupShardConfigurationRequest {
+	return c.MockModifyReplicationGroupShardConfigurationRequest(i)
+}
+
+// IncreaseReplicaCountRequest calls the underlying
+// MockIncreaseReplicaCountRequest method.
+func (c *MockClient) IncreaseReplicaCountRequest(i *elasticache.IncreaseReplicaCountInput) elasticache.IncreaseReplicaCountRequest {
+	return c.MockIncreaseReplicaCountRequest(i)
+}
+
+// DecreaseReplicaCountRequest calls the underlying
+// MockDecreaseReplicaCountRequest method.
+func (c *MockClient) DecreaseReplicaCountRequest(i *elasticache.DecreaseReplicaCountInput) elasticache.DecreaseReplicaCountRequest {
+	return c.MockDecreaseReplicaCountRequest(i)
+}
+
 // DeleteReplicationGroupRequest calls the underlying
 // MockDeleteReplicationGroupRequest method.
 func (c *MockClient) DeleteReplicationGroupRequest(i *elasticache.DeleteReplicationGroupInput) elasticache.DeleteReplicationGroupRequest {
@@ -69,6 +105,24 @@ func (c *MockClient) DescribeCacheClustersRequest(i *elasticache.DescribeCacheCl
 	return c.MockDescribeCacheClustersRequest(i)
 }
 
+// CreateCacheClusterRequest calls the underlying
+// MockCreateCacheClusterRequest method.
+func (c *MockClient) CreateCacheClusterRequest(i *elasticache.CreateCacheClusterInput) elasticache.CreateCacheClusterRequest {
+	return c.MockCreateCacheClusterRequest(i)
+}
+
+// ModifyCacheClusterRequest calls the underlying
+// MockModifyCacheClusterRequest method.
+func (c *MockClient) ModifyCacheClusterRequest(i *elasticache.ModifyCacheClusterInput) elasticache.ModifyCacheClusterRequest {
+	return c.MockModifyCacheClusterRequest(i)
+}
+
+// DeleteCacheClusterRequest calls the underlying
+// MockDeleteCacheClusterRequest method.
+func (c *MockClient) DeleteCacheClusterRequest(i *elasticache.DeleteCacheClusterInput) elasticache.DeleteCacheClusterRequest {
+	return c.MockDeleteCacheClusterRequest(i)
+}
+
 // DescribeCacheSubnetGroupsRequest calls the underlying
 // MockDescribeCacheSubnetGroupsRequest method.
 func (c *MockClient) DescribeCacheSubnetGroupsRequest(i *elasticache.DescribeCacheSubnetGroupsInput) elasticache.DescribeCacheSubnetGroupsRequest {
@@ -92,3 +146,63 @@ func (c *MockClient) ModifyCacheSubnetGroupRequest(i *elasticache.ModifyCacheSub
 func (c *MockClient) DeleteCacheSubnetGroupRequest(i *elasticache.DeleteCacheSubnetGroupInput) elasticache.DeleteCacheSubnetGroupRequest {
 	return c.MockDeleteCacheSubnetGroupRequest(i)
 }
+
+// CreateCacheParameterGroupRequest calls the underlying
+// MockCreateCacheParameterGroupRequest method.
+func (c *MockClient) CreateCacheParameterGroupRequest(i *elasticache.CreateCacheParameterGroupInput) elasticache.CreateCacheParameterGroupRequest {
+	return c.MockCreateCacheParameterGroupRequest(i)
+}
+
+// DescribeCacheParameterGroupsRequest calls the underlying
+// MockDescribeCacheParameterGroupsRequest method.
+func (c *MockClient) DescribeCacheParameterGroupsRequest(i *elasticache.DescribeCacheParameterGroupsInput) elasticache.DescribeCacheParameterGroupsRequest {
+	return c.MockDescribeCacheParameterGroupsRequest(i)
+}
+
+// DescribeCacheParametersRequest calls the underlying
+// MockDescribeCacheParametersRequest method.
+func (c *MockClient) DescribeCacheParametersRequest(i *elasticache.DescribeCacheParametersInput) elasticache.DescribeCacheParametersRequest {
+	return c.MockDescribeCacheParametersRequest(i)
+}
+
+// ModifyCacheParameterGroupRequest calls the underlying
+// MockModifyCacheParameterGroupRequest method.
+func (c *MockClient) ModifyCacheParameterGroupRequest(i *elasticache.ModifyCacheParameterGroupInput) elasticache.ModifyCacheParameterGroupRequest {
+	return c.MockModifyCacheParameterGroupRequest(i)
+}
+
+// ResetCacheParameterGroupRequest calls the underlying
+// MockResetCacheParameterGroupRequest method.
+func (c *MockClient) ResetCacheParameterGroupRequest(i *elasticache.ResetCacheParameterGroupInput) elasticache.ResetCacheParameterGroupRequest {
+	return c.MockResetCacheParameterGroupRequest(i)
+}
+
+// DeleteCacheParameterGroupRequest calls the underlying
+// MockDeleteCacheParameterGroupRequest method.
+func (c *MockClient) DeleteCacheParameterGroupRequest(i *elasticache.DeleteCacheParameterGroupInput) elasticache.DeleteCacheParameterGroupRequest {
+	return c.MockDeleteCacheParameterGroupRequest(i)
+}
+
+// DescribeSnapshotsRequest calls the underlying
+// MockDescribeSnapshotsRequest method.
+func (c *MockClient) DescribeSnapshotsRequest(i *elasticache.DescribeSnapshotsInput) elasticache.DescribeSnapshotsRequest {
+	return c.MockDescribeSnapshotsRequest(i)
+}
+
+// CreateSnapshotRequest calls the underlying
+// MockCreateSnapshotRequest method.
+func (c *MockClient) CreateSnapshotRequest(i *elasticache.CreateSnapshotInput) elasticache.CreateSnapshotRequest {
+	return c.MockCreateSnapshotRequest(i)
+}
+
+// CopySnapshotRequest calls the underlying
+// MockCopySnapshotRequest method.
+func (c *MockClient) CopySnapshotRequest(i *elasticache.CopySnapshotInput) elasticache.CopySnapshotRequest {
+	return c.MockCopySnapshotRequest(i)
+}
+
+// DeleteSnapshotRequest calls the underlying
+// MockDeleteSnapshotRequest method.
+func (c *MockClient) DeleteSnapshotRequest(i *elasticache.DeleteSnapshotInput) elasticache.DeleteSnapshotRequest {
+	return c.MockDeleteSnapshotRequest(i)
+}
@@ -63,11 +63,13 @@ func GenerateCreateELBInput(name string, p v1alpha1.ELBParameters) *elb.CreateLo
 
 // LateInitializeELB fills the empty fields in *v1alpha1.ELBParameters with
 // the values seen in elasticLoadBalancing.ELB.
-func LateInitializeELB(in *v1alpha1.ELBParameters, v *elb.LoadBalancerDescription, elbTags []elb.Tag) { // nolint:gocyclo
+func LateInitializeELB(in *v1alpha1.ELBParameters, v *elb.LoadBalancerDescription, elbTags []elb.Tag, attrs *elb.LoadBalancerAttributes) { // nolint:gocyclo
 	if v == nil {
 		return
 	}
 
+	in.Attributes = lateInitializeAttributes(in.Attributes, attrs)
+
 	in.Scheme = clients.LateInitializeStringPtr(in.Scheme, v.Scheme)
 
 	if len(in.AvailabilityZones) == 0 && len(v.AvailabilityZones) != 0 {
@@ -106,6 +108,41 @@ func LateInitializeELB(in *v1alpha1.ELBParameters, v *elb.LoadBalancerDescriptio
 	}
 }
 
+func lateInitializeAttributes(in *v1alpha1.LoadBalancerAttributes, attrs *elb.LoadBalancerAttributes) *v1alpha1.LoadBalancerAttributes { // nolint:gocyclo
+	if attrs == nil {
+		return in
+	}
+	if in == nil {
+		in = &v1alpha1.LoadBalancerAttributes{}
+	}
+
+	if attrs.AccessLog != nil {
+		if in.AccessLog == nil {
+			in.AccessLog = &v1alpha1.AccessLog{Enabled: aws.BoolValue(attrs.AccessLog.Enabled)}
+		}
+		in.AccessLog.EmitInterval = clients.LateInitializeInt64Ptr(in.AccessLog.EmitInterval, attrs.AccessLog.EmitInterval)
+		in.AccessLog.S3BucketName = clients.LateInitializeStringPtr(in.AccessLog.S3BucketName, attrs.AccessLog.S3BucketName)
+		in.AccessLog.S3BucketPrefix = clients.LateInitializeStringPtr(in.AccessLog.S3BucketPrefix, attrs.AccessLog.S3BucketPrefix)
+	}
+
+	if attrs.ConnectionDraining != nil {
+		if in.ConnectionDraining == nil {
+			in.ConnectionDraining = &v1alpha1.ConnectionDraining{Enabled: aws.BoolValue(attrs.ConnectionDraining.Enabled)}
+		}
+		in.ConnectionDraining.Timeout = clients.LateInitializeInt64Ptr(in.ConnectionDraining.Timeout, attrs.ConnectionDraining.Timeout)
+	}
+
+	if in.ConnectionSettings == nil && attrs.ConnectionSettings != nil {
+		in.ConnectionSettings = &v1alpha1.ConnectionSettings{IdleTimeout: aws.Int64Value(attrs.ConnectionSettings.IdleTimeout)}
+	}
+
+	if in.CrossZoneLoadBalancing == nil && attrs.CrossZoneLoadBalancing != nil {
+		in.CrossZoneLoadBalancing = &v1alpha1.CrossZoneLoadBalancing{Enabled: aws.BoolValue(attrs.CrossZoneLoadBalancing.Enabled)}
+	}
+
+	return in
+}
+
 // IsELBNotFound returns true if the error is because the item doesn't exist.
 func IsELBNotFound(err error) bool {
 	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == elb.ErrCodeAccessPointNotFoundException {
@@ -141,17 +178,23 @@ func GenerateELBObservation(e elb.LoadBalancerDescription) v1alpha1.ELBObservati
 // CreatePatch creates a v1alpha1.ELBParameters that has only the changed
 // values between the target v1alpha1.ELBParameters and the current
 // elb.LoadBalancerDescription.
-func CreatePatch(in elb.LoadBalancerDescription, target v1alpha1.ELBParameters, elbTags []elb.Tag) (*v1alpha1.ELBParameters, error) {
+func CreatePatch(in elb.LoadBalancerDescription, target v1alpha1.ELBParameters, elbTags []elb.Tag, attrs *elb.LoadBalancerAttributes) (*v1alpha1.ELBParameters, error) {
 	// v1alpha1.ELBParameters contains multiple list types. Sorting these list types is required before
 	// creating a patch as jsonpatch.CreateMergePatch considers the order of items in a list.
 
 	currentParams := &v1alpha1.ELBParameters{}
-	LateInitializeELB(currentParams, &in, elbTags)
+	LateInitializeELB(currentParams, &in, elbTags, attrs)
 	sortParametersArrays(currentParams)
 
 	targetCopy := target.DeepCopy()
 	sortParametersArrays(targetCopy)
 
+	// Policies and their assignments are not part of the load balancer
+	// description and are compared separately.
+	targetCopy.Policies = nil
+	targetCopy.ListenerPolicies = nil
+	targetCopy.BackendServerPolicies = nil
+
 	// For listener.Protocol and listener.InstanceProtocol, values in lower and upper case
 	// are allowed. But the AWS API always returns the upper case strings.
 	for i, v := range targetCopy.Listeners {
@@ -171,14 +214,191 @@ func CreatePatch(in elb.LoadBalancerDescription, target v1alpha1.ELBParameters,
 }
 
 // IsUpToDate checks whether there is a change in any of the modifiable fields.
-func IsUpToDate(p v1alpha1.ELBParameters, elb elb.LoadBalancerDescription, elbTags []elb.Tag) (bool, error) {
-	patch, err := CreatePatch(elb, p, elbTags)
+func IsUpToDate(p v1alpha1.ELBParameters, lb elb.LoadBalancerDescription, elbTags []elb.Tag, attrs *elb.LoadBalancerAttributes, policies []elb.PolicyDescription, created []string) (bool, error) {
+	patch, err := CreatePatch(lb, p, elbTags, attrs)
 	if err != nil {
 		return false, err
 	}
+	if len(GetMissingPolicies(p.Policies, policies)) != 0 ||
+		len(GetObsoletePolicies(p, lb, policies, created)) != 0 ||
+		len(GetOutdatedListenerPolicies(p.ListenerPolicies, lb.ListenerDescriptions)) != 0 ||
+		len(GetOutdatedBackendServerPolicies(p.BackendServerPolicies, lb.BackendServerDescriptions)) != 0 {
+		return false, nil
+	}
 	return cmp.Equal(&v1alpha1.ELBParameters{}, patch, cmpopts.IgnoreTypes([]corev1alpha1.Reference{}, []corev1alpha1.Selector{})), nil
 }
 
+// GenerateLoadBalancerAttributes generates elb.LoadBalancerAttributes from
+// the given v1alpha1.LoadBalancerAttributes.
+func GenerateLoadBalancerAttributes(p *v1alpha1.LoadBalancerAttributes) *elb.LoadBalancerAttributes {
+	if p == nil {
+		return nil
+	}
+	attrs := &elb.LoadBalancerAttributes{}
+	if p.AccessLog != nil {
+		attrs.AccessLog = &elb.AccessLog{
+			Enabled:        aws.Bool(p.AccessLog.Enabled),
+			EmitInterval:   p.AccessLog.EmitInterval,
+			S3BucketName:   p.AccessLog.S3BucketName,
+			S3BucketPrefix: p.AccessLog.S3BucketPrefix,
+		}
+	}
+	if p.ConnectionDraining != nil {
+		attrs.ConnectionDraining = &elb.ConnectionDraining{
+			Enabled: aws.Bool(p.ConnectionDraining.Enabled),
+			Timeout: p.ConnectionDraining.Timeout,
+		}
+	}
+	if p.ConnectionSettings != nil {
+		attrs.ConnectionSettings = &elb.ConnectionSettings{
+			IdleTimeout: aws.Int64(p.ConnectionSettings.IdleTimeout),
+		}
+	}
+	if p.CrossZoneLoadBalancing != nil {
+		attrs.CrossZoneLoadBalancing = &elb.CrossZoneLoadBalancing{
+			Enabled: aws.Bool(p.CrossZoneLoadBalancing.Enabled),
+		}
+	}
+	return attrs
+}
+
+// GenerateCreatePolicyInput generates elb.CreateLoadBalancerPolicyInput from
+// the given v1alpha1.Policy.
+func GenerateCreatePolicyInput(name string, p v1alpha1.Policy) *elb.CreateLoadBalancerPolicyInput {
+	input := &elb.CreateLoadBalancerPolicyInput{
+		LoadBalancerName: aws.String(name),
+		PolicyName:       aws.String(p.PolicyName),
+		PolicyTypeName:   aws.String(p.PolicyTypeName),
+	}
+	if len(p.PolicyAttributes) != 0 {
+		input.PolicyAttributes = make([]elb.PolicyAttribute, len(p.PolicyAttributes))
+		for i, a := range p.PolicyAttributes {
+			input.PolicyAttributes[i] = elb.PolicyAttribute{
+				AttributeName:  aws.String(a.AttributeName),
+				AttributeValue: a.AttributeValue,
+			}
+		}
+	}
+	return input
+}
+
+// GetMissingPolicies returns the desired policies that do not exist for the
+// load balancer. Policies are immutable, so they are compared by name only.
+func GetMissingPolicies(desired []v1alpha1.Policy, observed []elb.PolicyDescription) []v1alpha1.Policy {
+	existing := make(map[string]struct{}, len(observed))
+	for _, p := range observed {
+		existing[aws.StringValue(p.PolicyName)] = struct{}{}
+	}
+	var missing []v1alpha1.Policy
+	for _, p := range desired {
+		if _, ok := existing[p.PolicyName]; !ok {
+			missing = append(missing, p)
+		}
+	}
+	return missing
+}
+
+// GetObsoletePolicies returns the names of the given created policies of the
+// load balancer that are not desired anymore and are not assigned to any
+// listener or back-end server once the desired assignments are applied.
+// Listeners and instance ports that are not mentioned in the desired
+// assignments keep their observed policies. No policy is obsolete if no
+// policies are desired.
+func GetObsoletePolicies(p v1alpha1.ELBParameters, lb elb.LoadBalancerDescription, observed []elb.PolicyDescription, created []string) []string { // nolint:gocyclo
+	if len(p.Policies) == 0 || len(created) == 0 {
+		return nil
+	}
+	owned := map[string]struct{}{}
+	for _, name := range created {
+		owned[name] = struct{}{}
+	}
+	desired := map[string]struct{}{}
+	for _, policy := range p.Policies {
+		desired[policy.PolicyName] = struct{}{}
+	}
+
+	assigned := map[string]struct{}{}
+	listeners := map[int64][]string{}
+	for _, l := range lb.ListenerDescriptions {
+		if l.Listener != nil {
+			listeners[aws.Int64Value(l.Listener.LoadBalancerPort)] = l.PolicyNames
+		}
+	}
+	for _, l := range p.ListenerPolicies {
+		listeners[l.LoadBalancerPort] = l.PolicyNames
+	}
+	backends := map[int64][]string{}
+	for _, b := range lb.BackendServerDescriptions {
+		backends[aws.Int64Value(b.InstancePort)] = b.PolicyNames
+	}
+	for _, b := range p.BackendServerPolicies {
+		backends[b.InstancePort] = b.PolicyNames
+	}
+	for _, names := range listeners {
+		for _, n := range names {
+			assigned[n] = struct{}{}
+		}
+	}
+	for _, names := range backends {
+		for _, n := range names {
+			assigned[n] = struct{}{}
+		}
+	}
+
+	var obsolete []string
+	for _, policy := range observed {
+		name := aws.StringValue(policy.PolicyName)
+		_, isOwned := owned[name]
+		_, isDesired := desired[name]
+		_, isAssigned := assigned[name]
+		if isOwned && !isDesired && !isAssigned {
+			obsolete = append(obsolete, name)
+		}
+	}
+	return obsolete
+}
+
+// GetOutdatedListenerPolicies returns the desired listener policy assignments
+// that differ from the ones observed on the load balancer. Listeners that are
+// not mentioned in the desired assignments are left untouched.
+func GetOutdatedListenerPolicies(desired []v1alpha1.ListenerPolicy, observed []elb.ListenerDescription) []v1alpha1.ListenerPolicy {
+	current := map[int64][]string{}
+	for _, l := range observed {
+		if l.Listener != nil {
+			current[aws.Int64Value(l.Listener.LoadBalancerPort)] = l.PolicyNames
+		}
+	}
+	var outdated []v1alpha1.ListenerPolicy
+	for _, l := range desired {
+		if !areStringSetsEqual(l.PolicyNames, current[l.LoadBalancerPort]) {
+			outdated = append(outdated, l)
+		}
+	}
+	return outdated
+}
+
+// GetOutdatedBackendServerPolicies returns the desired back-end server policy
+// assignments that differ from the ones observed on the load balancer.
+// Instance ports that are not mentioned in the desired assignments are left
+// untouched.
+func GetOutdatedBackendServerPolicies(desired []v1alpha1.BackendServerPolicy, observed []elb.BackendServerDescription) []v1alpha1.BackendServerPolicy {
+	current := map[int64][]string{}
+	for _, b := range observed {
+		current[aws.Int64Value(b.InstancePort)] = b.PolicyNames
+	}
+	var outdated []v1alpha1.BackendServerPolicy
+	for _, b := range desired {
+		if !areStringSetsEqual(b.PolicyNames, current[b.InstancePort]) {
+			outdated = append(outdated, b)
+		}
+	}
+	return outdated
+}
+
+func areStringSetsEqual(a, b []string) bool {
+	return cmp.Equal(a, b, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(x, y string) bool { return x < y }))
+}
+
 // BuildELBListeners builds a list of elb.Listener from given list of v1alpha1.Listener.
 func BuildELBListeners(listeners []v1alpha1.Listener) []elb.Listener {
 	if len(listeners) > 0 {
@@ -213,6 +433,55 @@ func BuildELBTags(tags []v1alpha1.Tag) []elb.Tag {
 	return elbTags
 }
 
+// GetAttachmentInstanceIDs returns the de-duplicated list of instance IDs
+// that should be registered by the given v1alpha1.ELBAttachmentParameters.
+func GetAttachmentInstanceIDs(p v1alpha1.ELBAttachmentParameters) []string {
+	ids := make([]string, 0, len(p.InstanceIDs)+1)
+	seen := map[string]struct{}{}
+	for _, id := range append([]string{p.InstanceID}, p.InstanceIDs...) {
+		if _, ok := seen[id]; ok || id == "" {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// BuildELBInstances generates a list of elb.Instance from given instance IDs.
+func BuildELBInstances(ids []string) []elb.Instance {
+	if len(ids) == 0 {
+		return nil
+	}
+	instances := make([]elb.Instance, len(ids))
+	for i, id := range ids {
+		instances[i] = elb.Instance{InstanceId: aws.String(id)}
+	}
+	return instances
+}
+
+// GenerateInstanceHealth is used to produce a list of v1alpha1.InstanceHealth
+// from the observed elb.InstanceState list, limited to the given instance IDs.
+func GenerateInstanceHealth(states []elb.InstanceState, ids []string) []v1alpha1.InstanceHealth {
+	filter := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		filter[id] = struct{}{}
+	}
+	var health []v1alpha1.InstanceHealth
+	for _, s := range states {
+		if _, ok := filter[aws.StringValue(s.InstanceId)]; !ok {
+			continue
+		}
+		health = append(health, v1alpha1.InstanceHealth{
+			InstanceID:  aws.StringValue(s.InstanceId),
+			State:       aws.StringValue(s.State),
+			ReasonCode:  aws.StringValue(s.ReasonCode),
+			Description: aws.StringValue(s.Description),
+		})
+	}
+	return health
+}
+
 func sortParametersArrays(p *v1alpha1.ELBParameters) {
 	sort.Strings(p.AvailabilityZones)
 	sort.Strings(p.SecurityGroupIDs)
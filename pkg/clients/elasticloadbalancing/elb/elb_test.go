@@ -92,9 +92,10 @@ func loadBalancer(m ...func(*elb.LoadBalancerDescription)) *elb.LoadBalancerDesc
 
 func TestLateInitializeELB(t *testing.T) {
 	type args struct {
-		spec *v1alpha1.ELBParameters
-		in   elb.LoadBalancerDescription
-		tags []elb.Tag
+		spec  *v1alpha1.ELBParameters
+		in    elb.LoadBalancerDescription
+		tags  []elb.Tag
+		attrs *elb.LoadBalancerAttributes
 	}
 	cases := map[string]struct {
 		args args
@@ -137,11 +138,33 @@ func TestLateInitializeELB(t *testing.T) {
 				p.Tags = tags
 			}),
 		},
+		"Attributes": {
+			args: args{
+				spec: elbParams(func(p *v1alpha1.ELBParameters) {
+					p.Attributes = &v1alpha1.LoadBalancerAttributes{
+						ConnectionDraining: &v1alpha1.ConnectionDraining{Enabled: true},
+					}
+				}),
+				in: *loadBalancer(),
+				attrs: &elb.LoadBalancerAttributes{
+					ConnectionDraining:     &elb.ConnectionDraining{Enabled: aws.Bool(false), Timeout: aws.Int64(300)},
+					ConnectionSettings:     &elb.ConnectionSettings{IdleTimeout: aws.Int64(60)},
+					CrossZoneLoadBalancing: &elb.CrossZoneLoadBalancing{Enabled: aws.Bool(true)},
+				},
+			},
+			want: elbParams(func(p *v1alpha1.ELBParameters) {
+				p.Attributes = &v1alpha1.LoadBalancerAttributes{
+					ConnectionDraining:     &v1alpha1.ConnectionDraining{Enabled: true, Timeout: aws.Int64(300)},
+					ConnectionSettings:     &v1alpha1.ConnectionSettings{IdleTimeout: 60},
+					CrossZoneLoadBalancing: &v1alpha1.CrossZoneLoadBalancing{Enabled: true},
+				}
+			}),
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			LateInitializeELB(tc.args.spec, &tc.args.in, tc.args.tags)
+			LateInitializeELB(tc.args.spec, &tc.args.in, tc.args.tags, tc.args.attrs)
 			if diff := cmp.Diff(tc.args.spec, tc.want); diff != "" {
 				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
 			}
@@ -316,7 +339,7 @@ func TestCreatePatch(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			result, _ := CreatePatch(tc.args.lb, tc.args.p, tc.args.tags)
+			result, _ := CreatePatch(tc.args.lb, tc.args.p, tc.args.tags, nil)
 			if diff := cmp.Diff(tc.want.patch, result); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
@@ -326,9 +349,12 @@ func TestCreatePatch(t *testing.T) {
 
 func TestIsUpToDate(t *testing.T) {
 	type args struct {
-		lb   elb.LoadBalancerDescription
-		p    v1alpha1.ELBParameters
-		tags []elb.Tag
+		lb       elb.LoadBalancerDescription
+		p        v1alpha1.ELBParameters
+		tags     []elb.Tag
+		attrs    *elb.LoadBalancerAttributes
+		policies []elb.PolicyDescription
+		created  []string
 	}
 
 	cases := map[string]struct {
@@ -367,11 +393,191 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"DifferentAttributes": {
+			args: args{
+				lb: *loadBalancer(),
+				p: *elbParams(func(p *v1alpha1.ELBParameters) {
+					p.Attributes = &v1alpha1.LoadBalancerAttributes{
+						CrossZoneLoadBalancing: &v1alpha1.CrossZoneLoadBalancing{Enabled: true},
+					}
+				}),
+				attrs: &elb.LoadBalancerAttributes{
+					CrossZoneLoadBalancing: &elb.CrossZoneLoadBalancing{Enabled: aws.Bool(false)},
+				},
+			},
+			want: false,
+		},
+		"PoliciesUpToDate": {
+			args: args{
+				lb: *loadBalancer(func(lb *elb.LoadBalancerDescription) {
+					lb.ListenerDescriptions[0].PolicyNames = []string{"stickiness"}
+				}),
+				p: *elbParams(func(p *v1alpha1.ELBParameters) {
+					p.Policies = []v1alpha1.Policy{{PolicyName: "stickiness", PolicyTypeName: "LBCookieStickinessPolicyType"}}
+					p.ListenerPolicies = []v1alpha1.ListenerPolicy{{LoadBalancerPort: 80, PolicyNames: []string{"stickiness"}}}
+				}),
+				policies: []elb.PolicyDescription{{PolicyName: aws.String("stickiness")}},
+			},
+			want: true,
+		},
+		"MissingPolicy": {
+			args: args{
+				lb: *loadBalancer(),
+				p: *elbParams(func(p *v1alpha1.ELBParameters) {
+					p.Policies = []v1alpha1.Policy{{PolicyName: "stickiness", PolicyTypeName: "LBCookieStickinessPolicyType"}}
+				}),
+			},
+			want: false,
+		},
+		"DifferentBackendServerPolicies": {
+			args: args{
+				lb: *loadBalancer(),
+				p: *elbParams(func(p *v1alpha1.ELBParameters) {
+					p.BackendServerPolicies = []v1alpha1.BackendServerPolicy{{InstancePort: 443, PolicyNames: []string{"proxy"}}}
+				}),
+			},
+			want: false,
+		},
+		"ObsoletePolicy": {
+			args: args{
+				lb: *loadBalancer(),
+				p: *elbParams(func(p *v1alpha1.ELBParameters) {
+					p.Policies = []v1alpha1.Policy{{PolicyName: "proxy", PolicyTypeName: "ProxyProtocolPolicyType"}}
+				}),
+				policies: []elb.PolicyDescription{{PolicyName: aws.String("proxy")}, {PolicyName: aws.String("stickiness")}},
+				created:  []string{"proxy", "stickiness"},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _ := IsUpToDate(tc.args.p, tc.args.lb, tc.args.tags, tc.args.attrs, tc.args.policies, tc.args.created)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetObsoletePolicies(t *testing.T) {
+	type args struct {
+		p        v1alpha1.ELBParameters
+		lb       elb.LoadBalancerDescription
+		policies []elb.PolicyDescription
+		created  []string
+	}
+
+	proxy := func(p *v1alpha1.ELBParameters) {
+		p.Policies = []v1alpha1.Policy{{PolicyName: "proxy", PolicyTypeName: "ProxyProtocolPolicyType"}}
+	}
+
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"DesiredPolicy": {
+			args: args{
+				p: *elbParams(func(p *v1alpha1.ELBParameters) {
+					p.Policies = []v1alpha1.Policy{{PolicyName: "stickiness", PolicyTypeName: "LBCookieStickinessPolicyType"}}
+				}),
+				lb:       *loadBalancer(),
+				policies: []elb.PolicyDescription{{PolicyName: aws.String("stickiness")}},
+				created:  []string{"stickiness"},
+			},
+		},
+		"RemovedAndUnassigned": {
+			args: args{
+				p:        *elbParams(proxy),
+				lb:       *loadBalancer(),
+				policies: []elb.PolicyDescription{{PolicyName: aws.String("stickiness")}},
+				created:  []string{"proxy", "stickiness"},
+			},
+			want: []string{"stickiness"},
+		},
+		"NotCreated": {
+			args: args{
+				p:        *elbParams(proxy),
+				lb:       *loadBalancer(),
+				policies: []elb.PolicyDescription{{PolicyName: aws.String("stickiness")}},
+				created:  []string{"proxy"},
+			},
+		},
+		"NoDesiredPolicies": {
+			args: args{
+				p:        *elbParams(),
+				lb:       *loadBalancer(),
+				policies: []elb.PolicyDescription{{PolicyName: aws.String("stickiness")}},
+				created:  []string{"stickiness"},
+			},
+		},
+		"RemovedButAssignedToListener": {
+			args: args{
+				p: *elbParams(proxy),
+				lb: *loadBalancer(func(lb *elb.LoadBalancerDescription) {
+					lb.ListenerDescriptions[0].PolicyNames = []string{"ELBSecurityPolicy-2016-08"}
+				}),
+				policies: []elb.PolicyDescription{{PolicyName: aws.String("ELBSecurityPolicy-2016-08")}},
+				created:  []string{"ELBSecurityPolicy-2016-08"},
+			},
+		},
+		"RemovedButAssignedToBackendServer": {
+			args: args{
+				p: *elbParams(proxy),
+				lb: *loadBalancer(func(lb *elb.LoadBalancerDescription) {
+					lb.BackendServerDescriptions = []elb.BackendServerDescription{{InstancePort: aws.Int64(443), PolicyNames: []string{"stickiness"}}}
+				}),
+				policies: []elb.PolicyDescription{{PolicyName: aws.String("stickiness")}},
+				created:  []string{"stickiness"},
+			},
+		},
+		"UnassignedByDesiredListenerPolicies": {
+			args: args{
+				p: *elbParams(proxy, func(p *v1alpha1.ELBParameters) {
+					p.ListenerPolicies = []v1alpha1.ListenerPolicy{{LoadBalancerPort: 80}}
+				}),
+				lb: *loadBalancer(func(lb *elb.LoadBalancerDescription) {
+					lb.ListenerDescriptions[0].PolicyNames = []string{"stickiness"}
+				}),
+				policies: []elb.PolicyDescription{{PolicyName: aws.String("stickiness")}},
+				created:  []string{"stickiness"},
+			},
+			want: []string{"stickiness"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetObsoletePolicies(tc.args.p, tc.args.lb, tc.args.policies, tc.args.created)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetAttachmentInstanceIDs(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ELBAttachmentParameters
+		want []string
+	}{
+		"SingleInstance": {
+			p:    v1alpha1.ELBAttachmentParameters{InstanceID: "i-1"},
+			want: []string{"i-1"},
+		},
+		"MergedAndDeduplicated": {
+			p:    v1alpha1.ELBAttachmentParameters{InstanceID: "i-1", InstanceIDs: []string{"i-2", "i-1", "i-3"}},
+			want: []string{"i-1", "i-2", "i-3"},
+		},
+		"Empty": {
+			want: []string{},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got, _ := IsUpToDate(tc.args.p, tc.args.lb, tc.args.tags)
+			got := GetAttachmentInstanceIDs(tc.p)
 			if diff := cmp.Diff(tc.want, got); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
@@ -40,7 +40,15 @@ type MockClient struct {
 	MockDeleteLoadBalancerListenersRequest             func(*elb.DeleteLoadBalancerListenersInput) elb.DeleteLoadBalancerListenersRequest
 	MockRegisterInstancesWithLoadBalancerRequest       func(*elb.RegisterInstancesWithLoadBalancerInput) elb.RegisterInstancesWithLoadBalancerRequest
 	MockDeregisterInstancesFromLoadBalancerRequest     func(*elb.DeregisterInstancesFromLoadBalancerInput) elb.DeregisterInstancesFromLoadBalancerRequest
+	MockDescribeInstanceHealthRequest                  func(*elb.DescribeInstanceHealthInput) elb.DescribeInstanceHealthRequest
 	MockDescribeTagsRequest                            func(*elb.DescribeTagsInput) elb.DescribeTagsRequest
+	MockDescribeLoadBalancerAttributesRequest          func(*elb.DescribeLoadBalancerAttributesInput) elb.DescribeLoadBalancerAttributesRequest
+	MockModifyLoadBalancerAttributesRequest            func(*elb.ModifyLoadBalancerAttributesInput) elb.ModifyLoadBalancerAttributesRequest
+	MockDescribeLoadBalancerPoliciesRequest            func(*elb.DescribeLoadBalancerPoliciesInput) elb.DescribeLoadBalancerPoliciesRequest
+	MockCreateLoadBalancerPolicyRequest                func(*elb.CreateLoadBalancerPolicyInput) elb.CreateLoadBalancerPolicyRequest
+	MockDeleteLoadBalancerPolicyRequest                func(*elb.DeleteLoadBalancerPolicyInput) elb.DeleteLoadBalancerPolicyRequest
+	MockSetLoadBalancerPoliciesOfListenerRequest       func(*elb.SetLoadBalancerPoliciesOfListenerInput) elb.SetLoadBalancerPoliciesOfListenerRequest
+	MockSetLoadBalancerPoliciesForBackendServerRequest func(*elb.SetLoadBalancerPoliciesForBackendServerInput) elb.SetLoadBalancerPoliciesForBackendServerRequest
 }
 
 // DescribeLoadBalancersRequest calls the underlying
@@ -120,3 +128,51 @@ func (c *MockClient) DeregisterInstancesFromLoadBalancerRequest(i *elasticloadba
 func (c *MockClient) DescribeTagsRequest(i *elasticloadbalancing.DescribeTagsInput) elasticloadbalancing.DescribeTagsRequest {
 	return c.MockDescribeTagsRequest(i)
 }
+
+// DescribeLoadBalancerAttributesRequest calls the underlying
+// MockDescribeLoadBalancerAttributesRequest method.
+func (c *MockClient) DescribeLoadBalancerAttributesRequest(i *elasticloadbalancing.DescribeLoadBalancerAttributesInput) elasticloadbalancing.DescribeLoadBalancerAttributesRequest {
+	return c.MockDescribeLoadBalancerAttributesRequest(i)
+}
+
+// ModifyLoadBalancerAttributesRequest calls the underlying
+// MockModifyLoadBalancerAttributesRequest method.
+func (c *MockClient) ModifyLoadBalancerAttributesRequest(i *elasticloadbalancing.ModifyLoadBalancerAttributesInput) elasticloadbalancing.ModifyLoadBalancerAttributesRequest {
+	return c.MockModifyLoadBalancerAttributesRequest(i)
+}
+
+// DescribeLoadBalancerPoliciesRequest calls the underlying
+// MockDescribeLoadBalancerPoliciesRequest method.
+func (c *MockClient) DescribeLoadBalancerPoliciesRequest(i *elasticloadbalancing.DescribeLoadBalancerPoliciesInput) elasticloadbalancing.DescribeLoadBalancerPoliciesRequest {
+	return c.MockDescribeLoadBalancerPoliciesRequest(i)
+}
+
+// CreateLoadBalancerPolicyRequest calls the underlying
+// MockCreateLoadBalancerPolicyRequest method.
+func (c *MockClient) CreateLoadBalancerPolicyRequest(i *elasticloadbalancing.CreateLoadBalancerPolicyInput) elasticloadbalancing.CreateLoadBalancerPolicyRequest {
+	return c.MockCreateLoadBalancerPolicyRequest(i)
+}
+
+// DeleteLoadBalancerPolicyRequest calls the underlying
+// MockDeleteLoadBalancerPolicyRequest method.
+func (c *MockClient) DeleteLoadBalancerPolicyRequest(i *elasticloadbalancing.DeleteLoadBalancerPolicyInput) elasticloadbalancing.DeleteLoadBalancerPolicyRequest {
+	return c.MockDeleteLoadBalancerPolicyRequest(i)
+}
+
+// SetLoadBalancerPoliciesOfListenerRequest calls the underlying
+// MockSetLoadBalancerPoliciesOfListenerRequest method.
+func (c *MockClient) SetLoadBalancerPoliciesOfListenerRequest(i *elasticloadbalancing.SetLoadBalancerPoliciesOfListenerInput) elasticloadbalancing.SetLoadBalancerPoliciesOfListenerRequest {
+	return c.MockSetLoadBalancerPoliciesOfListenerRequest(i)
+}
+
+// SetLoadBalancerPoliciesForBackendServerRequest calls the underlying
+// MockSetLoadBalancerPoliciesForBackendServerRequest method.
+func (c *MockClient) SetLoadBalancerPoliciesForBackendServerRequest(i *elasticloadbalancing.SetLoadBalancerPoliciesForBackendServerInput) elasticloadbalancing.SetLoadBalancerPoliciesForBackendServerRequest {
+	return c.MockSetLoadBalancerPoliciesForBackendServerRequest(i)
+}
+
+// DescribeInstanceHealthRequest calls the underlying
+// MockDescribeInstanceHealthRequest method.
+func (c *MockClient) DescribeInstanceHealthRequest(i *elasticloadbalancing.DescribeInstanceHealthInput) elasticloadbalancing.DescribeInstanceHealthRequest {
+	return c.MockDescribeInstanceHealthRequest(i)
+}
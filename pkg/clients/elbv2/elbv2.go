@@ -0,0 +1,555 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/elasticloadbalancingv2iface"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	clients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// A Client handles CRUD operations for Elastic Load Balancing v2 resources.
+type Client elasticloadbalancingv2iface.ClientAPI
+
+// NewClient returns a new Elastic Load Balancing v2 client. Credentials must
+// be passed as JSON encoded data.
+func NewClient(ctx context.Context, credentials []byte, region string, auth clients.AuthMethod) (Client, error) {
+	cfg, err := auth(ctx, credentials, clients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return elbv2.New(*cfg), err
+}
+
+// IsLoadBalancerNotFound returns true if the error is because the load
+// balancer doesn't exist.
+func IsLoadBalancerNotFound(err error) bool {
+	return isErrorCode(err, elbv2.ErrCodeLoadBalancerNotFoundException)
+}
+
+// IsTargetGroupNotFound returns true if the error is because the target group
+// doesn't exist.
+func IsTargetGroupNotFound(err error) bool {
+	return isErrorCode(err, elbv2.ErrCodeTargetGroupNotFoundException)
+}
+
+// IsListenerNotFound returns true if the error is because the listener
+// doesn't exist.
+func IsListenerNotFound(err error) bool {
+	return isErrorCode(err, elbv2.ErrCodeListenerNotFoundException)
+}
+
+// IsRuleNotFound returns true if the error is because the rule doesn't exist.
+func IsRuleNotFound(err error) bool {
+	return isErrorCode(err, elbv2.ErrCodeRuleNotFoundException)
+}
+
+func isErrorCode(err error, code string) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == code {
+		return true
+	}
+	return false
+}
+
+// GenerateTags generates a list of elbv2.Tag from the given list of
+// v1alpha1.Tag.
+func GenerateTags(tags []v1alpha1.Tag) []elbv2.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]elbv2.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = elbv2.Tag{
+			Key:   aws.String(t.Key),
+			Value: t.Value,
+		}
+	}
+	return res
+}
+
+// DiffTags returns the tags that have to be added or updated and the keys of
+// the tags that have to be removed to bring the observed tags to the desired
+// state.
+func DiffTags(desired []v1alpha1.Tag, observed []elbv2.Tag) (add []elbv2.Tag, remove []string) {
+	o := make(map[string]string, len(observed))
+	for _, t := range observed {
+		o[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	d := make(map[string]bool, len(desired))
+	for _, t := range desired {
+		d[t.Key] = true
+		if v, ok := o[t.Key]; ok && v == aws.StringValue(t.Value) {
+			continue
+		}
+		add = append(add, elbv2.Tag{Key: aws.String(t.Key), Value: t.Value})
+	}
+	for _, t := range observed {
+		if !d[aws.StringValue(t.Key)] {
+			remove = append(remove, aws.StringValue(t.Key))
+		}
+	}
+	sort.Strings(remove)
+	return add, remove
+}
+
+// GenerateCreateLoadBalancerInput returns the input for creating a load
+// balancer with the given name.
+func GenerateCreateLoadBalancerInput(name string, p v1alpha1.LoadBalancerParameters) *elbv2.CreateLoadBalancerInput {
+	return &elbv2.CreateLoadBalancerInput{
+		Name:           aws.String(name),
+		Type:           elbv2.LoadBalancerTypeEnum(aws.StringValue(p.Type)),
+		Scheme:         elbv2.LoadBalancerSchemeEnum(aws.StringValue(p.Scheme)),
+		IpAddressType:  elbv2.IpAddressType(aws.StringValue(p.IPAddressType)),
+		Subnets:        p.SubnetIDs,
+		SecurityGroups: p.SecurityGroupIDs,
+		Tags:           GenerateTags(p.Tags),
+	}
+}
+
+// GetSubnetIDs returns the IDs of the subnets the load balancer is attached
+// to.
+func GetSubnetIDs(lb elbv2.LoadBalancer) []string {
+	if len(lb.AvailabilityZones) == 0 {
+		return nil
+	}
+	res := make([]string, 0, len(lb.AvailabilityZones))
+	for _, az := range lb.AvailabilityZones {
+		if az.SubnetId != nil {
+			res = append(res, aws.StringValue(az.SubnetId))
+		}
+	}
+	return res
+}
+
+// LateInitializeLoadBalancer fills the empty fields in
+// *v1alpha1.LoadBalancerParameters with the values seen in
+// elbv2.LoadBalancer.
+func LateInitializeLoadBalancer(p *v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer) {
+	p.Type = lateInitializeEnum(p.Type, string(lb.Type))
+	p.Scheme = lateInitializeEnum(p.Scheme, string(lb.Scheme))
+	p.IPAddressType = lateInitializeEnum(p.IPAddressType, string(lb.IpAddressType))
+	if len(p.SubnetIDs) == 0 {
+		p.SubnetIDs = GetSubnetIDs(lb)
+	}
+	if len(p.SecurityGroupIDs) == 0 && len(lb.SecurityGroups) != 0 {
+		p.SecurityGroupIDs = lb.SecurityGroups
+	}
+}
+
+// IsLoadBalancerUpToDate returns true if the modifiable fields of the load
+// balancer match the desired state.
+func IsLoadBalancerUpToDate(p v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer, tags []elbv2.Tag) bool {
+	if aws.StringValue(p.IPAddressType) != string(lb.IpAddressType) {
+		return false
+	}
+	if !AreStringSetsEqual(p.SubnetIDs, GetSubnetIDs(lb)) ||
+		!AreStringSetsEqual(p.SecurityGroupIDs, lb.SecurityGroups) {
+		return false
+	}
+	add, remove := DiffTags(p.Tags, tags)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// GenerateLoadBalancerObservation is used to produce
+// v1alpha1.LoadBalancerObservation from elbv2.LoadBalancer.
+func GenerateLoadBalancerObservation(lb elbv2.LoadBalancer) v1alpha1.LoadBalancerObservation {
+	o := v1alpha1.LoadBalancerObservation{
+		LoadBalancerARN:       aws.StringValue(lb.LoadBalancerArn),
+		DNSName:               aws.StringValue(lb.DNSName),
+		CanonicalHostedZoneID: aws.StringValue(lb.CanonicalHostedZoneId),
+		VPCID:                 aws.StringValue(lb.VpcId),
+	}
+	if lb.State != nil {
+		o.State = string(lb.State.Code)
+	}
+	return o
+}
+
+// GenerateCreateTargetGroupInput returns the input for creating a target
+// group with the given name.
+func GenerateCreateTargetGroupInput(name string, p v1alpha1.TargetGroupParameters) *elbv2.CreateTargetGroupInput {
+	in := &elbv2.CreateTargetGroupInput{
+		Name:                       aws.String(name),
+		TargetType:                 elbv2.TargetTypeEnum(aws.StringValue(p.TargetType)),
+		Protocol:                   elbv2.ProtocolEnum(aws.StringValue(p.Protocol)),
+		Port:                       p.Port,
+		VpcId:                      p.VPCID,
+		HealthCheckEnabled:         p.HealthCheckEnabled,
+		HealthCheckProtocol:        elbv2.ProtocolEnum(aws.StringValue(p.HealthCheckProtocol)),
+		HealthCheckPort:            p.HealthCheckPort,
+		HealthCheckPath:            p.HealthCheckPath,
+		HealthCheckIntervalSeconds: p.HealthCheckIntervalSeconds,
+		HealthCheckTimeoutSeconds:  p.HealthCheckTimeoutSeconds,
+		HealthyThresholdCount:      p.HealthyThresholdCount,
+		UnhealthyThresholdCount:    p.UnhealthyThresholdCount,
+	}
+	if p.Matcher != nil {
+		in.Matcher = &elbv2.Matcher{HttpCode: p.Matcher}
+	}
+	return in
+}
+
+// GenerateModifyTargetGroupInput returns the input for updating the health
+// check configuration of the target group with the given ARN.
+func GenerateModifyTargetGroupInput(arn string, p v1alpha1.TargetGroupParameters) *elbv2.ModifyTargetGroupInput {
+	in := &elbv2.ModifyTargetGroupInput{
+		TargetGroupArn:             aws.String(arn),
+		HealthCheckEnabled:         p.HealthCheckEnabled,
+		HealthCheckProtocol:        elbv2.ProtocolEnum(aws.StringValue(p.HealthCheckProtocol)),
+		HealthCheckPort:            p.HealthCheckPort,
+		HealthCheckPath:            p.HealthCheckPath,
+		HealthCheckIntervalSeconds: p.HealthCheckIntervalSeconds,
+		HealthCheckTimeoutSeconds:  p.HealthCheckTimeoutSeconds,
+		HealthyThresholdCount:      p.HealthyThresholdCount,
+		UnhealthyThresholdCount:    p.UnhealthyThresholdCount,
+	}
+	if p.Matcher != nil {
+		in.Matcher = &elbv2.Matcher{HttpCode: p.Matcher}
+	}
+	return in
+}
+
+// LateInitializeTargetGroup fills the empty fields in
+// *v1alpha1.TargetGroupParameters with the values seen in elbv2.TargetGroup.
+func LateInitializeTargetGroup(p *v1alpha1.TargetGroupParameters, tg elbv2.TargetGroup) {
+	p.TargetType = lateInitializeEnum(p.TargetType, string(tg.TargetType))
+	p.Protocol = lateInitializeEnum(p.Protocol, string(tg.Protocol))
+	p.Port = clients.LateInitializeInt64Ptr(p.Port, tg.Port)
+	p.VPCID = clients.LateInitializeStringPtr(p.VPCID, tg.VpcId)
+	p.HealthCheckEnabled = clients.LateInitializeBoolPtr(p.HealthCheckEnabled, tg.HealthCheckEnabled)
+	p.HealthCheckProtocol = lateInitializeEnum(p.HealthCheckProtocol, string(tg.HealthCheckProtocol))
+	p.HealthCheckPort = clients.LateInitializeStringPtr(p.HealthCheckPort, tg.HealthCheckPort)
+	p.HealthCheckPath = clients.LateInitializeStringPtr(p.HealthCheckPath, tg.HealthCheckPath)
+	p.HealthCheckIntervalSeconds = clients.LateInitializeInt64Ptr(p.HealthCheckIntervalSeconds, tg.HealthCheckIntervalSeconds)
+	p.HealthCheckTimeoutSeconds = clients.LateInitializeInt64Ptr(p.HealthCheckTimeoutSeconds, tg.HealthCheckTimeoutSeconds)
+	p.HealthyThresholdCount = clients.LateInitializeInt64Ptr(p.HealthyThresholdCount, tg.HealthyThresholdCount)
+	p.UnhealthyThresholdCount = clients.LateInitializeInt64Ptr(p.UnhealthyThresholdCount, tg.UnhealthyThresholdCount)
+	if tg.Matcher != nil {
+		p.Matcher = clients.LateInitializeStringPtr(p.Matcher, tg.Matcher.HttpCode)
+	}
+}
+
+// IsTargetGroupUpToDate returns true if the health check configuration and
+// the tags of the target group match the desired state.
+func IsTargetGroupUpToDate(p v1alpha1.TargetGroupParameters, tg elbv2.TargetGroup, tags []elbv2.Tag) bool { // nolint:gocyclo
+	switch {
+	case aws.BoolValue(p.HealthCheckEnabled) != aws.BoolValue(tg.HealthCheckEnabled),
+		aws.StringValue(p.HealthCheckProtocol) != string(tg.HealthCheckProtocol),
+		aws.StringValue(p.HealthCheckPort) != aws.StringValue(tg.HealthCheckPort),
+		aws.StringValue(p.HealthCheckPath) != aws.StringValue(tg.HealthCheckPath),
+		aws.Int64Value(p.HealthCheckIntervalSeconds) != aws.Int64Value(tg.HealthCheckIntervalSeconds),
+		aws.Int64Value(p.HealthCheckTimeoutSeconds) != aws.Int64Value(tg.HealthCheckTimeoutSeconds),
+		aws.Int64Value(p.HealthyThresholdCount) != aws.Int64Value(tg.HealthyThresholdCount),
+		aws.Int64Value(p.UnhealthyThresholdCount) != aws.Int64Value(tg.UnhealthyThresholdCount):
+		return false
+	}
+	if p.Matcher != nil && (tg.Matcher == nil || aws.StringValue(p.Matcher) != aws.StringValue(tg.Matcher.HttpCode)) {
+		return false
+	}
+	add, remove := DiffTags(p.Tags, tags)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// GenerateTargetGroupObservation is used to produce
+// v1alpha1.TargetGroupObservation from elbv2.TargetGroup.
+func GenerateTargetGroupObservation(tg elbv2.TargetGroup) v1alpha1.TargetGroupObservation {
+	return v1alpha1.TargetGroupObservation{
+		TargetGroupARN:   aws.StringValue(tg.TargetGroupArn),
+		LoadBalancerARNs: tg.LoadBalancerArns,
+	}
+}
+
+// GenerateActions generates a list of elbv2.Action from the given list of
+// v1alpha1.Action.
+func GenerateActions(actions []v1alpha1.Action) []elbv2.Action {
+	if len(actions) == 0 {
+		return nil
+	}
+	res := make([]elbv2.Action, len(actions))
+	for i, a := range actions {
+		res[i] = elbv2.Action{
+			Type:           elbv2.ActionTypeEnum(a.Type),
+			Order:          a.Order,
+			TargetGroupArn: a.TargetGroupARN,
+		}
+		if a.RedirectConfig != nil {
+			res[i].RedirectConfig = &elbv2.RedirectActionConfig{
+				Host:       a.RedirectConfig.Host,
+				Path:       a.RedirectConfig.Path,
+				Port:       a.RedirectConfig.Port,
+				Protocol:   a.RedirectConfig.Protocol,
+				Query:      a.RedirectConfig.Query,
+				StatusCode: elbv2.RedirectActionStatusCodeEnum(a.RedirectConfig.StatusCode),
+			}
+		}
+		if a.FixedResponseConfig != nil {
+			res[i].FixedResponseConfig = &elbv2.FixedResponseActionConfig{
+				ContentType: a.FixedResponseConfig.ContentType,
+				MessageBody: a.FixedResponseConfig.MessageBody,
+				StatusCode:  aws.String(a.FixedResponseConfig.StatusCode),
+			}
+		}
+	}
+	return res
+}
+
+// AreActionsUpToDate returns true if the observed actions match the desired
+// ones. Optional fields that are not specified in the desired actions are
+// ignored since AWS fills them with defaults.
+func AreActionsUpToDate(desired []v1alpha1.Action, observed []elbv2.Action) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	for i := range desired {
+		if !isActionUpToDate(desired[i], observed[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isActionUpToDate(d v1alpha1.Action, o elbv2.Action) bool { // nolint:gocyclo
+	if d.Type != string(o.Type) {
+		return false
+	}
+	if d.Order != nil && aws.Int64Value(d.Order) != aws.Int64Value(o.Order) {
+		return false
+	}
+	if d.TargetGroupARN != nil && aws.StringValue(d.TargetGroupARN) != aws.StringValue(o.TargetGroupArn) {
+		return false
+	}
+	if d.RedirectConfig != nil {
+		r := o.RedirectConfig
+		if r == nil || d.RedirectConfig.StatusCode != string(r.StatusCode) ||
+			!isOptionalStringUpToDate(d.RedirectConfig.Host, r.Host) ||
+			!isOptionalStringUpToDate(d.RedirectConfig.Path, r.Path) ||
+			!isOptionalStringUpToDate(d.RedirectConfig.Port, r.Port) ||
+			!isOptionalStringUpToDate(d.RedirectConfig.Protocol, r.Protocol) ||
+			!isOptionalStringUpToDate(d.RedirectConfig.Query, r.Query) {
+			return false
+		}
+	}
+	if d.FixedResponseConfig != nil {
+		f := o.FixedResponseConfig
+		if f == nil || d.FixedResponseConfig.StatusCode != aws.StringValue(f.StatusCode) ||
+			!isOptionalStringUpToDate(d.FixedResponseConfig.ContentType, f.ContentType) ||
+			!isOptionalStringUpToDate(d.FixedResponseConfig.MessageBody, f.MessageBody) {
+			return false
+		}
+	}
+	return true
+}
+
+// GenerateCreateListenerInput returns the input for creating a listener.
+func GenerateCreateListenerInput(p v1alpha1.ListenerParameters) *elbv2.CreateListenerInput {
+	return &elbv2.CreateListenerInput{
+		LoadBalancerArn: p.LoadBalancerARN,
+		Port:            aws.Int64(p.Port),
+		Protocol:        elbv2.ProtocolEnum(p.Protocol),
+		SslPolicy:       p.SSLPolicy,
+		Certificates:    generateCertificates(p.CertificateARN),
+		DefaultActions:  GenerateActions(p.DefaultActions),
+	}
+}
+
+// GenerateModifyListenerInput returns the input for updating the listener
+// with the given ARN.
+func GenerateModifyListenerInput(arn string, p v1alpha1.ListenerParameters) *elbv2.ModifyListenerInput {
+	return &elbv2.ModifyListenerInput{
+		ListenerArn:    aws.String(arn),
+		Port:           aws.Int64(p.Port),
+		Protocol:       elbv2.ProtocolEnum(p.Protocol),
+		SslPolicy:      p.SSLPolicy,
+		Certificates:   generateCertificates(p.CertificateARN),
+		DefaultActions: GenerateActions(p.DefaultActions),
+	}
+}
+
+// LateInitializeListener fills the empty fields in
+// *v1alpha1.ListenerParameters with the values seen in elbv2.Listener.
+func LateInitializeListener(p *v1alpha1.ListenerParameters, l elbv2.Listener) {
+	p.SSLPolicy = clients.LateInitializeStringPtr(p.SSLPolicy, l.SslPolicy)
+	if len(l.Certificates) != 0 {
+		p.CertificateARN = clients.LateInitializeStringPtr(p.CertificateARN, l.Certificates[0].CertificateArn)
+	}
+}
+
+// IsListenerUpToDate returns true if the listener matches the desired state.
+func IsListenerUpToDate(p v1alpha1.ListenerParameters, l elbv2.Listener) bool {
+	if p.Port != aws.Int64Value(l.Port) || p.Protocol != string(l.Protocol) {
+		return false
+	}
+	if !isOptionalStringUpToDate(p.SSLPolicy, l.SslPolicy) {
+		return false
+	}
+	if p.CertificateARN != nil && (len(l.Certificates) == 0 ||
+		aws.StringValue(p.CertificateARN) != aws.StringValue(l.Certificates[0].CertificateArn)) {
+		return false
+	}
+	return AreActionsUpToDate(p.DefaultActions, l.DefaultActions)
+}
+
+// GenerateRuleConditions generates a list of elbv2.RuleCondition from the
+// given list of v1alpha1.RuleCondition.
+func GenerateRuleConditions(conditions []v1alpha1.RuleCondition) []elbv2.RuleCondition {
+	if len(conditions) == 0 {
+		return nil
+	}
+	res := make([]elbv2.RuleCondition, len(conditions))
+	for i, c := range conditions {
+		res[i] = elbv2.RuleCondition{Field: aws.String(c.Field)}
+		switch c.Field {
+		case "host-header":
+			res[i].HostHeaderConfig = &elbv2.HostHeaderConditionConfig{Values: c.Values}
+		case "path-pattern":
+			res[i].PathPatternConfig = &elbv2.PathPatternConditionConfig{Values: c.Values}
+		case "http-header":
+			res[i].HttpHeaderConfig = &elbv2.HttpHeaderConditionConfig{HttpHeaderName: c.HTTPHeaderName, Values: c.Values}
+		case "http-request-method":
+			res[i].HttpRequestMethodConfig = &elbv2.HttpRequestMethodConditionConfig{Values: c.Values}
+		case "source-ip":
+			res[i].SourceIpConfig = &elbv2.SourceIpConditionConfig{Values: c.Values}
+		case "query-string":
+			pairs := make([]elbv2.QueryStringKeyValuePair, len(c.QueryStringValues))
+			for j, q := range c.QueryStringValues {
+				pairs[j] = elbv2.QueryStringKeyValuePair{Key: q.Key, Value: aws.String(q.Value)}
+			}
+			res[i].QueryStringConfig = &elbv2.QueryStringConditionConfig{Values: pairs}
+		}
+	}
+	return res
+}
+
+// GenerateCreateRuleInput returns the input for creating a listener rule.
+func GenerateCreateRuleInput(p v1alpha1.ListenerRuleParameters) *elbv2.CreateRuleInput {
+	return &elbv2.CreateRuleInput{
+		ListenerArn: p.ListenerARN,
+		Priority:    aws.Int64(p.Priority),
+		Conditions:  GenerateRuleConditions(p.Conditions),
+		Actions:     GenerateActions(p.Actions),
+	}
+}
+
+// GenerateModifyRuleInput returns the input for updating the conditions and
+// the actions of the rule with the given ARN.
+func GenerateModifyRuleInput(arn string, p v1alpha1.ListenerRuleParameters) *elbv2.ModifyRuleInput {
+	return &elbv2.ModifyRuleInput{
+		RuleArn:    aws.String(arn),
+		Conditions: GenerateRuleConditions(p.Conditions),
+		Actions:    GenerateActions(p.Actions),
+	}
+}
+
+// IsRulePriorityUpToDate returns true if the priority of the rule matches the
+// desired one.
+func IsRulePriorityUpToDate(p v1alpha1.ListenerRuleParameters, r elbv2.Rule) bool {
+	return strconv.FormatInt(p.Priority, 10) == aws.StringValue(r.Priority)
+}
+
+// IsRuleUpToDate returns true if the conditions and the actions of the rule
+// match the desired state.
+func IsRuleUpToDate(p v1alpha1.ListenerRuleParameters, r elbv2.Rule) bool {
+	if len(p.Conditions) != len(r.Conditions) {
+		return false
+	}
+	for i := range p.Conditions {
+		if !isRuleConditionUpToDate(p.Conditions[i], r.Conditions[i]) {
+			return false
+		}
+	}
+	return AreActionsUpToDate(p.Actions, r.Actions)
+}
+
+func isRuleConditionUpToDate(d v1alpha1.RuleCondition, o elbv2.RuleCondition) bool { // nolint:gocyclo
+	if d.Field != aws.StringValue(o.Field) {
+		return false
+	}
+	values := o.Values
+	switch {
+	case o.HostHeaderConfig != nil:
+		values = o.HostHeaderConfig.Values
+	case o.PathPatternConfig != nil:
+		values = o.PathPatternConfig.Values
+	case o.HttpHeaderConfig != nil:
+		if aws.StringValue(d.HTTPHeaderName) != aws.StringValue(o.HttpHeaderConfig.HttpHeaderName) {
+			return false
+		}
+		values = o.HttpHeaderConfig.Values
+	case o.HttpRequestMethodConfig != nil:
+		values = o.HttpRequestMethodConfig.Values
+	case o.SourceIpConfig != nil:
+		values = o.SourceIpConfig.Values
+	case o.QueryStringConfig != nil:
+		if len(d.QueryStringValues) != len(o.QueryStringConfig.Values) {
+			return false
+		}
+		for i, q := range o.QueryStringConfig.Values {
+			if aws.StringValue(d.QueryStringValues[i].Key) != aws.StringValue(q.Key) ||
+				d.QueryStringValues[i].Value != aws.StringValue(q.Value) {
+				return false
+			}
+		}
+	}
+	return AreStringSetsEqual(d.Values, values)
+}
+
+func generateCertificates(arn *string) []elbv2.Certificate {
+	if arn == nil {
+		return nil
+	}
+	return []elbv2.Certificate{{CertificateArn: arn}}
+}
+
+// isOptionalStringUpToDate returns true if the desired value is not set or
+// equals the observed one.
+func isOptionalStringUpToDate(desired, observed *string) bool {
+	return desired == nil || aws.StringValue(desired) == aws.StringValue(observed)
+}
+
+func lateInitializeEnum(in *string, from string) *string {
+	if in != nil || from == "" {
+		return in
+	}
+	return aws.String(from)
+}
+
+// AreStringSetsEqual returns true if both slices contain the same elements
+// regardless of their order.
+func AreStringSetsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	m := make(map[string]int, len(a))
+	for _, s := range a {
+		m[s]++
+	}
+	for _, s := range b {
+		if m[s] == 0 {
+			return false
+		}
+		m[s]--
+	}
+	return true
+}
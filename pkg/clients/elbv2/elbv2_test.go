@@ -0,0 +1,233 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []elbv2.Tag
+		remove []string
+	}
+
+	cases := map[string]struct {
+		desired  []v1alpha1.Tag
+		observed []elbv2.Tag
+		want     want
+	}{
+		"NoChange": {
+			desired:  []v1alpha1.Tag{{Key: "k", Value: aws.String("v")}},
+			observed: []elbv2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+		},
+		"AddUpdateRemove": {
+			desired: []v1alpha1.Tag{
+				{Key: "new", Value: aws.String("v")},
+				{Key: "changed", Value: aws.String("v2")},
+			},
+			observed: []elbv2.Tag{
+				{Key: aws.String("changed"), Value: aws.String("v1")},
+				{Key: aws.String("old"), Value: aws.String("v")},
+			},
+			want: want{
+				add: []elbv2.Tag{
+					{Key: aws.String("new"), Value: aws.String("v")},
+					{Key: aws.String("changed"), Value: aws.String("v2")},
+				},
+				remove: []string{"old"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAreActionsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired  []v1alpha1.Action
+		observed []elbv2.Action
+		want     bool
+	}{
+		"ForwardUpToDate": {
+			desired:  []v1alpha1.Action{{Type: "forward", TargetGroupARN: aws.String("tg")}},
+			observed: []elbv2.Action{{Type: elbv2.ActionTypeEnumForward, Order: aws.Int64(1), TargetGroupArn: aws.String("tg")}},
+			want:     true,
+		},
+		"ForwardTargetGroupChanged": {
+			desired:  []v1alpha1.Action{{Type: "forward", TargetGroupARN: aws.String("tg")}},
+			observed: []elbv2.Action{{Type: elbv2.ActionTypeEnumForward, TargetGroupArn: aws.String("other")}},
+			want:     false,
+		},
+		"RedirectWithDefaults": {
+			desired: []v1alpha1.Action{{Type: "redirect", RedirectConfig: &v1alpha1.RedirectActionConfig{
+				Protocol:   aws.String("HTTPS"),
+				Port:       aws.String("443"),
+				StatusCode: "HTTP_301",
+			}}},
+			observed: []elbv2.Action{{Type: elbv2.ActionTypeEnumRedirect, RedirectConfig: &elbv2.RedirectActionConfig{
+				Host:       aws.String("#{host}"),
+				Path:       aws.String("/#{path}"),
+				Port:       aws.String("443"),
+				Protocol:   aws.String("HTTPS"),
+				Query:      aws.String("#{query}"),
+				StatusCode: elbv2.RedirectActionStatusCodeEnumHttp301,
+			}}},
+			want: true,
+		},
+		"FixedResponseChanged": {
+			desired: []v1alpha1.Action{{Type: "fixed-response", FixedResponseConfig: &v1alpha1.FixedResponseActionConfig{
+				StatusCode: "404",
+			}}},
+			observed: []elbv2.Action{{Type: elbv2.ActionTypeEnumFixedResponse, FixedResponseConfig: &elbv2.FixedResponseActionConfig{
+				StatusCode: aws.String("503"),
+			}}},
+			want: false,
+		},
+		"DifferentLength": {
+			desired: []v1alpha1.Action{{Type: "forward", TargetGroupARN: aws.String("tg")}},
+			want:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := AreActionsUpToDate(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsRuleUpToDate(t *testing.T) {
+	actions := []v1alpha1.Action{{Type: "forward", TargetGroupARN: aws.String("tg")}}
+	observedActions := []elbv2.Action{{Type: elbv2.ActionTypeEnumForward, TargetGroupArn: aws.String("tg")}}
+
+	cases := map[string]struct {
+		p    v1alpha1.ListenerRuleParameters
+		r    elbv2.Rule
+		want bool
+	}{
+		"HostHeaderUpToDate": {
+			p: v1alpha1.ListenerRuleParameters{
+				Conditions: []v1alpha1.RuleCondition{{Field: "host-header", Values: []string{"b.example.com", "a.example.com"}}},
+				Actions:    actions,
+			},
+			r: elbv2.Rule{
+				Conditions: []elbv2.RuleCondition{{
+					Field:            aws.String("host-header"),
+					HostHeaderConfig: &elbv2.HostHeaderConditionConfig{Values: []string{"a.example.com", "b.example.com"}},
+				}},
+				Actions: observedActions,
+			},
+			want: true,
+		},
+		"HTTPHeaderNameChanged": {
+			p: v1alpha1.ListenerRuleParameters{
+				Conditions: []v1alpha1.RuleCondition{{Field: "http-header", HTTPHeaderName: aws.String("X-Env"), Values: []string{"prod"}}},
+				Actions:    actions,
+			},
+			r: elbv2.Rule{
+				Conditions: []elbv2.RuleCondition{{
+					Field:            aws.String("http-header"),
+					HttpHeaderConfig: &elbv2.HttpHeaderConditionConfig{HttpHeaderName: aws.String("X-Stage"), Values: []string{"prod"}},
+				}},
+				Actions: observedActions,
+			},
+			want: false,
+		},
+		"QueryStringUpToDate": {
+			p: v1alpha1.ListenerRuleParameters{
+				Conditions: []v1alpha1.RuleCondition{{Field: "query-string", QueryStringValues: []v1alpha1.QueryStringKeyValuePair{{Key: aws.String("v"), Value: "2"}}}},
+				Actions:    actions,
+			},
+			r: elbv2.Rule{
+				Conditions: []elbv2.RuleCondition{{
+					Field:             aws.String("query-string"),
+					QueryStringConfig: &elbv2.QueryStringConditionConfig{Values: []elbv2.QueryStringKeyValuePair{{Key: aws.String("v"), Value: aws.String("2")}}},
+				}},
+				Actions: observedActions,
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsRuleUpToDate(tc.p, tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeTargetGroup(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.TargetGroupParameters
+		tg   elbv2.TargetGroup
+		want v1alpha1.TargetGroupParameters
+	}{
+		"FillsEmptyFields": {
+			p: v1alpha1.TargetGroupParameters{
+				HealthCheckPath: aws.String("/healthz"),
+			},
+			tg: elbv2.TargetGroup{
+				TargetType:          elbv2.TargetTypeEnumIp,
+				Protocol:            elbv2.ProtocolEnumHttp,
+				Port:                aws.Int64(8080),
+				HealthCheckPath:     aws.String("/"),
+				HealthCheckProtocol: elbv2.ProtocolEnumHttp,
+				Matcher:             &elbv2.Matcher{HttpCode: aws.String("200")},
+			},
+			want: v1alpha1.TargetGroupParameters{
+				TargetType:          aws.String("ip"),
+				Protocol:            aws.String("HTTP"),
+				Port:                aws.Int64(8080),
+				HealthCheckPath:     aws.String("/healthz"),
+				HealthCheckProtocol: aws.String("HTTP"),
+				Matcher:             aws.String("200"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeTargetGroup(&tc.p, tc.tg)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optiongroup
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client is the external client used for OptionGroup Custom Resource
+type Client interface {
+	CreateOptionGroupRequest(*rds.CreateOptionGroupInput) rds.CreateOptionGroupRequest
+	DescribeOptionGroupsRequest(*rds.DescribeOptionGroupsInput) rds.DescribeOptionGroupsRequest
+	ModifyOptionGroupRequest(*rds.ModifyOptionGroupInput) rds.ModifyOptionGroupRequest
+	DeleteOptionGroupRequest(*rds.DeleteOptionGroupInput) rds.DeleteOptionGroupRequest
+}
+
+// NewClient returns a new client using AWS credentials as JSON encoded data.
+func NewClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (Client, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return rds.New(*cfg), nil
+}
+
+// IsNotFound returns true if the error is because the option group doesn't
+// exist.
+func IsNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), rds.ErrCodeOptionGroupNotFoundFault)
+}
+
+// GenerateCreateOptionGroupInput from OptionGroupParameters.
+func GenerateCreateOptionGroupInput(name string, p v1beta1.OptionGroupParameters) *rds.CreateOptionGroupInput {
+	c := &rds.CreateOptionGroupInput{
+		OptionGroupName:        aws.String(name),
+		EngineName:             aws.String(p.EngineName),
+		MajorEngineVersion:     aws.String(p.MajorEngineVersion),
+		OptionGroupDescription: aws.String(p.Description),
+	}
+	if len(p.Tags) != 0 {
+		c.Tags = make([]rds.Tag, len(p.Tags))
+		for i, t := range p.Tags {
+			c.Tags[i] = rds.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+		}
+	}
+	return c
+}
+
+// GenerateModifyOptionGroupInput returns the input to bring the observed
+// option group to the desired state. It returns nil if there is nothing to
+// modify.
+func GenerateModifyOptionGroupInput(name string, p v1beta1.OptionGroupParameters, og rds.OptionGroup) *rds.ModifyOptionGroupInput {
+	include := GetOptionsToInclude(p.Options, og.Options)
+	remove := GetOptionsToRemove(p.Options, og.Options)
+	if len(include) == 0 && len(remove) == 0 {
+		return nil
+	}
+	return &rds.ModifyOptionGroupInput{
+		OptionGroupName:  aws.String(name),
+		ApplyImmediately: p.ApplyImmediately,
+		OptionsToInclude: include,
+		OptionsToRemove:  remove,
+	}
+}
+
+// GenerateObservation is used to produce v1beta1.OptionGroupObservation from
+// rds.OptionGroup.
+func GenerateObservation(og rds.OptionGroup) v1beta1.OptionGroupObservation {
+	o := v1beta1.OptionGroupObservation{
+		OptionGroupARN: aws.StringValue(og.OptionGroupArn),
+		VPCID:          aws.StringValue(og.VpcId),
+	}
+	if len(og.Options) == 0 {
+		return o
+	}
+	o.Options = make([]v1beta1.OptionObservation, len(og.Options))
+	for i, opt := range og.Options {
+		obs := v1beta1.OptionObservation{
+			OptionName:    aws.StringValue(opt.OptionName),
+			OptionVersion: aws.StringValue(opt.OptionVersion),
+			Port:          int(aws.Int64Value(opt.Port)),
+			Permanent:     aws.BoolValue(opt.Permanent),
+			Persistent:    aws.BoolValue(opt.Persistent),
+		}
+		for _, s := range opt.OptionSettings {
+			obs.OptionSettings = append(obs.OptionSettings, v1beta1.OptionSetting{
+				Name:  aws.StringValue(s.Name),
+				Value: aws.StringValue(s.Value),
+			})
+		}
+		for _, m := range opt.DBSecurityGroupMemberships {
+			obs.DBSecurityGroupMemberships = append(obs.DBSecurityGroupMemberships, v1beta1.DBSecurityGroupMembership{
+				DBSecurityGroupName: aws.StringValue(m.DBSecurityGroupName),
+				Status:              aws.StringValue(m.Status),
+			})
+		}
+		for _, m := range opt.VpcSecurityGroupMemberships {
+			obs.VPCSecurityGroupMemberships = append(obs.VPCSecurityGroupMemberships, v1beta1.VPCSecurityGroupMembership{
+				VPCSecurityGroupID: aws.StringValue(m.VpcSecurityGroupId),
+				Status:             aws.StringValue(m.Status),
+			})
+		}
+		o.Options[i] = obs
+	}
+	return o
+}
+
+// GetOptionsToInclude returns the desired options that are either missing in
+// the observed option group or configured differently.
+func GetOptionsToInclude(desired []v1beta1.OptionConfiguration, observed []rds.Option) []rds.OptionConfiguration {
+	current := make(map[string]rds.Option, len(observed))
+	for _, o := range observed {
+		current[aws.StringValue(o.OptionName)] = o
+	}
+	var res []rds.OptionConfiguration
+	for _, d := range desired {
+		if o, ok := current[d.OptionName]; ok && isOptionUpToDate(d, o) {
+			continue
+		}
+		res = append(res, GenerateOptionConfiguration(d))
+	}
+	return res
+}
+
+// GetOptionsToRemove returns the names of the observed options that are not
+// desired anymore. Permanent options are never removed since AWS does not
+// allow that.
+func GetOptionsToRemove(desired []v1beta1.OptionConfiguration, observed []rds.Option) []string {
+	names := make(map[string]struct{}, len(desired))
+	for _, d := range desired {
+		names[d.OptionName] = struct{}{}
+	}
+	var res []string
+	for _, o := range observed {
+		if _, ok := names[aws.StringValue(o.OptionName)]; ok || aws.BoolValue(o.Permanent) {
+			continue
+		}
+		res = append(res, aws.StringValue(o.OptionName))
+	}
+	return res
+}
+
+// IsUpToDate checks whether the observed options match the desired ones.
+func IsUpToDate(p v1beta1.OptionGroupParameters, og rds.OptionGroup) bool {
+	return len(GetOptionsToInclude(p.Options, og.Options)) == 0 && len(GetOptionsToRemove(p.Options, og.Options)) == 0
+}
+
+// GenerateOptionConfiguration converts the given v1beta1.OptionConfiguration
+// into rds.OptionConfiguration.
+func GenerateOptionConfiguration(d v1beta1.OptionConfiguration) rds.OptionConfiguration {
+	c := rds.OptionConfiguration{
+		OptionName:                  aws.String(d.OptionName),
+		OptionVersion:               d.OptionVersion,
+		Port:                        awsclients.Int64Address(d.Port),
+		DBSecurityGroupMemberships:  d.DBSecurityGroupMemberships,
+		VpcSecurityGroupMemberships: d.VPCSecurityGroupMemberships,
+	}
+	for _, s := range d.OptionSettings {
+		c.OptionSettings = append(c.OptionSettings, rds.OptionSetting{
+			Name:  aws.String(s.Name),
+			Value: aws.String(s.Value),
+		})
+	}
+	return c
+}
+
+// isOptionUpToDate compares only the fields that are specified in the desired
+// configuration, since AWS fills the rest with their defaults.
+func isOptionUpToDate(d v1beta1.OptionConfiguration, o rds.Option) bool {
+	if d.OptionVersion != nil && aws.StringValue(d.OptionVersion) != aws.StringValue(o.OptionVersion) {
+		return false
+	}
+	if d.Port != nil && int64(*d.Port) != aws.Int64Value(o.Port) {
+		return false
+	}
+	settings := make(map[string]string, len(o.OptionSettings))
+	for _, s := range o.OptionSettings {
+		settings[aws.StringValue(s.Name)] = aws.StringValue(s.Value)
+	}
+	for _, s := range d.OptionSettings {
+		if v, ok := settings[s.Name]; !ok || v != s.Value {
+			return false
+		}
+	}
+	dbsgs := make([]string, len(o.DBSecurityGroupMemberships))
+	for i, m := range o.DBSecurityGroupMemberships {
+		dbsgs[i] = aws.StringValue(m.DBSecurityGroupName)
+	}
+	vpcsgs := make([]string, len(o.VpcSecurityGroupMemberships))
+	for i, m := range o.VpcSecurityGroupMemberships {
+		vpcsgs[i] = aws.StringValue(m.VpcSecurityGroupId)
+	}
+	return areStringSetsEqual(d.DBSecurityGroupMemberships, dbsgs) && areStringSetsEqual(d.VPCSecurityGroupMemberships, vpcsgs)
+}
+
+func areStringSetsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sa := append([]string{}, a...)
+	sb := append([]string{}, b...)
+	sort.Strings(sa)
+	sort.Strings(sb)
+	for i := range sa {
+		if sa[i] != sb[i] {
+			return false
+		}
+	}
+	return true
+}
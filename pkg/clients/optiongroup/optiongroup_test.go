@@ -0,0 +1,145 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optiongroup
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+)
+
+var (
+	optionName = "SQLSERVER_BACKUP_RESTORE"
+	roleARN    = "arn:aws:iam::123456789012:role/backup"
+	port       = 1433
+)
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1beta1.OptionGroupParameters
+		og   rds.OptionGroup
+		want bool
+	}{
+		"UpToDate": {
+			p: v1beta1.OptionGroupParameters{Options: []v1beta1.OptionConfiguration{{
+				OptionName:                  optionName,
+				OptionSettings:              []v1beta1.OptionSetting{{Name: "IAM_ROLE_ARN", Value: roleARN}},
+				VPCSecurityGroupMemberships: []string{"sg-2", "sg-1"},
+			}}},
+			og: rds.OptionGroup{Options: []rds.Option{{
+				OptionName:    aws.String(optionName),
+				OptionVersion: aws.String("1.0"),
+				OptionSettings: []rds.OptionSetting{
+					{Name: aws.String("IAM_ROLE_ARN"), Value: aws.String(roleARN)},
+					{Name: aws.String("OTHER"), Value: aws.String("default")},
+				},
+				VpcSecurityGroupMemberships: []rds.VpcSecurityGroupMembership{
+					{VpcSecurityGroupId: aws.String("sg-1")},
+					{VpcSecurityGroupId: aws.String("sg-2")},
+				},
+			}}},
+			want: true,
+		},
+		"SettingChanged": {
+			p: v1beta1.OptionGroupParameters{Options: []v1beta1.OptionConfiguration{{
+				OptionName:     optionName,
+				OptionSettings: []v1beta1.OptionSetting{{Name: "IAM_ROLE_ARN", Value: roleARN}},
+			}}},
+			og: rds.OptionGroup{Options: []rds.Option{{
+				OptionName:     aws.String(optionName),
+				OptionSettings: []rds.OptionSetting{{Name: aws.String("IAM_ROLE_ARN"), Value: aws.String("old")}},
+			}}},
+			want: false,
+		},
+		"PortChanged": {
+			p: v1beta1.OptionGroupParameters{Options: []v1beta1.OptionConfiguration{{OptionName: optionName, Port: &port}}},
+			og: rds.OptionGroup{Options: []rds.Option{{
+				OptionName: aws.String(optionName),
+				Port:       aws.Int64(1434),
+			}}},
+			want: false,
+		},
+		"ExtraOption": {
+			p:    v1beta1.OptionGroupParameters{},
+			og:   rds.OptionGroup{Options: []rds.Option{{OptionName: aws.String(optionName)}}},
+			want: false,
+		},
+		"ExtraPermanentOption": {
+			p:    v1beta1.OptionGroupParameters{},
+			og:   rds.OptionGroup{Options: []rds.Option{{OptionName: aws.String("TDE"), Permanent: aws.Bool(true)}}},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.p, tc.og)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateModifyOptionGroupInput(t *testing.T) {
+	name := "example"
+
+	cases := map[string]struct {
+		p    v1beta1.OptionGroupParameters
+		og   rds.OptionGroup
+		want *rds.ModifyOptionGroupInput
+	}{
+		"NothingToDo": {
+			p:  v1beta1.OptionGroupParameters{Options: []v1beta1.OptionConfiguration{{OptionName: optionName}}},
+			og: rds.OptionGroup{Options: []rds.Option{{OptionName: aws.String(optionName)}}},
+		},
+		"IncludeAndRemove": {
+			p: v1beta1.OptionGroupParameters{
+				ApplyImmediately: aws.Bool(true),
+				Options: []v1beta1.OptionConfiguration{{
+					OptionName:     optionName,
+					Port:           &port,
+					OptionSettings: []v1beta1.OptionSetting{{Name: "IAM_ROLE_ARN", Value: roleARN}},
+				}},
+			},
+			og: rds.OptionGroup{Options: []rds.Option{{OptionName: aws.String("TDE")}}},
+			want: &rds.ModifyOptionGroupInput{
+				OptionGroupName:  aws.String(name),
+				ApplyImmediately: aws.Bool(true),
+				OptionsToInclude: []rds.OptionConfiguration{{
+					OptionName:     aws.String(optionName),
+					Port:           aws.Int64(int64(port)),
+					OptionSettings: []rds.OptionSetting{{Name: aws.String("IAM_ROLE_ARN"), Value: aws.String(roleARN)}},
+				}},
+				OptionsToRemove: []string{"TDE"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyOptionGroupInput("example", tc.p, tc.og)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
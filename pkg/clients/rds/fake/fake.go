@@ -17,6 +17,9 @@ limitations under the License.
 package fake
 
 import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 )
 
@@ -27,6 +30,14 @@ type MockRDSClient struct {
 	MockModify   func(*rds.ModifyDBInstanceInput) rds.ModifyDBInstanceRequest
 	MockDelete   func(*rds.DeleteDBInstanceInput) rds.DeleteDBInstanceRequest
 	MockAddTags  func(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	MockReboot   func(*rds.RebootDBInstanceInput) rds.RebootDBInstanceRequest
+
+	MockRestoreFromSnapshot  func(*rds.RestoreDBInstanceFromDBSnapshotInput) rds.RestoreDBInstanceFromDBSnapshotRequest
+	MockRestoreToPointInTime func(*rds.RestoreDBInstanceToPointInTimeInput) rds.RestoreDBInstanceToPointInTimeRequest
+	MockCreateReadReplica    func(*rds.CreateDBInstanceReadReplicaInput) rds.CreateDBInstanceReadReplicaRequest
+	MockPromoteReadReplica   func(*rds.PromoteReadReplicaInput) rds.PromoteReadReplicaRequest
+	MockGetMetricStatistics  func(*cloudwatch.GetMetricStatisticsInput) cloudwatch.GetMetricStatisticsRequest
+	MockBuildAuthToken       func(ctx context.Context, endpoint, dbUser string) (string, error)
 }
 
 // DescribeDBInstancesRequest finds RDS Instance by name
@@ -53,3 +64,39 @@ func (m *MockRDSClient) DeleteDBInstanceRequest(i *rds.DeleteDBInstanceInput) rd
 func (m *MockRDSClient) AddTagsToResourceRequest(i *rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest {
 	return m.MockAddTags(i)
 }
+
+// RebootDBInstanceRequest reboots RDS Instance.
+func (m *MockRDSClient) RebootDBInstanceRequest(i *rds.RebootDBInstanceInput) rds.RebootDBInstanceRequest {
+	return m.MockReboot(i)
+}
+
+// RestoreDBInstanceFromDBSnapshotRequest restores RDS Instance from a snapshot.
+func (m *MockRDSClient) RestoreDBInstanceFromDBSnapshotRequest(i *rds.RestoreDBInstanceFromDBSnapshotInput) rds.RestoreDBInstanceFromDBSnapshotRequest {
+	return m.MockRestoreFromSnapshot(i)
+}
+
+// RestoreDBInstanceToPointInTimeRequest restores RDS Instance to a point in
+// time.
+func (m *MockRDSClient) RestoreDBInstanceToPointInTimeRequest(i *rds.RestoreDBInstanceToPointInTimeInput) rds.RestoreDBInstanceToPointInTimeRequest {
+	return m.MockRestoreToPointInTime(i)
+}
+
+// CreateDBInstanceReadReplicaRequest creates RDS Instance as a read replica.
+func (m *MockRDSClient) CreateDBInstanceReadReplicaRequest(i *rds.CreateDBInstanceReadReplicaInput) rds.CreateDBInstanceReadReplicaRequest {
+	return m.MockCreateReadReplica(i)
+}
+
+// PromoteReadReplicaRequest promotes RDS Instance read replica.
+func (m *MockRDSClient) PromoteReadReplicaRequest(i *rds.PromoteReadReplicaInput) rds.PromoteReadReplicaRequest {
+	return m.MockPromoteReadReplica(i)
+}
+
+// GetMetricStatisticsRequest gets metric statistics of RDS Instance.
+func (m *MockRDSClient) GetMetricStatisticsRequest(i *cloudwatch.GetMetricStatisticsInput) cloudwatch.GetMetricStatisticsRequest {
+	return m.MockGetMetricStatistics(i)
+}
+
+// BuildAuthToken builds an IAM database authentication token for RDS Instance.
+func (m *MockRDSClient) BuildAuthToken(ctx context.Context, endpoint, dbUser string) (string, error) {
+	return m.MockBuildAuthToken(ctx, endpoint, dbUser)
+}
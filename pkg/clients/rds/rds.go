@@ -19,11 +19,18 @@ package rds
 import (
 	"context"
 	"encoding/json"
+	"math"
+	"net"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awsarn "github.com/aws/aws-sdk-go-v2/aws/arn"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/rdsutils"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
@@ -36,6 +43,38 @@ import (
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+// ParameterApplyStatusPendingReboot is the parameter apply status of a DB
+// parameter group whose changes are applied on the next reboot.
+const ParameterApplyStatusPendingReboot = "pending-reboot"
+
+// PendingMasterPasswordSecretSuffix is appended to the name of the connection
+// secret of an RDSInstance to form the name of the secret that holds a
+// generated master password while it is being rotated, i.e. until it is in
+// effect and published to the connection secret.
+const PendingMasterPasswordSecretSuffix = "-pending-master-password"
+
+const (
+	metricsNamespace        = "AWS/RDS"
+	metricReplicaLag        = "ReplicaLag"
+	dimensionDBInstanceName = "DBInstanceIdentifier"
+
+	// replicaLagPeriod is the period of the replica lag datapoints. RDS
+	// publishes its metrics every minute.
+	replicaLagPeriod = time.Minute
+	// replicaLagWindow is how far back the replica lag datapoints are looked
+	// up.
+	replicaLagWindow = 5 * time.Minute
+
+	// DefaultAuthTokenRefreshInterval is the time between two refreshes of an
+	// IAM database authentication token, which expires after 15 minutes.
+	DefaultAuthTokenRefreshInterval = 10 * time.Minute
+
+	// MaxAuthTokenRefreshInterval is the longest time between two refreshes
+	// of an IAM database authentication token. Longer intervals are capped so
+	// that a token is always replaced before it expires.
+	MaxAuthTokenRefreshInterval = 14 * time.Minute
+)
+
 // Client defines RDS RDSClient operations
 type Client interface {
 	CreateDBInstanceRequest(*rds.CreateDBInstanceInput) rds.CreateDBInstanceRequest
@@ -43,6 +82,35 @@ type Client interface {
 	ModifyDBInstanceRequest(*rds.ModifyDBInstanceInput) rds.ModifyDBInstanceRequest
 	DeleteDBInstanceRequest(*rds.DeleteDBInstanceInput) rds.DeleteDBInstanceRequest
 	AddTagsToResourceRequest(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	RebootDBInstanceRequest(*rds.RebootDBInstanceInput) rds.RebootDBInstanceRequest
+	RestoreDBInstanceFromDBSnapshotRequest(*rds.RestoreDBInstanceFromDBSnapshotInput) rds.RestoreDBInstanceFromDBSnapshotRequest
+	RestoreDBInstanceToPointInTimeRequest(*rds.RestoreDBInstanceToPointInTimeInput) rds.RestoreDBInstanceToPointInTimeRequest
+	CreateDBInstanceReadReplicaRequest(*rds.CreateDBInstanceReadReplicaInput) rds.CreateDBInstanceReadReplicaRequest
+	PromoteReadReplicaRequest(*rds.PromoteReadReplicaInput) rds.PromoteReadReplicaRequest
+
+	// GetMetricStatisticsRequest is served by CloudWatch, which is where RDS
+	// publishes the metrics of DB instances, like the replica lag.
+	GetMetricStatisticsRequest(*cloudwatch.GetMetricStatisticsInput) cloudwatch.GetMetricStatisticsRequest
+
+	// BuildAuthToken returns an IAM database authentication token for the
+	// given database user. The token is signed locally with the credentials
+	// of the client and no request is made to AWS.
+	BuildAuthToken(ctx context.Context, endpoint, dbUser string) (string, error)
+}
+
+type client struct {
+	*rds.Client
+	metrics *cloudwatch.Client
+	signer  *v4.Signer
+	region  string
+}
+
+func (c *client) GetMetricStatisticsRequest(in *cloudwatch.GetMetricStatisticsInput) cloudwatch.GetMetricStatisticsRequest {
+	return c.metrics.GetMetricStatisticsRequest(in)
+}
+
+func (c *client) BuildAuthToken(ctx context.Context, endpoint, dbUser string) (string, error) {
+	return rdsutils.BuildAuthToken(ctx, endpoint, c.region, dbUser, c.signer)
 }
 
 // NewClient creates new RDS RDSClient with provided AWS Configurations/Credentials
@@ -54,7 +122,12 @@ func NewClient(ctx context.Context, credentials []byte, region string, auth awsc
 	if cfg == nil {
 		return nil, errors.New("config cannot be nil")
 	}
-	return rds.New(*cfg), err
+	return &client{
+		Client:  rds.New(*cfg),
+		metrics: cloudwatch.New(*cfg),
+		signer:  v4.NewSigner(cfg.Credentials),
+		region:  cfg.Region,
+	}, err
 }
 
 // IsErrorAlreadyExists returns true if the supplied error indicates an instance
@@ -140,6 +213,229 @@ func GenerateCreateDBInstanceInput(name, password string, p *v1beta1.RDSInstance
 	return c
 }
 
+// GenerateRestoreDBInstanceFromDBSnapshotInput returns the input to restore
+// the DB instance from the snapshot given in RestoreFrom. Only the settings
+// accepted by the restore call are included; the rest are applied with a
+// modification once the restored DB instance is available.
+func GenerateRestoreDBInstanceFromDBSnapshotInput(name string, p *v1beta1.RDSInstanceParameters) *rds.RestoreDBInstanceFromDBSnapshotInput {
+	c := GenerateCreateDBInstanceInput(name, "", p)
+	r := &rds.RestoreDBInstanceFromDBSnapshotInput{
+		DBInstanceIdentifier:            c.DBInstanceIdentifier,
+		AutoMinorVersionUpgrade:         c.AutoMinorVersionUpgrade,
+		AvailabilityZone:                c.AvailabilityZone,
+		CopyTagsToSnapshot:              c.CopyTagsToSnapshot,
+		DBInstanceClass:                 c.DBInstanceClass,
+		DBName:                          c.DBName,
+		DBParameterGroupName:            c.DBParameterGroupName,
+		DBSubnetGroupName:               c.DBSubnetGroupName,
+		DeletionProtection:              c.DeletionProtection,
+		Domain:                          c.Domain,
+		DomainIAMRoleName:               c.DomainIAMRoleName,
+		EnableCloudwatchLogsExports:     c.EnableCloudwatchLogsExports,
+		EnableIAMDatabaseAuthentication: c.EnableIAMDatabaseAuthentication,
+		Engine:                          c.Engine,
+		Iops:                            c.Iops,
+		LicenseModel:                    c.LicenseModel,
+		MultiAZ:                         c.MultiAZ,
+		OptionGroupName:                 c.OptionGroupName,
+		Port:                            c.Port,
+		ProcessorFeatures:               c.ProcessorFeatures,
+		PubliclyAccessible:              c.PubliclyAccessible,
+		StorageType:                     c.StorageType,
+		Tags:                            c.Tags,
+		UseDefaultProcessorFeatures:     p.UseDefaultProcessorFeatures,
+		VpcSecurityGroupIds:             c.VpcSecurityGroupIds,
+	}
+	if p.RestoreFrom != nil {
+		r.DBSnapshotIdentifier = p.RestoreFrom.SnapshotIdentifier
+	}
+	return r
+}
+
+// GenerateRestoreDBInstanceToPointInTimeInput returns the input to restore
+// the DB instance from the point in time of the source DB instance given in
+// RestoreFrom. Only the settings accepted by the restore call are included;
+// the rest are applied with a modification once the restored DB instance is
+// available.
+func GenerateRestoreDBInstanceToPointInTimeInput(name string, p *v1beta1.RDSInstanceParameters) *rds.RestoreDBInstanceToPointInTimeInput {
+	c := GenerateCreateDBInstanceInput(name, "", p)
+	r := &rds.RestoreDBInstanceToPointInTimeInput{
+		TargetDBInstanceIdentifier:      c.DBInstanceIdentifier,
+		AutoMinorVersionUpgrade:         c.AutoMinorVersionUpgrade,
+		AvailabilityZone:                c.AvailabilityZone,
+		CopyTagsToSnapshot:              c.CopyTagsToSnapshot,
+		DBInstanceClass:                 c.DBInstanceClass,
+		DBName:                          c.DBName,
+		DBParameterGroupName:            c.DBParameterGroupName,
+		DBSubnetGroupName:               c.DBSubnetGroupName,
+		DeletionProtection:              c.DeletionProtection,
+		Domain:                          c.Domain,
+		DomainIAMRoleName:               c.DomainIAMRoleName,
+		EnableCloudwatchLogsExports:     c.EnableCloudwatchLogsExports,
+		EnableIAMDatabaseAuthentication: c.EnableIAMDatabaseAuthentication,
+		Engine:                          c.Engine,
+		Iops:                            c.Iops,
+		LicenseModel:                    c.LicenseModel,
+		MultiAZ:                         c.MultiAZ,
+		OptionGroupName:                 c.OptionGroupName,
+		Port:                            c.Port,
+		ProcessorFeatures:               c.ProcessorFeatures,
+		PubliclyAccessible:              c.PubliclyAccessible,
+		StorageType:                     c.StorageType,
+		Tags:                            c.Tags,
+		UseDefaultProcessorFeatures:     p.UseDefaultProcessorFeatures,
+		VpcSecurityGroupIds:             c.VpcSecurityGroupIds,
+	}
+	if p.RestoreFrom != nil {
+		r.SourceDBInstanceIdentifier = p.RestoreFrom.SourceDBInstanceIdentifier
+		r.UseLatestRestorableTime = p.RestoreFrom.UseLatestRestorableTime
+		if p.RestoreFrom.RestoreTime != nil {
+			r.RestoreTime = &p.RestoreFrom.RestoreTime.Time
+		}
+	}
+	return r
+}
+
+// GenerateCreateDBInstanceReadReplicaInput returns the input to create the DB
+// instance as a read replica of the one given in SourceDBInstanceIdentifier.
+// The region of the source is taken from its ARN so that the request is
+// presigned for cross-region replication.
+func GenerateCreateDBInstanceReadReplicaInput(name string, p *v1beta1.RDSInstanceParameters) *rds.CreateDBInstanceReadReplicaInput {
+	c := GenerateCreateDBInstanceInput(name, "", p)
+	r := &rds.CreateDBInstanceReadReplicaInput{
+		DBInstanceIdentifier:               c.DBInstanceIdentifier,
+		SourceDBInstanceIdentifier:         p.SourceDBInstanceIdentifier,
+		AutoMinorVersionUpgrade:            c.AutoMinorVersionUpgrade,
+		AvailabilityZone:                   c.AvailabilityZone,
+		CopyTagsToSnapshot:                 c.CopyTagsToSnapshot,
+		DBInstanceClass:                    c.DBInstanceClass,
+		DBParameterGroupName:               c.DBParameterGroupName,
+		DBSubnetGroupName:                  c.DBSubnetGroupName,
+		DeletionProtection:                 c.DeletionProtection,
+		Domain:                             c.Domain,
+		DomainIAMRoleName:                  c.DomainIAMRoleName,
+		EnableCloudwatchLogsExports:        c.EnableCloudwatchLogsExports,
+		EnableIAMDatabaseAuthentication:    c.EnableIAMDatabaseAuthentication,
+		EnablePerformanceInsights:          c.EnablePerformanceInsights,
+		Iops:                               c.Iops,
+		KmsKeyId:                           c.KmsKeyId,
+		MonitoringInterval:                 c.MonitoringInterval,
+		MonitoringRoleArn:                  c.MonitoringRoleArn,
+		MultiAZ:                            c.MultiAZ,
+		OptionGroupName:                    c.OptionGroupName,
+		PerformanceInsightsKMSKeyId:        c.PerformanceInsightsKMSKeyId,
+		PerformanceInsightsRetentionPeriod: c.PerformanceInsightsRetentionPeriod,
+		Port:                               c.Port,
+		ProcessorFeatures:                  c.ProcessorFeatures,
+		PubliclyAccessible:                 c.PubliclyAccessible,
+		StorageType:                        c.StorageType,
+		Tags:                               c.Tags,
+		UseDefaultProcessorFeatures:        p.UseDefaultProcessorFeatures,
+		VpcSecurityGroupIds:                c.VpcSecurityGroupIds,
+	}
+	if a, err := awsarn.Parse(aws.StringValue(p.SourceDBInstanceIdentifier)); err == nil {
+		r.SourceRegion = aws.String(a.Region)
+	}
+	return r
+}
+
+// IsPromotionPending returns true if the DB instance is a read replica that
+// is asked to be promoted.
+func IsPromotionPending(p v1beta1.RDSInstanceParameters, o v1beta1.RDSInstanceObservation) bool {
+	return aws.BoolValue(p.PromoteReadReplica) && o.ReadReplicaSourceDBInstanceIdentifier != ""
+}
+
+// IsAuthTokenRefreshDue returns true if a new IAM database authentication
+// token of the available DB instance is due to be published at the given
+// time.
+func IsAuthTokenRefreshDue(p v1beta1.RDSInstanceParameters, o v1beta1.RDSInstanceObservation, now time.Time) bool {
+	if p.IAMAuthToken == nil || !aws.BoolValue(p.EnableIAMDatabaseAuthentication) ||
+		o.DBInstanceStatus != v1beta1.RDSInstanceStateAvailable || o.Endpoint.Address == "" {
+		return false
+	}
+	if o.IAMAuthTokenRefreshTime == nil {
+		return true
+	}
+	interval := DefaultAuthTokenRefreshInterval
+	if p.IAMAuthToken.RefreshInterval != nil {
+		interval = p.IAMAuthToken.RefreshInterval.Duration
+	}
+	if interval > MaxAuthTokenRefreshInterval {
+		interval = MaxAuthTokenRefreshInterval
+	}
+	return !now.Before(o.IAMAuthTokenRefreshTime.Add(interval))
+}
+
+// GetAuthTokenConnectionDetails returns the connection details that are
+// published along with the given IAM database authentication token.
+func GetAuthTokenConnectionDetails(in v1beta1.RDSInstance, token string) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		v1alpha1.ResourceCredentialsSecretEndpointKey: []byte(in.Status.AtProvider.Endpoint.Address),
+		v1alpha1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(in.Status.AtProvider.Endpoint.Port)),
+		v1alpha1.ResourceCredentialsSecretUserKey:     []byte(in.Spec.ForProvider.IAMAuthToken.Username),
+		v1alpha1.ResourceCredentialsSecretPasswordKey: []byte(token),
+	}
+}
+
+// GetAuthTokenEndpoint returns the endpoint that IAM database authentication
+// tokens of the given DB instance are signed for.
+func GetAuthTokenEndpoint(o v1beta1.RDSInstanceObservation) string {
+	return net.JoinHostPort(o.Endpoint.Address, strconv.Itoa(o.Endpoint.Port))
+}
+
+// IsPasswordRotationDue returns true if the auto-generated master password
+// of the DB instance is due to be rotated at the given time. The first
+// rotation is due one interval after the creation of the DB instance.
+func IsPasswordRotationDue(p v1beta1.RDSInstanceParameters, o v1beta1.RDSInstanceObservation, now time.Time) bool {
+	if p.MasterPasswordRotation == nil || p.MasterPasswordSecretRef != nil || o.ReadReplicaSourceDBInstanceIdentifier != "" {
+		return false
+	}
+	last := o.MasterPasswordRotateTime
+	if last == nil {
+		last = o.InstanceCreateTime
+	}
+	if last == nil {
+		return false
+	}
+	return !now.Before(last.Add(p.MasterPasswordRotation.Interval.Duration))
+}
+
+// GenerateReplicaLagInput returns the input to get the replica lag of the
+// given DB instance in the window that ends at the given time.
+func GenerateReplicaLagInput(name string, end time.Time) *cloudwatch.GetMetricStatisticsInput {
+	return &cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String(metricsNamespace),
+		MetricName: aws.String(metricReplicaLag),
+		Dimensions: []cloudwatch.Dimension{{
+			Name:  aws.String(dimensionDBInstanceName),
+			Value: aws.String(name),
+		}},
+		StartTime:  aws.Time(end.Add(-replicaLagWindow)),
+		EndTime:    aws.Time(end),
+		Period:     aws.Int64(int64(replicaLagPeriod.Seconds())),
+		Statistics: []cloudwatch.Statistic{cloudwatch.StatisticAverage},
+	}
+}
+
+// GetReplicaLag returns the replica lag in seconds from the most recent of
+// the given datapoints. It returns nil if there is no datapoint.
+func GetReplicaLag(dps []cloudwatch.Datapoint) *int {
+	var latest *cloudwatch.Datapoint
+	for i := range dps {
+		if dps[i].Average == nil {
+			continue
+		}
+		if latest == nil || aws.TimeValue(dps[i].Timestamp).After(aws.TimeValue(latest.Timestamp)) {
+			latest = &dps[i]
+		}
+	}
+	if latest == nil {
+		return nil
+	}
+	lag := int(math.Round(aws.Float64Value(latest.Average)))
+	return &lag
+}
+
 // CreatePatch creates a *v1beta1.RDSInstanceParameters that has only the changed
 // values between the target *v1beta1.RDSInstanceParameters and the current
 // *rds.DBInstance
@@ -450,8 +746,6 @@ func IsUpToDate(p v1beta1.RDSInstanceParameters, db rds.DBInstance) (bool, error
 	//  object are not late-inited. So, this func always returns true when
 	//  those configurations are changed by the user.
 
-	// TODO(muvaf): If a secret is provided for password, this logic should check
-	// whether it's changed by comparing it to the password in the published secret.
 	patch, err := CreatePatch(&db, &p)
 	if err != nil {
 		return false, err
@@ -461,9 +755,27 @@ func IsUpToDate(p v1beta1.RDSInstanceParameters, db rds.DBInstance) (bool, error
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "Tags"),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "SkipFinalSnapshotBeforeDeletion"),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "FinalDBSnapshotIdentifier"),
+		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "RebootOnParameterChange"),
+		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "RestoreFrom"),
+		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "SourceDBInstanceIdentifier"),
+		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "PromoteReadReplica"),
+		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "MasterPasswordSecretRef"),
+		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "MasterPasswordRotation"),
+		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "IAMAuthToken"),
 	), nil
 }
 
+// IsRebootPending returns true if any of the DB parameter groups of the
+// instance has changes that are applied only after a reboot.
+func IsRebootPending(o v1beta1.RDSInstanceObservation) bool {
+	for _, pg := range o.DBParameterGroups {
+		if pg.ParameterApplyStatus == ParameterApplyStatusPendingReboot {
+			return true
+		}
+	}
+	return false
+}
+
 // GetConnectionDetails extracts managed.ConnectionDetails out of v1beta1.RDSInstance.
 func GetConnectionDetails(in v1beta1.RDSInstance) managed.ConnectionDetails {
 	if in.Status.AtProvider.Endpoint.Address == "" {
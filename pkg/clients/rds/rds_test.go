@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -187,6 +188,18 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"IgnoresMasterPassword": {
+			args: args{
+				db: rds.DBInstance{
+					DBName: &dbName,
+				},
+				p: v1beta1.RDSInstanceParameters{
+					DBName:                  &dbName,
+					MasterPasswordSecretRef: &v1alpha1.SecretKeySelector{Key: "password"},
+				},
+			},
+			want: true,
+		},
 	}
 
 	for name, tc := range cases {
@@ -810,3 +823,316 @@ func TestGenerateModifyDBInstanceInput(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateRestoreDBInstanceFromDBSnapshotInput(t *testing.T) {
+	snapshot := "snapshot"
+	cases := map[string]struct {
+		params v1beta1.RDSInstanceParameters
+		want   *rds.RestoreDBInstanceFromDBSnapshotInput
+	}{
+		"RestoreFromSnapshot": {
+			params: v1beta1.RDSInstanceParameters{
+				DBInstanceClass:       instanceClass,
+				Engine:                engine,
+				BackupRetentionPeriod: &retention,
+				MultiAZ:               &multiAZ,
+				Port:                  &port,
+				RestoreFrom:           &v1beta1.RestoreFromParameters{SnapshotIdentifier: &snapshot},
+				Tags:                  []v1beta1.Tag{{Key: name, Value: value}},
+			},
+			want: &rds.RestoreDBInstanceFromDBSnapshotInput{
+				DBInstanceIdentifier: &name,
+				DBSnapshotIdentifier: &snapshot,
+				DBInstanceClass:      &instanceClass,
+				Engine:               &engine,
+				MultiAZ:              &multiAZ,
+				Port:                 &port64,
+				Tags:                 []rds.Tag{{Key: &name, Value: &value}},
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GenerateRestoreDBInstanceFromDBSnapshotInput(name, &tc.params)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateRestoreDBInstanceToPointInTimeInput(t *testing.T) {
+	source := "source"
+	restoreTime := metav1.NewTime(time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC))
+	cases := map[string]struct {
+		params v1beta1.RDSInstanceParameters
+		want   *rds.RestoreDBInstanceToPointInTimeInput
+	}{
+		"RestoreTime": {
+			params: v1beta1.RDSInstanceParameters{
+				DBInstanceClass: instanceClass,
+				Engine:          engine,
+				RestoreFrom: &v1beta1.RestoreFromParameters{
+					SourceDBInstanceIdentifier: &source,
+					RestoreTime:                &restoreTime,
+				},
+			},
+			want: &rds.RestoreDBInstanceToPointInTimeInput{
+				TargetDBInstanceIdentifier: &name,
+				SourceDBInstanceIdentifier: &source,
+				RestoreTime:                &restoreTime.Time,
+				DBInstanceClass:            &instanceClass,
+				Engine:                     &engine,
+			},
+		},
+		"LatestRestorableTime": {
+			params: v1beta1.RDSInstanceParameters{
+				DBInstanceClass: instanceClass,
+				Engine:          engine,
+				RestoreFrom: &v1beta1.RestoreFromParameters{
+					SourceDBInstanceIdentifier: &source,
+					UseLatestRestorableTime:    &trueFlag,
+				},
+			},
+			want: &rds.RestoreDBInstanceToPointInTimeInput{
+				TargetDBInstanceIdentifier: &name,
+				SourceDBInstanceIdentifier: &source,
+				UseLatestRestorableTime:    &trueFlag,
+				DBInstanceClass:            &instanceClass,
+				Engine:                     &engine,
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GenerateRestoreDBInstanceToPointInTimeInput(name, &tc.params)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCreateDBInstanceReadReplicaInput(t *testing.T) {
+	source := "source"
+	sourceARN := "arn:aws:rds:eu-west-1:123456789012:db:source"
+	sourceRegion := "eu-west-1"
+	cases := map[string]struct {
+		params v1beta1.RDSInstanceParameters
+		want   *rds.CreateDBInstanceReadReplicaInput
+	}{
+		"SameRegion": {
+			params: v1beta1.RDSInstanceParameters{
+				DBInstanceClass:            instanceClass,
+				Engine:                     engine,
+				MultiAZ:                    &multiAZ,
+				SourceDBInstanceIdentifier: &source,
+			},
+			want: &rds.CreateDBInstanceReadReplicaInput{
+				DBInstanceIdentifier:       &name,
+				SourceDBInstanceIdentifier: &source,
+				DBInstanceClass:            &instanceClass,
+				MultiAZ:                    &multiAZ,
+			},
+		},
+		"CrossRegion": {
+			params: v1beta1.RDSInstanceParameters{
+				DBInstanceClass:            instanceClass,
+				Engine:                     engine,
+				KMSKeyID:                   &kmsID,
+				SourceDBInstanceIdentifier: &sourceARN,
+			},
+			want: &rds.CreateDBInstanceReadReplicaInput{
+				DBInstanceIdentifier:       &name,
+				SourceDBInstanceIdentifier: &sourceARN,
+				SourceRegion:               &sourceRegion,
+				DBInstanceClass:            &instanceClass,
+				KmsKeyId:                   &kmsID,
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GenerateCreateDBInstanceReadReplicaInput(name, &tc.params)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetReplicaLag(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Minute)
+	latest, earlier := 2.6, 10.0
+	lag := 3
+	cases := map[string]struct {
+		dps  []cloudwatch.Datapoint
+		want *int
+	}{
+		"NoDatapoints": {},
+		"Latest": {
+			dps: []cloudwatch.Datapoint{
+				{Timestamp: &now, Average: &latest},
+				{Timestamp: &before, Average: &earlier},
+			},
+			want: &lag,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GetReplicaLag(tc.dps)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPasswordRotationDue(t *testing.T) {
+	created := metav1.NewTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	rotated := metav1.NewTime(created.Add(2 * time.Hour))
+	now := created.Add(150 * time.Minute)
+	policy := &v1beta1.PasswordRotationPolicy{Interval: metav1.Duration{Duration: time.Hour}}
+
+	type args struct {
+		p v1beta1.RDSInstanceParameters
+		o v1beta1.RDSInstanceObservation
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NoPolicy": {
+			args: args{
+				o: v1beta1.RDSInstanceObservation{InstanceCreateTime: &created},
+			},
+			want: false,
+		},
+		"SecretGiven": {
+			args: args{
+				p: v1beta1.RDSInstanceParameters{MasterPasswordRotation: policy, MasterPasswordSecretRef: &v1alpha1.SecretKeySelector{}},
+				o: v1beta1.RDSInstanceObservation{InstanceCreateTime: &created},
+			},
+			want: false,
+		},
+		"ReadReplica": {
+			args: args{
+				p: v1beta1.RDSInstanceParameters{MasterPasswordRotation: policy},
+				o: v1beta1.RDSInstanceObservation{InstanceCreateTime: &created, ReadReplicaSourceDBInstanceIdentifier: "source"},
+			},
+			want: false,
+		},
+		"NeverRotated": {
+			args: args{
+				p: v1beta1.RDSInstanceParameters{MasterPasswordRotation: policy},
+				o: v1beta1.RDSInstanceObservation{InstanceCreateTime: &created},
+			},
+			want: true,
+		},
+		"RecentlyRotated": {
+			args: args{
+				p: v1beta1.RDSInstanceParameters{MasterPasswordRotation: policy},
+				o: v1beta1.RDSInstanceObservation{InstanceCreateTime: &created, MasterPasswordRotateTime: &rotated},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsPasswordRotationDue(tc.args.p, tc.args.o, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAuthTokenRefreshDue(t *testing.T) {
+	refreshed := metav1.NewTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	endpoint := v1beta1.Endpoint{Address: address, Port: 5432}
+	config := &v1beta1.IAMAuthTokenConfiguration{Username: "app"}
+	enabled := aws.Bool(true)
+
+	type args struct {
+		p   v1beta1.RDSInstanceParameters
+		o   v1beta1.RDSInstanceObservation
+		now time.Time
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NotConfigured": {
+			args: args{
+				p: v1beta1.RDSInstanceParameters{EnableIAMDatabaseAuthentication: enabled},
+				o: v1beta1.RDSInstanceObservation{DBInstanceStatus: v1beta1.RDSInstanceStateAvailable, Endpoint: endpoint},
+			},
+			want: false,
+		},
+		"IAMAuthenticationDisabled": {
+			args: args{
+				p: v1beta1.RDSInstanceParameters{IAMAuthToken: config},
+				o: v1beta1.RDSInstanceObservation{DBInstanceStatus: v1beta1.RDSInstanceStateAvailable, Endpoint: endpoint},
+			},
+			want: false,
+		},
+		"NotAvailable": {
+			args: args{
+				p: v1beta1.RDSInstanceParameters{EnableIAMDatabaseAuthentication: enabled, IAMAuthToken: config},
+				o: v1beta1.RDSInstanceObservation{DBInstanceStatus: v1beta1.RDSInstanceStateCreating},
+			},
+			want: false,
+		},
+		"NeverRefreshed": {
+			args: args{
+				p: v1beta1.RDSInstanceParameters{EnableIAMDatabaseAuthentication: enabled, IAMAuthToken: config},
+				o: v1beta1.RDSInstanceObservation{DBInstanceStatus: v1beta1.RDSInstanceStateAvailable, Endpoint: endpoint},
+			},
+			want: true,
+		},
+		"RecentlyRefreshed": {
+			args: args{
+				p:   v1beta1.RDSInstanceParameters{EnableIAMDatabaseAuthentication: enabled, IAMAuthToken: config},
+				o:   v1beta1.RDSInstanceObservation{DBInstanceStatus: v1beta1.RDSInstanceStateAvailable, Endpoint: endpoint, IAMAuthTokenRefreshTime: &refreshed},
+				now: refreshed.Add(DefaultAuthTokenRefreshInterval - time.Minute),
+			},
+			want: false,
+		},
+		"RefreshIntervalPassed": {
+			args: args{
+				p:   v1beta1.RDSInstanceParameters{EnableIAMDatabaseAuthentication: enabled, IAMAuthToken: config},
+				o:   v1beta1.RDSInstanceObservation{DBInstanceStatus: v1beta1.RDSInstanceStateAvailable, Endpoint: endpoint, IAMAuthTokenRefreshTime: &refreshed},
+				now: refreshed.Add(DefaultAuthTokenRefreshInterval),
+			},
+			want: true,
+		},
+		"RefreshIntervalCapped": {
+			args: args{
+				p: v1beta1.RDSInstanceParameters{EnableIAMDatabaseAuthentication: enabled, IAMAuthToken: &v1beta1.IAMAuthTokenConfiguration{
+					Username:        "app",
+					RefreshInterval: &metav1.Duration{Duration: time.Hour},
+				}},
+				o:   v1beta1.RDSInstanceObservation{DBInstanceStatus: v1beta1.RDSInstanceStateAvailable, Endpoint: endpoint, IAMAuthTokenRefreshTime: &refreshed},
+				now: refreshed.Add(MaxAuthTokenRefreshInterval),
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAuthTokenRefreshDue(tc.args.p, tc.args.o, tc.args.now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,374 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscalinggroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsautoscaling "github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/autoscaling/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/autoscaling"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an AutoScalingGroup resource"
+
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errKubeUpdateFailed  = "cannot update AutoScalingGroup custom resource"
+
+	errClient                = "cannot create a new AutoScalingGroup client"
+	errDescribe              = "failed to describe AutoScalingGroup"
+	errMultipleItems         = "retrieved multiple AutoScalingGroups for the given name"
+	errNotFound              = "cannot find the AutoScalingGroup to update"
+	errDescribePolicies      = "failed to describe the scaling policies of the AutoScalingGroup"
+	errDescribeTemplate      = "failed to describe the launch template of the AutoScalingGroup"
+	errCreate                = "failed to create the AutoScalingGroup resource"
+	errUpdate                = "failed to update the AutoScalingGroup resource"
+	errAttachTargetGroups    = "failed to attach target groups to the AutoScalingGroup"
+	errDetachTargetGroups    = "failed to detach target groups from the AutoScalingGroup"
+	errUpdateTags            = "failed to update tags of the AutoScalingGroup"
+	errDeleteTags            = "failed to delete tags of the AutoScalingGroup"
+	errPutScalingPolicy      = "failed to put a scaling policy of the AutoScalingGroup"
+	errDeleteScalingPolicy   = "failed to delete a scaling policy of the AutoScalingGroup"
+	errTerminateInstance     = "failed to terminate an outdated instance of the AutoScalingGroup"
+	errDelete                = "failed to delete the AutoScalingGroup resource"
+	errStatusUpdate          = "cannot update status of the AutoScalingGroup custom resource"
+	errNoLaunchTemplateFound = "cannot find the launch template of the AutoScalingGroup"
+)
+
+// SetupAutoScalingGroup adds a controller that reconciles AutoScalingGroups.
+func SetupAutoScalingGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.AutoScalingGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.AutoScalingGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AutoScalingGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: autoscaling.NewClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (autoscaling.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		asgClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: asgClient, kube: c.client}, errors.Wrap(err, errClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.client.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	asgClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: asgClient, kube: c.client}, errors.Wrap(err, errClient)
+}
+
+type external struct {
+	kube   client.Client
+	client autoscaling.Client
+}
+
+func (e *external) describe(ctx context.Context, name string) (*awsautoscaling.AutoScalingGroup, error) {
+	response, err := e.client.DescribeAutoScalingGroupsRequest(&awsautoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{name},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// a group that doesn't exist is not an error but an empty response.
+	switch len(response.AutoScalingGroups) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &response.AutoScalingGroups[0], nil
+	}
+	return nil, errors.New(errMultipleItems)
+}
+
+func (e *external) describePolicies(ctx context.Context, name string) ([]awsautoscaling.ScalingPolicy, error) {
+	var policies []awsautoscaling.ScalingPolicy
+	var token *string
+	for {
+		response, err := e.client.DescribePoliciesRequest(&awsautoscaling.DescribePoliciesInput{
+			AutoScalingGroupName: aws.String(name),
+			NextToken:            token,
+		}).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, response.ScalingPolicies...)
+		if aws.StringValue(response.NextToken) == "" {
+			return policies, nil
+		}
+		token = response.NextToken
+	}
+}
+
+// refreshEnabled returns true if the user opted in to the replacement of
+// outdated instances.
+func refreshEnabled(p v1alpha1.AutoScalingGroupParameters) bool {
+	return p.InstanceRefresh != nil && p.InstanceRefresh.TerminateOutdatedInstances
+}
+
+// outdatedInstances returns the instances that are due to be replaced by an
+// instance refresh. Symbolic launch template versions are resolved so that
+// instances are compared by the version number they were launched with.
+func (e *external) outdatedInstances(ctx context.Context, cr *v1alpha1.AutoScalingGroup, g awsautoscaling.AutoScalingGroup) ([]string, error) {
+	if !refreshEnabled(cr.Spec.ForProvider) || g.LaunchTemplate == nil {
+		return nil, nil
+	}
+	response, err := e.client.DescribeLaunchTemplatesRequest(&awsec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateIds: []string{aws.StringValue(g.LaunchTemplate.LaunchTemplateId)},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.LaunchTemplates) != 1 {
+		return nil, errors.New(errNoLaunchTemplateFound)
+	}
+	return autoscaling.GetOutdatedInstances(g, &response.LaunchTemplates[0]), nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	if aws.StringValue(observed.Status) == autoscaling.StatusDeleteInProgress {
+		cr.Status.AtProvider = autoscaling.GenerateAutoScalingGroupObservation(*observed, nil, 0)
+		cr.SetConditions(runtimev1alpha1.Deleting())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	policies, err := e.describePolicies(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribePolicies)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	autoscaling.LateInitializeAutoScalingGroup(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	outdated, err := e.outdatedInstances(ctx, cr, *observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeTemplate)
+	}
+
+	cr.Status.AtProvider = autoscaling.GenerateAutoScalingGroupObservation(*observed, policies, len(outdated))
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	put, remove := autoscaling.DiffScalingPolicies(cr.Spec.ForProvider.ScalingPolicies, policies)
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: autoscaling.IsAutoScalingGroupUpToDate(cr.Spec.ForProvider, *observed) &&
+			len(put)+len(remove) == 0 && len(outdated) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	if err := e.kube.Status().Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errStatusUpdate)
+	}
+
+	_, err := e.client.CreateAutoScalingGroupRequest(autoscaling.GenerateCreateAutoScalingGroupInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	// scaling policies can only be added to an existing group.
+	for _, sp := range cr.Spec.ForProvider.ScalingPolicies {
+		if _, err := e.client.PutScalingPolicyRequest(autoscaling.GeneratePutScalingPolicyInput(meta.GetExternalName(cr), sp)).Send(ctx); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errPutScalingPolicy)
+		}
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	name := meta.GetExternalName(cr)
+	observed, err := e.describe(ctx, name)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalUpdate{}, errors.New(errNotFound)
+	}
+
+	configUpToDate := autoscaling.IsAutoScalingGroupConfigUpToDate(cr.Spec.ForProvider, *observed)
+	if !configUpToDate {
+		if _, err := e.client.UpdateAutoScalingGroupRequest(autoscaling.GenerateUpdateAutoScalingGroupInput(name, cr.Spec.ForProvider)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	attach, detach := autoscaling.DiffStrings(cr.Spec.ForProvider.TargetGroupARNs, observed.TargetGroupARNs)
+	if len(attach) != 0 {
+		if _, err := e.client.AttachLoadBalancerTargetGroupsRequest(&awsautoscaling.AttachLoadBalancerTargetGroupsInput{
+			AutoScalingGroupName: aws.String(name),
+			TargetGroupARNs:      attach,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAttachTargetGroups)
+		}
+	}
+	if len(detach) != 0 {
+		if _, err := e.client.DetachLoadBalancerTargetGroupsRequest(&awsautoscaling.DetachLoadBalancerTargetGroupsInput{
+			AutoScalingGroupName: aws.String(name),
+			TargetGroupARNs:      detach,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDetachTargetGroups)
+		}
+	}
+
+	update, remove := autoscaling.DiffTags(name, cr.Spec.ForProvider.Tags, observed.Tags)
+	if len(update) != 0 {
+		if _, err := e.client.CreateOrUpdateTagsRequest(&awsautoscaling.CreateOrUpdateTagsInput{Tags: update}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+		}
+	}
+	if len(remove) != 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsautoscaling.DeleteTagsInput{Tags: remove}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteTags)
+		}
+	}
+
+	policies, err := e.describePolicies(ctx, name)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribePolicies)
+	}
+	put, removePolicies := autoscaling.DiffScalingPolicies(cr.Spec.ForProvider.ScalingPolicies, policies)
+	for _, sp := range put {
+		if _, err := e.client.PutScalingPolicyRequest(autoscaling.GeneratePutScalingPolicyInput(name, sp)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errPutScalingPolicy)
+		}
+	}
+	for _, p := range removePolicies {
+		if _, err := e.client.DeletePolicyRequest(&awsautoscaling.DeletePolicyInput{
+			AutoScalingGroupName: aws.String(name),
+			PolicyName:           aws.String(p),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteScalingPolicy)
+		}
+	}
+
+	// The instances are refreshed only once the group uses the desired launch
+	// template, which is observed in a later reconciliation if it was just
+	// updated.
+	if !configUpToDate || !refreshEnabled(cr.Spec.ForProvider) {
+		return managed.ExternalUpdate{}, nil
+	}
+	outdated, err := e.outdatedInstances(ctx, cr, *observed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeTemplate)
+	}
+	for _, id := range autoscaling.GetInstancesToRefresh(*observed, outdated, *cr.Spec.ForProvider.InstanceRefresh) {
+		if _, err := e.client.TerminateInstanceInAutoScalingGroupRequest(&awsautoscaling.TerminateInstanceInAutoScalingGroupInput{
+			InstanceId:                     aws.String(id),
+			ShouldDecrementDesiredCapacity: aws.Bool(false),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errTerminateInstance)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.Status == autoscaling.StatusDeleteInProgress {
+		return nil
+	}
+
+	// ForceDelete terminates the instances of the group along with it.
+	_, err := e.client.DeleteAutoScalingGroupRequest(&awsautoscaling.DeleteAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(meta.GetExternalName(cr)),
+		ForceDelete:          aws.Bool(true),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(autoscaling.IsAutoScalingGroupNotFound, err), errDelete)
+}
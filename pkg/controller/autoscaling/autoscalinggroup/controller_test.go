@@ -0,0 +1,541 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscalinggroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsautoscaling "github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/autoscaling/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/autoscaling"
+	"github.com/crossplane/provider-aws/pkg/clients/autoscaling/fake"
+)
+
+const (
+	providerName = "aws-creds"
+)
+
+var (
+	asgName    = "some-asg"
+	templateID = "lt-0123"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	asg  autoscaling.Client
+	kube client.Client
+	cr   *v1alpha1.AutoScalingGroup
+}
+
+type asgModifier func(*v1alpha1.AutoScalingGroup)
+
+func withSpec(p v1alpha1.AutoScalingGroupParameters) asgModifier {
+	return func(r *v1alpha1.AutoScalingGroup) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.AutoScalingGroupObservation) asgModifier {
+	return func(r *v1alpha1.AutoScalingGroup) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) asgModifier {
+	return func(r *v1alpha1.AutoScalingGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func autoScalingGroup(m ...asgModifier) *v1alpha1.AutoScalingGroup {
+	cr := &v1alpha1.AutoScalingGroup{
+		Spec: v1alpha1.AutoScalingGroupSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	meta.SetExternalName(cr, asgName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params() v1alpha1.AutoScalingGroupParameters {
+	return v1alpha1.AutoScalingGroupParameters{
+		LaunchTemplate: v1alpha1.LaunchTemplateSpecification{
+			LaunchTemplateID: aws.String(templateID),
+			Version:          aws.String("2"),
+		},
+		MinSize:                          1,
+		MaxSize:                          3,
+		DefaultCooldown:                  aws.Int64(300),
+		HealthCheckType:                  aws.String("EC2"),
+		HealthCheckGracePeriod:           aws.Int64(0),
+		AvailabilityZones:                []string{"us-east-1a"},
+		NewInstancesProtectedFromScaleIn: aws.Bool(false),
+		InstanceRefresh:                  &v1alpha1.InstanceRefresh{TerminateOutdatedInstances: true},
+	}
+}
+
+func instance(id, version string) awsautoscaling.Instance {
+	return awsautoscaling.Instance{
+		InstanceId:       aws.String(id),
+		AvailabilityZone: aws.String("us-east-1a"),
+		HealthStatus:     aws.String("Healthy"),
+		LifecycleState:   awsautoscaling.LifecycleStateInService,
+		LaunchTemplate: &awsautoscaling.LaunchTemplateSpecification{
+			LaunchTemplateId: aws.String(templateID),
+			Version:          aws.String(version),
+		},
+	}
+}
+
+func group() awsautoscaling.AutoScalingGroup {
+	return awsautoscaling.AutoScalingGroup{
+		AutoScalingGroupName: aws.String(asgName),
+		LaunchTemplate: &awsautoscaling.LaunchTemplateSpecification{
+			LaunchTemplateId: aws.String(templateID),
+			Version:          aws.String("2"),
+		},
+		MinSize:                          aws.Int64(1),
+		MaxSize:                          aws.Int64(3),
+		DesiredCapacity:                  aws.Int64(2),
+		DefaultCooldown:                  aws.Int64(300),
+		HealthCheckType:                  aws.String("EC2"),
+		HealthCheckGracePeriod:           aws.Int64(0),
+		AvailabilityZones:                []string{"us-east-1a"},
+		NewInstancesProtectedFromScaleIn: aws.Bool(false),
+		Instances:                        []awsautoscaling.Instance{instance("i-1", "1"), instance("i-2", "2")},
+	}
+}
+
+func describeGroups(groups ...awsautoscaling.AutoScalingGroup) func(*awsautoscaling.DescribeAutoScalingGroupsInput) awsautoscaling.DescribeAutoScalingGroupsRequest {
+	return func(*awsautoscaling.DescribeAutoScalingGroupsInput) awsautoscaling.DescribeAutoScalingGroupsRequest {
+		return awsautoscaling.DescribeAutoScalingGroupsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsautoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: groups}},
+		}
+	}
+}
+
+func describePolicies(*awsautoscaling.DescribePoliciesInput) awsautoscaling.DescribePoliciesRequest {
+	return awsautoscaling.DescribePoliciesRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsautoscaling.DescribePoliciesOutput{}},
+	}
+}
+
+func describeLaunchTemplates(*awsec2.DescribeLaunchTemplatesInput) awsec2.DescribeLaunchTemplatesRequest {
+	return awsec2.DescribeLaunchTemplatesRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeLaunchTemplatesOutput{
+			LaunchTemplates: []awsec2.LaunchTemplate{{LaunchTemplateId: aws.String(templateID), LatestVersionNumber: aws.Int64(2), DefaultVersionNumber: aws.Int64(1)}},
+		}},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.AutoScalingGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	upToDate := group()
+	upToDate.Instances = []awsautoscaling.Instance{instance("i-2", "2")}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				asg: &fake.MockClient{
+					MockDescribeAutoScalingGroupsRequest: describeGroups(upToDate),
+					MockDescribePoliciesRequest:          describePolicies,
+					MockDescribeLaunchTemplatesRequest:   describeLaunchTemplates,
+				},
+				cr: autoScalingGroup(withSpec(params())),
+			},
+			want: want{
+				cr: autoScalingGroup(withSpec(params()),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha1.AutoScalingGroupObservation{
+						DesiredCapacity: 2,
+						Instances: []v1alpha1.Instance{
+							{InstanceID: "i-2", AvailabilityZone: "us-east-1a", HealthStatus: "Healthy", LifecycleState: "InService", LaunchTemplateVersion: "2"},
+						},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"OutdatedInstances": {
+			args: args{
+				asg: &fake.MockClient{
+					MockDescribeAutoScalingGroupsRequest: describeGroups(group()),
+					MockDescribePoliciesRequest:          describePolicies,
+					MockDescribeLaunchTemplatesRequest:   describeLaunchTemplates,
+				},
+				cr: autoScalingGroup(withSpec(params())),
+			},
+			want: want{
+				cr: autoScalingGroup(withSpec(params()),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha1.AutoScalingGroupObservation{
+						DesiredCapacity: 2,
+						Instances: []v1alpha1.Instance{
+							{InstanceID: "i-1", AvailabilityZone: "us-east-1a", HealthStatus: "Healthy", LifecycleState: "InService", LaunchTemplateVersion: "1"},
+							{InstanceID: "i-2", AvailabilityZone: "us-east-1a", HealthStatus: "Healthy", LifecycleState: "InService", LaunchTemplateVersion: "2"},
+						},
+						OutdatedInstances: 1,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				asg: &fake.MockClient{
+					MockDescribeAutoScalingGroupsRequest: describeGroups(),
+				},
+				cr: autoScalingGroup(withSpec(params())),
+			},
+			want: want{
+				cr: autoScalingGroup(withSpec(params())),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				asg: &fake.MockClient{
+					MockDescribeAutoScalingGroupsRequest: func(*awsautoscaling.DescribeAutoScalingGroupsInput) awsautoscaling.DescribeAutoScalingGroupsRequest {
+						return awsautoscaling.DescribeAutoScalingGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: autoScalingGroup(withSpec(params())),
+			},
+			want: want{
+				cr:  autoScalingGroup(withSpec(params())),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.asg}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.AutoScalingGroup
+		err error
+	}
+
+	policy := v1alpha1.ScalingPolicy{PolicyName: "scale-out", PolicyType: "SimpleScaling", ScalingAdjustment: aws.Int64(1)}
+	p := params()
+	p.ScalingPolicies = []v1alpha1.ScalingPolicy{policy}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				asg: &fake.MockClient{
+					MockCreateAutoScalingGroupRequest: func(*awsautoscaling.CreateAutoScalingGroupInput) awsautoscaling.CreateAutoScalingGroupRequest {
+						return awsautoscaling.CreateAutoScalingGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsautoscaling.CreateAutoScalingGroupOutput{}},
+						}
+					},
+					MockPutScalingPolicyRequest: func(in *awsautoscaling.PutScalingPolicyInput) awsautoscaling.PutScalingPolicyRequest {
+						if diff := cmp.Diff(autoscaling.GeneratePutScalingPolicyInput(asgName, policy), in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsautoscaling.PutScalingPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsautoscaling.PutScalingPolicyOutput{}},
+						}
+					},
+				},
+				cr: autoScalingGroup(withSpec(p)),
+			},
+			want: want{
+				cr: autoScalingGroup(withSpec(p), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				kube: &test.MockClient{
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				asg: &fake.MockClient{
+					MockCreateAutoScalingGroupRequest: func(*awsautoscaling.CreateAutoScalingGroupInput) awsautoscaling.CreateAutoScalingGroupRequest {
+						return awsautoscaling.CreateAutoScalingGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: autoScalingGroup(withSpec(p)),
+			},
+			want: want{
+				cr:  autoScalingGroup(withSpec(p), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.asg}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		terminated []string
+		err        error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"RefreshInstances": {
+			args: args{
+				asg: &fake.MockClient{
+					MockDescribeAutoScalingGroupsRequest: describeGroups(group()),
+					MockDescribePoliciesRequest:          describePolicies,
+					MockDescribeLaunchTemplatesRequest:   describeLaunchTemplates,
+				},
+				cr: autoScalingGroup(withSpec(params())),
+			},
+			want: want{
+				terminated: []string{"i-1"},
+			},
+		},
+		"RefreshNotEnabled": {
+			args: args{
+				asg: &fake.MockClient{
+					MockDescribeAutoScalingGroupsRequest: describeGroups(group()),
+					MockDescribePoliciesRequest:          describePolicies,
+				},
+				cr: autoScalingGroup(withSpec(func() v1alpha1.AutoScalingGroupParameters {
+					p := params()
+					p.InstanceRefresh.TerminateOutdatedInstances = false
+					return p
+				}())),
+			},
+			want: want{},
+		},
+		"NotFound": {
+			args: args{
+				asg: &fake.MockClient{
+					MockDescribeAutoScalingGroupsRequest: describeGroups(),
+				},
+				cr: autoScalingGroup(withSpec(params())),
+			},
+			want: want{
+				err: errors.New(errNotFound),
+			},
+		},
+		"UpdateConfigFirst": {
+			args: args{
+				asg: &fake.MockClient{
+					MockDescribeAutoScalingGroupsRequest: describeGroups(group()),
+					MockDescribePoliciesRequest:          describePolicies,
+					MockUpdateAutoScalingGroupRequest: func(*awsautoscaling.UpdateAutoScalingGroupInput) awsautoscaling.UpdateAutoScalingGroupRequest {
+						return awsautoscaling.UpdateAutoScalingGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsautoscaling.UpdateAutoScalingGroupOutput{}},
+						}
+					},
+				},
+				cr: autoScalingGroup(withSpec(func() v1alpha1.AutoScalingGroupParameters {
+					p := params()
+					p.LaunchTemplate.Version = aws.String("3")
+					return p
+				}())),
+			},
+			want: want{},
+		},
+		"UpdateFail": {
+			args: args{
+				asg: &fake.MockClient{
+					MockDescribeAutoScalingGroupsRequest: describeGroups(group()),
+					MockUpdateAutoScalingGroupRequest: func(*awsautoscaling.UpdateAutoScalingGroupInput) awsautoscaling.UpdateAutoScalingGroupRequest {
+						return awsautoscaling.UpdateAutoScalingGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: autoScalingGroup(withSpec(func() v1alpha1.AutoScalingGroupParameters {
+					p := params()
+					p.MaxSize = 5
+					return p
+				}())),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var terminated []string
+			tc.asg.(*fake.MockClient).MockTerminateInstanceInAutoScalingGroupRequest = func(in *awsautoscaling.TerminateInstanceInAutoScalingGroupInput) awsautoscaling.TerminateInstanceInAutoScalingGroupRequest {
+				terminated = append(terminated, aws.StringValue(in.InstanceId))
+				return awsautoscaling.TerminateInstanceInAutoScalingGroupRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsautoscaling.TerminateInstanceInAutoScalingGroupOutput{}},
+				}
+			}
+			e := &external{kube: tc.kube, client: tc.asg}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.terminated, terminated); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.AutoScalingGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				asg: &fake.MockClient{
+					MockDeleteAutoScalingGroupRequest: func(in *awsautoscaling.DeleteAutoScalingGroupInput) awsautoscaling.DeleteAutoScalingGroupRequest {
+						return awsautoscaling.DeleteAutoScalingGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsautoscaling.DeleteAutoScalingGroupOutput{}},
+						}
+					},
+				},
+				cr: autoScalingGroup(),
+			},
+			want: want{
+				cr: autoScalingGroup(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				asg: &fake.MockClient{},
+				cr:  autoScalingGroup(withStatus(v1alpha1.AutoScalingGroupObservation{Status: autoscaling.StatusDeleteInProgress})),
+			},
+			want: want{
+				cr: autoScalingGroup(withStatus(v1alpha1.AutoScalingGroupObservation{Status: autoscaling.StatusDeleteInProgress}),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				asg: &fake.MockClient{
+					MockDeleteAutoScalingGroupRequest: func(in *awsautoscaling.DeleteAutoScalingGroupInput) awsautoscaling.DeleteAutoScalingGroupRequest {
+						return awsautoscaling.DeleteAutoScalingGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New("ValidationError", "AutoScalingGroup name not found - AutoScalingGroup some-asg not found", nil)},
+						}
+					},
+				},
+				cr: autoScalingGroup(),
+			},
+			want: want{
+				cr: autoScalingGroup(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				asg: &fake.MockClient{
+					MockDeleteAutoScalingGroupRequest: func(in *awsautoscaling.DeleteAutoScalingGroupInput) awsautoscaling.DeleteAutoScalingGroupRequest {
+						return awsautoscaling.DeleteAutoScalingGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: autoScalingGroup(),
+			},
+			want: want{
+				cr:  autoScalingGroup(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.asg}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -25,20 +25,44 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthority"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthoritypermission"
 	"github.com/crossplane/provider-aws/pkg/controller/applicationintegration/sqs"
+	"github.com/crossplane/provider-aws/pkg/controller/autoscaling/autoscalinggroup"
 	"github.com/crossplane/provider-aws/pkg/controller/cache"
+	"github.com/crossplane/provider-aws/pkg/controller/cache/cachecluster"
+	"github.com/crossplane/provider-aws/pkg/controller/cache/cacheparametergroup"
+	"github.com/crossplane/provider-aws/pkg/controller/cache/cachesnapshot"
 	"github.com/crossplane/provider-aws/pkg/controller/cache/cachesubnetgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/compute"
 	"github.com/crossplane/provider-aws/pkg/controller/database"
+	"github.com/crossplane/provider-aws/pkg/controller/database/dbcluster"
+	"github.com/crossplane/provider-aws/pkg/controller/database/dbclusterparametergroup"
+	"github.com/crossplane/provider-aws/pkg/controller/database/dbparametergroup"
+	"github.com/crossplane/provider-aws/pkg/controller/database/dbproxy"
+	"github.com/crossplane/provider-aws/pkg/controller/database/dbproxytargetgroup"
+	"github.com/crossplane/provider-aws/pkg/controller/database/dbsnapshot"
 	"github.com/crossplane/provider-aws/pkg/controller/database/dbsubnetgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/database/dynamodb"
+	"github.com/crossplane/provider-aws/pkg/controller/database/optiongroup"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/dhcpoptions"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/flowlog"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/internetgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/keypair"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/launchtemplate"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/networkacl"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/routetable"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/securitygroup"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/snapshot"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/subnet"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/volume"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/volumeattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpc"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcendpoint"
 	"github.com/crossplane/provider-aws/pkg/controller/eks"
 	"github.com/crossplane/provider-aws/pkg/controller/elasticloadbalancing/elb"
 	"github.com/crossplane/provider-aws/pkg/controller/elasticloadbalancing/elbattachment"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/listener"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/listenerrule"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/loadbalancer"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/targetgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamgrouppolicyattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamgroupusermembership"
@@ -63,6 +87,9 @@ func Setup(mgr ctrl.Manager, l logging.Logger) error {
 		cache.SetupReplicationGroupClaimBinding,
 		cache.SetupReplicationGroup,
 		cachesubnetgroup.SetupCacheSubnetGroup,
+		cachecluster.SetupCacheCluster,
+		cacheparametergroup.SetupCacheParameterGroup,
+		cachesnapshot.SetupCacheSnapshot,
 		compute.SetupEKSClusterClaimScheduling,
 		compute.SetupEKSClusterClaimDefaulting,
 		compute.SetupEKSClusterClaimBinding,
@@ -98,7 +125,28 @@ func Setup(mgr ctrl.Manager, l logging.Logger) error {
 		securitygroup.SetupSecurityGroup,
 		internetgateway.SetupInternetGateway,
 		routetable.SetupRouteTable,
+		launchtemplate.SetupLaunchTemplate,
+		networkacl.SetupNetworkACL,
+		vpcendpoint.SetupVPCEndpoint,
+		flowlog.SetupFlowLog,
+		dhcpoptions.SetupDHCPOptions,
+		volume.SetupVolume,
+		volumeattachment.SetupVolumeAttachment,
+		snapshot.SetupSnapshot,
+		keypair.SetupKeyPair,
+		autoscalinggroup.SetupAutoScalingGroup,
+		loadbalancer.SetupLoadBalancer,
+		targetgroup.SetupTargetGroup,
+		listener.SetupListener,
+		listenerrule.SetupListenerRule,
 		dbsubnetgroup.SetupDBSubnetGroup,
+		dbcluster.SetupDBCluster,
+		dbparametergroup.SetupDBParameterGroup,
+		dbclusterparametergroup.SetupDBClusterParameterGroup,
+		optiongroup.SetupOptionGroup,
+		dbsnapshot.SetupDBSnapshot,
+		dbproxy.SetupDBProxy,
+		dbproxytargetgroup.SetupDBProxyTargetGroup,
 		certificateauthority.SetupCertificateAuthority,
 		certificateauthoritypermission.SetupCertificateAuthorityPermission,
 		acm.SetupCertificate,
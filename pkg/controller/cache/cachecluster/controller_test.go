@@ -0,0 +1,503 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cachecluster
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awscache "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache/fake"
+)
+
+var (
+	clusterID = "example"
+	nodeType  = "cache.t3.micro"
+	version   = "1.5.16"
+	address   = "example.abcdef.cfg.use1.cache.amazonaws.com"
+	node1     = "example.abcdef.0001.use1.cache.amazonaws.com"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	cache elasticache.Client
+	kube  client.Client
+	cr    *v1alpha1.CacheCluster
+}
+
+type ccModifier func(*v1alpha1.CacheCluster)
+
+func withConditions(c ...runtimev1alpha1.Condition) ccModifier {
+	return func(r *v1alpha1.CacheCluster) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withNumCacheNodes(n int) ccModifier {
+	return func(r *v1alpha1.CacheCluster) { r.Spec.ForProvider.NumCacheNodes = n }
+}
+
+func withEngineVersion(v string) ccModifier {
+	return func(r *v1alpha1.CacheCluster) { r.Spec.ForProvider.EngineVersion = &v }
+}
+
+func withObservation(o v1alpha1.CacheClusterObservation) ccModifier {
+	return func(r *v1alpha1.CacheCluster) { r.Status.AtProvider = o }
+}
+
+func cluster(m ...ccModifier) *v1alpha1.CacheCluster {
+	cr := &v1alpha1.CacheCluster{
+		Spec: v1alpha1.CacheClusterSpec{
+			ForProvider: v1alpha1.CacheClusterParameters{
+				CacheNodeType: nodeType,
+				Engine:        "memcached",
+				NumCacheNodes: 1,
+			},
+		},
+	}
+	meta.SetExternalName(cr, clusterID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(err error, cc awscache.CacheCluster) func(*awscache.DescribeCacheClustersInput) awscache.DescribeCacheClustersRequest {
+	return func(in *awscache.DescribeCacheClustersInput) awscache.DescribeCacheClustersRequest {
+		return awscache.DescribeCacheClustersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awscache.DescribeCacheClustersOutput{
+				CacheClusters: []awscache.CacheCluster{cc},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.CacheCluster
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheClustersRequest: describe(nil, awscache.CacheCluster{
+						CacheClusterStatus:    aws.String(v1alpha1.CacheClusterStatusAvailable),
+						CacheNodeType:         aws.String(nodeType),
+						EngineVersion:         aws.String(version),
+						NumCacheNodes:         aws.Int64(1),
+						ConfigurationEndpoint: &awscache.Endpoint{Address: aws.String(address), Port: aws.Int64(11211)},
+						CacheNodes: []awscache.CacheNode{{
+							CacheNodeId: aws.String("0001"),
+							Endpoint:    &awscache.Endpoint{Address: aws.String(node1), Port: aws.Int64(11211)},
+						}},
+					}),
+				},
+				cr: cluster(withEngineVersion(version)),
+			},
+			want: want{
+				cr: cluster(withEngineVersion(version),
+					withConditions(runtimev1alpha1.Available()),
+					withObservation(v1alpha1.CacheClusterObservation{
+						CacheClusterStatus:    v1alpha1.CacheClusterStatusAvailable,
+						ConfigurationEndpoint: v1alpha1.Endpoint{Address: address, Port: 11211},
+						NumCacheNodes:         1,
+						CacheNodes: []v1alpha1.CacheNode{{
+							CacheNodeID: "0001",
+							Endpoint:    v1alpha1.Endpoint{Address: node1, Port: 11211},
+						}},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(address),
+						runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte("11211"),
+						elasticache.CacheNodeConnectionKeyPrefix + "0001":    []byte(node1 + ":11211"),
+					},
+				},
+			},
+		},
+		"ScalingPending": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheClustersRequest: describe(nil, awscache.CacheCluster{
+						CacheClusterStatus:    aws.String(v1alpha1.CacheClusterStatusModifying),
+						CacheNodeType:         aws.String(nodeType),
+						EngineVersion:         aws.String(version),
+						NumCacheNodes:         aws.Int64(1),
+						PendingModifiedValues: &awscache.PendingModifiedValues{NumCacheNodes: aws.Int64(3)},
+					}),
+				},
+				cr: cluster(withEngineVersion(version), withNumCacheNodes(3)),
+			},
+			want: want{
+				cr: cluster(withEngineVersion(version), withNumCacheNodes(3),
+					withConditions(runtimev1alpha1.Unavailable()),
+					withObservation(v1alpha1.CacheClusterObservation{
+						CacheClusterStatus:    v1alpha1.CacheClusterStatusModifying,
+						NumCacheNodes:         1,
+						PendingModifiedValues: v1alpha1.CacheClusterPendingModifiedValues{NumCacheNodes: 3},
+					})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheClustersRequest: describe(nil, awscache.CacheCluster{
+						CacheClusterStatus: aws.String(v1alpha1.CacheClusterStatusCreating),
+						CacheNodeType:      aws.String(nodeType),
+						EngineVersion:      aws.String(version),
+						NumCacheNodes:      aws.Int64(1),
+					}),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   cluster(),
+			},
+			want: want{
+				cr: cluster(withEngineVersion(version),
+					withConditions(runtimev1alpha1.Creating()),
+					withObservation(v1alpha1.CacheClusterObservation{
+						CacheClusterStatus: v1alpha1.CacheClusterStatusCreating,
+						NumCacheNodes:      1,
+					})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"FailedLateInitialize": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheClustersRequest: describe(nil, awscache.CacheCluster{
+						EngineVersion: aws.String(version),
+					}),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   cluster(),
+			},
+			want: want{
+				cr:  cluster(withEngineVersion(version)),
+				err: errors.Wrap(errBoom, errUpdateCacheClusterCR),
+			},
+		},
+		"NotFound": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheClustersRequest: describe(awserr.New(awscache.ErrCodeCacheClusterNotFoundFault, "", nil), awscache.CacheCluster{}),
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheClustersRequest: describe(errBoom, awscache.CacheCluster{}),
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(),
+				err: errors.Wrap(errBoom, errDescribeCacheCluster),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cache, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.CacheCluster
+		err error
+	}
+
+	create := func(err error) func(*awscache.CreateCacheClusterInput) awscache.CreateCacheClusterRequest {
+		return func(in *awscache.CreateCacheClusterInput) awscache.CreateCacheClusterRequest {
+			if diff := cmp.Diff(clusterID, aws.StringValue(in.CacheClusterId)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awscache.CreateCacheClusterRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awscache.CreateCacheClusterOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cache: &fake.MockClient{MockCreateCacheClusterRequest: create(nil)},
+				cr:    cluster(),
+			},
+			want: want{
+				cr: cluster(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"AlreadyExists": {
+			args: args{
+				cache: &fake.MockClient{MockCreateCacheClusterRequest: create(awserr.New(awscache.ErrCodeCacheClusterAlreadyExistsFault, "", nil))},
+				cr:    cluster(),
+			},
+			want: want{
+				cr: cluster(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"Failed": {
+			args: args{
+				cache: &fake.MockClient{MockCreateCacheClusterRequest: create(errBoom)},
+				cr:    cluster(),
+			},
+			want: want{
+				cr:  cluster(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreateCacheCluster),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cache}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.CacheCluster
+		err error
+	}
+
+	modify := func(err error, remove []string) func(*awscache.ModifyCacheClusterInput) awscache.ModifyCacheClusterRequest {
+		return func(in *awscache.ModifyCacheClusterInput) awscache.ModifyCacheClusterRequest {
+			if diff := cmp.Diff(remove, in.CacheNodeIdsToRemove); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awscache.ModifyCacheClusterRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awscache.ModifyCacheClusterOutput{}},
+			}
+		}
+	}
+	threeNodes := awscache.CacheCluster{
+		NumCacheNodes: aws.Int64(3),
+		CacheNodes: []awscache.CacheNode{
+			{CacheNodeId: aws.String("0001")},
+			{CacheNodeId: aws.String("0002")},
+			{CacheNodeId: aws.String("0003")},
+		},
+	}
+	available := withObservation(v1alpha1.CacheClusterObservation{CacheClusterStatus: v1alpha1.CacheClusterStatusAvailable})
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ScaleIn": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheClustersRequest: describe(nil, threeNodes),
+					MockModifyCacheClusterRequest:    modify(nil, []string{"0003", "0002"}),
+				},
+				cr: cluster(withNumCacheNodes(1), available),
+			},
+			want: want{
+				cr: cluster(withNumCacheNodes(1), available),
+			},
+		},
+		"ScaleOut": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheClustersRequest: describe(nil, threeNodes),
+					MockModifyCacheClusterRequest:    modify(nil, nil),
+				},
+				cr: cluster(withNumCacheNodes(5), available),
+			},
+			want: want{
+				cr: cluster(withNumCacheNodes(5), available),
+			},
+		},
+		"NotAvailable": {
+			args: args{
+				cache: &fake.MockClient{},
+				cr:    cluster(withObservation(v1alpha1.CacheClusterObservation{CacheClusterStatus: v1alpha1.CacheClusterStatusModifying})),
+			},
+			want: want{
+				cr: cluster(withObservation(v1alpha1.CacheClusterObservation{CacheClusterStatus: v1alpha1.CacheClusterStatusModifying})),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheClustersRequest: describe(errBoom, awscache.CacheCluster{}),
+				},
+				cr: cluster(available),
+			},
+			want: want{
+				cr:  cluster(available),
+				err: errors.Wrap(errBoom, errDescribeCacheCluster),
+			},
+		},
+		"FailedModify": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheClustersRequest: describe(nil, threeNodes),
+					MockModifyCacheClusterRequest:    modify(errBoom, []string{"0003", "0002"}),
+				},
+				cr: cluster(available),
+			},
+			want: want{
+				cr:  cluster(available),
+				err: errors.Wrap(errBoom, errModifyCacheCluster),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cache}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.CacheCluster
+		err error
+	}
+
+	del := func(err error) func(*awscache.DeleteCacheClusterInput) awscache.DeleteCacheClusterRequest {
+		return func(*awscache.DeleteCacheClusterInput) awscache.DeleteCacheClusterRequest {
+			return awscache.DeleteCacheClusterRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awscache.DeleteCacheClusterOutput{}},
+			}
+		}
+	}
+	deleting := withObservation(v1alpha1.CacheClusterObservation{CacheClusterStatus: v1alpha1.CacheClusterStatusDeleting})
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cache: &fake.MockClient{MockDeleteCacheClusterRequest: del(nil)},
+				cr:    cluster(),
+			},
+			want: want{
+				cr: cluster(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cache: &fake.MockClient{},
+				cr:    cluster(deleting),
+			},
+			want: want{
+				cr: cluster(deleting, withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				cache: &fake.MockClient{MockDeleteCacheClusterRequest: del(awserr.New(awscache.ErrCodeCacheClusterNotFoundFault, "", nil))},
+				cr:    cluster(),
+			},
+			want: want{
+				cr: cluster(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				cache: &fake.MockClient{MockDeleteCacheClusterRequest: del(errBoom)},
+				cr:    cluster(),
+			},
+			want: want{
+				cr:  cluster(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteCacheCluster),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cache}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheparametergroup
+
+import (
+	"context"
+
+	commonaws "github.com/aws/aws-sdk-go-v2/aws"
+	awscache "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+)
+
+// Error strings.
+const (
+	errNotCacheParameterGroup      = "managed resource is not a Cache Parameter Group"
+	errDescribeCacheParameterGroup = "cannot describe Cache Parameter Group"
+	errDescribeCacheParameters     = "cannot describe parameters of Cache Parameter Group"
+	errCreateCacheParameterGroup   = "cannot create Cache Parameter Group"
+	errModifyCacheParameterGroup   = "cannot modify parameters of Cache Parameter Group"
+	errResetCacheParameterGroup    = "cannot reset parameters of Cache Parameter Group"
+	errDeleteCacheParameterGroup   = "cannot delete Cache Parameter Group"
+
+	errNewClient         = "cannot create new ElastiCache client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+)
+
+// SetupCacheParameterGroup adds a controller that reconciles
+// CacheParameterGroups.
+func SetupCacheParameterGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.CacheParameterGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.CacheParameterGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CacheParameterGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: elasticache.NewClient}),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (elasticache.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return nil, errors.New(errNotCacheParameterGroup)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if commonaws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient}, errors.Wrap(err, errNewClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.client.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient}, errors.Wrap(err, errNewClient)
+}
+
+type external struct {
+	client elasticache.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCacheParameterGroup)
+	}
+
+	rsp, err := e.client.DescribeCacheParameterGroupsRequest(&awscache.DescribeCacheParameterGroupsInput{
+		CacheParameterGroupName: commonaws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(elasticache.IsCacheParameterGroupNotFound, err), errDescribeCacheParameterGroup)
+	}
+	params, err := e.describeParameters(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeCacheParameters)
+	}
+
+	// We use an explicit name, so, if there is no error, there should be
+	// only 1 element in the list.
+	cr.Status.AtProvider = v1alpha1.CacheParameterGroupObservation{
+		ARN:        commonaws.StringValue(rsp.CacheParameterGroups[0].ARN),
+		Parameters: elasticache.GenerateCacheParameterObservations(params),
+	}
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: elasticache.IsCacheParameterGroupUpToDate(cr.Spec.ForProvider.Parameters, params),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCacheParameterGroup)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	_, err := e.client.CreateCacheParameterGroupRequest(&awscache.CreateCacheParameterGroupInput{
+		CacheParameterGroupName:   commonaws.String(meta.GetExternalName(cr)),
+		CacheParameterGroupFamily: commonaws.String(cr.Spec.ForProvider.CacheParameterGroupFamily),
+		Description:               commonaws.String(cr.Spec.ForProvider.Description),
+	}).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(resource.Ignore(elasticache.IsCacheParameterGroupAlreadyExists, err), errCreateCacheParameterGroup)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCacheParameterGroup)
+	}
+
+	params, err := e.describeParameters(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeCacheParameters)
+	}
+	for _, chunk := range elasticache.ChunkCacheParameters(elasticache.GetOutdatedCacheParameters(cr.Spec.ForProvider.Parameters, params)) {
+		if _, err := e.client.ModifyCacheParameterGroupRequest(&awscache.ModifyCacheParameterGroupInput{
+			CacheParameterGroupName: commonaws.String(meta.GetExternalName(cr)),
+			ParameterNameValues:     chunk,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModifyCacheParameterGroup)
+		}
+	}
+	for _, chunk := range elasticache.ChunkCacheParameters(elasticache.GetCacheParametersToReset(cr.Spec.ForProvider.Parameters, params)) {
+		if _, err := e.client.ResetCacheParameterGroupRequest(&awscache.ResetCacheParameterGroupInput{
+			CacheParameterGroupName: commonaws.String(meta.GetExternalName(cr)),
+			ParameterNameValues:     chunk,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errResetCacheParameterGroup)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CacheParameterGroup)
+	if !ok {
+		return errors.New(errNotCacheParameterGroup)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeleteCacheParameterGroupRequest(&awscache.DeleteCacheParameterGroupInput{
+		CacheParameterGroupName: commonaws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(elasticache.IsCacheParameterGroupNotFound, err), errDeleteCacheParameterGroup)
+}
+
+// describeParameters returns all the parameters of the given group that are
+// modified from their engine default.
+func (e *external) describeParameters(ctx context.Context, name string) ([]awscache.Parameter, error) {
+	var params []awscache.Parameter
+	input := &awscache.DescribeCacheParametersInput{
+		CacheParameterGroupName: commonaws.String(name),
+		Source:                  commonaws.String(elasticache.CacheParameterSourceUser),
+	}
+	for {
+		rsp, err := e.client.DescribeCacheParametersRequest(input).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, rsp.Parameters...)
+		if commonaws.StringValue(rsp.Marker) == "" {
+			return params, nil
+		}
+		input.Marker = rsp.Marker
+	}
+}
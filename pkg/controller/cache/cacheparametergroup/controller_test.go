@@ -0,0 +1,413 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheparametergroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awscache "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache/fake"
+)
+
+var (
+	groupARN = "arn:aws:elasticache:us-east-1:123456789012:parametergroup:example"
+	errBoom  = errors.New("boom")
+)
+
+type args struct {
+	client elasticache.Client
+	cr     *v1alpha1.CacheParameterGroup
+}
+
+type groupModifier func(*v1alpha1.CacheParameterGroup)
+
+func withConditions(c ...runtimev1alpha1.Condition) groupModifier {
+	return func(r *v1alpha1.CacheParameterGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withParameters(p ...v1alpha1.CacheParameter) groupModifier {
+	return func(r *v1alpha1.CacheParameterGroup) { r.Spec.ForProvider.Parameters = p }
+}
+
+func withObservation(o v1alpha1.CacheParameterGroupObservation) groupModifier {
+	return func(r *v1alpha1.CacheParameterGroup) { r.Status.AtProvider = o }
+}
+
+func group(m ...groupModifier) *v1alpha1.CacheParameterGroup {
+	cr := &v1alpha1.CacheParameterGroup{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeGroups(err error) func(*awscache.DescribeCacheParameterGroupsInput) awscache.DescribeCacheParameterGroupsRequest {
+	return func(*awscache.DescribeCacheParameterGroupsInput) awscache.DescribeCacheParameterGroupsRequest {
+		return awscache.DescribeCacheParameterGroupsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awscache.DescribeCacheParameterGroupsOutput{
+				CacheParameterGroups: []awscache.CacheParameterGroup{{ARN: aws.String(groupARN)}},
+			}},
+		}
+	}
+}
+
+func describeParameters(err error, p ...awscache.Parameter) func(*awscache.DescribeCacheParametersInput) awscache.DescribeCacheParametersRequest {
+	return func(*awscache.DescribeCacheParametersInput) awscache.DescribeCacheParametersRequest {
+		return awscache.DescribeCacheParametersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awscache.DescribeCacheParametersOutput{Parameters: p}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	maxConns := v1alpha1.CacheParameter{ParameterName: "maxmemory-policy", ParameterValue: "allkeys-lru"}
+	observed := awscache.Parameter{
+		ParameterName:  aws.String("maxmemory-policy"),
+		ParameterValue: aws.String("allkeys-lru"),
+		ChangeType:     awscache.ChangeTypeImmediate,
+	}
+
+	type want struct {
+		cr     *v1alpha1.CacheParameterGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeCacheParameterGroupsRequest: describeGroups(nil),
+					MockDescribeCacheParametersRequest:      describeParameters(nil, observed),
+				},
+				cr: group(withParameters(maxConns)),
+			},
+			want: want{
+				cr: group(withParameters(maxConns),
+					withConditions(runtimev1alpha1.Available()),
+					withObservation(v1alpha1.CacheParameterGroupObservation{
+						ARN: groupARN,
+						Parameters: []v1alpha1.CacheParameterObservation{{
+							ParameterName:  "maxmemory-policy",
+							ParameterValue: "allkeys-lru",
+							ChangeType:     string(awscache.ChangeTypeImmediate),
+						}},
+					})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ParameterChanged": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeCacheParameterGroupsRequest: describeGroups(nil),
+					MockDescribeCacheParametersRequest:      describeParameters(nil),
+				},
+				cr: group(withParameters(maxConns)),
+			},
+			want: want{
+				cr: group(withParameters(maxConns),
+					withConditions(runtimev1alpha1.Available()),
+					withObservation(v1alpha1.CacheParameterGroupObservation{ARN: groupARN})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeCacheParameterGroupsRequest: describeGroups(awserr.New(awscache.ErrCodeCacheParameterGroupNotFoundFault, "", nil)),
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeCacheParameterGroupsRequest: describeGroups(errBoom),
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(),
+				err: errors.Wrap(errBoom, errDescribeCacheParameterGroup),
+			},
+		},
+		"FailedDescribeParameters": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeCacheParameterGroupsRequest: describeGroups(nil),
+					MockDescribeCacheParametersRequest:      describeParameters(errBoom),
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(),
+				err: errors.Wrap(errBoom, errDescribeCacheParameters),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.CacheParameterGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateCacheParameterGroupRequest: func(*awscache.CreateCacheParameterGroupInput) awscache.CreateCacheParameterGroupRequest {
+						return awscache.CreateCacheParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.CreateCacheParameterGroupOutput{}},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateCacheParameterGroupRequest: func(*awscache.CreateCacheParameterGroupInput) awscache.CreateCacheParameterGroupRequest {
+						return awscache.CreateCacheParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreateCacheParameterGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	maxConns := v1alpha1.CacheParameter{ParameterName: "maxmemory-policy", ParameterValue: "volatile-lru"}
+	stale := awscache.Parameter{ParameterName: aws.String("timeout"), ParameterValue: aws.String("300")}
+
+	type want struct {
+		cr  *v1alpha1.CacheParameterGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifyAndReset": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeCacheParametersRequest: describeParameters(nil, stale),
+					MockModifyCacheParameterGroupRequest: func(in *awscache.ModifyCacheParameterGroupInput) awscache.ModifyCacheParameterGroupRequest {
+						if diff := cmp.Diff("maxmemory-policy", aws.StringValue(in.ParameterNameValues[0].ParameterName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscache.ModifyCacheParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.ModifyCacheParameterGroupOutput{}},
+						}
+					},
+					MockResetCacheParameterGroupRequest: func(in *awscache.ResetCacheParameterGroupInput) awscache.ResetCacheParameterGroupRequest {
+						if diff := cmp.Diff("timeout", aws.StringValue(in.ParameterNameValues[0].ParameterName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscache.ResetCacheParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.ResetCacheParameterGroupOutput{}},
+						}
+					},
+				},
+				cr: group(withParameters(maxConns)),
+			},
+			want: want{
+				cr: group(withParameters(maxConns)),
+			},
+		},
+		"FailedModify": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeCacheParametersRequest: describeParameters(nil),
+					MockModifyCacheParameterGroupRequest: func(*awscache.ModifyCacheParameterGroupInput) awscache.ModifyCacheParameterGroupRequest {
+						return awscache.ModifyCacheParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(withParameters(maxConns)),
+			},
+			want: want{
+				cr:  group(withParameters(maxConns)),
+				err: errors.Wrap(errBoom, errModifyCacheParameterGroup),
+			},
+		},
+		"FailedReset": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeCacheParametersRequest: describeParameters(nil, stale),
+					MockResetCacheParameterGroupRequest: func(*awscache.ResetCacheParameterGroupInput) awscache.ResetCacheParameterGroupRequest {
+						return awscache.ResetCacheParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(),
+				err: errors.Wrap(errBoom, errResetCacheParameterGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.CacheParameterGroup
+		err error
+	}
+
+	del := func(err error) func(*awscache.DeleteCacheParameterGroupInput) awscache.DeleteCacheParameterGroupRequest {
+		return func(*awscache.DeleteCacheParameterGroupInput) awscache.DeleteCacheParameterGroupRequest {
+			return awscache.DeleteCacheParameterGroupRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awscache.DeleteCacheParameterGroupOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{MockDeleteCacheParameterGroupRequest: del(nil)},
+				cr:     group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockClient{MockDeleteCacheParameterGroupRequest: del(awserr.New(awscache.ErrCodeCacheParameterGroupNotFoundFault, "", nil))},
+				cr:     group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockClient{MockDeleteCacheParameterGroupRequest: del(errBoom)},
+				cr:     group(),
+			},
+			want: want{
+				cr:  group(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteCacheParameterGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cachesnapshot
+
+import (
+	"context"
+
+	commonaws "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/cache/v1beta1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+)
+
+// Error strings.
+const (
+	errNotCacheSnapshot      = "managed resource is not a Cache Snapshot"
+	errDescribeCacheSnapshot = "cannot describe Cache Snapshot"
+	errCreateCacheSnapshot   = "cannot create Cache Snapshot"
+	errExportCacheSnapshot   = "cannot export Cache Snapshot"
+	errDeleteCacheSnapshot   = "cannot delete Cache Snapshot"
+	errUpdateCacheSnapshotCR = "cannot update Cache Snapshot Custom Resource"
+	errResolveReferences     = "cannot resolve references"
+
+	errNewClient         = "cannot create new ElastiCache client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+)
+
+// SetupCacheSnapshot adds a controller that reconciles CacheSnapshots.
+func SetupCacheSnapshot(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.CacheSnapshotGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.CacheSnapshot{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CacheSnapshotGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: elasticache.NewClient}),
+			managed.WithReferenceResolver(&referenceResolver{client: mgr.GetClient()}),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+}
+
+// A referenceResolver resolves the reference of a CacheSnapshot to its
+// ReplicationGroup. It cannot be a ResolveReferences method of CacheSnapshot
+// because the API package of ReplicationGroup imports the one of
+// CacheSnapshot.
+type referenceResolver struct {
+	client client.Client
+}
+
+func (r *referenceResolver) ResolveReferences(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CacheSnapshot)
+	if !ok {
+		return errors.New(errNotCacheSnapshot)
+	}
+
+	existing := cr.DeepCopy()
+	rsp, err := reference.NewAPIResolver(r.client, cr).Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(cr.Spec.ForProvider.ReplicationGroupID),
+		Reference:    cr.Spec.ForProvider.ReplicationGroupIDRef,
+		Selector:     cr.Spec.ForProvider.ReplicationGroupIDSelector,
+		To:           reference.To{Managed: &v1beta1.ReplicationGroup{}, List: &v1beta1.ReplicationGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, errResolveReferences)
+	}
+	cr.Spec.ForProvider.ReplicationGroupID = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.ReplicationGroupIDRef = rsp.ResolvedReference
+
+	if cmp.Equal(existing, cr) {
+		return nil
+	}
+	return errors.Wrap(r.client.Update(ctx, cr), errUpdateCacheSnapshotCR)
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (elasticache.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.CacheSnapshot)
+	if !ok {
+		return nil, errors.New(errNotCacheSnapshot)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if commonaws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient}, errors.Wrap(err, errNewClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.client.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient}, errors.Wrap(err, errNewClient)
+}
+
+type external struct {
+	client elasticache.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CacheSnapshot)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCacheSnapshot)
+	}
+
+	rsp, err := e.client.DescribeSnapshotsRequest(elasticache.NewDescribeSnapshotsInput(meta.GetExternalName(cr))).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(elasticache.IsSnapshotNotFound, err), errDescribeCacheSnapshot)
+	}
+	if len(rsp.Snapshots) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+
+	// The location the snapshot was exported to is not known to AWS.
+	exported := cr.Status.AtProvider.ExportedTo
+	cr.Status.AtProvider = elasticache.GenerateSnapshotObservation(rsp.Snapshots[0])
+	cr.Status.AtProvider.ExportedTo = exported
+
+	switch cr.Status.AtProvider.SnapshotStatus {
+	case v1alpha1.CacheSnapshotStatusAvailable:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.CacheSnapshotStatusCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case v1alpha1.CacheSnapshotStatusDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: elasticache.IsSnapshotUpToDate(cr.Spec.ForProvider, cr.Status.AtProvider, meta.GetExternalName(cr)),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CacheSnapshot)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCacheSnapshot)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	_, err := e.client.CreateSnapshotRequest(elasticache.NewCreateSnapshotInput(cr.Spec.ForProvider, meta.GetExternalName(cr))).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(resource.Ignore(elasticache.IsSnapshotAlreadyExists, err), errCreateCacheSnapshot)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CacheSnapshot)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCacheSnapshot)
+	}
+	// Only an available snapshot can be exported.
+	if cr.Status.AtProvider.SnapshotStatus != v1alpha1.CacheSnapshotStatusAvailable {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	// The snapshot may already have been exported if the status recording it
+	// was lost, in which case the copy already exists in the target bucket.
+	name := meta.GetExternalName(cr)
+	_, err := e.client.CopySnapshotRequest(elasticache.NewCopySnapshotInput(cr.Spec.ForProvider, name)).Send(ctx)
+	if resource.Ignore(elasticache.IsSnapshotAlreadyExists, err) != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errExportCacheSnapshot)
+	}
+	cr.Status.AtProvider.ExportedTo = elasticache.SnapshotExportTarget(cr.Spec.ForProvider, name)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CacheSnapshot)
+	if !ok {
+		return errors.New(errNotCacheSnapshot)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.SnapshotStatus == v1alpha1.CacheSnapshotStatusDeleting {
+		return nil
+	}
+
+	_, err := e.client.DeleteSnapshotRequest(elasticache.NewDeleteSnapshotInput(meta.GetExternalName(cr))).Send(ctx)
+	return errors.Wrap(resource.Ignore(elasticache.IsSnapshotNotFound, err), errDeleteCacheSnapshot)
+}
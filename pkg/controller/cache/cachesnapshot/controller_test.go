@@ -0,0 +1,493 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cachesnapshot
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awscache "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/cache/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache/fake"
+)
+
+var (
+	snapshotName       = "example"
+	replicationGroupID = "example-group"
+	bucket             = "example-bucket"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	cache elasticache.Client
+	cr    *v1alpha1.CacheSnapshot
+}
+
+type snapshotModifier func(*v1alpha1.CacheSnapshot)
+
+func withConditions(c ...runtimev1alpha1.Condition) snapshotModifier {
+	return func(r *v1alpha1.CacheSnapshot) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExport(bucket string) snapshotModifier {
+	return func(r *v1alpha1.CacheSnapshot) {
+		r.Spec.ForProvider.Export = &v1alpha1.CacheSnapshotExport{TargetBucket: bucket}
+	}
+}
+
+func withReplicationGroupIDRef(name string) snapshotModifier {
+	return func(r *v1alpha1.CacheSnapshot) {
+		r.Spec.ForProvider.ReplicationGroupIDRef = &runtimev1alpha1.Reference{Name: name}
+	}
+}
+
+func withReplicationGroupID(id string) snapshotModifier {
+	return func(r *v1alpha1.CacheSnapshot) { r.Spec.ForProvider.ReplicationGroupID = &id }
+}
+
+func withObservation(o v1alpha1.CacheSnapshotObservation) snapshotModifier {
+	return func(r *v1alpha1.CacheSnapshot) { r.Status.AtProvider = o }
+}
+
+func snapshot(m ...snapshotModifier) *v1alpha1.CacheSnapshot {
+	cr := &v1alpha1.CacheSnapshot{}
+	meta.SetExternalName(cr, snapshotName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(err error, s ...awscache.Snapshot) func(*awscache.DescribeSnapshotsInput) awscache.DescribeSnapshotsRequest {
+	return func(in *awscache.DescribeSnapshotsInput) awscache.DescribeSnapshotsRequest {
+		return awscache.DescribeSnapshotsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awscache.DescribeSnapshotsOutput{
+				Snapshots: s,
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+var _ managed.ReferenceResolver = &referenceResolver{}
+
+func TestResolveReferences(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.CacheSnapshot
+		err error
+	}
+
+	getReplicationGroup := func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+		meta.SetExternalName(obj.(*v1beta1.ReplicationGroup), replicationGroupID)
+		return nil
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		cr   *v1alpha1.CacheSnapshot
+		want
+	}{
+		"Resolved": {
+			kube: &test.MockClient{MockGet: getReplicationGroup, MockUpdate: test.NewMockUpdateFn(nil)},
+			cr:   snapshot(withReplicationGroupIDRef("group")),
+			want: want{
+				cr: snapshot(withReplicationGroupIDRef("group"), withReplicationGroupID(replicationGroupID)),
+			},
+		},
+		"AlreadyResolved": {
+			kube: &test.MockClient{MockGet: getReplicationGroup},
+			cr:   snapshot(withReplicationGroupIDRef("group"), withReplicationGroupID(replicationGroupID)),
+			want: want{
+				cr: snapshot(withReplicationGroupIDRef("group"), withReplicationGroupID(replicationGroupID)),
+			},
+		},
+		"FailedUpdate": {
+			kube: &test.MockClient{MockGet: getReplicationGroup, MockUpdate: test.NewMockUpdateFn(errBoom)},
+			cr:   snapshot(withReplicationGroupIDRef("group")),
+			want: want{
+				cr:  snapshot(withReplicationGroupIDRef("group"), withReplicationGroupID(replicationGroupID)),
+				err: errors.Wrap(errBoom, errUpdateCacheSnapshotCR),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &referenceResolver{client: tc.kube}
+			err := r.ResolveReferences(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.CacheSnapshot
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeSnapshotsRequest: describe(nil, awscache.Snapshot{SnapshotStatus: aws.String(v1alpha1.CacheSnapshotStatusAvailable)}),
+				},
+				cr: snapshot(),
+			},
+			want: want{
+				cr: snapshot(
+					withConditions(runtimev1alpha1.Available()),
+					withObservation(v1alpha1.CacheSnapshotObservation{SnapshotStatus: v1alpha1.CacheSnapshotStatusAvailable})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Creating": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeSnapshotsRequest: describe(nil, awscache.Snapshot{SnapshotStatus: aws.String(v1alpha1.CacheSnapshotStatusCreating)}),
+				},
+				cr: snapshot(),
+			},
+			want: want{
+				cr: snapshot(
+					withConditions(runtimev1alpha1.Creating()),
+					withObservation(v1alpha1.CacheSnapshotObservation{SnapshotStatus: v1alpha1.CacheSnapshotStatusCreating})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ExportPending": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeSnapshotsRequest: describe(nil, awscache.Snapshot{SnapshotStatus: aws.String(v1alpha1.CacheSnapshotStatusAvailable)}),
+				},
+				cr: snapshot(withExport(bucket)),
+			},
+			want: want{
+				cr: snapshot(withExport(bucket),
+					withConditions(runtimev1alpha1.Available()),
+					withObservation(v1alpha1.CacheSnapshotObservation{SnapshotStatus: v1alpha1.CacheSnapshotStatusAvailable})),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"Exported": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeSnapshotsRequest: describe(nil, awscache.Snapshot{SnapshotStatus: aws.String(v1alpha1.CacheSnapshotStatusAvailable)}),
+				},
+				cr: snapshot(withExport(bucket), withObservation(v1alpha1.CacheSnapshotObservation{ExportedTo: bucket + "/" + snapshotName})),
+			},
+			want: want{
+				cr: snapshot(withExport(bucket),
+					withConditions(runtimev1alpha1.Available()),
+					withObservation(v1alpha1.CacheSnapshotObservation{
+						ExportedTo:     bucket + "/" + snapshotName,
+						SnapshotStatus: v1alpha1.CacheSnapshotStatusAvailable,
+					})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotFound": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeSnapshotsRequest: describe(awserr.New(awscache.ErrCodeSnapshotNotFoundFault, "", nil)),
+				},
+				cr: snapshot(),
+			},
+			want: want{
+				cr: snapshot(),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeSnapshotsRequest: describe(errBoom),
+				},
+				cr: snapshot(),
+			},
+			want: want{
+				cr:  snapshot(),
+				err: errors.Wrap(errBoom, errDescribeCacheSnapshot),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cache}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.CacheSnapshot
+		err error
+	}
+
+	create := func(err error) func(*awscache.CreateSnapshotInput) awscache.CreateSnapshotRequest {
+		return func(in *awscache.CreateSnapshotInput) awscache.CreateSnapshotRequest {
+			if diff := cmp.Diff(snapshotName, aws.StringValue(in.SnapshotName)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(replicationGroupID, aws.StringValue(in.ReplicationGroupId)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awscache.CreateSnapshotRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awscache.CreateSnapshotOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cache: &fake.MockClient{MockCreateSnapshotRequest: create(nil)},
+				cr:    snapshot(withReplicationGroupID(replicationGroupID)),
+			},
+			want: want{
+				cr: snapshot(withReplicationGroupID(replicationGroupID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"AlreadyExists": {
+			args: args{
+				cache: &fake.MockClient{MockCreateSnapshotRequest: create(awserr.New(awscache.ErrCodeSnapshotAlreadyExistsFault, "", nil))},
+				cr:    snapshot(withReplicationGroupID(replicationGroupID)),
+			},
+			want: want{
+				cr: snapshot(withReplicationGroupID(replicationGroupID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"Failed": {
+			args: args{
+				cache: &fake.MockClient{MockCreateSnapshotRequest: create(errBoom)},
+				cr:    snapshot(withReplicationGroupID(replicationGroupID)),
+			},
+			want: want{
+				cr:  snapshot(withReplicationGroupID(replicationGroupID), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreateCacheSnapshot),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cache}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.CacheSnapshot
+		err error
+	}
+
+	copySnapshot := func(err error) func(*awscache.CopySnapshotInput) awscache.CopySnapshotRequest {
+		return func(in *awscache.CopySnapshotInput) awscache.CopySnapshotRequest {
+			if diff := cmp.Diff(bucket, aws.StringValue(in.TargetBucket)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awscache.CopySnapshotRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awscache.CopySnapshotOutput{}},
+			}
+		}
+	}
+	available := v1alpha1.CacheSnapshotObservation{SnapshotStatus: v1alpha1.CacheSnapshotStatusAvailable}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotAvailable": {
+			args: args{
+				cr: snapshot(withExport(bucket), withObservation(v1alpha1.CacheSnapshotObservation{SnapshotStatus: v1alpha1.CacheSnapshotStatusCreating})),
+			},
+			want: want{
+				cr: snapshot(withExport(bucket), withObservation(v1alpha1.CacheSnapshotObservation{SnapshotStatus: v1alpha1.CacheSnapshotStatusCreating})),
+			},
+		},
+		"Exported": {
+			args: args{
+				cache: &fake.MockClient{MockCopySnapshotRequest: copySnapshot(nil)},
+				cr:    snapshot(withExport(bucket), withObservation(available)),
+			},
+			want: want{
+				cr: snapshot(withExport(bucket), withObservation(v1alpha1.CacheSnapshotObservation{
+					ExportedTo:     bucket + "/" + snapshotName,
+					SnapshotStatus: v1alpha1.CacheSnapshotStatusAvailable,
+				})),
+			},
+		},
+		"AlreadyExported": {
+			args: args{
+				cache: &fake.MockClient{MockCopySnapshotRequest: copySnapshot(awserr.New(awscache.ErrCodeSnapshotAlreadyExistsFault, "", nil))},
+				cr:    snapshot(withExport(bucket), withObservation(available)),
+			},
+			want: want{
+				cr: snapshot(withExport(bucket), withObservation(v1alpha1.CacheSnapshotObservation{
+					ExportedTo:     bucket + "/" + snapshotName,
+					SnapshotStatus: v1alpha1.CacheSnapshotStatusAvailable,
+				})),
+			},
+		},
+		"FailedExport": {
+			args: args{
+				cache: &fake.MockClient{MockCopySnapshotRequest: copySnapshot(errBoom)},
+				cr:    snapshot(withExport(bucket), withObservation(available)),
+			},
+			want: want{
+				cr:  snapshot(withExport(bucket), withObservation(available)),
+				err: errors.Wrap(errBoom, errExportCacheSnapshot),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cache}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.CacheSnapshot
+		err error
+	}
+
+	del := func(err error) func(*awscache.DeleteSnapshotInput) awscache.DeleteSnapshotRequest {
+		return func(in *awscache.DeleteSnapshotInput) awscache.DeleteSnapshotRequest {
+			return awscache.DeleteSnapshotRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awscache.DeleteSnapshotOutput{}},
+			}
+		}
+	}
+	deleting := v1alpha1.CacheSnapshotObservation{SnapshotStatus: v1alpha1.CacheSnapshotStatusDeleting}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cache: &fake.MockClient{MockDeleteSnapshotRequest: del(nil)},
+				cr:    snapshot(),
+			},
+			want: want{
+				cr: snapshot(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: snapshot(withObservation(deleting)),
+			},
+			want: want{
+				cr: snapshot(withObservation(deleting), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				cache: &fake.MockClient{MockDeleteSnapshotRequest: del(awserr.New(awscache.ErrCodeSnapshotNotFoundFault, "", nil))},
+				cr:    snapshot(),
+			},
+			want: want{
+				cr: snapshot(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				cache: &fake.MockClient{MockDeleteSnapshotRequest: del(errBoom)},
+				cr:    snapshot(),
+			},
+			want: want{
+				cr:  snapshot(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteCacheSnapshot),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cache}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
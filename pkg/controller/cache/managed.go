@@ -18,13 +18,16 @@ package cache
 
 import (
 	"context"
+	"encoding/json"
 	"reflect"
 	"sort"
+	"time"
 
 	commonaws "github.com/aws/aws-sdk-go-v2/aws"
 	elasticacheservice "github.com/aws/aws-sdk-go-v2/service/elasticache"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -56,7 +59,14 @@ const (
 	errGenerateAuthToken        = "cannot generate ElastiCache auth token"
 	errCreateReplicationGroup   = "cannot create ElastiCache replication group"
 	errModifyReplicationGroup   = "cannot modify ElastiCache replication group"
+	errModifyShardConfiguration = "cannot modify ElastiCache replication group shard configuration"
+	errIncreaseReplicaCount     = "cannot increase ElastiCache replication group replica count"
+	errDecreaseReplicaCount     = "cannot decrease ElastiCache replication group replica count"
 	errDeleteReplicationGroup   = "cannot delete ElastiCache replication group"
+	errUpdateAuthToken          = "cannot update ElastiCache replication group auth token"
+	errGetConnectionSecret      = "cannot get connection secret"
+	errStoreAuthToken           = "cannot store pending auth token in connection secret"
+	errCompleteAuthToken        = "cannot remove pending auth token from connection secret"
 )
 
 // SetupReplicationGroup adds a controller that reconciles ReplicationGroups.
@@ -147,7 +157,16 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateReplicationGroupCR)
 		}
 	}
+	// AWS does not always report when the auth token was last modified, so we
+	// keep track of when we generated one. Replication groups that were not
+	// created by us fall back to the creation time of their clusters.
+	rotated := cr.Status.AtProvider.AuthTokenRotateTime
+	if rotated == nil && commonaws.BoolValue(cr.Spec.ForProvider.AuthEnabled) && oneCC.CacheClusterCreateTime != nil {
+		t := metav1.NewTime(*oneCC.CacheClusterCreateTime)
+		rotated = &t
+	}
 	cr.Status.AtProvider = elasticache.GenerateObservation(rg)
+	cr.Status.AtProvider.AuthTokenRotateTime = rotated
 
 	switch cr.Status.AtProvider.Status {
 	case v1beta1.StatusAvailable:
@@ -161,9 +180,14 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		cr.Status.SetConditions(runtimev1alpha1.Unavailable())
 	}
 
+	tokenUpdateDue, err := e.isAuthTokenUpdateDue(ctx, cr, time.Now())
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  !elasticache.ReplicationGroupNeedsUpdate(cr.Spec.ForProvider, rg, ccList),
+		ResourceUpToDate:  !elasticache.ReplicationGroupNeedsUpdate(cr.Spec.ForProvider, rg, ccList) && !tokenUpdateDue,
 		ConnectionDetails: elasticache.ConnectionEndpoint(rg),
 	}, nil
 }
@@ -187,6 +211,8 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 			return managed.ExternalCreation{}, errors.Wrap(err, errGenerateAuthToken)
 		}
 		token = &t
+		now := metav1.Now()
+		cr.Status.AtProvider.AuthTokenRotateTime = &now
 	}
 	r := e.client.CreateReplicationGroupRequest(elasticache.NewCreateReplicationGroupInput(cr.Spec.ForProvider, meta.GetExternalName(cr), token))
 	if _, err := r.Send(ctx); err != nil {
@@ -212,11 +238,146 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Status.AtProvider.Status != v1beta1.StatusAvailable {
 		return managed.ExternalUpdate{}, nil
 	}
-	mr := e.client.ModifyReplicationGroupRequest(elasticache.NewModifyReplicationGroupInput(cr.Spec.ForProvider, meta.GetExternalName(cr)))
-	_, err := mr.Send(ctx)
+	// AWS is still busy with the last auth token update.
+	if cr.Status.AtProvider.PendingModifiedValues.AuthTokenStatus != "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	tokenUpdateDue, err := e.isAuthTokenUpdateDue(ctx, cr, time.Now())
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if tokenUpdateDue {
+		return e.updateAuthToken(ctx, cr, time.Now())
+	}
+	// Resharding and replica scaling put the replication group into the
+	// `modifying` state, so we issue at most one of them per reconcile and
+	// wait for the group to become available again before making any other
+	// modification. The progress of resharding is reported in the status.
+	id := meta.GetExternalName(cr)
+	switch {
+	case elasticache.NodeGroupCountNeedsUpdate(cr.Spec.ForProvider, cr.Status.AtProvider):
+		r := e.client.ModifyReplicationGroupShardConfigurationRequest(elasticache.NewModifyReplicationGroupShardConfigurationInput(cr.Spec.ForProvider, id, cr.Status.AtProvider))
+		_, err := r.Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errModifyShardConfiguration)
+	case elasticache.ReplicaCountNeedsIncrease(cr.Spec.ForProvider, cr.Status.AtProvider):
+		r := e.client.IncreaseReplicaCountRequest(elasticache.NewIncreaseReplicaCountInput(cr.Spec.ForProvider, id))
+		_, err := r.Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errIncreaseReplicaCount)
+	case elasticache.ReplicaCountNeedsDecrease(cr.Spec.ForProvider, cr.Status.AtProvider):
+		r := e.client.DecreaseReplicaCountRequest(elasticache.NewDecreaseReplicaCountInput(cr.Spec.ForProvider, id))
+		_, err := r.Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDecreaseReplicaCount)
+	}
+	mr := e.client.ModifyReplicationGroupRequest(elasticache.NewModifyReplicationGroupInput(cr.Spec.ForProvider, id))
+	_, err = mr.Send(ctx)
 	return managed.ExternalUpdate{}, errors.Wrap(err, errModifyReplicationGroup)
 }
 
+// updateAuthToken rotates in a new auth token, which is published while the
+// old one is still valid, or makes the published one the only valid token
+// once AWS is done rotating it in. The new token is kept in the connection
+// secret until it is set so that every step can be retried with it. Whether
+// it is rotated in is worked out from the time the token was last modified.
+func (e *external) updateAuthToken(ctx context.Context, cr *v1beta1.ReplicationGroup, now time.Time) (managed.ExternalUpdate, error) {
+	s, err := e.getConnectionSecret(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	id := meta.GetExternalName(cr)
+	token := string(s.Data[elasticache.PendingAuthTokenKey])
+	if elasticache.IsAuthTokenRotationDue(cr.Spec.ForProvider, cr.Status.AtProvider, now) {
+		if token == "" {
+			if token, err = e.storePendingAuthToken(ctx, cr); err != nil {
+				return managed.ExternalUpdate{}, err
+			}
+		}
+		r := e.client.ModifyReplicationGroupRequest(elasticache.NewUpdateAuthTokenInput(id, token, elasticacheservice.AuthTokenUpdateStrategyTypeRotate))
+		if _, err := r.Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAuthToken)
+		}
+		t := metav1.NewTime(now)
+		cr.Status.AtProvider.AuthTokenRotateTime = &t
+		return managed.ExternalUpdate{
+			ConnectionDetails: managed.ConnectionDetails{runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(token)},
+		}, nil
+	}
+	if token == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	// The old token stays valid until the new one is published.
+	if token != string(s.Data[runtimev1alpha1.ResourceCredentialsSecretPasswordKey]) {
+		return managed.ExternalUpdate{
+			ConnectionDetails: managed.ConnectionDetails{runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(token)},
+		}, nil
+	}
+	r := e.client.ModifyReplicationGroupRequest(elasticache.NewUpdateAuthTokenInput(id, token, elasticacheservice.AuthTokenUpdateStrategyTypeSet))
+	if _, err := r.Send(ctx); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAuthToken)
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(e.removePendingAuthToken(ctx, s), errCompleteAuthToken)
+}
+
+// removePendingAuthToken removes the pending auth token from the given
+// connection secret. Only the pending key is patched so that the rest of the
+// secret is left as is, even if it changed since it was read.
+func (e *external) removePendingAuthToken(ctx context.Context, s *corev1.Secret) error {
+	p, err := json.Marshal(map[string]interface{}{
+		"data": map[string]interface{}{elasticache.PendingAuthTokenKey: nil},
+	})
+	if err != nil {
+		return err
+	}
+	return e.kube.Patch(ctx, s, client.RawPatch(types.MergePatchType, p))
+}
+
+// storePendingAuthToken generates a new auth token and stores it in the
+// connection secret before it is sent to AWS.
+func (e *external) storePendingAuthToken(ctx context.Context, cr *v1beta1.ReplicationGroup) (string, error) {
+	token, err := password.Generate()
+	if err != nil {
+		return "", errors.Wrap(err, errGenerateAuthToken)
+	}
+	s := resource.ConnectionSecretFor(cr, v1beta1.ReplicationGroupGroupVersionKind)
+	s.Data[elasticache.PendingAuthTokenKey] = []byte(token)
+	err = resource.NewAPIPatchingApplicator(e.kube).Apply(ctx, s, resource.ConnectionSecretMustBeControllableBy(cr.GetUID()))
+	return token, errors.Wrap(err, errStoreAuthToken)
+}
+
+// getConnectionSecret returns the connection secret of the given replication
+// group, or an empty one if it does not exist yet.
+func (e *external) getConnectionSecret(ctx context.Context, cr *v1beta1.ReplicationGroup) (*corev1.Secret, error) {
+	s := &corev1.Secret{}
+	nn := types.NamespacedName{
+		Name:      cr.Spec.WriteConnectionSecretToReference.Name,
+		Namespace: cr.Spec.WriteConnectionSecretToReference.Namespace,
+	}
+	err := e.kube.Get(ctx, nn, s)
+	return s, errors.Wrap(resource.IgnoreNotFound(err), errGetConnectionSecret)
+}
+
+// isAuthTokenUpdateDue returns true if the auth token of the given replication
+// group is due to be rotated, or if a rotated token is pending to be set. The
+// auth token can only be rotated if it is published to a connection secret.
+func (e *external) isAuthTokenUpdateDue(ctx context.Context, cr *v1beta1.ReplicationGroup, now time.Time) (bool, error) {
+	p := cr.Spec.ForProvider
+	if cr.Spec.WriteConnectionSecretToReference == nil || p.AuthTokenRotation == nil || !commonaws.BoolValue(p.AuthEnabled) {
+		return false, nil
+	}
+	// AWS is still busy with the last auth token update.
+	if cr.Status.AtProvider.PendingModifiedValues.AuthTokenStatus != "" {
+		return false, nil
+	}
+	if elasticache.IsAuthTokenRotationDue(p, cr.Status.AtProvider, now) {
+		return true, nil
+	}
+	s, err := e.getConnectionSecret(ctx, cr)
+	if err != nil {
+		return false, err
+	}
+	_, ok := s.Data[elasticache.PendingAuthTokenKey]
+	return ok, nil
+}
+
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1beta1.ReplicationGroup)
 	if !ok {
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
@@ -33,6 +34,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
@@ -74,6 +76,10 @@ var (
 
 	cacheClusterID = name + "-0001"
 
+	authToken                 = "coolToken"
+	authTokenLastModifiedDate = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	authTokenRotatedDate      = metav1.NewTime(time.Now())
+
 	ctx       = context.Background()
 	errorBoom = errors.New("boom")
 
@@ -100,6 +106,18 @@ var (
 	}
 )
 
+// equateApproxTime treats times that are less than a minute apart as equal so
+// that times taken from the clock while testing can be compared.
+func equateApproxTime() cmp.Option {
+	return cmp.Comparer(func(a, b *metav1.Time) bool {
+		if a == nil || b == nil {
+			return a == b
+		}
+		d := a.Sub(b.Time)
+		return d > -time.Minute && d < time.Minute
+	})
+}
+
 type testCase struct {
 	name         string
 	e            managed.ExternalClient
@@ -147,6 +165,46 @@ func withClusterEnabled(e bool) replicationGroupModifier {
 	return func(r *v1beta1.ReplicationGroup) { r.Status.AtProvider.ClusterEnabled = e }
 }
 
+func withNumNodeGroups(n int) replicationGroupModifier {
+	return func(r *v1beta1.ReplicationGroup) { r.Spec.ForProvider.NumNodeGroups = &n }
+}
+
+func withReplicasPerNodeGroup(n int) replicationGroupModifier {
+	return func(r *v1beta1.ReplicationGroup) { r.Spec.ForProvider.ReplicasPerNodeGroup = &n }
+}
+
+func withNodeGroups(replicas ...int) replicationGroupModifier {
+	return func(r *v1beta1.ReplicationGroup) {
+		r.Status.AtProvider.NodeGroups = make([]v1beta1.NodeGroup, len(replicas))
+		for i, n := range replicas {
+			r.Status.AtProvider.NodeGroups[i] = v1beta1.NodeGroup{
+				NodeGroupID:      fmt.Sprintf("%04d", i+1),
+				NodeGroupMembers: make([]v1beta1.NodeGroupMember, n+1),
+			}
+		}
+	}
+}
+
+func withAuthTokenRotation(d time.Duration) replicationGroupModifier {
+	return func(r *v1beta1.ReplicationGroup) {
+		r.Spec.ForProvider.AuthTokenRotation = &v1beta1.AuthTokenRotationPolicy{Interval: metav1.Duration{Duration: d}}
+	}
+}
+
+func withAuthTokenLastModifiedDate(t metav1.Time) replicationGroupModifier {
+	return func(r *v1beta1.ReplicationGroup) { r.Status.AtProvider.AuthTokenLastModifiedDate = &t }
+}
+
+func withAuthTokenRotateTime(t metav1.Time) replicationGroupModifier {
+	return func(r *v1beta1.ReplicationGroup) { r.Status.AtProvider.AuthTokenRotateTime = &t }
+}
+
+func withAuthTokenStatus(s elasticache.AuthTokenUpdateStatus) replicationGroupModifier {
+	return func(r *v1beta1.ReplicationGroup) {
+		r.Status.AtProvider.PendingModifiedValues.AuthTokenStatus = string(s)
+	}
+}
+
 func withTags(tagMaps ...map[string]string) replicationGroupModifier {
 	var tagList []v1beta1.Tag
 	for _, tagMap := range tagMaps {
@@ -208,6 +266,7 @@ func TestCreate(t *testing.T) {
 				withAuthEnabled(true),
 				withConditions(runtimev1alpha1.Creating()),
 				withReplicationGroupID(name),
+				withAuthTokenRotateTime(metav1.Now()),
 			),
 			tokenCreated: true,
 		},
@@ -239,7 +298,7 @@ func TestCreate(t *testing.T) {
 			if tc.tokenCreated != (len(creation.ConnectionDetails[runtimev1alpha1.ResourceCredentialsSecretPasswordKey]) != 0) {
 				t.Errorf("tc.e.Create(...) token creation: want: %t got: %t", tc.tokenCreated, len(creation.ConnectionDetails) != 0)
 			}
-			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions(), equateApproxTime()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
 		})
@@ -386,6 +445,46 @@ func TestObserve(t *testing.T) {
 				withConditions(runtimev1alpha1.Creating()),
 			),
 		},
+		{
+			name: "SuccessfulObserveAuthTokenRotateTime",
+			e: &external{client: &fake.MockClient{
+				MockDescribeReplicationGroupsRequest: func(_ *elasticache.DescribeReplicationGroupsInput) elasticache.DescribeReplicationGroupsRequest {
+					return elasticache.DescribeReplicationGroupsRequest{
+						Request: &aws.Request{
+							HTTPRequest: &http.Request{},
+							Retryer:     aws.NoOpRetryer{},
+							Data: &elasticache.DescribeReplicationGroupsOutput{
+								ReplicationGroups: []elasticache.ReplicationGroup{{
+									AuthTokenEnabled: aws.Bool(true),
+									Status:           aws.String(v1beta1.StatusCreating),
+									MemberClusters:   []string{cacheClusterID},
+								}},
+							},
+						},
+					}
+				},
+				MockDescribeCacheClustersRequest: func(_ *elasticache.DescribeCacheClustersInput) elasticache.DescribeCacheClustersRequest {
+					return elasticache.DescribeCacheClustersRequest{
+						Request: &aws.Request{
+							HTTPRequest: &http.Request{},
+							Retryer:     aws.NoOpRetryer{},
+							Data: &elasticache.DescribeCacheClustersOutput{
+								CacheClusters: []elasticache.CacheCluster{{CacheClusterCreateTime: &authTokenLastModifiedDate.Time}},
+							},
+						},
+					}
+				},
+			}},
+			r: replicationGroup(withReplicationGroupID(name), withAuthEnabled(true)),
+			want: replicationGroup(
+				withReplicationGroupID(name),
+				withAuthEnabled(true),
+				withProviderStatus(v1beta1.StatusCreating),
+				withMemberClusters([]string{cacheClusterID}),
+				withAuthTokenRotateTime(authTokenLastModifiedDate),
+				withConditions(runtimev1alpha1.Creating()),
+			),
+		},
 		{
 			name: "FailedObserveLateInitializeError",
 			e: &external{
@@ -564,6 +663,360 @@ func TestUpdate(t *testing.T) {
 			),
 			returnsErr: true,
 		},
+		{
+			name: "ModifyShardConfiguration",
+			e: &external{client: &fake.MockClient{
+				MockModifyReplicationGroupShardConfigurationRequest: func(_ *elasticache.ModifyReplicationGroupShardConfigurationInput) elasticache.ModifyReplicationGroupShardConfigurationRequest {
+					return elasticache.ModifyReplicationGroupShardConfigurationRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &elasticache.ModifyReplicationGroupShardConfigurationOutput{}},
+					}
+				},
+			}},
+			r: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withNumNodeGroups(3),
+				withReplicasPerNodeGroup(1),
+				withNodeGroups(2, 2),
+			),
+			want: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withNumNodeGroups(3),
+				withReplicasPerNodeGroup(1),
+				withNodeGroups(2, 2),
+			),
+		},
+		{
+			name: "FailedModifyShardConfiguration",
+			e: &external{client: &fake.MockClient{
+				MockModifyReplicationGroupShardConfigurationRequest: func(_ *elasticache.ModifyReplicationGroupShardConfigurationInput) elasticache.ModifyReplicationGroupShardConfigurationRequest {
+					return elasticache.ModifyReplicationGroupShardConfigurationRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errorBoom},
+					}
+				},
+			}},
+			r: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withNumNodeGroups(1),
+				withNodeGroups(2, 2),
+			),
+			want: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withNumNodeGroups(1),
+				withNodeGroups(2, 2),
+			),
+			returnsErr: true,
+		},
+		{
+			name: "IncreaseReplicaCount",
+			e: &external{client: &fake.MockClient{
+				MockIncreaseReplicaCountRequest: func(_ *elasticache.IncreaseReplicaCountInput) elasticache.IncreaseReplicaCountRequest {
+					return elasticache.IncreaseReplicaCountRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &elasticache.IncreaseReplicaCountOutput{}},
+					}
+				},
+			}},
+			r: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withNumNodeGroups(2),
+				withReplicasPerNodeGroup(2),
+				withNodeGroups(2, 1),
+			),
+			want: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withNumNodeGroups(2),
+				withReplicasPerNodeGroup(2),
+				withNodeGroups(2, 1),
+			),
+		},
+		{
+			name: "FailedDecreaseReplicaCount",
+			e: &external{client: &fake.MockClient{
+				MockDecreaseReplicaCountRequest: func(_ *elasticache.DecreaseReplicaCountInput) elasticache.DecreaseReplicaCountRequest {
+					return elasticache.DecreaseReplicaCountRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errorBoom},
+					}
+				},
+			}},
+			r: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withReplicasPerNodeGroup(1),
+				withNodeGroups(2),
+			),
+			want: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withReplicasPerNodeGroup(1),
+				withNodeGroups(2),
+			),
+			returnsErr: true,
+		},
+		{
+			name: "WaitForResharding",
+			e:    &external{},
+			r: replicationGroup(
+				withProviderStatus(v1beta1.StatusModifying),
+				withNumNodeGroups(3),
+				withNodeGroups(2, 2),
+			),
+			want: replicationGroup(
+				withProviderStatus(v1beta1.StatusModifying),
+				withNumNodeGroups(3),
+				withNodeGroups(2, 2),
+			),
+		},
+		{
+			name: "RotateAuthToken",
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+					MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+						if len(obj.(*corev1.Secret).Data[elasticacheclient.PendingAuthTokenKey]) == 0 {
+							return errorBoom
+						}
+						return nil
+					},
+				},
+				client: &fake.MockClient{
+					MockModifyReplicationGroupRequest: func(in *elasticache.ModifyReplicationGroupInput) elasticache.ModifyReplicationGroupRequest {
+						if in.AuthTokenUpdateStrategy != elasticache.AuthTokenUpdateStrategyTypeRotate || awsclients.StringValue(in.AuthToken) == "" {
+							return elasticache.ModifyReplicationGroupRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errorBoom}}
+						}
+						return elasticache.ModifyReplicationGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &elasticache.ModifyReplicationGroupOutput{}},
+						}
+					},
+				},
+			},
+			r: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withAuthEnabled(true),
+				withAuthTokenRotation(time.Hour),
+				withAuthTokenLastModifiedDate(authTokenLastModifiedDate),
+			),
+			want: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withAuthEnabled(true),
+				withAuthTokenRotation(time.Hour),
+				withAuthTokenLastModifiedDate(authTokenLastModifiedDate),
+				withAuthTokenRotateTime(metav1.Now()),
+			),
+			tokenCreated: true,
+		},
+		{
+			name: "RotatePendingAuthToken",
+			e: &external{
+				kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{elasticacheclient.PendingAuthTokenKey: []byte(authToken)}
+					return nil
+				}},
+				client: &fake.MockClient{
+					MockModifyReplicationGroupRequest: func(in *elasticache.ModifyReplicationGroupInput) elasticache.ModifyReplicationGroupRequest {
+						if in.AuthTokenUpdateStrategy != elasticache.AuthTokenUpdateStrategyTypeRotate || awsclients.StringValue(in.AuthToken) != authToken {
+							return elasticache.ModifyReplicationGroupRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errorBoom}}
+						}
+						return elasticache.ModifyReplicationGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &elasticache.ModifyReplicationGroupOutput{}},
+						}
+					},
+				},
+			},
+			r: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withAuthEnabled(true),
+				withAuthTokenRotation(time.Hour),
+				withAuthTokenLastModifiedDate(authTokenLastModifiedDate),
+			),
+			want: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withAuthEnabled(true),
+				withAuthTokenRotation(time.Hour),
+				withAuthTokenLastModifiedDate(authTokenLastModifiedDate),
+				withAuthTokenRotateTime(metav1.Now()),
+			),
+			tokenCreated: true,
+		},
+		{
+			name: "FailedRotateAuthToken",
+			e: &external{
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+					MockCreate: test.NewMockCreateFn(nil),
+				},
+				client: &fake.MockClient{
+					MockModifyReplicationGroupRequest: func(_ *elasticache.ModifyReplicationGroupInput) elasticache.ModifyReplicationGroupRequest {
+						return elasticache.ModifyReplicationGroupRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errorBoom}}
+					},
+				},
+			},
+			r: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withAuthEnabled(true),
+				withAuthTokenRotation(time.Hour),
+				withAuthTokenLastModifiedDate(authTokenLastModifiedDate),
+			),
+			want: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withAuthEnabled(true),
+				withAuthTokenRotation(time.Hour),
+				withAuthTokenLastModifiedDate(authTokenLastModifiedDate),
+			),
+			returnsErr: true,
+		},
+		{
+			name: "FailedStorePendingAuthToken",
+			e: &external{
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+					MockCreate: test.NewMockCreateFn(errorBoom),
+				},
+			},
+			r: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withAuthEnabled(true),
+				withAuthTokenRotation(time.Hour),
+				withAuthTokenLastModifiedDate(authTokenLastModifiedDate),
+			),
+			want: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withAuthEnabled(true),
+				withAuthTokenRotation(time.Hour),
+				withAuthTokenLastModifiedDate(authTokenLastModifiedDate),
+			),
+			returnsErr: true,
+		},
+		{
+			name: "RotateAuthTokenFromRotateTime",
+			e: &external{
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+					MockCreate: test.NewMockCreateFn(nil),
+				},
+				client: &fake.MockClient{
+					MockModifyReplicationGroupRequest: func(in *elasticache.ModifyReplicationGroupInput) elasticache.ModifyReplicationGroupRequest {
+						if in.AuthTokenUpdateStrategy != elasticache.AuthTokenUpdateStrategyTypeRotate {
+							return elasticache.ModifyReplicationGroupRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errorBoom}}
+						}
+						return elasticache.ModifyReplicationGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &elasticache.ModifyReplicationGroupOutput{}},
+						}
+					},
+				},
+			},
+			r: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withAuthEnabled(true),
+				withAuthTokenRotation(time.Hour),
+				withAuthTokenRotateTime(authTokenLastModifiedDate),
+			),
+			want: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withAuthEnabled(true),
+				withAuthTokenRotation(time.Hour),
+				withAuthTokenRotateTime(metav1.Now()),
+			),
+			tokenCreated: true,
+		},
+		{
+			name: "WaitForAuthTokenRotation",
+			e:    &external{},
+			r: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withAuthEnabled(true),
+				withAuthTokenRotation(time.Hour),
+				withAuthTokenStatus(elasticache.AuthTokenUpdateStatusRotating),
+			),
+			want: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withAuthEnabled(true),
+				withAuthTokenRotation(time.Hour),
+				withAuthTokenStatus(elasticache.AuthTokenUpdateStatusRotating),
+			),
+		},
+		{
+			name: "PublishPendingAuthToken",
+			e: &external{
+				kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{
+						runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte("oldToken"),
+						elasticacheclient.PendingAuthTokenKey:                []byte(authToken),
+					}
+					return nil
+				}},
+			},
+			r: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withAuthEnabled(true),
+				withAuthTokenRotation(time.Hour),
+				withAuthTokenLastModifiedDate(authTokenRotatedDate),
+			),
+			want: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withAuthEnabled(true),
+				withAuthTokenRotation(time.Hour),
+				withAuthTokenLastModifiedDate(authTokenRotatedDate),
+			),
+			tokenCreated: true,
+		},
+		{
+			name: "SetAuthToken",
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{
+							runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(authToken),
+							elasticacheclient.PendingAuthTokenKey:                []byte(authToken),
+						}
+						return nil
+					},
+					MockPatch: func(_ context.Context, _ runtime.Object, p client.Patch, _ ...client.PatchOption) error {
+						if b, _ := p.Data(nil); p.Type() != types.MergePatchType || string(b) != `{"data":{"`+elasticacheclient.PendingAuthTokenKey+`":null}}` {
+							return errorBoom
+						}
+						return nil
+					},
+				},
+				client: &fake.MockClient{
+					MockModifyReplicationGroupRequest: func(in *elasticache.ModifyReplicationGroupInput) elasticache.ModifyReplicationGroupRequest {
+						if in.AuthTokenUpdateStrategy != elasticache.AuthTokenUpdateStrategyTypeSet || awsclients.StringValue(in.AuthToken) != authToken {
+							return elasticache.ModifyReplicationGroupRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errorBoom}}
+						}
+						return elasticache.ModifyReplicationGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &elasticache.ModifyReplicationGroupOutput{}},
+						}
+					},
+				},
+			},
+			r: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withAuthEnabled(true),
+				withAuthTokenRotation(time.Hour),
+				withAuthTokenLastModifiedDate(authTokenRotatedDate),
+			),
+			want: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withAuthEnabled(true),
+				withAuthTokenRotation(time.Hour),
+				withAuthTokenLastModifiedDate(authTokenRotatedDate),
+			),
+		},
+		{
+			name: "FailedGetConnectionSecret",
+			e: &external{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errorBoom)},
+			},
+			r: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withAuthEnabled(true),
+				withAuthTokenRotation(time.Hour),
+				withAuthTokenLastModifiedDate(authTokenRotatedDate),
+			),
+			want: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withAuthEnabled(true),
+				withAuthTokenRotation(time.Hour),
+				withAuthTokenLastModifiedDate(authTokenRotatedDate),
+			),
+			returnsErr: true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -577,7 +1030,7 @@ func TestUpdate(t *testing.T) {
 				t.Errorf("tc.e.Update(...) token creation: want: %t got: %t", tc.tokenCreated, len(update.ConnectionDetails) != 0)
 			}
 
-			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions(), equateApproxTime()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
 		})
@@ -0,0 +1,304 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbcluster
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dbcluster"
+)
+
+const (
+	errNotDBCluster     = "managed resource is not a DBCluster custom resource"
+	errKubeUpdateFailed = "cannot update DBCluster custom resource"
+
+	errCreateClient      = "cannot create DBCluster client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+
+	errCreateFailed            = "cannot create DB cluster"
+	errModifyFailed            = "cannot modify DB cluster"
+	errAddTagsFailed           = "cannot add tags to DB cluster"
+	errDeleteFailed            = "cannot delete DB cluster"
+	errDescribeFailed          = "cannot describe DB cluster"
+	errPatchCreationFailed     = "cannot create a patch object"
+	errUpToDateFailed          = "cannot check whether object is up-to-date"
+	errGetPasswordSecretFailed = "cannot get password secret"
+)
+
+// SetupDBCluster adds a controller that reconciles DBClusters.
+func SetupDBCluster(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1beta1.DBClusterGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.DBCluster{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.DBClusterGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: dbcluster.NewClient}),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (dbcluster.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.DBCluster)
+	if !ok {
+		return nil, errors.New(errNotDBCluster)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		rdsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: rdsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	rdsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: rdsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	client dbcluster.Client
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.DBCluster)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDBCluster)
+	}
+	rsp, err := e.client.DescribeDBClustersRequest(&awsrds.DescribeDBClustersInput{DBClusterIdentifier: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(dbcluster.IsNotFound, err), errDescribeFailed)
+	}
+
+	// We use an explicit identifier, so, if there is no error, there should
+	// be only 1 element in the list.
+	cluster := rsp.DBClusters[0]
+	current := cr.Spec.ForProvider.DeepCopy()
+	dbcluster.LateInitialize(&cr.Spec.ForProvider, &cluster)
+	if !reflect.DeepEqual(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+	cr.Status.AtProvider = dbcluster.GenerateObservation(cluster)
+
+	switch cr.Status.AtProvider.Status {
+	case v1beta1.DBClusterStateAvailable:
+		cr.Status.SetConditions(runtimev1alpha1.Available())
+	case v1beta1.DBClusterStateCreating:
+		cr.Status.SetConditions(runtimev1alpha1.Creating())
+	case v1beta1.DBClusterStateDeleting:
+		cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.Status.SetConditions(runtimev1alpha1.Unavailable())
+	}
+	upToDate, err := dbcluster.IsUpToDate(cr.Spec.ForProvider, cluster)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: dbcluster.GetConnectionDetails(*cr),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.DBCluster)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDBCluster)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	if cr.Status.AtProvider.Status == v1beta1.DBClusterStateCreating {
+		return managed.ExternalCreation{}, nil
+	}
+	pw, err := password.Generate()
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if cr.Spec.ForProvider.MasterPasswordSecretRef != nil {
+		if pw, err = e.getPassword(ctx, cr.Spec.ForProvider.MasterPasswordSecretRef); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	if _, err := e.client.CreateDBClusterRequest(dbcluster.GenerateCreateDBClusterInput(meta.GetExternalName(cr), pw, &cr.Spec.ForProvider)).Send(ctx); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+	conn := managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+	}
+	if cr.Spec.ForProvider.MasterUsername != nil {
+		conn[runtimev1alpha1.ResourceCredentialsSecretUserKey] = []byte(aws.StringValue(cr.Spec.ForProvider.MasterUsername))
+	}
+	return managed.ExternalCreation{ConnectionDetails: conn}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mg.(*v1beta1.DBCluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDBCluster)
+	}
+	switch cr.Status.AtProvider.Status {
+	case v1beta1.DBClusterStateModifying, v1beta1.DBClusterStateCreating:
+		return managed.ExternalUpdate{}, nil
+	}
+	// AWS rejects modification requests if you send fields whose value is same
+	// as the current one, so we create a patch out of the desired and the
+	// current state.
+	rsp, err := e.client.DescribeDBClustersRequest(&awsrds.DescribeDBClustersInput{DBClusterIdentifier: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeFailed)
+	}
+	patch, err := dbcluster.CreatePatch(&rsp.DBClusters[0], &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPatchCreationFailed)
+	}
+	modify := dbcluster.GenerateModifyDBClusterInput(meta.GetExternalName(cr), patch, rsp.DBClusters[0])
+	var conn managed.ConnectionDetails
+	if cr.Spec.ForProvider.MasterPasswordSecretRef != nil {
+		pw, err := e.getPassword(ctx, cr.Spec.ForProvider.MasterPasswordSecretRef)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		conn = managed.ConnectionDetails{
+			runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		}
+		modify.MasterUserPassword = aws.String(pw)
+	}
+	if _, err = e.client.ModifyDBClusterRequest(modify).Send(ctx); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errModifyFailed)
+	}
+	if len(patch.Tags) > 0 {
+		tags := make([]awsrds.Tag, len(patch.Tags))
+		for i, t := range patch.Tags {
+			tags[i] = awsrds.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+		}
+		_, err = e.client.AddTagsToResourceRequest(&awsrds.AddTagsToResourceInput{
+			ResourceName: aws.String(cr.Status.AtProvider.DBClusterARN),
+			Tags:         tags,
+		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTagsFailed)
+		}
+	}
+	return managed.ExternalUpdate{ConnectionDetails: conn}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.DBCluster)
+	if !ok {
+		return errors.New(errNotDBCluster)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.Status == v1beta1.DBClusterStateDeleting {
+		return nil
+	}
+	// Update is a best effort here so that a change in deletion protection
+	// takes effect before the deletion is requested.
+	_, err := e.Update(ctx, cr)
+	if dbcluster.IsNotFound(err) {
+		return nil
+	}
+	_, err = e.client.DeleteDBClusterRequest(&awsrds.DeleteDBClusterInput{
+		DBClusterIdentifier:       aws.String(meta.GetExternalName(cr)),
+		SkipFinalSnapshot:         cr.Spec.ForProvider.SkipFinalSnapshotBeforeDeletion,
+		FinalDBSnapshotIdentifier: cr.Spec.ForProvider.FinalDBSnapshotIdentifier,
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(dbcluster.IsNotFound, err), errDeleteFailed)
+}
+
+func (e *external) getPassword(ctx context.Context, ref *runtimev1alpha1.SecretKeySelector) (string, error) {
+	s := &corev1.Secret{}
+	nn := types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}
+	if err := e.kube.Get(ctx, nn, s); err != nil {
+		return "", errors.Wrap(err, errGetPasswordSecretFailed)
+	}
+	return string(s.Data[ref.Key]), nil
+}
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.DBCluster)
+	if !ok {
+		return errors.New(errNotDBCluster)
+	}
+	tagMap := map[string]string{}
+	for _, t := range cr.Spec.ForProvider.Tags {
+		tagMap[t.Key] = t.Value
+	}
+	for k, v := range resource.GetExternalTags(mg) {
+		tagMap[k] = v
+	}
+	cr.Spec.ForProvider.Tags = make([]v1beta1.Tag, len(tagMap))
+	i := 0
+	for k, v := range tagMap {
+		cr.Spec.ForProvider.Tags[i] = v1beta1.Tag{Key: k, Value: v}
+		i++
+	}
+	sort.Slice(cr.Spec.ForProvider.Tags, func(i, j int) bool {
+		return cr.Spec.ForProvider.Tags[i].Key < cr.Spec.ForProvider.Tags[j].Key
+	})
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
@@ -0,0 +1,540 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbcluster
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/dbcluster"
+	"github.com/crossplane/provider-aws/pkg/clients/dbcluster/fake"
+)
+
+const (
+	providerName = "aws-creds"
+
+	writerEndpoint = "cluster.cluster-abc.us-east-1.rds.amazonaws.com"
+	readerEndpoint = "cluster.cluster-ro-abc.us-east-1.rds.amazonaws.com"
+)
+
+var (
+	masterUsername = "root"
+	errBoom        = errors.New("boom")
+)
+
+type args struct {
+	rds  dbcluster.Client
+	kube client.Client
+	cr   *v1beta1.DBCluster
+}
+
+type clusterModifier func(*v1beta1.DBCluster)
+
+func withMasterUsername(s *string) clusterModifier {
+	return func(r *v1beta1.DBCluster) { r.Spec.ForProvider.MasterUsername = s }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) clusterModifier {
+	return func(r *v1beta1.DBCluster) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s string) clusterModifier {
+	return func(r *v1beta1.DBCluster) { r.Status.AtProvider.Status = s }
+}
+
+func withEndpoints(w, r string) clusterModifier {
+	return func(c *v1beta1.DBCluster) {
+		c.Status.AtProvider.Endpoint = w
+		c.Status.AtProvider.ReaderEndpoint = r
+	}
+}
+
+func withTags(tags ...v1beta1.Tag) clusterModifier {
+	return func(r *v1beta1.DBCluster) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withPasswordSecretRef(s runtimev1alpha1.SecretKeySelector) clusterModifier {
+	return func(r *v1beta1.DBCluster) { r.Spec.ForProvider.MasterPasswordSecretRef = &s }
+}
+
+func cluster(m ...clusterModifier) *v1beta1.DBCluster {
+	cr := &v1beta1.DBCluster{
+		Spec: v1beta1.DBClusterSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(c ...awsrds.DBCluster) func(*awsrds.DescribeDBClustersInput) awsrds.DescribeDBClustersRequest {
+	return func(*awsrds.DescribeDBClustersInput) awsrds.DescribeDBClustersRequest {
+		return awsrds.DescribeDBClustersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBClustersOutput{DBClusters: c}},
+		}
+	}
+}
+
+func describeErr(err error) func(*awsrds.DescribeDBClustersInput) awsrds.DescribeDBClustersRequest {
+	return func(*awsrds.DescribeDBClustersInput) awsrds.DescribeDBClustersRequest {
+		return awsrds.DescribeDBClustersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Error: err},
+		}
+	}
+}
+
+func modify(err error) func(*awsrds.ModifyDBClusterInput) awsrds.ModifyDBClusterRequest {
+	return func(*awsrds.ModifyDBClusterInput) awsrds.ModifyDBClusterRequest {
+		return awsrds.ModifyDBClusterRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBClusterOutput{}, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.DBCluster
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribeDBClustersRequest: describe(awsrds.DBCluster{
+						Status:         aws.String(v1beta1.DBClusterStateAvailable),
+						Endpoint:       aws.String(writerEndpoint),
+						ReaderEndpoint: aws.String(readerEndpoint),
+					}),
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1beta1.DBClusterStateAvailable),
+					withEndpoints(writerEndpoint, readerEndpoint)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(writerEndpoint),
+						dbcluster.ConnectionDetailsReaderEndpointKey:         []byte(readerEndpoint),
+					},
+				},
+			},
+		},
+		"CreatingState": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribeDBClustersRequest: describe(awsrds.DBCluster{Status: aws.String(v1beta1.DBClusterStateCreating)}),
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(
+					withConditions(runtimev1alpha1.Creating()),
+					withStatus(v1beta1.DBClusterStateCreating)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribeDBClustersRequest: describeErr(errBoom),
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(),
+				err: errors.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"NotFound": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribeDBClustersRequest: describeErr(errors.New(awsrds.ErrCodeDBClusterNotFoundFault)),
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(),
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				rds: &fake.MockDBClusterClient{
+					MockDescribeDBClustersRequest: describe(awsrds.DBCluster{
+						Status:         aws.String(v1beta1.DBClusterStateAvailable),
+						MasterUsername: &masterUsername,
+					}),
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(
+					withMasterUsername(&masterUsername),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1beta1.DBClusterStateAvailable)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitFailedKubeUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				rds: &fake.MockDBClusterClient{
+					MockDescribeDBClustersRequest: describe(awsrds.DBCluster{MasterUsername: &masterUsername}),
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(withMasterUsername(&masterUsername)),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rds}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.DBCluster
+		result managed.ExternalCreation
+		err    error
+	}
+
+	secretRef := runtimev1alpha1.SecretKeySelector{
+		SecretReference: runtimev1alpha1.SecretReference{Name: "password", Namespace: "default"},
+		Key:             "pw",
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulWithSecret": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+						s, ok := obj.(*corev1.Secret)
+						if !ok {
+							return errBoom
+						}
+						s.Data = map[string][]byte{secretRef.Key: []byte("very-strong")}
+						return nil
+					},
+				},
+				rds: &fake.MockDBClusterClient{
+					MockCreateDBClusterRequest: func(input *awsrds.CreateDBClusterInput) awsrds.CreateDBClusterRequest {
+						if diff := cmp.Diff("very-strong", aws.StringValue(input.MasterUserPassword)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.CreateDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBClusterOutput{}},
+						}
+					},
+				},
+				cr: cluster(withMasterUsername(&masterUsername), withPasswordSecretRef(secretRef)),
+			},
+			want: want{
+				cr: cluster(
+					withMasterUsername(&masterUsername),
+					withPasswordSecretRef(secretRef),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte("very-strong"),
+						runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(masterUsername),
+					},
+				},
+			},
+		},
+		"AlreadyCreating": {
+			args: args{
+				cr: cluster(withStatus(v1beta1.DBClusterStateCreating)),
+			},
+			want: want{
+				cr: cluster(
+					withStatus(v1beta1.DBClusterStateCreating),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedGetSecret": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				cr:   cluster(withPasswordSecretRef(secretRef)),
+			},
+			want: want{
+				cr: cluster(
+					withPasswordSecretRef(secretRef),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errGetPasswordSecretFailed),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockCreateDBClusterRequest: func(input *awsrds.CreateDBClusterInput) awsrds.CreateDBClusterRequest {
+						return awsrds.CreateDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rds}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.DBCluster
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	tag := v1beta1.Tag{Key: "foo", Value: "bar"}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribeDBClustersRequest: describe(awsrds.DBCluster{}),
+					MockModifyDBClusterRequest:    modify(nil),
+					MockAddTagsToResourceRequest: func(input *awsrds.AddTagsToResourceInput) awsrds.AddTagsToResourceRequest {
+						return awsrds.AddTagsToResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.AddTagsToResourceOutput{}},
+						}
+					},
+				},
+				cr: cluster(withTags(tag)),
+			},
+			want: want{
+				cr: cluster(withTags(tag)),
+			},
+		},
+		"AlreadyModifying": {
+			args: args{
+				cr: cluster(withStatus(v1beta1.DBClusterStateModifying)),
+			},
+			want: want{
+				cr: cluster(withStatus(v1beta1.DBClusterStateModifying)),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribeDBClustersRequest: describeErr(errBoom),
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(),
+				err: errors.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"FailedModify": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribeDBClustersRequest: describe(awsrds.DBCluster{}),
+					MockModifyDBClusterRequest:    modify(errBoom),
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(),
+				err: errors.Wrap(errBoom, errModifyFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rds}
+			u, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, u); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.DBCluster
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribeDBClustersRequest: describe(awsrds.DBCluster{}),
+					MockModifyDBClusterRequest:    modify(nil),
+					MockDeleteDBClusterRequest: func(input *awsrds.DeleteDBClusterInput) awsrds.DeleteDBClusterRequest {
+						return awsrds.DeleteDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeleteDBClusterOutput{}},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: cluster(withStatus(v1beta1.DBClusterStateDeleting)),
+			},
+			want: want{
+				cr: cluster(withStatus(v1beta1.DBClusterStateDeleting),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribeDBClustersRequest: describeErr(errors.New(awsrds.ErrCodeDBClusterNotFoundFault)),
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribeDBClustersRequest: describe(awsrds.DBCluster{}),
+					MockModifyDBClusterRequest:    modify(nil),
+					MockDeleteDBClusterRequest: func(input *awsrds.DeleteDBClusterInput) awsrds.DeleteDBClusterRequest {
+						return awsrds.DeleteDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rds}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
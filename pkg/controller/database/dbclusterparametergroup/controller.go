@@ -0,0 +1,217 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbclusterparametergroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dbparametergroup"
+)
+
+const (
+	errNotDBClusterParameterGroup = "managed resource is not a DBClusterParameterGroup custom resource"
+
+	errCreateClient      = "cannot create DBClusterParameterGroup client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+
+	errDescribe           = "cannot describe DB cluster parameter group"
+	errDescribeParameters = "cannot describe parameters of DB cluster parameter group"
+	errCreate             = "cannot create DB cluster parameter group"
+	errModify             = "cannot modify parameters of DB cluster parameter group"
+	errReset              = "cannot reset parameters of DB cluster parameter group"
+	errDelete             = "cannot delete DB cluster parameter group"
+)
+
+// SetupDBClusterParameterGroup adds a controller that reconciles
+// DBClusterParameterGroups.
+func SetupDBClusterParameterGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1beta1.DBClusterParameterGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.DBClusterParameterGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.DBClusterParameterGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: dbparametergroup.NewClient}),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (dbparametergroup.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.DBClusterParameterGroup)
+	if !ok {
+		return nil, errors.New(errNotDBClusterParameterGroup)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		rdsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: rdsClient}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	rdsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: rdsClient}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	client dbparametergroup.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.DBClusterParameterGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDBClusterParameterGroup)
+	}
+	rsp, err := e.client.DescribeDBClusterParameterGroupsRequest(&awsrds.DescribeDBClusterParameterGroupsInput{
+		DBClusterParameterGroupName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(dbparametergroup.IsNotFound, err), errDescribe)
+	}
+	params, err := e.describeParameters(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeParameters)
+	}
+
+	// We use an explicit name, so, if there is no error, there should be
+	// only 1 element in the list.
+	cr.Status.AtProvider = v1beta1.DBClusterParameterGroupObservation{
+		DBClusterParameterGroupARN: aws.StringValue(rsp.DBClusterParameterGroups[0].DBClusterParameterGroupArn),
+		Parameters:                 dbparametergroup.GenerateParameterObservations(params),
+	}
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: dbparametergroup.IsUpToDate(cr.Spec.ForProvider.Parameters, params),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.DBClusterParameterGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDBClusterParameterGroup)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.CreateDBClusterParameterGroupRequest(&awsrds.CreateDBClusterParameterGroupInput{
+		DBClusterParameterGroupName: aws.String(meta.GetExternalName(cr)),
+		DBParameterGroupFamily:      aws.String(cr.Spec.ForProvider.DBParameterGroupFamily),
+		Description:                 aws.String(cr.Spec.ForProvider.Description),
+		Tags:                        dbparametergroup.GenerateTags(cr.Spec.ForProvider.Tags),
+	}).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.DBClusterParameterGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDBClusterParameterGroup)
+	}
+	params, err := e.describeParameters(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeParameters)
+	}
+	for _, chunk := range dbparametergroup.ChunkParameters(dbparametergroup.GetOutdatedParameters(cr.Spec.ForProvider.Parameters, params)) {
+		if _, err := e.client.ModifyDBClusterParameterGroupRequest(&awsrds.ModifyDBClusterParameterGroupInput{
+			DBClusterParameterGroupName: aws.String(meta.GetExternalName(cr)),
+			Parameters:                  chunk,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+		}
+	}
+	for _, chunk := range dbparametergroup.ChunkParameters(dbparametergroup.GetParametersToReset(cr.Spec.ForProvider.Parameters, params)) {
+		if _, err := e.client.ResetDBClusterParameterGroupRequest(&awsrds.ResetDBClusterParameterGroupInput{
+			DBClusterParameterGroupName: aws.String(meta.GetExternalName(cr)),
+			Parameters:                  chunk,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errReset)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.DBClusterParameterGroup)
+	if !ok {
+		return errors.New(errNotDBClusterParameterGroup)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteDBClusterParameterGroupRequest(&awsrds.DeleteDBClusterParameterGroupInput{
+		DBClusterParameterGroupName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(dbparametergroup.IsNotFound, err), errDelete)
+}
+
+// describeParameters returns all the parameters of the given group that are
+// modified from their engine default.
+func (e *external) describeParameters(ctx context.Context, name string) ([]awsrds.Parameter, error) {
+	var params []awsrds.Parameter
+	input := &awsrds.DescribeDBClusterParametersInput{
+		DBClusterParameterGroupName: aws.String(name),
+		Source:                      aws.String(dbparametergroup.SourceUser),
+	}
+	for {
+		rsp, err := e.client.DescribeDBClusterParametersRequest(input).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, rsp.Parameters...)
+		if aws.StringValue(rsp.Marker) == "" {
+			return params, nil
+		}
+		input.Marker = rsp.Marker
+	}
+}
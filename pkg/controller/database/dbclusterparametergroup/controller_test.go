@@ -0,0 +1,415 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbclusterparametergroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/dbparametergroup"
+	"github.com/crossplane/provider-aws/pkg/clients/dbparametergroup/fake"
+)
+
+var (
+	groupARN = "arn:aws:rds:us-east-1:123456789012:cluster-pg:example"
+	errBoom  = errors.New("boom")
+)
+
+type args struct {
+	client dbparametergroup.Client
+	cr     *v1beta1.DBClusterParameterGroup
+}
+
+type groupModifier func(*v1beta1.DBClusterParameterGroup)
+
+func withConditions(c ...runtimev1alpha1.Condition) groupModifier {
+	return func(r *v1beta1.DBClusterParameterGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withParameters(p ...v1beta1.Parameter) groupModifier {
+	return func(r *v1beta1.DBClusterParameterGroup) { r.Spec.ForProvider.Parameters = p }
+}
+
+func withObservation(o v1beta1.DBClusterParameterGroupObservation) groupModifier {
+	return func(r *v1beta1.DBClusterParameterGroup) { r.Status.AtProvider = o }
+}
+
+func group(m ...groupModifier) *v1beta1.DBClusterParameterGroup {
+	cr := &v1beta1.DBClusterParameterGroup{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeGroups(err error) func(*awsrds.DescribeDBClusterParameterGroupsInput) awsrds.DescribeDBClusterParameterGroupsRequest {
+	return func(*awsrds.DescribeDBClusterParameterGroupsInput) awsrds.DescribeDBClusterParameterGroupsRequest {
+		return awsrds.DescribeDBClusterParameterGroupsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DescribeDBClusterParameterGroupsOutput{
+				DBClusterParameterGroups: []awsrds.DBClusterParameterGroup{{DBClusterParameterGroupArn: aws.String(groupARN)}},
+			}},
+		}
+	}
+}
+
+func describeParameters(err error, p ...awsrds.Parameter) func(*awsrds.DescribeDBClusterParametersInput) awsrds.DescribeDBClusterParametersRequest {
+	return func(*awsrds.DescribeDBClusterParametersInput) awsrds.DescribeDBClusterParametersRequest {
+		return awsrds.DescribeDBClusterParametersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DescribeDBClusterParametersOutput{Parameters: p}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	maxConns := v1beta1.Parameter{ParameterName: "max_connections", ParameterValue: "100"}
+	observed := awsrds.Parameter{
+		ParameterName:  aws.String("max_connections"),
+		ParameterValue: aws.String("100"),
+		ApplyMethod:    awsrds.ApplyMethodPendingReboot,
+		ApplyType:      aws.String("dynamic"),
+	}
+
+	type want struct {
+		cr     *v1beta1.DBClusterParameterGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockDescribeDBClusterParameterGroupsRequest: describeGroups(nil),
+					MockDescribeDBClusterParametersRequest:      describeParameters(nil, observed),
+				},
+				cr: group(withParameters(maxConns)),
+			},
+			want: want{
+				cr: group(withParameters(maxConns),
+					withConditions(runtimev1alpha1.Available()),
+					withObservation(v1beta1.DBClusterParameterGroupObservation{
+						DBClusterParameterGroupARN: groupARN,
+						Parameters: []v1beta1.ParameterObservation{{
+							ParameterName:  "max_connections",
+							ParameterValue: "100",
+							ApplyMethod:    v1beta1.ParameterApplyMethodPendingReboot,
+							ApplyType:      "dynamic",
+							PendingReboot:  true,
+						}},
+					})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ParameterChanged": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockDescribeDBClusterParameterGroupsRequest: describeGroups(nil),
+					MockDescribeDBClusterParametersRequest:      describeParameters(nil),
+				},
+				cr: group(withParameters(maxConns)),
+			},
+			want: want{
+				cr: group(withParameters(maxConns),
+					withConditions(runtimev1alpha1.Available()),
+					withObservation(v1beta1.DBClusterParameterGroupObservation{DBClusterParameterGroupARN: groupARN})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockDescribeDBClusterParameterGroupsRequest: describeGroups(errors.New(awsrds.ErrCodeDBClusterParameterGroupNotFoundFault)),
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockDescribeDBClusterParameterGroupsRequest: describeGroups(errBoom),
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"FailedDescribeParameters": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockDescribeDBClusterParameterGroupsRequest: describeGroups(nil),
+					MockDescribeDBClusterParametersRequest:      describeParameters(errBoom),
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(),
+				err: errors.Wrap(errBoom, errDescribeParameters),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.DBClusterParameterGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockCreateDBClusterParameterGroupRequest: func(*awsrds.CreateDBClusterParameterGroupInput) awsrds.CreateDBClusterParameterGroupRequest {
+						return awsrds.CreateDBClusterParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBClusterParameterGroupOutput{}},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockCreateDBClusterParameterGroupRequest: func(*awsrds.CreateDBClusterParameterGroupInput) awsrds.CreateDBClusterParameterGroupRequest {
+						return awsrds.CreateDBClusterParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	maxConns := v1beta1.Parameter{ParameterName: "max_connections", ParameterValue: "200"}
+	stale := awsrds.Parameter{ParameterName: aws.String("work_mem"), ParameterValue: aws.String("4096"), ApplyType: aws.String("dynamic")}
+
+	type want struct {
+		cr  *v1beta1.DBClusterParameterGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifyAndReset": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockDescribeDBClusterParametersRequest: describeParameters(nil, stale),
+					MockModifyDBClusterParameterGroupRequest: func(in *awsrds.ModifyDBClusterParameterGroupInput) awsrds.ModifyDBClusterParameterGroupRequest {
+						if diff := cmp.Diff("max_connections", aws.StringValue(in.Parameters[0].ParameterName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.ModifyDBClusterParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBClusterParameterGroupOutput{}},
+						}
+					},
+					MockResetDBClusterParameterGroupRequest: func(in *awsrds.ResetDBClusterParameterGroupInput) awsrds.ResetDBClusterParameterGroupRequest {
+						if diff := cmp.Diff("work_mem", aws.StringValue(in.Parameters[0].ParameterName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.ResetDBClusterParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ResetDBClusterParameterGroupOutput{}},
+						}
+					},
+				},
+				cr: group(withParameters(maxConns)),
+			},
+			want: want{
+				cr: group(withParameters(maxConns)),
+			},
+		},
+		"FailedModify": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockDescribeDBClusterParametersRequest: describeParameters(nil),
+					MockModifyDBClusterParameterGroupRequest: func(*awsrds.ModifyDBClusterParameterGroupInput) awsrds.ModifyDBClusterParameterGroupRequest {
+						return awsrds.ModifyDBClusterParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(withParameters(maxConns)),
+			},
+			want: want{
+				cr:  group(withParameters(maxConns)),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+		"FailedReset": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockDescribeDBClusterParametersRequest: describeParameters(nil, stale),
+					MockResetDBClusterParameterGroupRequest: func(*awsrds.ResetDBClusterParameterGroupInput) awsrds.ResetDBClusterParameterGroupRequest {
+						return awsrds.ResetDBClusterParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(),
+				err: errors.Wrap(errBoom, errReset),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.DBClusterParameterGroup
+		err error
+	}
+
+	del := func(err error) func(*awsrds.DeleteDBClusterParameterGroupInput) awsrds.DeleteDBClusterParameterGroupRequest {
+		return func(*awsrds.DeleteDBClusterParameterGroupInput) awsrds.DeleteDBClusterParameterGroupRequest {
+			return awsrds.DeleteDBClusterParameterGroupRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DeleteDBClusterParameterGroupOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{MockDeleteDBClusterParameterGroupRequest: del(nil)},
+				cr:     group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{MockDeleteDBClusterParameterGroupRequest: del(errors.New(awsrds.ErrCodeDBClusterParameterGroupNotFoundFault))},
+				cr:     group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{MockDeleteDBClusterParameterGroupRequest: del(errBoom)},
+				cr:     group(),
+			},
+			want: want{
+				cr:  group(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
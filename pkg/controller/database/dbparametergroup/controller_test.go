@@ -0,0 +1,415 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbparametergroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/dbparametergroup"
+	"github.com/crossplane/provider-aws/pkg/clients/dbparametergroup/fake"
+)
+
+var (
+	groupARN = "arn:aws:rds:us-east-1:123456789012:pg:example"
+	errBoom  = errors.New("boom")
+)
+
+type args struct {
+	client dbparametergroup.Client
+	cr     *v1beta1.DBParameterGroup
+}
+
+type groupModifier func(*v1beta1.DBParameterGroup)
+
+func withConditions(c ...runtimev1alpha1.Condition) groupModifier {
+	return func(r *v1beta1.DBParameterGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withParameters(p ...v1beta1.Parameter) groupModifier {
+	return func(r *v1beta1.DBParameterGroup) { r.Spec.ForProvider.Parameters = p }
+}
+
+func withObservation(o v1beta1.DBParameterGroupObservation) groupModifier {
+	return func(r *v1beta1.DBParameterGroup) { r.Status.AtProvider = o }
+}
+
+func group(m ...groupModifier) *v1beta1.DBParameterGroup {
+	cr := &v1beta1.DBParameterGroup{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeGroups(err error) func(*awsrds.DescribeDBParameterGroupsInput) awsrds.DescribeDBParameterGroupsRequest {
+	return func(*awsrds.DescribeDBParameterGroupsInput) awsrds.DescribeDBParameterGroupsRequest {
+		return awsrds.DescribeDBParameterGroupsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DescribeDBParameterGroupsOutput{
+				DBParameterGroups: []awsrds.DBParameterGroup{{DBParameterGroupArn: aws.String(groupARN)}},
+			}},
+		}
+	}
+}
+
+func describeParameters(err error, p ...awsrds.Parameter) func(*awsrds.DescribeDBParametersInput) awsrds.DescribeDBParametersRequest {
+	return func(*awsrds.DescribeDBParametersInput) awsrds.DescribeDBParametersRequest {
+		return awsrds.DescribeDBParametersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DescribeDBParametersOutput{Parameters: p}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	maxConns := v1beta1.Parameter{ParameterName: "max_connections", ParameterValue: "100"}
+	observed := awsrds.Parameter{
+		ParameterName:  aws.String("max_connections"),
+		ParameterValue: aws.String("100"),
+		ApplyMethod:    awsrds.ApplyMethodPendingReboot,
+		ApplyType:      aws.String("dynamic"),
+	}
+
+	type want struct {
+		cr     *v1beta1.DBParameterGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockDescribeDBParameterGroupsRequest: describeGroups(nil),
+					MockDescribeDBParametersRequest:      describeParameters(nil, observed),
+				},
+				cr: group(withParameters(maxConns)),
+			},
+			want: want{
+				cr: group(withParameters(maxConns),
+					withConditions(runtimev1alpha1.Available()),
+					withObservation(v1beta1.DBParameterGroupObservation{
+						DBParameterGroupARN: groupARN,
+						Parameters: []v1beta1.ParameterObservation{{
+							ParameterName:  "max_connections",
+							ParameterValue: "100",
+							ApplyMethod:    v1beta1.ParameterApplyMethodPendingReboot,
+							ApplyType:      "dynamic",
+							PendingReboot:  true,
+						}},
+					})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ParameterChanged": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockDescribeDBParameterGroupsRequest: describeGroups(nil),
+					MockDescribeDBParametersRequest:      describeParameters(nil),
+				},
+				cr: group(withParameters(maxConns)),
+			},
+			want: want{
+				cr: group(withParameters(maxConns),
+					withConditions(runtimev1alpha1.Available()),
+					withObservation(v1beta1.DBParameterGroupObservation{DBParameterGroupARN: groupARN})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockDescribeDBParameterGroupsRequest: describeGroups(errors.New(awsrds.ErrCodeDBParameterGroupNotFoundFault)),
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockDescribeDBParameterGroupsRequest: describeGroups(errBoom),
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"FailedDescribeParameters": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockDescribeDBParameterGroupsRequest: describeGroups(nil),
+					MockDescribeDBParametersRequest:      describeParameters(errBoom),
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(),
+				err: errors.Wrap(errBoom, errDescribeParameters),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.DBParameterGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockCreateDBParameterGroupRequest: func(*awsrds.CreateDBParameterGroupInput) awsrds.CreateDBParameterGroupRequest {
+						return awsrds.CreateDBParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBParameterGroupOutput{}},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockCreateDBParameterGroupRequest: func(*awsrds.CreateDBParameterGroupInput) awsrds.CreateDBParameterGroupRequest {
+						return awsrds.CreateDBParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	maxConns := v1beta1.Parameter{ParameterName: "max_connections", ParameterValue: "200"}
+	stale := awsrds.Parameter{ParameterName: aws.String("work_mem"), ParameterValue: aws.String("4096"), ApplyType: aws.String("dynamic")}
+
+	type want struct {
+		cr  *v1beta1.DBParameterGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifyAndReset": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockDescribeDBParametersRequest: describeParameters(nil, stale),
+					MockModifyDBParameterGroupRequest: func(in *awsrds.ModifyDBParameterGroupInput) awsrds.ModifyDBParameterGroupRequest {
+						if diff := cmp.Diff("max_connections", aws.StringValue(in.Parameters[0].ParameterName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.ModifyDBParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBParameterGroupOutput{}},
+						}
+					},
+					MockResetDBParameterGroupRequest: func(in *awsrds.ResetDBParameterGroupInput) awsrds.ResetDBParameterGroupRequest {
+						if diff := cmp.Diff("work_mem", aws.StringValue(in.Parameters[0].ParameterName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.ResetDBParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ResetDBParameterGroupOutput{}},
+						}
+					},
+				},
+				cr: group(withParameters(maxConns)),
+			},
+			want: want{
+				cr: group(withParameters(maxConns)),
+			},
+		},
+		"FailedModify": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockDescribeDBParametersRequest: describeParameters(nil),
+					MockModifyDBParameterGroupRequest: func(*awsrds.ModifyDBParameterGroupInput) awsrds.ModifyDBParameterGroupRequest {
+						return awsrds.ModifyDBParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(withParameters(maxConns)),
+			},
+			want: want{
+				cr:  group(withParameters(maxConns)),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+		"FailedReset": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{
+					MockDescribeDBParametersRequest: describeParameters(nil, stale),
+					MockResetDBParameterGroupRequest: func(*awsrds.ResetDBParameterGroupInput) awsrds.ResetDBParameterGroupRequest {
+						return awsrds.ResetDBParameterGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(),
+				err: errors.Wrap(errBoom, errReset),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.DBParameterGroup
+		err error
+	}
+
+	del := func(err error) func(*awsrds.DeleteDBParameterGroupInput) awsrds.DeleteDBParameterGroupRequest {
+		return func(*awsrds.DeleteDBParameterGroupInput) awsrds.DeleteDBParameterGroupRequest {
+			return awsrds.DeleteDBParameterGroupRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DeleteDBParameterGroupOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{MockDeleteDBParameterGroupRequest: del(nil)},
+				cr:     group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{MockDeleteDBParameterGroupRequest: del(errors.New(awsrds.ErrCodeDBParameterGroupNotFoundFault))},
+				cr:     group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockDBParameterGroupClient{MockDeleteDBParameterGroupRequest: del(errBoom)},
+				cr:     group(),
+			},
+			want: want{
+				cr:  group(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbproxy
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dbproxy"
+)
+
+const (
+	errNotDBProxy       = "managed resource is not a DBProxy custom resource"
+	errKubeUpdateFailed = "cannot update DBProxy custom resource"
+
+	errCreateClient      = "cannot create DBProxy client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+
+	errDescribe = "cannot describe DB proxy"
+	errCreate   = "cannot create DB proxy"
+	errModify   = "cannot modify DB proxy"
+	errDelete   = "cannot delete DB proxy"
+)
+
+// SetupDBProxy adds a controller that reconciles DBProxies.
+func SetupDBProxy(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1beta1.DBProxyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.DBProxy{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.DBProxyGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: dbproxy.NewClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (dbproxy.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.DBProxy)
+	if !ok {
+		return nil, errors.New(errNotDBProxy)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		rdsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: rdsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	rdsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: rdsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	client dbproxy.Client
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.DBProxy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDBProxy)
+	}
+	rsp, err := e.client.DescribeDBProxiesRequest(&awsrds.DescribeDBProxiesInput{
+		DBProxyName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(dbproxy.IsNotFound, err), errDescribe)
+	}
+
+	// We use an explicit identifier, so, if there is no error, there should
+	// be only 1 element in the list.
+	proxy := rsp.DBProxies[0]
+	current := cr.Spec.ForProvider.DeepCopy()
+	dbproxy.LateInitialize(&cr.Spec.ForProvider, &proxy)
+	if !reflect.DeepEqual(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+	cr.Status.AtProvider = dbproxy.GenerateObservation(proxy)
+
+	// The DB proxy keeps serving connections while it is being modified.
+	switch cr.Status.AtProvider.Status {
+	case v1beta1.DBProxyStateAvailable, v1beta1.DBProxyStateModifying:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1beta1.DBProxyStateCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case v1beta1.DBProxyStateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  dbproxy.IsUpToDate(cr.Spec.ForProvider, proxy),
+		ConnectionDetails: dbproxy.GetConnectionDetails(*cr),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.DBProxy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDBProxy)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.CreateDBProxyRequest(dbproxy.GenerateCreateDBProxyInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.DBProxy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDBProxy)
+	}
+	if cr.Status.AtProvider.Status != v1beta1.DBProxyStateAvailable {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err := e.client.ModifyDBProxyRequest(dbproxy.GenerateModifyDBProxyInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.DBProxy)
+	if !ok {
+		return errors.New(errNotDBProxy)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.Status == v1beta1.DBProxyStateDeleting {
+		return nil
+	}
+	_, err := e.client.DeleteDBProxyRequest(&awsrds.DeleteDBProxyInput{
+		DBProxyName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(dbproxy.IsNotFound, err), errDelete)
+}
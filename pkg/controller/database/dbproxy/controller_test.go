@@ -0,0 +1,419 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbproxy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/dbproxy"
+	"github.com/crossplane/provider-aws/pkg/clients/dbproxy/fake"
+)
+
+var (
+	proxyARN = "arn:aws:rds:us-east-1:123456789012:db-proxy:prx-example"
+	endpoint = "example.proxy-abcdefghijkl.us-east-1.rds.amazonaws.com"
+	errBoom  = errors.New("boom")
+)
+
+type args struct {
+	client dbproxy.Client
+	kube   client.Client
+	cr     *v1beta1.DBProxy
+}
+
+type proxyModifier func(*v1beta1.DBProxy)
+
+func withConditions(c ...runtimev1alpha1.Condition) proxyModifier {
+	return func(r *v1beta1.DBProxy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withEngineFamily(f string) proxyModifier {
+	return func(r *v1beta1.DBProxy) { r.Spec.ForProvider.EngineFamily = f }
+}
+
+func withIdleClientTimeout(i int) proxyModifier {
+	return func(r *v1beta1.DBProxy) { r.Spec.ForProvider.IdleClientTimeout = &i }
+}
+
+func withObservation(o v1beta1.DBProxyObservation) proxyModifier {
+	return func(r *v1beta1.DBProxy) { r.Status.AtProvider = o }
+}
+
+func proxy(m ...proxyModifier) *v1beta1.DBProxy {
+	cr := &v1beta1.DBProxy{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeProxies(err error, px awsrds.DBProxy) func(*awsrds.DescribeDBProxiesInput) awsrds.DescribeDBProxiesRequest {
+	return func(*awsrds.DescribeDBProxiesInput) awsrds.DescribeDBProxiesRequest {
+		return awsrds.DescribeDBProxiesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DescribeDBProxiesOutput{
+				DBProxies: []awsrds.DBProxy{px},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.DBProxy
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AvailableUpToDate": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribeDBProxiesRequest: describeProxies(nil, awsrds.DBProxy{
+						DBProxyArn: aws.String(proxyARN),
+						Status:     awsrds.DBProxyStatusAvailable,
+						Endpoint:   aws.String(endpoint),
+					}),
+				},
+				cr: proxy(withEngineFamily(v1beta1.DBProxyEngineFamilyPostgreSQL)),
+			},
+			want: want{
+				cr: proxy(withEngineFamily(v1beta1.DBProxyEngineFamilyPostgreSQL),
+					withConditions(runtimev1alpha1.Available()),
+					withObservation(v1beta1.DBProxyObservation{
+						DBProxyARN: proxyARN,
+						Status:     v1beta1.DBProxyStateAvailable,
+						Endpoint:   endpoint,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte("5432"),
+					},
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribeDBProxiesRequest: describeProxies(nil, awsrds.DBProxy{
+						Status:            awsrds.DBProxyStatusAvailable,
+						IdleClientTimeout: aws.Int64(1800),
+					}),
+				},
+				cr: proxy(withIdleClientTimeout(600)),
+			},
+			want: want{
+				cr: proxy(withIdleClientTimeout(600),
+					withConditions(runtimev1alpha1.Available()),
+					withObservation(v1beta1.DBProxyObservation{Status: v1beta1.DBProxyStateAvailable})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribeDBProxiesRequest: describeProxies(nil, awsrds.DBProxy{
+						Status:            awsrds.DBProxyStatusCreating,
+						IdleClientTimeout: aws.Int64(1800),
+					}),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   proxy(),
+			},
+			want: want{
+				cr: proxy(withIdleClientTimeout(1800),
+					withConditions(runtimev1alpha1.Creating()),
+					withObservation(v1beta1.DBProxyObservation{Status: v1beta1.DBProxyStateCreating})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"FailedLateInitialize": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribeDBProxiesRequest: describeProxies(nil, awsrds.DBProxy{
+						Status:            awsrds.DBProxyStatusCreating,
+						IdleClientTimeout: aws.Int64(1800),
+					}),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   proxy(),
+			},
+			want: want{
+				cr:  proxy(withIdleClientTimeout(1800)),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribeDBProxiesRequest: describeProxies(errors.New(awsrds.ErrCodeDBProxyNotFoundFault), awsrds.DBProxy{}),
+				},
+				cr: proxy(),
+			},
+			want: want{
+				cr: proxy(),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribeDBProxiesRequest: describeProxies(errBoom, awsrds.DBProxy{}),
+				},
+				cr: proxy(),
+			},
+			want: want{
+				cr:  proxy(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.DBProxy
+		err error
+	}
+
+	create := func(err error) func(*awsrds.CreateDBProxyInput) awsrds.CreateDBProxyRequest {
+		return func(in *awsrds.CreateDBProxyInput) awsrds.CreateDBProxyRequest {
+			if diff := cmp.Diff(awsrds.EngineFamilyPostgresql, in.EngineFamily); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awsrds.CreateDBProxyRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.CreateDBProxyOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDBProxyClient{MockCreateDBProxyRequest: create(nil)},
+				cr:     proxy(withEngineFamily(v1beta1.DBProxyEngineFamilyPostgreSQL)),
+			},
+			want: want{
+				cr: proxy(withEngineFamily(v1beta1.DBProxyEngineFamilyPostgreSQL), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockDBProxyClient{MockCreateDBProxyRequest: create(errBoom)},
+				cr:     proxy(withEngineFamily(v1beta1.DBProxyEngineFamilyPostgreSQL)),
+			},
+			want: want{
+				cr:  proxy(withEngineFamily(v1beta1.DBProxyEngineFamilyPostgreSQL), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.DBProxy
+		err error
+	}
+
+	modify := func(err error) func(*awsrds.ModifyDBProxyInput) awsrds.ModifyDBProxyRequest {
+		return func(in *awsrds.ModifyDBProxyInput) awsrds.ModifyDBProxyRequest {
+			if diff := cmp.Diff(int64(600), aws.Int64Value(in.IdleClientTimeout)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awsrds.ModifyDBProxyRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.ModifyDBProxyOutput{}},
+			}
+		}
+	}
+	available := withObservation(v1beta1.DBProxyObservation{Status: v1beta1.DBProxyStateAvailable})
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDBProxyClient{MockModifyDBProxyRequest: modify(nil)},
+				cr:     proxy(withIdleClientTimeout(600), available),
+			},
+			want: want{
+				cr: proxy(withIdleClientTimeout(600), available),
+			},
+		},
+		"NotAvailable": {
+			args: args{
+				client: &fake.MockDBProxyClient{},
+				cr:     proxy(withIdleClientTimeout(600), withObservation(v1beta1.DBProxyObservation{Status: v1beta1.DBProxyStateModifying})),
+			},
+			want: want{
+				cr: proxy(withIdleClientTimeout(600), withObservation(v1beta1.DBProxyObservation{Status: v1beta1.DBProxyStateModifying})),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockDBProxyClient{MockModifyDBProxyRequest: modify(errBoom)},
+				cr:     proxy(withIdleClientTimeout(600), available),
+			},
+			want: want{
+				cr:  proxy(withIdleClientTimeout(600), available),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.DBProxy
+		err error
+	}
+
+	del := func(err error) func(*awsrds.DeleteDBProxyInput) awsrds.DeleteDBProxyRequest {
+		return func(*awsrds.DeleteDBProxyInput) awsrds.DeleteDBProxyRequest {
+			return awsrds.DeleteDBProxyRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DeleteDBProxyOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDBProxyClient{MockDeleteDBProxyRequest: del(nil)},
+				cr:     proxy(),
+			},
+			want: want{
+				cr: proxy(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockDBProxyClient{},
+				cr:     proxy(withObservation(v1beta1.DBProxyObservation{Status: v1beta1.DBProxyStateDeleting})),
+			},
+			want: want{
+				cr: proxy(withObservation(v1beta1.DBProxyObservation{Status: v1beta1.DBProxyStateDeleting}),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockDBProxyClient{MockDeleteDBProxyRequest: del(errors.New(awsrds.ErrCodeDBProxyNotFoundFault))},
+				cr:     proxy(),
+			},
+			want: want{
+				cr: proxy(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockDBProxyClient{MockDeleteDBProxyRequest: del(errBoom)},
+				cr:     proxy(),
+			},
+			want: want{
+				cr:  proxy(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbproxytargetgroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dbproxy"
+)
+
+const (
+	errNotDBProxyTargetGroup = "managed resource is not a DBProxyTargetGroup custom resource"
+
+	errCreateClient      = "cannot create DBProxyTargetGroup client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+
+	errDescribe        = "cannot describe DB proxy target group"
+	errDescribeTargets = "cannot describe targets of DB proxy target group"
+	errRegister        = "cannot register targets of DB proxy target group"
+	errModify          = "cannot modify DB proxy target group"
+	errDeregister      = "cannot deregister targets of DB proxy target group"
+)
+
+// SetupDBProxyTargetGroup adds a controller that reconciles
+// DBProxyTargetGroups.
+func SetupDBProxyTargetGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1beta1.DBProxyTargetGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.DBProxyTargetGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.DBProxyTargetGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: dbproxy.NewClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (dbproxy.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.DBProxyTargetGroup)
+	if !ok {
+		return nil, errors.New(errNotDBProxyTargetGroup)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		rdsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: rdsClient}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	rdsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: rdsClient}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	client dbproxy.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.DBProxyTargetGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDBProxyTargetGroup)
+	}
+	name := aws.String(dbproxy.GetTargetGroupName(cr.Spec.ForProvider))
+	rsp, err := e.client.DescribeDBProxyTargetGroupsRequest(&awsrds.DescribeDBProxyTargetGroupsInput{
+		DBProxyName:     cr.Spec.ForProvider.DBProxyName,
+		TargetGroupName: name,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(dbproxy.IsTargetGroupNotFound, err), errDescribe)
+	}
+	trsp, err := e.client.DescribeDBProxyTargetsRequest(&awsrds.DescribeDBProxyTargetsInput{
+		DBProxyName:     cr.Spec.ForProvider.DBProxyName,
+		TargetGroupName: name,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(dbproxy.IsTargetGroupNotFound, err), errDescribeTargets)
+	}
+
+	// The target group is created along with its DB proxy, so, it is
+	// considered to exist only once the desired target is registered.
+	if !dbproxy.IsTargetRegistered(cr.Spec.ForProvider, trsp.Targets) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	// We use an explicit identifier, so, if there is no error, there should
+	// be only 1 element in the list.
+	tg := rsp.TargetGroups[0]
+	cr.Status.AtProvider = dbproxy.GenerateTargetGroupObservation(tg, trsp.Targets)
+
+	switch dbproxy.GetTargetsState(cr.Status.AtProvider.Targets) {
+	case v1beta1.DBProxyTargetStateAvailable:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1beta1.DBProxyTargetStateRegistering:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: dbproxy.IsTargetGroupUpToDate(cr.Spec.ForProvider, tg),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.DBProxyTargetGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDBProxyTargetGroup)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.RegisterDBProxyTargetsRequest(dbproxy.GenerateRegisterDBProxyTargetsInput(cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errRegister)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.DBProxyTargetGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDBProxyTargetGroup)
+	}
+	_, err := e.client.ModifyDBProxyTargetGroupRequest(dbproxy.GenerateModifyDBProxyTargetGroupInput(cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.DBProxyTargetGroup)
+	if !ok {
+		return errors.New(errNotDBProxyTargetGroup)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeregisterDBProxyTargetsRequest(dbproxy.GenerateDeregisterDBProxyTargetsInput(cr.Spec.ForProvider)).Send(ctx)
+	return errors.Wrap(resource.Ignore(dbproxy.IsTargetNotFound, err), errDeregister)
+}
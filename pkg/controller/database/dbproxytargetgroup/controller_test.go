@@ -0,0 +1,417 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbproxytargetgroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/dbproxy"
+	"github.com/crossplane/provider-aws/pkg/clients/dbproxy/fake"
+)
+
+var (
+	proxyName  = "example"
+	instanceID = "example-instance"
+	groupARN   = "arn:aws:rds:us-east-1:123456789012:target-group:prx-tg-example"
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	client dbproxy.Client
+	cr     *v1beta1.DBProxyTargetGroup
+}
+
+type targetGroupModifier func(*v1beta1.DBProxyTargetGroup)
+
+func withConditions(c ...runtimev1alpha1.Condition) targetGroupModifier {
+	return func(r *v1beta1.DBProxyTargetGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withMaxConnectionsPercent(i int) targetGroupModifier {
+	return func(r *v1beta1.DBProxyTargetGroup) {
+		r.Spec.ForProvider.ConnectionPoolConfig = &v1beta1.ConnectionPoolConfiguration{MaxConnectionsPercent: &i}
+	}
+}
+
+func withObservation(o v1beta1.DBProxyTargetGroupObservation) targetGroupModifier {
+	return func(r *v1beta1.DBProxyTargetGroup) { r.Status.AtProvider = o }
+}
+
+func targetGroup(m ...targetGroupModifier) *v1beta1.DBProxyTargetGroup {
+	cr := &v1beta1.DBProxyTargetGroup{
+		Spec: v1beta1.DBProxyTargetGroupSpec{
+			ForProvider: v1beta1.DBProxyTargetGroupParameters{
+				DBProxyName:          aws.String(proxyName),
+				DBInstanceIdentifier: aws.String(instanceID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeTargetGroups(err error, tg awsrds.DBProxyTargetGroup) func(*awsrds.DescribeDBProxyTargetGroupsInput) awsrds.DescribeDBProxyTargetGroupsRequest {
+	return func(*awsrds.DescribeDBProxyTargetGroupsInput) awsrds.DescribeDBProxyTargetGroupsRequest {
+		return awsrds.DescribeDBProxyTargetGroupsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DescribeDBProxyTargetGroupsOutput{
+				TargetGroups: []awsrds.DBProxyTargetGroup{tg},
+			}},
+		}
+	}
+}
+
+func describeTargets(err error, targets ...awsrds.DBProxyTarget) func(*awsrds.DescribeDBProxyTargetsInput) awsrds.DescribeDBProxyTargetsRequest {
+	return func(*awsrds.DescribeDBProxyTargetsInput) awsrds.DescribeDBProxyTargetsRequest {
+		return awsrds.DescribeDBProxyTargetsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DescribeDBProxyTargetsOutput{
+				Targets: targets,
+			}},
+		}
+	}
+}
+
+func instanceTarget(state awsrds.TargetState) awsrds.DBProxyTarget {
+	return awsrds.DBProxyTarget{
+		Type:          awsrds.TargetTypeRdsInstance,
+		RdsResourceId: aws.String(instanceID),
+		TargetHealth:  &awsrds.TargetHealth{State: state},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.DBProxyTargetGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribeDBProxyTargetGroupsRequest: describeTargetGroups(nil, awsrds.DBProxyTargetGroup{
+						TargetGroupArn: aws.String(groupARN),
+						Status:         aws.String("available"),
+					}),
+					MockDescribeDBProxyTargetsRequest: describeTargets(nil, instanceTarget(awsrds.TargetStateAvailable)),
+				},
+				cr: targetGroup(),
+			},
+			want: want{
+				cr: targetGroup(withConditions(runtimev1alpha1.Available()),
+					withObservation(v1beta1.DBProxyTargetGroupObservation{
+						TargetGroupARN: groupARN,
+						Status:         "available",
+						Targets: []v1beta1.DBProxyTargetObservation{{
+							Type:          string(awsrds.TargetTypeRdsInstance),
+							RDSResourceID: instanceID,
+							State:         v1beta1.DBProxyTargetStateAvailable,
+						}},
+					})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"RegisteringNotUpToDate": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribeDBProxyTargetGroupsRequest: describeTargetGroups(nil, awsrds.DBProxyTargetGroup{
+						ConnectionPoolConfig: &awsrds.ConnectionPoolConfigurationInfo{MaxConnectionsPercent: aws.Int64(100)},
+					}),
+					MockDescribeDBProxyTargetsRequest: describeTargets(nil, instanceTarget(awsrds.TargetStateRegistering)),
+				},
+				cr: targetGroup(withMaxConnectionsPercent(50)),
+			},
+			want: want{
+				cr: targetGroup(withMaxConnectionsPercent(50),
+					withConditions(runtimev1alpha1.Creating()),
+					withObservation(v1beta1.DBProxyTargetGroupObservation{
+						Targets: []v1beta1.DBProxyTargetObservation{{
+							Type:          string(awsrds.TargetTypeRdsInstance),
+							RDSResourceID: instanceID,
+							State:         v1beta1.DBProxyTargetStateRegistering,
+						}},
+					})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"TargetNotRegistered": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribeDBProxyTargetGroupsRequest: describeTargetGroups(nil, awsrds.DBProxyTargetGroup{}),
+					MockDescribeDBProxyTargetsRequest:      describeTargets(nil),
+				},
+				cr: targetGroup(),
+			},
+			want: want{
+				cr: targetGroup(),
+			},
+		},
+		"TargetGroupNotFound": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribeDBProxyTargetGroupsRequest: describeTargetGroups(errors.New(awsrds.ErrCodeDBProxyTargetGroupNotFoundFault), awsrds.DBProxyTargetGroup{}),
+				},
+				cr: targetGroup(),
+			},
+			want: want{
+				cr: targetGroup(),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribeDBProxyTargetGroupsRequest: describeTargetGroups(errBoom, awsrds.DBProxyTargetGroup{}),
+				},
+				cr: targetGroup(),
+			},
+			want: want{
+				cr:  targetGroup(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"FailedDescribeTargets": {
+			args: args{
+				client: &fake.MockDBProxyClient{
+					MockDescribeDBProxyTargetGroupsRequest: describeTargetGroups(nil, awsrds.DBProxyTargetGroup{}),
+					MockDescribeDBProxyTargetsRequest:      describeTargets(errBoom),
+				},
+				cr: targetGroup(),
+			},
+			want: want{
+				cr:  targetGroup(),
+				err: errors.Wrap(errBoom, errDescribeTargets),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.DBProxyTargetGroup
+		err error
+	}
+
+	register := func(err error) func(*awsrds.RegisterDBProxyTargetsInput) awsrds.RegisterDBProxyTargetsRequest {
+		return func(in *awsrds.RegisterDBProxyTargetsInput) awsrds.RegisterDBProxyTargetsRequest {
+			if diff := cmp.Diff([]string{instanceID}, in.DBInstanceIdentifiers); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(v1beta1.DBProxyDefaultTargetGroupName, aws.StringValue(in.TargetGroupName)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awsrds.RegisterDBProxyTargetsRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.RegisterDBProxyTargetsOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDBProxyClient{MockRegisterDBProxyTargetsRequest: register(nil)},
+				cr:     targetGroup(),
+			},
+			want: want{
+				cr: targetGroup(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockDBProxyClient{MockRegisterDBProxyTargetsRequest: register(errBoom)},
+				cr:     targetGroup(),
+			},
+			want: want{
+				cr:  targetGroup(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errRegister),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.DBProxyTargetGroup
+		err error
+	}
+
+	modify := func(err error) func(*awsrds.ModifyDBProxyTargetGroupInput) awsrds.ModifyDBProxyTargetGroupRequest {
+		return func(in *awsrds.ModifyDBProxyTargetGroupInput) awsrds.ModifyDBProxyTargetGroupRequest {
+			if diff := cmp.Diff(int64(50), aws.Int64Value(in.ConnectionPoolConfig.MaxConnectionsPercent)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awsrds.ModifyDBProxyTargetGroupRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.ModifyDBProxyTargetGroupOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDBProxyClient{MockModifyDBProxyTargetGroupRequest: modify(nil)},
+				cr:     targetGroup(withMaxConnectionsPercent(50)),
+			},
+			want: want{
+				cr: targetGroup(withMaxConnectionsPercent(50)),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockDBProxyClient{MockModifyDBProxyTargetGroupRequest: modify(errBoom)},
+				cr:     targetGroup(withMaxConnectionsPercent(50)),
+			},
+			want: want{
+				cr:  targetGroup(withMaxConnectionsPercent(50)),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.DBProxyTargetGroup
+		err error
+	}
+
+	deregister := func(err error) func(*awsrds.DeregisterDBProxyTargetsInput) awsrds.DeregisterDBProxyTargetsRequest {
+		return func(*awsrds.DeregisterDBProxyTargetsInput) awsrds.DeregisterDBProxyTargetsRequest {
+			return awsrds.DeregisterDBProxyTargetsRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DeregisterDBProxyTargetsOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDBProxyClient{MockDeregisterDBProxyTargetsRequest: deregister(nil)},
+				cr:     targetGroup(),
+			},
+			want: want{
+				cr: targetGroup(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeregistered": {
+			args: args{
+				client: &fake.MockDBProxyClient{MockDeregisterDBProxyTargetsRequest: deregister(errors.New(awsrds.ErrCodeDBProxyTargetNotFoundFault))},
+				cr:     targetGroup(),
+			},
+			want: want{
+				cr: targetGroup(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockDBProxyClient{MockDeregisterDBProxyTargetsRequest: deregister(errBoom)},
+				cr:     targetGroup(),
+			},
+			want: want{
+				cr:  targetGroup(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDeregister),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
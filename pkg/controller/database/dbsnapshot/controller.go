@@ -0,0 +1,200 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbsnapshot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dbsnapshot"
+)
+
+const (
+	errNotDBSnapshot = "managed resource is not a DBSnapshot custom resource"
+
+	errCreateClient      = "cannot create DBSnapshot client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+
+	errDescribe           = "cannot describe DB snapshot"
+	errDescribeAttributes = "cannot describe attributes of DB snapshot"
+	errCreate             = "cannot create DB snapshot"
+	errCopy               = "cannot copy DB snapshot"
+	errModifyAttribute    = "cannot modify attribute of DB snapshot"
+	errDelete             = "cannot delete DB snapshot"
+)
+
+// SetupDBSnapshot adds a controller that reconciles DBSnapshots.
+func SetupDBSnapshot(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1beta1.DBSnapshotGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.DBSnapshot{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.DBSnapshotGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: dbsnapshot.NewClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (dbsnapshot.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.DBSnapshot)
+	if !ok {
+		return nil, errors.New(errNotDBSnapshot)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		rdsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: rdsClient}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	rdsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: rdsClient}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	client dbsnapshot.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.DBSnapshot)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDBSnapshot)
+	}
+	rsp, err := e.client.DescribeDBSnapshotsRequest(&awsrds.DescribeDBSnapshotsInput{
+		DBSnapshotIdentifier: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(dbsnapshot.IsNotFound, err), errDescribe)
+	}
+
+	// We use an explicit identifier, so, if there is no error, there should
+	// be only 1 element in the list.
+	snapshot := rsp.DBSnapshots[0]
+
+	// Attributes of a DB snapshot can be managed only once it is available.
+	var shared []string
+	available := aws.StringValue(snapshot.Status) == v1beta1.DBSnapshotStateAvailable
+	if available {
+		attrs, err := e.client.DescribeDBSnapshotAttributesRequest(&awsrds.DescribeDBSnapshotAttributesInput{
+			DBSnapshotIdentifier: aws.String(meta.GetExternalName(cr)),
+		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errDescribeAttributes)
+		}
+		shared = dbsnapshot.GetSharedAccounts(attrs.DBSnapshotAttributesResult)
+	}
+	cr.Status.AtProvider = dbsnapshot.GenerateObservation(snapshot, shared)
+
+	switch cr.Status.AtProvider.Status {
+	case v1beta1.DBSnapshotStateAvailable:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1beta1.DBSnapshotStateCreating, v1beta1.DBSnapshotStateCopying:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case v1beta1.DBSnapshotStateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: !available || dbsnapshot.IsUpToDate(cr.Spec.ForProvider, shared),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.DBSnapshot)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDBSnapshot)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	if cr.Spec.ForProvider.SourceDBSnapshotIdentifier != nil {
+		_, err := e.client.CopyDBSnapshotRequest(dbsnapshot.GenerateCopyDBSnapshotInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+		return managed.ExternalCreation{}, errors.Wrap(err, errCopy)
+	}
+	_, err := e.client.CreateDBSnapshotRequest(dbsnapshot.GenerateCreateDBSnapshotInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.DBSnapshot)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDBSnapshot)
+	}
+	input := dbsnapshot.GenerateModifyDBSnapshotAttributeInput(meta.GetExternalName(cr), cr.Spec.ForProvider.SharedAccounts, cr.Status.AtProvider.SharedAccounts)
+	if input == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err := e.client.ModifyDBSnapshotAttributeRequest(input).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errModifyAttribute)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.DBSnapshot)
+	if !ok {
+		return errors.New(errNotDBSnapshot)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.Status == v1beta1.DBSnapshotStateDeleting {
+		return nil
+	}
+	_, err := e.client.DeleteDBSnapshotRequest(&awsrds.DeleteDBSnapshotInput{
+		DBSnapshotIdentifier: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(dbsnapshot.IsNotFound, err), errDelete)
+}
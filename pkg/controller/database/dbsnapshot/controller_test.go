@@ -0,0 +1,465 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbsnapshot
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/dbsnapshot"
+	"github.com/crossplane/provider-aws/pkg/clients/dbsnapshot/fake"
+)
+
+var (
+	snapshotARN = "arn:aws:rds:us-east-1:123456789012:snapshot:example"
+	sourceARN   = "arn:aws:rds:eu-west-1:123456789012:snapshot:source"
+	account     = "210987654321"
+	errBoom     = errors.New("boom")
+)
+
+type args struct {
+	client dbsnapshot.Client
+	cr     *v1beta1.DBSnapshot
+}
+
+type snapshotModifier func(*v1beta1.DBSnapshot)
+
+func withConditions(c ...runtimev1alpha1.Condition) snapshotModifier {
+	return func(r *v1beta1.DBSnapshot) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSharedAccounts(a ...string) snapshotModifier {
+	return func(r *v1beta1.DBSnapshot) { r.Spec.ForProvider.SharedAccounts = a }
+}
+
+func withSourceDBSnapshotIdentifier(s string) snapshotModifier {
+	return func(r *v1beta1.DBSnapshot) { r.Spec.ForProvider.SourceDBSnapshotIdentifier = &s }
+}
+
+func withObservation(o v1beta1.DBSnapshotObservation) snapshotModifier {
+	return func(r *v1beta1.DBSnapshot) { r.Status.AtProvider = o }
+}
+
+func snapshot(m ...snapshotModifier) *v1beta1.DBSnapshot {
+	cr := &v1beta1.DBSnapshot{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeSnapshots(err error, status string, progress int64) func(*awsrds.DescribeDBSnapshotsInput) awsrds.DescribeDBSnapshotsRequest {
+	return func(*awsrds.DescribeDBSnapshotsInput) awsrds.DescribeDBSnapshotsRequest {
+		return awsrds.DescribeDBSnapshotsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DescribeDBSnapshotsOutput{
+				DBSnapshots: []awsrds.DBSnapshot{{
+					DBSnapshotArn:   aws.String(snapshotARN),
+					Status:          aws.String(status),
+					PercentProgress: aws.Int64(progress),
+				}},
+			}},
+		}
+	}
+}
+
+func describeAttributes(err error, shared ...string) func(*awsrds.DescribeDBSnapshotAttributesInput) awsrds.DescribeDBSnapshotAttributesRequest {
+	return func(*awsrds.DescribeDBSnapshotAttributesInput) awsrds.DescribeDBSnapshotAttributesRequest {
+		return awsrds.DescribeDBSnapshotAttributesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DescribeDBSnapshotAttributesOutput{
+				DBSnapshotAttributesResult: &awsrds.DBSnapshotAttributesResult{
+					DBSnapshotAttributes: []awsrds.DBSnapshotAttribute{{
+						AttributeName:   aws.String(v1beta1.DBSnapshotAttributeRestore),
+						AttributeValues: shared,
+					}},
+				},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.DBSnapshot
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AvailableUpToDate": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockDescribeDBSnapshotsRequest:          describeSnapshots(nil, v1beta1.DBSnapshotStateAvailable, 100),
+					MockDescribeDBSnapshotAttributesRequest: describeAttributes(nil, account),
+				},
+				cr: snapshot(withSharedAccounts(account)),
+			},
+			want: want{
+				cr: snapshot(withSharedAccounts(account),
+					withConditions(runtimev1alpha1.Available()),
+					withObservation(v1beta1.DBSnapshotObservation{
+						DBSnapshotARN:   snapshotARN,
+						Status:          v1beta1.DBSnapshotStateAvailable,
+						PercentProgress: 100,
+						SharedAccounts:  []string{account},
+					})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"AvailableNotShared": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockDescribeDBSnapshotsRequest:          describeSnapshots(nil, v1beta1.DBSnapshotStateAvailable, 100),
+					MockDescribeDBSnapshotAttributesRequest: describeAttributes(nil),
+				},
+				cr: snapshot(withSharedAccounts(account)),
+			},
+			want: want{
+				cr: snapshot(withSharedAccounts(account),
+					withConditions(runtimev1alpha1.Available()),
+					withObservation(v1beta1.DBSnapshotObservation{
+						DBSnapshotARN:   snapshotARN,
+						Status:          v1beta1.DBSnapshotStateAvailable,
+						PercentProgress: 100,
+					})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockDescribeDBSnapshotsRequest: describeSnapshots(nil, v1beta1.DBSnapshotStateCreating, 42),
+				},
+				cr: snapshot(withSharedAccounts(account)),
+			},
+			want: want{
+				cr: snapshot(withSharedAccounts(account),
+					withConditions(runtimev1alpha1.Creating()),
+					withObservation(v1beta1.DBSnapshotObservation{
+						DBSnapshotARN:   snapshotARN,
+						Status:          v1beta1.DBSnapshotStateCreating,
+						PercentProgress: 42,
+					})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockDescribeDBSnapshotsRequest: describeSnapshots(errors.New(awsrds.ErrCodeDBSnapshotNotFoundFault), "", 0),
+				},
+				cr: snapshot(),
+			},
+			want: want{
+				cr: snapshot(),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockDescribeDBSnapshotsRequest: describeSnapshots(errBoom, "", 0),
+				},
+				cr: snapshot(),
+			},
+			want: want{
+				cr:  snapshot(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"FailedDescribeAttributes": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockDescribeDBSnapshotsRequest:          describeSnapshots(nil, v1beta1.DBSnapshotStateAvailable, 100),
+					MockDescribeDBSnapshotAttributesRequest: describeAttributes(errBoom),
+				},
+				cr: snapshot(),
+			},
+			want: want{
+				cr:  snapshot(),
+				err: errors.Wrap(errBoom, errDescribeAttributes),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.DBSnapshot
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulSnapshot": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockCreateDBSnapshotRequest: func(*awsrds.CreateDBSnapshotInput) awsrds.CreateDBSnapshotRequest {
+						return awsrds.CreateDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBSnapshotOutput{}},
+						}
+					},
+				},
+				cr: snapshot(),
+			},
+			want: want{
+				cr: snapshot(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"SuccessfulCopy": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockCopyDBSnapshotRequest: func(in *awsrds.CopyDBSnapshotInput) awsrds.CopyDBSnapshotRequest {
+						if diff := cmp.Diff(sourceARN, aws.StringValue(in.SourceDBSnapshotIdentifier)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.CopyDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CopyDBSnapshotOutput{}},
+						}
+					},
+				},
+				cr: snapshot(withSourceDBSnapshotIdentifier(sourceARN)),
+			},
+			want: want{
+				cr: snapshot(withSourceDBSnapshotIdentifier(sourceARN), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedSnapshot": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockCreateDBSnapshotRequest: func(*awsrds.CreateDBSnapshotInput) awsrds.CreateDBSnapshotRequest {
+						return awsrds.CreateDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: snapshot(),
+			},
+			want: want{
+				cr:  snapshot(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"FailedCopy": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockCopyDBSnapshotRequest: func(*awsrds.CopyDBSnapshotInput) awsrds.CopyDBSnapshotRequest {
+						return awsrds.CopyDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: snapshot(withSourceDBSnapshotIdentifier(sourceARN)),
+			},
+			want: want{
+				cr:  snapshot(withSourceDBSnapshotIdentifier(sourceARN), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCopy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.DBSnapshot
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Share": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockModifyDBSnapshotAttributeRequest: func(in *awsrds.ModifyDBSnapshotAttributeInput) awsrds.ModifyDBSnapshotAttributeRequest {
+						if diff := cmp.Diff([]string{account}, in.ValuesToAdd); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.ModifyDBSnapshotAttributeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBSnapshotAttributeOutput{}},
+						}
+					},
+				},
+				cr: snapshot(withSharedAccounts(account)),
+			},
+			want: want{
+				cr: snapshot(withSharedAccounts(account)),
+			},
+		},
+		"NothingToModify": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{},
+				cr:     snapshot(),
+			},
+			want: want{
+				cr: snapshot(),
+			},
+		},
+		"FailedModify": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{
+					MockModifyDBSnapshotAttributeRequest: func(*awsrds.ModifyDBSnapshotAttributeInput) awsrds.ModifyDBSnapshotAttributeRequest {
+						return awsrds.ModifyDBSnapshotAttributeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: snapshot(withSharedAccounts(account)),
+			},
+			want: want{
+				cr:  snapshot(withSharedAccounts(account)),
+				err: errors.Wrap(errBoom, errModifyAttribute),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.DBSnapshot
+		err error
+	}
+
+	del := func(err error) func(*awsrds.DeleteDBSnapshotInput) awsrds.DeleteDBSnapshotRequest {
+		return func(*awsrds.DeleteDBSnapshotInput) awsrds.DeleteDBSnapshotRequest {
+			return awsrds.DeleteDBSnapshotRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DeleteDBSnapshotOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{MockDeleteDBSnapshotRequest: del(nil)},
+				cr:     snapshot(),
+			},
+			want: want{
+				cr: snapshot(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{},
+				cr:     snapshot(withObservation(v1beta1.DBSnapshotObservation{Status: v1beta1.DBSnapshotStateDeleting})),
+			},
+			want: want{
+				cr: snapshot(withObservation(v1beta1.DBSnapshotObservation{Status: v1beta1.DBSnapshotStateDeleting}),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{MockDeleteDBSnapshotRequest: del(errors.New(awsrds.ErrCodeDBSnapshotNotFoundFault))},
+				cr:     snapshot(),
+			},
+			want: want{
+				cr: snapshot(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockDBSnapshotClient{MockDeleteDBSnapshotRequest: del(errBoom)},
+				cr:     snapshot(),
+			},
+			want: want{
+				cr:  snapshot(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optiongroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/optiongroup"
+)
+
+const (
+	errNotOptionGroup = "managed resource is not an OptionGroup custom resource"
+
+	errCreateClient      = "cannot create OptionGroup client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+
+	errDescribe = "cannot describe option group"
+	errCreate   = "cannot create option group"
+	errModify   = "cannot modify option group"
+	errDelete   = "cannot delete option group"
+)
+
+// SetupOptionGroup adds a controller that reconciles OptionGroups.
+func SetupOptionGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1beta1.OptionGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.OptionGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.OptionGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: optiongroup.NewClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (optiongroup.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.OptionGroup)
+	if !ok {
+		return nil, errors.New(errNotOptionGroup)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		rdsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: rdsClient}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	rdsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: rdsClient}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	client optiongroup.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.OptionGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotOptionGroup)
+	}
+	rsp, err := e.client.DescribeOptionGroupsRequest(&awsrds.DescribeOptionGroupsInput{
+		OptionGroupName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(optiongroup.IsNotFound, err), errDescribe)
+	}
+
+	// We use an explicit name, so, if there is no error, there should be
+	// only 1 element in the list.
+	og := rsp.OptionGroupsList[0]
+	cr.Status.AtProvider = optiongroup.GenerateObservation(og)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: optiongroup.IsUpToDate(cr.Spec.ForProvider, og),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.OptionGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotOptionGroup)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.client.CreateOptionGroupRequest(optiongroup.GenerateCreateOptionGroupInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.OptionGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotOptionGroup)
+	}
+	rsp, err := e.client.DescribeOptionGroupsRequest(&awsrds.DescribeOptionGroupsInput{
+		OptionGroupName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	input := optiongroup.GenerateModifyOptionGroupInput(meta.GetExternalName(cr), cr.Spec.ForProvider, rsp.OptionGroupsList[0])
+	if input == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.ModifyOptionGroupRequest(input).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.OptionGroup)
+	if !ok {
+		return errors.New(errNotOptionGroup)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteOptionGroupRequest(&awsrds.DeleteOptionGroupInput{
+		OptionGroupName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(optiongroup.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,383 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optiongroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/optiongroup"
+	"github.com/crossplane/provider-aws/pkg/clients/optiongroup/fake"
+)
+
+var (
+	groupARN   = "arn:aws:rds:us-east-1:123456789012:og:example"
+	optionName = "SQLSERVER_BACKUP_RESTORE"
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	client optiongroup.Client
+	cr     *v1beta1.OptionGroup
+}
+
+type groupModifier func(*v1beta1.OptionGroup)
+
+func withConditions(c ...runtimev1alpha1.Condition) groupModifier {
+	return func(r *v1beta1.OptionGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withOptions(o ...v1beta1.OptionConfiguration) groupModifier {
+	return func(r *v1beta1.OptionGroup) { r.Spec.ForProvider.Options = o }
+}
+
+func withObservation(o v1beta1.OptionGroupObservation) groupModifier {
+	return func(r *v1beta1.OptionGroup) { r.Status.AtProvider = o }
+}
+
+func group(m ...groupModifier) *v1beta1.OptionGroup {
+	cr := &v1beta1.OptionGroup{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeGroups(err error, o ...awsrds.Option) func(*awsrds.DescribeOptionGroupsInput) awsrds.DescribeOptionGroupsRequest {
+	return func(*awsrds.DescribeOptionGroupsInput) awsrds.DescribeOptionGroupsRequest {
+		return awsrds.DescribeOptionGroupsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DescribeOptionGroupsOutput{
+				OptionGroupsList: []awsrds.OptionGroup{{OptionGroupArn: aws.String(groupARN), Options: o}},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	desired := v1beta1.OptionConfiguration{OptionName: optionName}
+	observed := awsrds.Option{OptionName: aws.String(optionName)}
+
+	type want struct {
+		cr     *v1beta1.OptionGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroupsRequest: describeGroups(nil, observed),
+				},
+				cr: group(withOptions(desired)),
+			},
+			want: want{
+				cr: group(withOptions(desired),
+					withConditions(runtimev1alpha1.Available()),
+					withObservation(v1beta1.OptionGroupObservation{
+						OptionGroupARN: groupARN,
+						Options:        []v1beta1.OptionObservation{{OptionName: optionName}},
+					})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"OptionMissing": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroupsRequest: describeGroups(nil),
+				},
+				cr: group(withOptions(desired)),
+			},
+			want: want{
+				cr: group(withOptions(desired),
+					withConditions(runtimev1alpha1.Available()),
+					withObservation(v1beta1.OptionGroupObservation{OptionGroupARN: groupARN})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroupsRequest: describeGroups(errors.New(awsrds.ErrCodeOptionGroupNotFoundFault)),
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroupsRequest: describeGroups(errBoom),
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.OptionGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockCreateOptionGroupRequest: func(*awsrds.CreateOptionGroupInput) awsrds.CreateOptionGroupRequest {
+						return awsrds.CreateOptionGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateOptionGroupOutput{}},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockCreateOptionGroupRequest: func(*awsrds.CreateOptionGroupInput) awsrds.CreateOptionGroupRequest {
+						return awsrds.CreateOptionGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	desired := v1beta1.OptionConfiguration{OptionName: optionName}
+	stale := awsrds.Option{OptionName: aws.String("TDE")}
+
+	type want struct {
+		cr  *v1beta1.OptionGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"IncludeAndRemove": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroupsRequest: describeGroups(nil, stale),
+					MockModifyOptionGroupRequest: func(in *awsrds.ModifyOptionGroupInput) awsrds.ModifyOptionGroupRequest {
+						if diff := cmp.Diff(optionName, aws.StringValue(in.OptionsToInclude[0].OptionName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff([]string{"TDE"}, in.OptionsToRemove); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.ModifyOptionGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyOptionGroupOutput{}},
+						}
+					},
+				},
+				cr: group(withOptions(desired)),
+			},
+			want: want{
+				cr: group(withOptions(desired)),
+			},
+		},
+		"NothingToModify": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroupsRequest: describeGroups(nil),
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroupsRequest: describeGroups(errBoom),
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"FailedModify": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroupsRequest: describeGroups(nil),
+					MockModifyOptionGroupRequest: func(*awsrds.ModifyOptionGroupInput) awsrds.ModifyOptionGroupRequest {
+						return awsrds.ModifyOptionGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(withOptions(desired)),
+			},
+			want: want{
+				cr:  group(withOptions(desired)),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.OptionGroup
+		err error
+	}
+
+	del := func(err error) func(*awsrds.DeleteOptionGroupInput) awsrds.DeleteOptionGroupRequest {
+		return func(*awsrds.DeleteOptionGroupInput) awsrds.DeleteOptionGroupRequest {
+			return awsrds.DeleteOptionGroupRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DeleteOptionGroupOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockOptionGroupClient{MockDeleteOptionGroupRequest: del(nil)},
+				cr:     group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockOptionGroupClient{MockDeleteOptionGroupRequest: del(errors.New(awsrds.ErrCodeOptionGroupNotFoundFault))},
+				cr:     group(),
+			},
+			want: want{
+				cr: group(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockOptionGroupClient{MockDeleteOptionGroupRequest: del(errBoom)},
+				cr:     group(),
+			},
+			want: want{
+				cr:  group(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -20,11 +20,14 @@ import (
 	"context"
 	"reflect"
 	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -51,14 +54,28 @@ const (
 	errGetProvider       = "cannot get provider"
 	errGetProviderSecret = "cannot get provider secret"
 
-	errCreateFailed            = "cannot create RDS instance"
-	errModifyFailed            = "cannot modify RDS instance"
-	errAddTagsFailed           = "cannot add tags to RDS instance"
-	errDeleteFailed            = "cannot delete RDS instance"
-	errDescribeFailed          = "cannot describe RDS instance"
-	errPatchCreationFailed     = "cannot create a patch object"
-	errUpToDateFailed          = "cannot check whether object is up-to-date"
-	errGetPasswordSecretFailed = "cannot get password secret"
+	errCreateFailed             = "cannot create RDS instance"
+	errModifyFailed             = "cannot modify RDS instance"
+	errAddTagsFailed            = "cannot add tags to RDS instance"
+	errDeleteFailed             = "cannot delete RDS instance"
+	errDescribeFailed           = "cannot describe RDS instance"
+	errPatchCreationFailed      = "cannot create a patch object"
+	errUpToDateFailed           = "cannot check whether object is up-to-date"
+	errGetPasswordSecretFailed  = "cannot get password secret"
+	errGetConnSecretFailed      = "cannot get connection secret"
+	errGeneratePasswordFailed   = "cannot generate password"
+	errRestoreNoPassword        = "masterPasswordSecretRef is required to restore an RDS instance"
+	errGetPendingPasswordFailed = "cannot get pending master password secret"
+	errStorePasswordFailed      = "cannot store pending master password secret"
+	errCompleteRotationFailed   = "cannot delete pending master password secret"
+	errRotationWithSecretRef    = "masterPasswordRotation cannot be used together with masterPasswordSecretRef"
+	errBuildAuthTokenFailed     = "cannot build IAM database authentication token"
+	errPublishAuthTokenFailed   = "cannot publish IAM database authentication token"
+	errRebootFailed             = "cannot reboot RDS instance"
+	errRestoreFailed            = "cannot restore RDS instance"
+	errCreateReplicaFailed      = "cannot create RDS instance read replica"
+	errPromoteFailed            = "cannot promote RDS instance read replica"
+	errReplicaLagFailed         = "cannot get replica lag of RDS instance"
 )
 
 // SetupRDSInstance adds a controller that reconciles RDSInstances.
@@ -70,7 +87,7 @@ func SetupRDSInstance(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1beta1.RDSInstance{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.RDSInstanceGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: rds.NewClient}),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: rds.NewClient, log: l.WithValues("controller", name)}),
 			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -80,6 +97,7 @@ func SetupRDSInstance(mgr ctrl.Manager, l logging.Logger) error {
 type connector struct {
 	kube        client.Client
 	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (rds.Client, error)
+	log         logging.Logger
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -95,7 +113,7 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 
 	if aws.BoolValue(p.Spec.UseServiceAccount) {
 		rdsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
-		return &external{client: rdsClient, kube: c.kube}, errors.Wrap(err, errCreateRDSClient)
+		return &external{client: rdsClient, kube: c.kube, log: c.log}, errors.Wrap(err, errCreateRDSClient)
 	}
 
 	if p.GetCredentialsSecretReference() == nil {
@@ -109,12 +127,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}
 
 	rdsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
-	return &external{client: rdsClient, kube: c.kube}, errors.Wrap(err, errCreateRDSClient)
+	return &external{client: rdsClient, kube: c.kube, log: c.log}, errors.Wrap(err, errCreateRDSClient)
 }
 
 type external struct {
 	client rds.Client
 	kube   client.Client
+	log    logging.Logger
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -122,6 +141,11 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotRDSInstance)
 	}
+	// A given master password is never rotated. The check is skipped during
+	// deletion so that it does not block it.
+	if cr.Spec.ForProvider.MasterPasswordRotation != nil && cr.Spec.ForProvider.MasterPasswordSecretRef != nil && !meta.WasDeleted(cr) {
+		return managed.ExternalObservation{}, errors.New(errRotationWithSecretRef)
+	}
 	// TODO(muvaf): There are some parameters that require a specific call
 	// for retrieval. For example, DescribeDBInstancesOutput does not expose
 	// the tags map of the RDS instance, you have to make ListTagsForResourceRequest
@@ -142,7 +166,25 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
 		}
 	}
+	// The times of the password rotation and token refresh are not known to
+	// AWS.
+	rotated := cr.Status.AtProvider.MasterPasswordRotateTime
+	rotating := cr.Status.AtProvider.MasterPasswordRotationStartTime
+	refreshed := cr.Status.AtProvider.IAMAuthTokenRefreshTime
 	cr.Status.AtProvider = rds.GenerateObservation(instance)
+	cr.Status.AtProvider.MasterPasswordRotateTime = rotated
+	cr.Status.AtProvider.MasterPasswordRotationStartTime = rotating
+	cr.Status.AtProvider.IAMAuthTokenRefreshTime = refreshed
+	if cr.Status.AtProvider.ReadReplicaSourceDBInstanceIdentifier != "" {
+		// The replica lag is informational, so a CloudWatch failure does not
+		// prevent the instance from being reconciled.
+		rsp, err := e.client.GetMetricStatisticsRequest(rds.GenerateReplicaLagInput(meta.GetExternalName(cr), time.Now())).Send(ctx)
+		if err != nil {
+			e.log.Debug(errReplicaLagFailed, "error", err)
+		} else {
+			cr.Status.AtProvider.ReplicaLag = rds.GetReplicaLag(rsp.Datapoints)
+		}
+	}
 
 	switch cr.Status.AtProvider.DBInstanceStatus {
 	case v1beta1.RDSInstanceStateAvailable:
@@ -155,10 +197,36 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	default:
 		cr.Status.SetConditions(runtimev1alpha1.Unavailable())
 	}
+	if now := time.Now(); rds.IsAuthTokenRefreshDue(cr.Spec.ForProvider, cr.Status.AtProvider, now) {
+		if err := e.publishAuthToken(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		t := metav1.NewTime(now)
+		cr.Status.AtProvider.IAMAuthTokenRefreshTime = &t
+	}
 	upToDate, err := rds.IsUpToDate(cr.Spec.ForProvider, instance)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
 	}
+	if aws.BoolValue(cr.Spec.ForProvider.RebootOnParameterChange) && rds.IsRebootPending(cr.Status.AtProvider) {
+		upToDate = false
+	}
+	if rds.IsPromotionPending(cr.Spec.ForProvider, cr.Status.AtProvider) {
+		upToDate = false
+	}
+	if err := e.completePasswordRotation(ctx, cr, time.Now()); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if isPasswordRotationDue(cr, time.Now()) {
+		upToDate = false
+	}
+	if upToDate {
+		changed, err := e.isMasterPasswordChanged(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = !changed
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:    true,
@@ -176,29 +244,45 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Status.AtProvider.DBInstanceStatus == v1beta1.RDSInstanceStateCreating {
 		return managed.ExternalCreation{}, nil
 	}
+	// The master password cannot be given during a restore, so, it has to be
+	// known in order to be set on the restored DB instance and published.
+	if cr.Spec.ForProvider.RestoreFrom != nil && cr.Spec.ForProvider.MasterPasswordSecretRef == nil {
+		return managed.ExternalCreation{}, errors.New(errRestoreNoPassword)
+	}
 	pw, err := password.Generate()
 	if err != nil {
 		return managed.ExternalCreation{}, err
 	}
 	if cr.Spec.ForProvider.MasterPasswordSecretRef != nil {
-		s := &corev1.Secret{}
-		nn := types.NamespacedName{
-			Name:      cr.Spec.ForProvider.MasterPasswordSecretRef.Name,
-			Namespace: cr.Spec.ForProvider.MasterPasswordSecretRef.Namespace,
+		if pw, err = e.getMasterPassword(ctx, cr.Spec.ForProvider.MasterPasswordSecretRef); err != nil {
+			return managed.ExternalCreation{}, err
 		}
-		if err := e.kube.Get(ctx, nn, s); err != nil {
-			return managed.ExternalCreation{}, errors.Wrap(err, errGetPasswordSecretFailed)
-		}
-		pw = string(s.Data[cr.Spec.ForProvider.MasterPasswordSecretRef.Key])
 	}
 
-	req := e.client.CreateDBInstanceRequest(rds.GenerateCreateDBInstanceInput(meta.GetExternalName(cr), pw, &cr.Spec.ForProvider))
-	_, err = req.Send(ctx)
+	switch r := cr.Spec.ForProvider.RestoreFrom; {
+	case r != nil && r.SnapshotIdentifier != nil:
+		_, err = e.client.RestoreDBInstanceFromDBSnapshotRequest(rds.GenerateRestoreDBInstanceFromDBSnapshotInput(meta.GetExternalName(cr), &cr.Spec.ForProvider)).Send(ctx)
+		err = errors.Wrap(err, errRestoreFailed)
+	case r != nil:
+		_, err = e.client.RestoreDBInstanceToPointInTimeRequest(rds.GenerateRestoreDBInstanceToPointInTimeInput(meta.GetExternalName(cr), &cr.Spec.ForProvider)).Send(ctx)
+		err = errors.Wrap(err, errRestoreFailed)
+	case cr.Spec.ForProvider.SourceDBInstanceIdentifier != nil:
+		_, err = e.client.CreateDBInstanceReadReplicaRequest(rds.GenerateCreateDBInstanceReadReplicaInput(meta.GetExternalName(cr), &cr.Spec.ForProvider)).Send(ctx)
+		err = errors.Wrap(err, errCreateReplicaFailed)
+	default:
+		_, err = e.client.CreateDBInstanceRequest(rds.GenerateCreateDBInstanceInput(meta.GetExternalName(cr), pw, &cr.Spec.ForProvider)).Send(ctx)
+		err = errors.Wrap(err, errCreateFailed)
+	}
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+		return managed.ExternalCreation{}, err
 	}
-	conn := managed.ConnectionDetails{
-		runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+	conn := managed.ConnectionDetails{}
+	// A restored DB instance keeps the master password of its source until
+	// the one in MasterPasswordSecretRef is found to differ from the published
+	// one and set by a modification. A read replica always uses the master
+	// password of its source.
+	if cr.Spec.ForProvider.SourceDBInstanceIdentifier == nil && cr.Spec.ForProvider.RestoreFrom == nil {
+		conn[runtimev1alpha1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
 	}
 	if cr.Spec.ForProvider.MasterUsername != nil {
 		conn[runtimev1alpha1.ResourceCredentialsSecretUserKey] = []byte(aws.StringValue(cr.Spec.ForProvider.MasterUsername))
@@ -212,9 +296,29 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotRDSInstance)
 	}
 	switch cr.Status.AtProvider.DBInstanceStatus {
-	case v1beta1.RDSInstanceStateModifying, v1beta1.RDSInstanceStateCreating:
+	case v1beta1.RDSInstanceStateModifying, v1beta1.RDSInstanceStateCreating, v1beta1.RDSInstanceStateRebooting:
 		return managed.ExternalUpdate{}, nil
 	}
+	// Parameter changes that are pending a reboot are applied before any
+	// other modification so that the reboot does not interrupt it.
+	if cr.Status.AtProvider.DBInstanceStatus == v1beta1.RDSInstanceStateAvailable &&
+		aws.BoolValue(cr.Spec.ForProvider.RebootOnParameterChange) && rds.IsRebootPending(cr.Status.AtProvider) {
+		_, err := e.client.RebootDBInstanceRequest(&awsrds.RebootDBInstanceInput{
+			DBInstanceIdentifier: aws.String(meta.GetExternalName(cr)),
+		}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRebootFailed)
+	}
+	// Promotion reboots the DB instance, so, the rest of the modifications
+	// are made once it is available again as a standalone DB instance.
+	if cr.Status.AtProvider.DBInstanceStatus == v1beta1.RDSInstanceStateAvailable &&
+		rds.IsPromotionPending(cr.Spec.ForProvider, cr.Status.AtProvider) {
+		_, err := e.client.PromoteReadReplicaRequest(&awsrds.PromoteReadReplicaInput{
+			DBInstanceIdentifier:  aws.String(meta.GetExternalName(cr)),
+			BackupRetentionPeriod: awsclients.Int64Address(cr.Spec.ForProvider.BackupRetentionPeriod),
+			PreferredBackupWindow: cr.Spec.ForProvider.PreferredBackupWindow,
+		}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPromoteFailed)
+	}
 	// AWS rejects modification requests if you send fields whose value is same
 	// as the current one. So, we have to create a patch out of the desired state
 	// and the current state. Since the DBInstance is not fully mirrored in status,
@@ -231,23 +335,37 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 	modify := rds.GenerateModifyDBInstanceInput(meta.GetExternalName(cr), patch)
 	var conn managed.ConnectionDetails
-	if cr.Spec.ForProvider.MasterPasswordSecretRef != nil {
-		s := &corev1.Secret{}
-		nn := types.NamespacedName{
-			Name:      cr.Spec.ForProvider.MasterPasswordSecretRef.Name,
-			Namespace: cr.Spec.ForProvider.MasterPasswordSecretRef.Namespace,
-		}
-		if err := e.kube.Get(ctx, nn, s); err != nil {
-			return managed.ExternalUpdate{}, errors.Wrap(err, errGetPasswordSecretFailed)
+	now := time.Now()
+	rotate := false
+	// The master password of a read replica cannot be modified. The new
+	// password is published only after AWS accepts the modification so that
+	// the connection secret never holds a password that is not in effect.
+	switch {
+	case cr.Spec.ForProvider.MasterPasswordSecretRef != nil && cr.Status.AtProvider.ReadReplicaSourceDBInstanceIdentifier == "":
+		pw, err := e.getMasterPassword(ctx, cr.Spec.ForProvider.MasterPasswordSecretRef)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
 		}
-		conn = managed.ConnectionDetails{
-			runtimev1alpha1.ResourceCredentialsSecretPasswordKey: s.Data[cr.Spec.ForProvider.MasterPasswordSecretRef.Key],
+		conn = managed.ConnectionDetails{runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pw)}
+		modify.MasterUserPassword = aws.String(pw)
+	case isPasswordRotationDue(cr, now):
+		pw, err := e.pendingMasterPassword(ctx, cr)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
 		}
-		modify.MasterUserPassword = aws.String(string(s.Data[cr.Spec.ForProvider.MasterPasswordSecretRef.Key]))
+		conn = managed.ConnectionDetails{runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pw)}
+		modify.MasterUserPassword = aws.String(pw)
+		rotate = true
 	}
 	if _, err = e.client.ModifyDBInstanceRequest(modify).Send(ctx); err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errModifyFailed)
 	}
+	// The rotation is recorded by Observe once the new password is
+	// published.
+	if rotate && cr.Status.AtProvider.MasterPasswordRotationStartTime == nil {
+		t := metav1.NewTime(now)
+		cr.Status.AtProvider.MasterPasswordRotationStartTime = &t
+	}
 	if len(patch.Tags) > 0 {
 		tags := make([]awsrds.Tag, len(patch.Tags))
 		for i, t := range patch.Tags {
@@ -293,6 +411,139 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	return errors.Wrap(resource.Ignore(rds.IsErrorNotFound, err), errDeleteFailed)
 }
 
+// getMasterPassword returns the password in the given secret key.
+func (e *external) getMasterPassword(ctx context.Context, ref *runtimev1alpha1.SecretKeySelector) (string, error) {
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", errors.Wrap(err, errGetPasswordSecretFailed)
+	}
+	return string(s.Data[ref.Key]), nil
+}
+
+// isMasterPasswordChanged returns true if the password in MasterPasswordSecretRef
+// differs from the one in the published connection secret. A connection
+// secret that does not exist yet is considered to have no password.
+func (e *external) isMasterPasswordChanged(ctx context.Context, cr *v1beta1.RDSInstance) (bool, error) {
+	ref := cr.Spec.ForProvider.MasterPasswordSecretRef
+	if ref == nil || cr.Spec.WriteConnectionSecretToReference == nil || cr.Status.AtProvider.ReadReplicaSourceDBInstanceIdentifier != "" {
+		return false, nil
+	}
+	pw, err := e.getMasterPassword(ctx, ref)
+	if err != nil {
+		return false, err
+	}
+	s := &corev1.Secret{}
+	nn := types.NamespacedName{
+		Name:      cr.Spec.WriteConnectionSecretToReference.Name,
+		Namespace: cr.Spec.WriteConnectionSecretToReference.Namespace,
+	}
+	if err := e.kube.Get(ctx, nn, s); resource.IgnoreNotFound(err) != nil {
+		return false, errors.Wrap(err, errGetConnSecretFailed)
+	}
+	return pw != string(s.Data[runtimev1alpha1.ResourceCredentialsSecretPasswordKey]), nil
+}
+
+// isPasswordRotationDue returns true if the auto-generated master password is
+// due to be rotated. The password is only rotated if it can be published.
+func isPasswordRotationDue(cr *v1beta1.RDSInstance, now time.Time) bool {
+	return cr.Spec.WriteConnectionSecretToReference != nil &&
+		rds.IsPasswordRotationDue(cr.Spec.ForProvider, cr.Status.AtProvider, now)
+}
+
+// pendingMasterPassword returns the master password that is being rotated to.
+// A new password is generated and stored in a secret of its own before it is
+// sent to AWS, so that it is not lost if it cannot be published, and it is
+// reused until the rotation is completed. The secret is controlled by the
+// RDSInstance so that it is garbage collected along with it.
+func (e *external) pendingMasterPassword(ctx context.Context, cr *v1beta1.RDSInstance) (string, error) {
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, pendingPasswordSecretName(cr), s); resource.IgnoreNotFound(err) != nil {
+		return "", errors.Wrap(err, errGetPendingPasswordFailed)
+	}
+	if pw := string(s.Data[runtimev1alpha1.ResourceCredentialsSecretPasswordKey]); pw != "" {
+		return pw, nil
+	}
+	pw, err := password.Generate()
+	if err != nil {
+		return "", errors.Wrap(err, errGeneratePasswordFailed)
+	}
+	nn := pendingPasswordSecretName(cr)
+	s = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            nn.Name,
+			Namespace:       nn.Namespace,
+			OwnerReferences: []metav1.OwnerReference{meta.AsController(meta.ReferenceTo(cr, v1beta1.RDSInstanceGroupVersionKind))},
+		},
+		Data: map[string][]byte{runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pw)},
+	}
+	err = resource.NewAPIPatchingApplicator(e.kube).Apply(ctx, s, resource.ConnectionSecretMustBeControllableBy(cr.GetUID()))
+	return pw, errors.Wrap(err, errStorePasswordFailed)
+}
+
+// completePasswordRotation records the rotation of the master password at the
+// given time once the pending password is published, and deletes the secret
+// that holds it. The secrets are only read while a rotation is in progress.
+func (e *external) completePasswordRotation(ctx context.Context, cr *v1beta1.RDSInstance, now time.Time) error {
+	ref := cr.Spec.WriteConnectionSecretToReference
+	if cr.Status.AtProvider.MasterPasswordRotationStartTime == nil || ref == nil {
+		return nil
+	}
+	pending := &corev1.Secret{}
+	err := e.kube.Get(ctx, pendingPasswordSecretName(cr), pending)
+	if kerrors.IsNotFound(err) {
+		// Nothing is left to publish; the next rotation starts over.
+		cr.Status.AtProvider.MasterPasswordRotationStartTime = nil
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, errGetPendingPasswordFailed)
+	}
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return errors.Wrap(resource.IgnoreNotFound(err), errGetConnSecretFailed)
+	}
+	pw := pending.Data[runtimev1alpha1.ResourceCredentialsSecretPasswordKey]
+	if string(pw) != string(s.Data[runtimev1alpha1.ResourceCredentialsSecretPasswordKey]) {
+		return nil
+	}
+	if err := e.kube.Delete(ctx, pending); resource.IgnoreNotFound(err) != nil {
+		return errors.Wrap(err, errCompleteRotationFailed)
+	}
+	t := metav1.NewTime(now)
+	cr.Status.AtProvider.MasterPasswordRotateTime = &t
+	cr.Status.AtProvider.MasterPasswordRotationStartTime = nil
+	return nil
+}
+
+// pendingPasswordSecretName returns the name of the secret that holds the
+// master password the given RDSInstance is being rotated to.
+func pendingPasswordSecretName(cr *v1beta1.RDSInstance) types.NamespacedName {
+	ref := cr.Spec.WriteConnectionSecretToReference
+	return types.NamespacedName{Name: ref.Name + rds.PendingMasterPasswordSecretSuffix, Namespace: ref.Namespace}
+}
+
+// publishAuthToken writes a new IAM database authentication token to the
+// secret given in the IAMAuthToken configuration. The secret is owned by the
+// RDSInstance so that it is garbage collected along with it.
+func (e *external) publishAuthToken(ctx context.Context, cr *v1beta1.RDSInstance) error {
+	token, err := e.client.BuildAuthToken(ctx, rds.GetAuthTokenEndpoint(cr.Status.AtProvider), cr.Spec.ForProvider.IAMAuthToken.Username)
+	if err != nil {
+		return errors.Wrap(err, errBuildAuthTokenFailed)
+	}
+	ref := cr.Spec.ForProvider.IAMAuthToken.WriteConnectionSecretToReference
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ref.Name,
+			Namespace:       ref.Namespace,
+			OwnerReferences: []metav1.OwnerReference{meta.AsController(meta.ReferenceTo(cr, v1beta1.RDSInstanceGroupVersionKind))},
+		},
+		Type: resource.SecretTypeConnection,
+		Data: rds.GetAuthTokenConnectionDetails(*cr, token),
+	}
+	err = resource.NewAPIPatchingApplicator(e.kube).Apply(ctx, s, resource.ConnectionSecretMustBeControllableBy(cr.GetUID()))
+	return errors.Wrap(err, errPublishAuthTokenFailed)
+}
+
 type tagger struct {
 	kube client.Client
 }
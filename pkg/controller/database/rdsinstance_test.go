@@ -19,21 +19,27 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
@@ -60,6 +66,8 @@ var (
 
 	replaceMe = "replace-me!"
 	errBoom   = errors.New("boom")
+
+	rotateTime = metav1.NewTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
 )
 
 type args struct {
@@ -104,6 +112,75 @@ func withPasswordSecretRef(s runtimev1alpha1.SecretKeySelector) rdsModifier {
 	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.MasterPasswordSecretRef = &s }
 }
 
+func withRebootOnParameterChange(b bool) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.RebootOnParameterChange = &b }
+}
+
+func withParameterApplyStatus(s string) rdsModifier {
+	return func(r *v1beta1.RDSInstance) {
+		r.Status.AtProvider.DBParameterGroups = []v1beta1.DBParameterGroupStatus{{DBParameterGroupName: "example", ParameterApplyStatus: s}}
+	}
+}
+
+func withRestoreFrom(r v1beta1.RestoreFromParameters) rdsModifier {
+	return func(i *v1beta1.RDSInstance) { i.Spec.ForProvider.RestoreFrom = &r }
+}
+
+func withSourceDBInstanceIdentifier(s string) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.SourceDBInstanceIdentifier = &s }
+}
+
+func withPromoteReadReplica(b bool) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.PromoteReadReplica = &b }
+}
+
+func withReadReplicaSource(s string) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Status.AtProvider.ReadReplicaSourceDBInstanceIdentifier = s }
+}
+
+func withReplicaLag(i int) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Status.AtProvider.ReplicaLag = &i }
+}
+
+func withPasswordRotation(d time.Duration) rdsModifier {
+	return func(r *v1beta1.RDSInstance) {
+		r.Spec.ForProvider.MasterPasswordRotation = &v1beta1.PasswordRotationPolicy{Interval: metav1.Duration{Duration: d}}
+	}
+}
+
+func withPasswordRotateTime(t metav1.Time) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Status.AtProvider.MasterPasswordRotateTime = &t }
+}
+
+func withPasswordRotationStartTime(t metav1.Time) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Status.AtProvider.MasterPasswordRotationStartTime = &t }
+}
+
+func withConnectionSecretRef(name string) rdsModifier {
+	return func(r *v1beta1.RDSInstance) {
+		r.Spec.WriteConnectionSecretToReference = &runtimev1alpha1.SecretReference{Name: name, Namespace: secretNamespace}
+	}
+}
+
+func withIAMAuthToken(c v1beta1.IAMAuthTokenConfiguration) rdsModifier {
+	return func(r *v1beta1.RDSInstance) {
+		r.Spec.ForProvider.EnableIAMDatabaseAuthentication = aws.Bool(true)
+		r.Spec.ForProvider.IAMAuthToken = &c
+	}
+}
+
+func withEndpoint(e v1beta1.Endpoint) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Status.AtProvider.Endpoint = e }
+}
+
+func withPort(p int) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.Port = &p }
+}
+
+func withAuthTokenRefreshTime(t metav1.Time) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Status.AtProvider.IAMAuthTokenRefreshTime = &t }
+}
+
 func instance(m ...rdsModifier) *v1beta1.RDSInstance {
 	cr := &v1beta1.RDSInstance{
 		Spec: v1beta1.RDSInstanceSpec{
@@ -286,6 +363,8 @@ func TestConnect(t *testing.T) {
 }
 
 func TestObserve(t *testing.T) {
+	refreshed := metav1.Now()
+
 	type want struct {
 		cr     *v1beta1.RDSInstance
 		result managed.ExternalObservation
@@ -381,6 +460,117 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"RebootPending": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{
+									{
+										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+										DBParameterGroups: []awsrds.DBParameterGroupStatus{{
+											DBParameterGroupName: aws.String("example"),
+											ParameterApplyStatus: aws.String(rds.ParameterApplyStatusPendingReboot),
+										}},
+									},
+								},
+							}},
+						}
+					},
+				},
+				cr: instance(withRebootOnParameterChange(true)),
+			},
+			want: want{
+				cr: instance(
+					withRebootOnParameterChange(true),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable)),
+					withParameterApplyStatus(rds.ParameterApplyStatusPendingReboot)),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: rds.GetConnectionDetails(v1beta1.RDSInstance{}),
+				},
+			},
+		},
+		"ReadReplicaPromotionPending": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{
+									{
+										DBInstanceStatus:                      aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+										ReadReplicaSourceDBInstanceIdentifier: aws.String("source"),
+									},
+								},
+							}},
+						}
+					},
+					MockGetMetricStatistics: func(input *cloudwatch.GetMetricStatisticsInput) cloudwatch.GetMetricStatisticsRequest {
+						return cloudwatch.GetMetricStatisticsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &cloudwatch.GetMetricStatisticsOutput{
+								Datapoints: []cloudwatch.Datapoint{{Average: aws.Float64(2.4)}},
+							}},
+						}
+					},
+				},
+				cr: instance(withPromoteReadReplica(true)),
+			},
+			want: want{
+				cr: instance(
+					withPromoteReadReplica(true),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable)),
+					withReadReplicaSource("source"),
+					withReplicaLag(2)),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: rds.GetConnectionDetails(v1beta1.RDSInstance{}),
+				},
+			},
+		},
+		"FailedReplicaLagIgnored": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{
+									{
+										DBInstanceStatus:                      aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+										ReadReplicaSourceDBInstanceIdentifier: aws.String("source"),
+									},
+								},
+							}},
+						}
+					},
+					MockGetMetricStatistics: func(input *cloudwatch.GetMetricStatisticsInput) cloudwatch.GetMetricStatisticsRequest {
+						return cloudwatch.GetMetricStatisticsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr: instance(
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable)),
+					withReadReplicaSource("source")),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: rds.GetConnectionDetails(v1beta1.RDSInstance{}),
+				},
+			},
+		},
 		"FailedDescribeRequest": {
 			args: args{
 				rds: &fake.MockRDSClient{
@@ -412,6 +602,259 @@ func TestObserve(t *testing.T) {
 				cr: instance(),
 			},
 		},
+		"PasswordChanged": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						s := obj.(*corev1.Secret)
+						switch key.Name {
+						case "password":
+							s.Data = map[string][]byte{"key": []byte("new")}
+						case connectionSecretName:
+							s.Data = map[string][]byte{runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte("old")}
+						}
+						return nil
+					},
+				},
+				rds: &fake.MockRDSClient{
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{
+									{
+										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+									},
+								},
+							}},
+						}
+					},
+				},
+				cr: instance(
+					withPasswordSecretRef(runtimev1alpha1.SecretKeySelector{SecretReference: runtimev1alpha1.SecretReference{Name: "password"}, Key: "key"}),
+					withConnectionSecretRef(connectionSecretName)),
+			},
+			want: want{
+				cr: instance(
+					withPasswordSecretRef(runtimev1alpha1.SecretKeySelector{SecretReference: runtimev1alpha1.SecretReference{Name: "password"}, Key: "key"}),
+					withConnectionSecretRef(connectionSecretName),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: rds.GetConnectionDetails(v1beta1.RDSInstance{}),
+				},
+			},
+		},
+		"PasswordRotationDue": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{
+									{
+										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+									},
+								},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: instance(withPasswordRotation(time.Hour), withPasswordRotateTime(rotateTime), withConnectionSecretRef(connectionSecretName)),
+			},
+			want: want{
+				cr: instance(
+					withPasswordRotation(time.Hour),
+					withPasswordRotateTime(rotateTime),
+					withConnectionSecretRef(connectionSecretName),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: rds.GetConnectionDetails(v1beta1.RDSInstance{}),
+				},
+			},
+		},
+		"PasswordRotationNotPublished": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{
+									{
+										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+									},
+								},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						pw := "old"
+						if key.Name == connectionSecretName+rds.PendingMasterPasswordSecretSuffix {
+							pw = "new"
+						}
+						obj.(*corev1.Secret).Data = map[string][]byte{runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pw)}
+						return nil
+					},
+				},
+				cr: instance(
+					withPasswordRotation(time.Hour),
+					withPasswordRotateTime(rotateTime),
+					withPasswordRotationStartTime(rotateTime),
+					withConnectionSecretRef(connectionSecretName)),
+			},
+			want: want{
+				cr: instance(
+					withPasswordRotation(time.Hour),
+					withPasswordRotateTime(rotateTime),
+					withPasswordRotationStartTime(rotateTime),
+					withConnectionSecretRef(connectionSecretName),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: rds.GetConnectionDetails(v1beta1.RDSInstance{}),
+				},
+			},
+		},
+		"PasswordRotationPendingSecretGone": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{
+									{
+										DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+									},
+								},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+				},
+				cr: instance(
+					withPasswordRotation(time.Hour),
+					withPasswordRotateTime(rotateTime),
+					withPasswordRotationStartTime(rotateTime),
+					withConnectionSecretRef(connectionSecretName)),
+			},
+			want: want{
+				cr: instance(
+					withPasswordRotation(time.Hour),
+					withPasswordRotateTime(rotateTime),
+					withConnectionSecretRef(connectionSecretName),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: rds.GetConnectionDetails(v1beta1.RDSInstance{}),
+				},
+			},
+		},
+		"PasswordRotationWithPasswordSecret": {
+			args: args{
+				cr: instance(
+					withPasswordRotation(time.Hour),
+					withPasswordSecretRef(runtimev1alpha1.SecretKeySelector{Key: secretKey})),
+			},
+			want: want{
+				cr: instance(
+					withPasswordRotation(time.Hour),
+					withPasswordSecretRef(runtimev1alpha1.SecretKeySelector{Key: secretKey})),
+				err: errors.New(errRotationWithSecretRef),
+			},
+		},
+		"AuthTokenRefreshNotDue": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{
+									{
+										DBInstanceStatus:                 aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+										IAMDatabaseAuthenticationEnabled: aws.Bool(true),
+										Endpoint:                         &awsrds.Endpoint{Address: aws.String("example.com"), Port: aws.Int64(5432)},
+									},
+								},
+							}},
+						}
+					},
+				},
+				cr: instance(
+					withIAMAuthToken(v1beta1.IAMAuthTokenConfiguration{Username: "app"}),
+					withPort(5432),
+					withAuthTokenRefreshTime(refreshed)),
+			},
+			want: want{
+				cr: instance(
+					withIAMAuthToken(v1beta1.IAMAuthTokenConfiguration{Username: "app"}),
+					withPort(5432),
+					withAuthTokenRefreshTime(refreshed),
+					withEndpoint(v1beta1.Endpoint{Address: "example.com", Port: 5432}),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: rds.GetConnectionDetails(*instance(withEndpoint(v1beta1.Endpoint{Address: "example.com", Port: 5432}))),
+				},
+			},
+		},
+		"AuthTokenPublishFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				rds: &fake.MockRDSClient{
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{
+									{
+										DBInstanceStatus:                 aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+										IAMDatabaseAuthenticationEnabled: aws.Bool(true),
+										Endpoint:                         &awsrds.Endpoint{Address: aws.String("example.com"), Port: aws.Int64(5432)},
+									},
+								},
+							}},
+						}
+					},
+					MockBuildAuthToken: func(_ context.Context, _, _ string) (string, error) {
+						return "token", nil
+					},
+				},
+				cr: instance(withIAMAuthToken(v1beta1.IAMAuthTokenConfiguration{Username: "app"}), withPort(5432)),
+			},
+			want: want{
+				cr: instance(
+					withIAMAuthToken(v1beta1.IAMAuthTokenConfiguration{Username: "app"}),
+					withPort(5432),
+					withEndpoint(v1beta1.Endpoint{Address: "example.com", Port: 5432}),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable))),
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get object"), errPublishAuthTokenFailed),
+			},
+		},
 		"LateInitSuccess": {
 			args: args{
 				kube: &test.MockClient{
@@ -478,7 +921,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.rds}
+			e := &external{kube: tc.kube, client: tc.rds, log: logging.NewNopLogger()}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -602,6 +1045,133 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errBoom, errGetPasswordSecretFailed),
 			},
 		},
+		"SuccessfulRestoreFromSnapshot": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockRestoreFromSnapshot: func(input *awsrds.RestoreDBInstanceFromDBSnapshotInput) awsrds.RestoreDBInstanceFromDBSnapshotRequest {
+						if diff := cmp.Diff("example", aws.StringValue(input.DBSnapshotIdentifier)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.RestoreDBInstanceFromDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.RestoreDBInstanceFromDBSnapshotOutput{}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				cr: instance(withMasterUsername(&masterUsername), withRestoreFrom(v1beta1.RestoreFromParameters{SnapshotIdentifier: aws.String("example")}), withPasswordSecretRef(runtimev1alpha1.SecretKeySelector{})),
+			},
+			want: want{
+				cr: instance(
+					withMasterUsername(&masterUsername),
+					withRestoreFrom(v1beta1.RestoreFromParameters{SnapshotIdentifier: aws.String("example")}),
+					withPasswordSecretRef(runtimev1alpha1.SecretKeySelector{}),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretUserKey: []byte(masterUsername),
+					},
+				},
+			},
+		},
+		"SuccessfulRestoreToPointInTimeWithSecret": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockRestoreToPointInTime: func(input *awsrds.RestoreDBInstanceToPointInTimeInput) awsrds.RestoreDBInstanceToPointInTimeRequest {
+						if diff := cmp.Diff("example", aws.StringValue(input.SourceDBInstanceIdentifier)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.RestoreDBInstanceToPointInTimeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.RestoreDBInstanceToPointInTimeOutput{}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				cr: instance(withRestoreFrom(v1beta1.RestoreFromParameters{SourceDBInstanceIdentifier: aws.String("example"), UseLatestRestorableTime: aws.Bool(true)}), withPasswordSecretRef(runtimev1alpha1.SecretKeySelector{})),
+			},
+			want: want{
+				cr: instance(
+					withRestoreFrom(v1beta1.RestoreFromParameters{SourceDBInstanceIdentifier: aws.String("example"), UseLatestRestorableTime: aws.Bool(true)}),
+					withPasswordSecretRef(runtimev1alpha1.SecretKeySelector{}),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"SuccessfulReadReplica": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockCreateReadReplica: func(input *awsrds.CreateDBInstanceReadReplicaInput) awsrds.CreateDBInstanceReadReplicaRequest {
+						if diff := cmp.Diff("source", aws.StringValue(input.SourceDBInstanceIdentifier)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.CreateDBInstanceReadReplicaRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBInstanceReadReplicaOutput{}},
+						}
+					},
+				},
+				cr: instance(withMasterUsername(&masterUsername), withSourceDBInstanceIdentifier("source")),
+			},
+			want: want{
+				cr: instance(
+					withMasterUsername(&masterUsername),
+					withSourceDBInstanceIdentifier("source"),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretUserKey: []byte(masterUsername),
+					},
+				},
+			},
+		},
+		"FailedReadReplica": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockCreateReadReplica: func(input *awsrds.CreateDBInstanceReadReplicaInput) awsrds.CreateDBInstanceReadReplicaRequest {
+						return awsrds.CreateDBInstanceReadReplicaRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withSourceDBInstanceIdentifier("source")),
+			},
+			want: want{
+				cr:  instance(withSourceDBInstanceIdentifier("source"), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreateReplicaFailed),
+			},
+		},
+		"FailedRestore": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockRestoreFromSnapshot: func(input *awsrds.RestoreDBInstanceFromDBSnapshotInput) awsrds.RestoreDBInstanceFromDBSnapshotRequest {
+						return awsrds.RestoreDBInstanceFromDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				cr: instance(withRestoreFrom(v1beta1.RestoreFromParameters{SnapshotIdentifier: aws.String("example")}), withPasswordSecretRef(runtimev1alpha1.SecretKeySelector{})),
+			},
+			want: want{
+				cr:  instance(withRestoreFrom(v1beta1.RestoreFromParameters{SnapshotIdentifier: aws.String("example")}), withPasswordSecretRef(runtimev1alpha1.SecretKeySelector{}), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errRestoreFailed),
+			},
+		},
+		"RestoreWithoutPasswordSecret": {
+			args: args{
+				cr: instance(withRestoreFrom(v1beta1.RestoreFromParameters{SnapshotIdentifier: aws.String("example")})),
+			},
+			want: want{
+				cr:  instance(withRestoreFrom(v1beta1.RestoreFromParameters{SnapshotIdentifier: aws.String("example")}), withConditions(runtimev1alpha1.Creating())),
+				err: errors.New(errRestoreNoPassword),
+			},
+		},
 		"FailedRequest": {
 			args: args{
 				rds: &fake.MockRDSClient{
@@ -688,6 +1258,84 @@ func TestUpdate(t *testing.T) {
 				cr: instance(withDBInstanceStatus(v1beta1.RDSInstanceStateModifying)),
 			},
 		},
+		"RebootPending": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockReboot: func(input *awsrds.RebootDBInstanceInput) awsrds.RebootDBInstanceRequest {
+						return awsrds.RebootDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.RebootDBInstanceOutput{}},
+						}
+					},
+				},
+				cr: instance(withRebootOnParameterChange(true),
+					withDBInstanceStatus(v1beta1.RDSInstanceStateAvailable),
+					withParameterApplyStatus(rds.ParameterApplyStatusPendingReboot)),
+			},
+			want: want{
+				cr: instance(withRebootOnParameterChange(true),
+					withDBInstanceStatus(v1beta1.RDSInstanceStateAvailable),
+					withParameterApplyStatus(rds.ParameterApplyStatusPendingReboot)),
+			},
+		},
+		"PromotionPending": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockPromoteReadReplica: func(input *awsrds.PromoteReadReplicaInput) awsrds.PromoteReadReplicaRequest {
+						return awsrds.PromoteReadReplicaRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.PromoteReadReplicaOutput{}},
+						}
+					},
+				},
+				cr: instance(withPromoteReadReplica(true),
+					withDBInstanceStatus(v1beta1.RDSInstanceStateAvailable),
+					withReadReplicaSource("source")),
+			},
+			want: want{
+				cr: instance(withPromoteReadReplica(true),
+					withDBInstanceStatus(v1beta1.RDSInstanceStateAvailable),
+					withReadReplicaSource("source")),
+			},
+		},
+		"FailedPromote": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockPromoteReadReplica: func(input *awsrds.PromoteReadReplicaInput) awsrds.PromoteReadReplicaRequest {
+						return awsrds.PromoteReadReplicaRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withPromoteReadReplica(true),
+					withDBInstanceStatus(v1beta1.RDSInstanceStateAvailable),
+					withReadReplicaSource("source")),
+			},
+			want: want{
+				cr: instance(withPromoteReadReplica(true),
+					withDBInstanceStatus(v1beta1.RDSInstanceStateAvailable),
+					withReadReplicaSource("source")),
+				err: errors.Wrap(errBoom, errPromoteFailed),
+			},
+		},
+		"FailedReboot": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockReboot: func(input *awsrds.RebootDBInstanceInput) awsrds.RebootDBInstanceRequest {
+						return awsrds.RebootDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withRebootOnParameterChange(true),
+					withDBInstanceStatus(v1beta1.RDSInstanceStateAvailable),
+					withParameterApplyStatus(rds.ParameterApplyStatusPendingReboot)),
+			},
+			want: want{
+				cr: instance(withRebootOnParameterChange(true),
+					withDBInstanceStatus(v1beta1.RDSInstanceStateAvailable),
+					withParameterApplyStatus(rds.ParameterApplyStatusPendingReboot)),
+				err: errors.Wrap(errBoom, errRebootFailed),
+			},
+		},
 		"FailedDescribe": {
 			args: args{
 				rds: &fake.MockRDSClient{
@@ -775,6 +1423,213 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestUpdatePasswordRotation(t *testing.T) {
+	var pw string
+	var stored *corev1.Secret
+	e := &external{
+		kube: &test.MockClient{
+			MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+			MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+				stored = obj.(*corev1.Secret)
+				return nil
+			},
+		},
+		client: &fake.MockRDSClient{
+			MockModify: func(input *awsrds.ModifyDBInstanceInput) awsrds.ModifyDBInstanceRequest {
+				pw = aws.StringValue(input.MasterUserPassword)
+				return awsrds.ModifyDBInstanceRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBInstanceOutput{}},
+				}
+			},
+			MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+				return awsrds.DescribeDBInstancesRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+						DBInstances: []awsrds.DBInstance{{}},
+					}},
+				}
+			},
+		},
+	}
+	cr := instance(withPasswordRotation(time.Hour), withPasswordRotateTime(rotateTime), withConnectionSecretRef(connectionSecretName))
+	cr.SetUID("uid")
+	u, err := e.Update(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Update(...): %s", err)
+	}
+	if pw == "" {
+		t.Errorf("Update(...): master password is not rotated")
+	}
+	if stored == nil {
+		t.Fatalf("Update(...): pending master password is not stored")
+	}
+	if diff := cmp.Diff(connectionSecretName+rds.PendingMasterPasswordSecretSuffix, stored.GetName()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(pw, string(stored.Data[runtimev1alpha1.ResourceCredentialsSecretPasswordKey])); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if c := metav1.GetControllerOf(stored); c == nil || c.UID != cr.GetUID() {
+		t.Errorf("Update(...): pending master password secret is not controlled by the instance")
+	}
+	if diff := cmp.Diff(pw, string(u.ConnectionDetails[runtimev1alpha1.ResourceCredentialsSecretPasswordKey])); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if !cr.Status.AtProvider.MasterPasswordRotateTime.Equal(&rotateTime) {
+		t.Errorf("Update(...): rotation time is updated before the password is published")
+	}
+	if cr.Status.AtProvider.MasterPasswordRotationStartTime == nil {
+		t.Errorf("Update(...): rotation start time is not recorded")
+	}
+}
+
+func TestUpdatePasswordRotationPending(t *testing.T) {
+	var pw string
+	e := &external{
+		kube: &test.MockClient{
+			MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+				obj.(*corev1.Secret).Data = map[string][]byte{runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte("pending")}
+				return nil
+			},
+		},
+		client: &fake.MockRDSClient{
+			MockModify: func(input *awsrds.ModifyDBInstanceInput) awsrds.ModifyDBInstanceRequest {
+				pw = aws.StringValue(input.MasterUserPassword)
+				return awsrds.ModifyDBInstanceRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBInstanceOutput{}},
+				}
+			},
+			MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+				return awsrds.DescribeDBInstancesRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+						DBInstances: []awsrds.DBInstance{{}},
+					}},
+				}
+			},
+		},
+	}
+	cr := instance(withPasswordRotation(time.Hour), withPasswordRotateTime(rotateTime), withConnectionSecretRef(connectionSecretName))
+	u, err := e.Update(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Update(...): %s", err)
+	}
+	if diff := cmp.Diff("pending", pw); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("pending", string(u.ConnectionDetails[runtimev1alpha1.ResourceCredentialsSecretPasswordKey])); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestObservePasswordRotationCompleted(t *testing.T) {
+	var deleted *corev1.Secret
+	e := &external{
+		kube: &test.MockClient{
+			MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+				obj.(*corev1.Secret).SetName(key.Name)
+				obj.(*corev1.Secret).Data = map[string][]byte{runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte("new")}
+				return nil
+			},
+			MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+				deleted = obj.(*corev1.Secret)
+				return nil
+			},
+		},
+		client: &fake.MockRDSClient{
+			MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+				return awsrds.DescribeDBInstancesRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+						DBInstances: []awsrds.DBInstance{
+							{
+								DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+							},
+						},
+					}},
+				}
+			},
+		},
+	}
+	cr := instance(
+		withPasswordRotation(time.Hour),
+		withPasswordRotateTime(rotateTime),
+		withPasswordRotationStartTime(rotateTime),
+		withConnectionSecretRef(connectionSecretName))
+	o, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+	if deleted == nil {
+		t.Fatalf("Observe(...): pending master password secret is not deleted")
+	}
+	if diff := cmp.Diff(connectionSecretName+rds.PendingMasterPasswordSecretSuffix, deleted.GetName()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if !cr.Status.AtProvider.MasterPasswordRotateTime.After(rotateTime.Time) {
+		t.Errorf("Observe(...): rotation time is not updated")
+	}
+	if cr.Status.AtProvider.MasterPasswordRotationStartTime != nil {
+		t.Errorf("Observe(...): rotation start time is not cleared")
+	}
+	if !o.ResourceUpToDate {
+		t.Errorf("Observe(...): resource is not up to date after the rotation")
+	}
+}
+
+func TestObserveAuthTokenRefresh(t *testing.T) {
+	var published *corev1.Secret
+	e := &external{
+		kube: &test.MockClient{
+			MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+			MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+				published = obj.(*corev1.Secret)
+				return nil
+			},
+		},
+		client: &fake.MockRDSClient{
+			MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+				return awsrds.DescribeDBInstancesRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+						DBInstances: []awsrds.DBInstance{
+							{
+								DBInstanceStatus:                 aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+								IAMDatabaseAuthenticationEnabled: aws.Bool(true),
+								Endpoint:                         &awsrds.Endpoint{Address: aws.String("example.com"), Port: aws.Int64(5432)},
+							},
+						},
+					}},
+				}
+			},
+			MockBuildAuthToken: func(_ context.Context, endpoint, dbUser string) (string, error) {
+				return endpoint + "/" + dbUser, nil
+			},
+		},
+	}
+	cr := instance(withIAMAuthToken(v1beta1.IAMAuthTokenConfiguration{
+		Username:                         "app",
+		WriteConnectionSecretToReference: runtimev1alpha1.SecretReference{Name: "token", Namespace: secretNamespace},
+	}), withPort(5432), withAuthTokenRefreshTime(rotateTime))
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+	if published == nil {
+		t.Fatalf("Observe(...): token is not published")
+	}
+	want := map[string][]byte{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte("example.com"),
+		runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte("5432"),
+		runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte("app"),
+		runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte("example.com:5432/app"),
+	}
+	if diff := cmp.Diff(want, published.Data); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(types.NamespacedName{Name: "token", Namespace: secretNamespace}, types.NamespacedName{Name: published.Name, Namespace: published.Namespace}); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if !cr.Status.AtProvider.IAMAuthTokenRefreshTime.After(rotateTime.Time) {
+		t.Errorf("Observe(...): token refresh time is not updated")
+	}
+}
+
 func TestDelete(t *testing.T) {
 	type want struct {
 		cr  *v1beta1.RDSInstance
@@ -0,0 +1,284 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dhcpoptions
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a DHCPOptions resource"
+
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errKubeUpdateFailed  = "cannot update DHCPOptions custom resource"
+
+	errClient        = "cannot create a new DHCPOptions client"
+	errDescribe      = "failed to describe DHCPOptions"
+	errDescribeVPC   = "failed to describe the VPC of the DHCPOptions"
+	errMultipleItems = "retrieved multiple DHCPOptions for the given dhcpOptionsId"
+	errCreate        = "failed to create the DHCPOptions resource"
+	errAssociate     = "failed to associate the DHCPOptions with the VPC"
+	errDisassociate  = "failed to associate the VPC with the default DHCPOptions"
+	errDelete        = "failed to delete the DHCPOptions resource"
+	errSpecUpdate    = "cannot update spec of the DHCPOptions custom resource"
+	errStatusUpdate  = "cannot update status of the DHCPOptions custom resource"
+	errCreateTags    = "failed to create tags for the DHCPOptions resource"
+)
+
+// SetupDHCPOptions adds a controller that reconciles DHCPOptions.
+func SetupDHCPOptions(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha4.DHCPOptionsGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha4.DHCPOptions{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha4.DHCPOptionsGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewDHCPOptionsClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ec2.DHCPOptionsClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha4.DHCPOptions)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		dhClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: dhClient, kube: c.client}, errors.Wrap(err, errClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.client.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	dhClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: dhClient, kube: c.client}, errors.Wrap(err, errClient)
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.DHCPOptionsClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2.DhcpOptions, error) {
+	response, err := e.client.DescribeDhcpOptionsRequest(&awsec2.DescribeDhcpOptionsInput{
+		DhcpOptionsIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.DhcpOptions) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &response.DhcpOptions[0], nil
+}
+
+// describeVPC returns the VPC with the given ID, or nil if there is no such
+// VPC.
+func (e *external) describeVPC(ctx context.Context, id string) (*awsec2.Vpc, error) {
+	if id == "" {
+		return nil, nil
+	}
+	response, err := e.client.DescribeVpcsRequest(&awsec2.DescribeVpcsInput{
+		VpcIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, resource.Ignore(ec2.IsVPCNotFoundErr, err)
+	}
+	if len(response.Vpcs) != 1 {
+		return nil, nil
+	}
+	return &response.Vpcs[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha4.DHCPOptions)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsDHCPOptionsNotFoundErr, err), errDescribe)
+	}
+
+	vpc, err := e.describeVPC(ctx, aws.StringValue(cr.Spec.ForProvider.VPCID))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeVPC)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeDHCPOptions(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	associated := ""
+	if vpc != nil && aws.StringValue(vpc.DhcpOptionsId) == meta.GetExternalName(cr) {
+		associated = aws.StringValue(vpc.VpcId)
+	}
+	cr.Status.AtProvider = ec2.GenerateDHCPOptionsObservation(*observed, associated)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsDHCPOptionsUpToDate(cr.Spec.ForProvider, *observed, vpc),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha4.DHCPOptions)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	if err := e.kube.Status().Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errStatusUpdate)
+	}
+
+	result, err := e.client.CreateDhcpOptionsRequest(ec2.GenerateCreateDHCPOptionsInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	if result.DhcpOptions == nil {
+		return managed.ExternalCreation{}, errors.New(errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(result.DhcpOptions.DhcpOptionsId))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha4.DHCPOptions)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	if !v1beta1.CompareTags(cr.Spec.ForProvider.Tags, observed.Tags) {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
+	vpc, err := e.describeVPC(ctx, aws.StringValue(cr.Spec.ForProvider.VPCID))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeVPC)
+	}
+	if ec2.IsDHCPOptionsAssociationUpToDate(cr.Spec.ForProvider, meta.GetExternalName(cr), vpc) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	_, err = e.client.AssociateDhcpOptionsRequest(&awsec2.AssociateDhcpOptionsInput{
+		DhcpOptionsId: aws.String(meta.GetExternalName(cr)),
+		VpcId:         cr.Spec.ForProvider.VPCID,
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errAssociate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha4.DHCPOptions)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	// A DHCP options set cannot be deleted while a VPC uses it, so the VPC
+	// is moved back to the default options first.
+	vpc, err := e.describeVPC(ctx, aws.StringValue(cr.Spec.ForProvider.VPCID))
+	if err != nil {
+		return errors.Wrap(err, errDescribeVPC)
+	}
+	if vpc != nil && aws.StringValue(vpc.DhcpOptionsId) == meta.GetExternalName(cr) {
+		if _, err := e.client.AssociateDhcpOptionsRequest(&awsec2.AssociateDhcpOptionsInput{
+			DhcpOptionsId: aws.String(ec2.DefaultDHCPOptionsID),
+			VpcId:         vpc.VpcId,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDisassociate)
+		}
+	}
+
+	_, err = e.client.DeleteDhcpOptionsRequest(&awsec2.DeleteDhcpOptionsInput{
+		DhcpOptionsId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(ec2.IsDHCPOptionsNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,447 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dhcpoptions
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+const (
+	providerName = "aws-creds"
+)
+
+var (
+	dhcpID   = "some dhcp options"
+	vpcID    = "some vpc"
+	dhcpKey  = "domain-name-servers"
+	dhcpAddr = "10.0.0.2"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	dhcp ec2.DHCPOptionsClient
+	kube client.Client
+	cr   *v1alpha4.DHCPOptions
+}
+
+type dhcpModifier func(*v1alpha4.DHCPOptions)
+
+func withExternalName(name string) dhcpModifier {
+	return func(r *v1alpha4.DHCPOptions) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p v1alpha4.DHCPOptionsParameters) dhcpModifier {
+	return func(r *v1alpha4.DHCPOptions) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha4.DHCPOptionsObservation) dhcpModifier {
+	return func(r *v1alpha4.DHCPOptions) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) dhcpModifier {
+	return func(r *v1alpha4.DHCPOptions) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func dhcpOptions(m ...dhcpModifier) *v1alpha4.DHCPOptions {
+	cr := &v1alpha4.DHCPOptions{
+		Spec: v1alpha4.DHCPOptionsSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func describe(opts ...awsec2.DhcpOptions) func(*awsec2.DescribeDhcpOptionsInput) awsec2.DescribeDhcpOptionsRequest {
+	return func(*awsec2.DescribeDhcpOptionsInput) awsec2.DescribeDhcpOptionsRequest {
+		return awsec2.DescribeDhcpOptionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeDhcpOptionsOutput{
+				DhcpOptions: opts,
+			}},
+		}
+	}
+}
+
+func describeVpcs(vpcs ...awsec2.Vpc) func(*awsec2.DescribeVpcsInput) awsec2.DescribeVpcsRequest {
+	return func(*awsec2.DescribeVpcsInput) awsec2.DescribeVpcsRequest {
+		return awsec2.DescribeVpcsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVpcsOutput{
+				Vpcs: vpcs,
+			}},
+		}
+	}
+}
+
+func associate(t *testing.T, wantID string) func(*awsec2.AssociateDhcpOptionsInput) awsec2.AssociateDhcpOptionsRequest {
+	return func(input *awsec2.AssociateDhcpOptionsInput) awsec2.AssociateDhcpOptionsRequest {
+		if diff := cmp.Diff(wantID, aws.StringValue(input.DhcpOptionsId)); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		return awsec2.AssociateDhcpOptionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.AssociateDhcpOptionsOutput{}},
+		}
+	}
+}
+
+func observed() awsec2.DhcpOptions {
+	return awsec2.DhcpOptions{
+		DhcpOptionsId: aws.String(dhcpID),
+		DhcpConfigurations: []awsec2.DhcpConfiguration{{
+			Key:    aws.String(dhcpKey),
+			Values: []awsec2.AttributeValue{{Value: aws.String(dhcpAddr)}},
+		}},
+	}
+}
+
+func params() v1alpha4.DHCPOptionsParameters {
+	return v1alpha4.DHCPOptionsParameters{
+		DHCPConfigurations: []v1alpha4.DHCPConfiguration{{Key: dhcpKey, Values: []string{dhcpAddr}}},
+		VPCID:              aws.String(vpcID),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.DHCPOptions
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Associated": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDescribe:     describe(observed()),
+					MockDescribeVpcs: describeVpcs(awsec2.Vpc{VpcId: aws.String(vpcID), DhcpOptionsId: aws.String(dhcpID)}),
+				},
+				cr: dhcpOptions(withSpec(params()), withExternalName(dhcpID)),
+			},
+			want: want{
+				cr: dhcpOptions(withSpec(params()), withExternalName(dhcpID),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha4.DHCPOptionsObservation{
+						DHCPOptionsID:   dhcpID,
+						AssociatedVPCID: vpcID,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotAssociated": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDescribe:     describe(observed()),
+					MockDescribeVpcs: describeVpcs(awsec2.Vpc{VpcId: aws.String(vpcID), DhcpOptionsId: aws.String("dopt-other")}),
+				},
+				cr: dhcpOptions(withSpec(params()), withExternalName(dhcpID)),
+			},
+			want: want{
+				cr: dhcpOptions(withSpec(params()), withExternalName(dhcpID),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha4.DHCPOptionsObservation{
+						DHCPOptionsID: dhcpID,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDescribe: func(input *awsec2.DescribeDhcpOptionsInput) awsec2.DescribeDhcpOptionsRequest {
+						return awsec2.DescribeDhcpOptionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dhcpOptions(withExternalName(dhcpID)),
+			},
+			want: want{
+				cr:  dhcpOptions(withExternalName(dhcpID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: dhcpOptions(),
+			},
+			want: want{
+				cr: dhcpOptions(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.dhcp}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.DHCPOptions
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().MockUpdate,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockCreate: func(input *awsec2.CreateDhcpOptionsInput) awsec2.CreateDhcpOptionsRequest {
+						want := []awsec2.NewDhcpConfiguration{{Key: aws.String(dhcpKey), Values: []string{dhcpAddr}}}
+						if diff := cmp.Diff(want, input.DhcpConfigurations); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateDhcpOptionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateDhcpOptionsOutput{
+								DhcpOptions: &awsec2.DhcpOptions{DhcpOptionsId: aws.String(dhcpID)},
+							}},
+						}
+					},
+				},
+				cr: dhcpOptions(withSpec(params())),
+			},
+			want: want{
+				cr: dhcpOptions(withSpec(params()), withExternalName(dhcpID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().MockUpdate,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockCreate: func(input *awsec2.CreateDhcpOptionsInput) awsec2.CreateDhcpOptionsRequest {
+						return awsec2.CreateDhcpOptionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dhcpOptions(withSpec(params())),
+			},
+			want: want{
+				cr:  dhcpOptions(withSpec(params()), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.dhcp}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.DHCPOptions
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Associate": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDescribe:     describe(observed()),
+					MockDescribeVpcs: describeVpcs(awsec2.Vpc{VpcId: aws.String(vpcID), DhcpOptionsId: aws.String("dopt-other")}),
+					MockAssociate:    associate(t, dhcpID),
+				},
+				cr: dhcpOptions(withSpec(params()), withExternalName(dhcpID)),
+			},
+			want: want{
+				cr: dhcpOptions(withSpec(params()), withExternalName(dhcpID)),
+			},
+		},
+		"AssociateFail": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDescribe:     describe(observed()),
+					MockDescribeVpcs: describeVpcs(awsec2.Vpc{VpcId: aws.String(vpcID)}),
+					MockAssociate: func(input *awsec2.AssociateDhcpOptionsInput) awsec2.AssociateDhcpOptionsRequest {
+						return awsec2.AssociateDhcpOptionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dhcpOptions(withSpec(params()), withExternalName(dhcpID)),
+			},
+			want: want{
+				cr:  dhcpOptions(withSpec(params()), withExternalName(dhcpID)),
+				err: errors.Wrap(errBoom, errAssociate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.dhcp}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha4.DHCPOptions
+		err error
+	}
+
+	deleteOK := func(input *awsec2.DeleteDhcpOptionsInput) awsec2.DeleteDhcpOptionsRequest {
+		return awsec2.DeleteDhcpOptionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteDhcpOptionsOutput{}},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"DisassociateAndDelete": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDescribeVpcs: describeVpcs(awsec2.Vpc{VpcId: aws.String(vpcID), DhcpOptionsId: aws.String(dhcpID)}),
+					MockAssociate:    associate(t, ec2.DefaultDHCPOptionsID),
+					MockDelete:       deleteOK,
+				},
+				cr: dhcpOptions(withSpec(params()), withExternalName(dhcpID)),
+			},
+			want: want{
+				cr: dhcpOptions(withSpec(params()), withExternalName(dhcpID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NoVPC": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDelete: deleteOK,
+				},
+				cr: dhcpOptions(withExternalName(dhcpID)),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(dhcpID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				dhcp: &fake.MockDHCPOptionsClient{
+					MockDelete: func(input *awsec2.DeleteDhcpOptionsInput) awsec2.DeleteDhcpOptionsRequest {
+						return awsec2.DeleteDhcpOptionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dhcpOptions(withExternalName(dhcpID)),
+			},
+			want: want{
+				cr:  dhcpOptions(withExternalName(dhcpID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.dhcp}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flowlog
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a FlowLog resource"
+
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errKubeUpdateFailed  = "cannot update FlowLog custom resource"
+
+	errClient        = "cannot create a new FlowLog client"
+	errDescribe      = "failed to describe FlowLog"
+	errMultipleItems = "retrieved multiple FlowLogs for the given flowLogId"
+	errNoResource    = "exactly one of vpcId, subnetId and networkInterfaceId must be set"
+	errCreate        = "failed to create the FlowLog resource"
+	errDelete        = "failed to delete the FlowLog resource"
+	errSpecUpdate    = "cannot update spec of the FlowLog custom resource"
+	errStatusUpdate  = "cannot update status of the FlowLog custom resource"
+	errCreateTags    = "failed to create tags for the FlowLog resource"
+)
+
+// SetupFlowLog adds a controller that reconciles FlowLogs.
+func SetupFlowLog(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha4.FlowLogGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha4.FlowLog{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha4.FlowLogGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewFlowLogClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ec2.FlowLogClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha4.FlowLog)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		flClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: flClient, kube: c.client}, errors.Wrap(err, errClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.client.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	flClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: flClient, kube: c.client}, errors.Wrap(err, errClient)
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.FlowLogClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2.FlowLog, error) {
+	response, err := e.client.DescribeFlowLogsRequest(&awsec2.DescribeFlowLogsInput{
+		FlowLogIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(response.FlowLogs) {
+	case 0:
+		// DescribeFlowLogs does not return an error for unknown IDs.
+		return nil, nil
+	case 1:
+		return &response.FlowLogs[0], nil
+	}
+	return nil, errors.New(errMultipleItems)
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha4.FlowLog)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsFlowLogNotFoundErr, err), errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeFlowLog(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateFlowLogObservation(*observed)
+
+	// FlowLogStatus is always ACTIVE for a flow log that exists, so the
+	// delivery status tells whether logs are actually being published.
+	if aws.StringValue(observed.DeliverLogsStatus) == "FAILED" {
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	} else {
+		cr.SetConditions(runtimev1alpha1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsFlowLogUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha4.FlowLog)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	if _, id := ec2.GetFlowLogResource(cr.Spec.ForProvider); id == "" {
+		return managed.ExternalCreation{}, errors.New(errNoResource)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	if err := e.kube.Status().Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errStatusUpdate)
+	}
+
+	result, err := e.client.CreateFlowLogsRequest(ec2.GenerateCreateFlowLogsInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	// CreateFlowLogs reports per-resource failures in the response rather
+	// than as an error.
+	for _, u := range result.Unsuccessful {
+		if u.Error != nil {
+			return managed.ExternalCreation{}, errors.Wrap(errors.New(aws.StringValue(u.Error.Message)), errCreate)
+		}
+	}
+	if len(result.FlowLogIds) != 1 {
+		return managed.ExternalCreation{}, errors.New(errCreate)
+	}
+
+	meta.SetExternalName(cr, result.FlowLogIds[0])
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha4.FlowLog)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+		Resources: []string{meta.GetExternalName(cr)},
+		Tags:      v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha4.FlowLog)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	response, err := e.client.DeleteFlowLogsRequest(&awsec2.DeleteFlowLogsInput{
+		FlowLogIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ec2.IsFlowLogNotFoundErr, err), errDelete)
+	}
+
+	// DeleteFlowLogs reports per-flow-log failures in the response rather
+	// than as an error.
+	for _, u := range response.Unsuccessful {
+		if u.Error == nil || aws.StringValue(u.Error.Code) == ec2.FlowLogIDNotFound {
+			continue
+		}
+		return errors.Wrap(errors.New(aws.StringValue(u.Error.Message)), errDelete)
+	}
+	return nil
+}
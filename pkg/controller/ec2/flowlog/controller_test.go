@@ -0,0 +1,419 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flowlog
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+const (
+	providerName = "aws-creds"
+)
+
+var (
+	flID     = "some flow log"
+	vpcID    = "some vpc"
+	logGroup = "some log group"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	fl   ec2.FlowLogClient
+	kube client.Client
+	cr   *v1alpha4.FlowLog
+}
+
+type flModifier func(*v1alpha4.FlowLog)
+
+func withExternalName(name string) flModifier {
+	return func(r *v1alpha4.FlowLog) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p v1alpha4.FlowLogParameters) flModifier {
+	return func(r *v1alpha4.FlowLog) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha4.FlowLogObservation) flModifier {
+	return func(r *v1alpha4.FlowLog) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) flModifier {
+	return func(r *v1alpha4.FlowLog) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func flowLog(m ...flModifier) *v1alpha4.FlowLog {
+	cr := &v1alpha4.FlowLog{
+		Spec: v1alpha4.FlowLogSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func describe(fls ...awsec2.FlowLog) func(*awsec2.DescribeFlowLogsInput) awsec2.DescribeFlowLogsRequest {
+	return func(*awsec2.DescribeFlowLogsInput) awsec2.DescribeFlowLogsRequest {
+		return awsec2.DescribeFlowLogsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeFlowLogsOutput{
+				FlowLogs: fls,
+			}},
+		}
+	}
+}
+
+func params() v1alpha4.FlowLogParameters {
+	return v1alpha4.FlowLogParameters{
+		TrafficType:        "ALL",
+		LogDestinationType: aws.String("cloud-watch-logs"),
+		LogGroupName:       aws.String(logGroup),
+		VPCID:              aws.String(vpcID),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.FlowLog
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				fl: &fake.MockFlowLogClient{
+					MockDescribe: describe(awsec2.FlowLog{
+						FlowLogId:          aws.String(flID),
+						FlowLogStatus:      aws.String("ACTIVE"),
+						DeliverLogsStatus:  aws.String("SUCCESS"),
+						LogDestinationType: awsec2.LogDestinationTypeCloudWatchLogs,
+						LogGroupName:       aws.String(logGroup),
+						ResourceId:         aws.String(vpcID),
+					}),
+				},
+				cr: flowLog(withSpec(params()), withExternalName(flID)),
+			},
+			want: want{
+				cr: flowLog(withSpec(params()), withExternalName(flID),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha4.FlowLogObservation{
+						FlowLogID:         flID,
+						FlowLogStatus:     "ACTIVE",
+						DeliverLogsStatus: "SUCCESS",
+						ResourceID:        vpcID,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DeliveryFailed": {
+			args: args{
+				fl: &fake.MockFlowLogClient{
+					MockDescribe: describe(awsec2.FlowLog{
+						FlowLogId:               aws.String(flID),
+						DeliverLogsStatus:       aws.String("FAILED"),
+						DeliverLogsErrorMessage: aws.String("Access error"),
+						LogDestinationType:      awsec2.LogDestinationTypeCloudWatchLogs,
+						LogGroupName:            aws.String(logGroup),
+					}),
+				},
+				cr: flowLog(withSpec(params()), withExternalName(flID)),
+			},
+			want: want{
+				cr: flowLog(withSpec(params()), withExternalName(flID),
+					withConditions(runtimev1alpha1.Unavailable()),
+					withStatus(v1alpha4.FlowLogObservation{
+						FlowLogID:               flID,
+						DeliverLogsStatus:       "FAILED",
+						DeliverLogsErrorMessage: "Access error",
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				fl: &fake.MockFlowLogClient{
+					MockDescribe: describe(),
+				},
+				cr: flowLog(withSpec(params()), withExternalName(flID)),
+			},
+			want: want{
+				cr: flowLog(withSpec(params()), withExternalName(flID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				fl: &fake.MockFlowLogClient{
+					MockDescribe: func(input *awsec2.DescribeFlowLogsInput) awsec2.DescribeFlowLogsRequest {
+						return awsec2.DescribeFlowLogsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: flowLog(withExternalName(flID)),
+			},
+			want: want{
+				cr:  flowLog(withExternalName(flID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: flowLog(),
+			},
+			want: want{
+				cr: flowLog(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.fl}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.FlowLog
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().MockUpdate,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				fl: &fake.MockFlowLogClient{
+					MockCreate: func(input *awsec2.CreateFlowLogsInput) awsec2.CreateFlowLogsRequest {
+						if diff := cmp.Diff([]string{vpcID}, input.ResourceIds); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateFlowLogsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateFlowLogsOutput{
+								FlowLogIds: []string{flID},
+							}},
+						}
+					},
+				},
+				cr: flowLog(withSpec(params())),
+			},
+			want: want{
+				cr: flowLog(withSpec(params()), withExternalName(flID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"Unsuccessful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().MockUpdate,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				fl: &fake.MockFlowLogClient{
+					MockCreate: func(input *awsec2.CreateFlowLogsInput) awsec2.CreateFlowLogsRequest {
+						return awsec2.CreateFlowLogsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateFlowLogsOutput{
+								Unsuccessful: []awsec2.UnsuccessfulItem{{
+									Error:      &awsec2.UnsuccessfulItemError{Code: aws.String("Boom"), Message: aws.String(errBoom.Error())},
+									ResourceId: aws.String(vpcID),
+								}},
+							}},
+						}
+					},
+				},
+				cr: flowLog(withSpec(params())),
+			},
+			want: want{
+				cr:  flowLog(withSpec(params()), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"NoResource": {
+			args: args{
+				cr: flowLog(withSpec(v1alpha4.FlowLogParameters{TrafficType: "ALL"})),
+			},
+			want: want{
+				cr:  flowLog(withSpec(v1alpha4.FlowLogParameters{TrafficType: "ALL"})),
+				err: errors.New(errNoResource),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().MockUpdate,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				fl: &fake.MockFlowLogClient{
+					MockCreate: func(input *awsec2.CreateFlowLogsInput) awsec2.CreateFlowLogsRequest {
+						return awsec2.CreateFlowLogsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: flowLog(withSpec(params())),
+			},
+			want: want{
+				cr:  flowLog(withSpec(params()), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.fl}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha4.FlowLog
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				fl: &fake.MockFlowLogClient{
+					MockDelete: func(input *awsec2.DeleteFlowLogsInput) awsec2.DeleteFlowLogsRequest {
+						return awsec2.DeleteFlowLogsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteFlowLogsOutput{}},
+						}
+					},
+				},
+				cr: flowLog(withExternalName(flID)),
+			},
+			want: want{
+				cr: flowLog(withExternalName(flID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				fl: &fake.MockFlowLogClient{
+					MockDelete: func(input *awsec2.DeleteFlowLogsInput) awsec2.DeleteFlowLogsRequest {
+						return awsec2.DeleteFlowLogsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteFlowLogsOutput{
+								Unsuccessful: []awsec2.UnsuccessfulItem{{
+									Error:      &awsec2.UnsuccessfulItemError{Code: aws.String(ec2.FlowLogIDNotFound)},
+									ResourceId: aws.String(flID),
+								}},
+							}},
+						}
+					},
+				},
+				cr: flowLog(withExternalName(flID)),
+			},
+			want: want{
+				cr: flowLog(withExternalName(flID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				fl: &fake.MockFlowLogClient{
+					MockDelete: func(input *awsec2.DeleteFlowLogsInput) awsec2.DeleteFlowLogsRequest {
+						return awsec2.DeleteFlowLogsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: flowLog(withExternalName(flID)),
+			},
+			want: want{
+				cr:  flowLog(withExternalName(flID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.fl}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keypair
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a KeyPair resource"
+
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errKubeUpdateFailed  = "cannot update KeyPair custom resource"
+
+	errClient             = "cannot create a new KeyPair client"
+	errDescribe           = "failed to describe KeyPair"
+	errMultipleItems      = "retrieved multiple KeyPairs for the given key name"
+	errGetPublicKeySecret = "cannot get the secret of the public key"
+	errCreate             = "failed to create the KeyPair resource"
+	errImport             = "failed to import the KeyPair resource"
+	errDelete             = "failed to delete the KeyPair resource"
+	errStatusUpdate       = "cannot update status of the KeyPair custom resource"
+	errCreateTags         = "failed to create tags for the KeyPair resource"
+)
+
+// SetupKeyPair adds a controller that reconciles KeyPairs.
+func SetupKeyPair(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha4.KeyPairGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha4.KeyPair{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha4.KeyPairGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewKeyPairClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ec2.KeyPairClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha4.KeyPair)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		kpClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: kpClient, kube: c.client}, errors.Wrap(err, errClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.client.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	kpClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: kpClient, kube: c.client}, errors.Wrap(err, errClient)
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.KeyPairClient
+}
+
+func (e *external) describe(ctx context.Context, name string) (*awsec2.KeyPairInfo, error) {
+	response, err := e.client.DescribeKeyPairsRequest(&awsec2.DescribeKeyPairsInput{
+		KeyNames: []string{name},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.KeyPairs) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &response.KeyPairs[0], nil
+}
+
+// getPublicKey returns the public key to import, or nil if the key pair
+// should be generated by AWS.
+func (e *external) getPublicKey(ctx context.Context, p v1alpha4.KeyPairParameters) ([]byte, error) {
+	if p.PublicKeyMaterial != nil {
+		return []byte(aws.StringValue(p.PublicKeyMaterial)), nil
+	}
+	if p.PublicKeySecretRef == nil {
+		return nil, nil
+	}
+	s := &corev1.Secret{}
+	nn := types.NamespacedName{
+		Name:      p.PublicKeySecretRef.Name,
+		Namespace: p.PublicKeySecretRef.Namespace,
+	}
+	if err := e.kube.Get(ctx, nn, s); err != nil {
+		return nil, errors.Wrap(err, errGetPublicKeySecret)
+	}
+	return s.Data[p.PublicKeySecretRef.Key], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha4.KeyPair)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsKeyPairNotFoundErr, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeKeyPair(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateKeyPairObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  ec2.IsKeyPairUpToDate(cr.Spec.ForProvider, *observed),
+		ConnectionDetails: ec2.GetKeyPairConnectionDetails(meta.GetExternalName(cr), aws.StringValue(observed.KeyFingerprint)),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha4.KeyPair)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	if err := e.kube.Status().Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errStatusUpdate)
+	}
+
+	publicKey, err := e.getPublicKey(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	if publicKey != nil {
+		result, err := e.client.ImportKeyPairRequest(ec2.GenerateImportKeyPairInput(meta.GetExternalName(cr), publicKey, cr.Spec.ForProvider)).Send(ctx)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errImport)
+		}
+		return managed.ExternalCreation{
+			ConnectionDetails: ec2.GetKeyPairConnectionDetails(meta.GetExternalName(cr), aws.StringValue(result.KeyFingerprint)),
+		}, nil
+	}
+
+	result, err := e.client.CreateKeyPairRequest(ec2.GenerateCreateKeyPairInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	// AWS returns the private key only once, so it has to be published now.
+	conn := ec2.GetKeyPairConnectionDetails(meta.GetExternalName(cr), aws.StringValue(result.KeyFingerprint))
+	conn[ec2.KeyPairPrivateKeyKey] = []byte(aws.StringValue(result.KeyMaterial))
+	return managed.ExternalCreation{ConnectionDetails: conn}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha4.KeyPair)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+		Resources: []string{cr.Status.AtProvider.KeyPairID},
+		Tags:      v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha4.KeyPair)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeleteKeyPairRequest(&awsec2.DeleteKeyPairInput{
+		KeyName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(ec2.IsKeyPairNotFoundErr, err), errDelete)
+}
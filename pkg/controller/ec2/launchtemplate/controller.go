@@ -0,0 +1,288 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package launchtemplate
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a LaunchTemplate resource"
+
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errKubeUpdateFailed  = "cannot update LaunchTemplate custom resource"
+
+	errClient           = "cannot create a new LaunchTemplate client"
+	errDescribe         = "failed to describe LaunchTemplate"
+	errDescribeVersions = "failed to describe the versions of the LaunchTemplate"
+	errMultipleItems    = "retrieved multiple LaunchTemplates for the given launchTemplateId"
+	errNoVersion        = "retrieved no version for the given launchTemplateId"
+	errCreate           = "failed to create the LaunchTemplate resource"
+	errCreateVersion    = "failed to create a new version of the LaunchTemplate"
+	errSetDefault       = "failed to set the default version of the LaunchTemplate"
+	errDelete           = "failed to delete the LaunchTemplate resource"
+	errSpecUpdate       = "cannot update spec of the LaunchTemplate custom resource"
+	errStatusUpdate     = "cannot update status of the LaunchTemplate custom resource"
+	errCreateTags       = "failed to create tags for the LaunchTemplate resource"
+)
+
+// SetupLaunchTemplate adds a controller that reconciles LaunchTemplates.
+func SetupLaunchTemplate(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha4.LaunchTemplateGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha4.LaunchTemplate{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha4.LaunchTemplateGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewLaunchTemplateClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ec2.LaunchTemplateClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha4.LaunchTemplate)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		ltClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: ltClient, kube: c.client}, errors.Wrap(err, errClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.client.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	ltClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: ltClient, kube: c.client}, errors.Wrap(err, errClient)
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.LaunchTemplateClient
+}
+
+// describe returns the launch template with the given ID together with its
+// latest version.
+func (e *external) describe(ctx context.Context, id string) (*awsec2.LaunchTemplate, *awsec2.LaunchTemplateVersion, error) {
+	response, err := e.client.DescribeLaunchTemplatesRequest(&awsec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.LaunchTemplates) != 1 {
+		return nil, nil, errors.New(errMultipleItems)
+	}
+
+	versions, err := e.client.DescribeLaunchTemplateVersionsRequest(&awsec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(id),
+		Versions:         []string{ec2.LaunchTemplateVersionLatest},
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errDescribeVersions)
+	}
+
+	if len(versions.LaunchTemplateVersions) != 1 {
+		return nil, nil, errors.New(errNoVersion)
+	}
+
+	return &response.LaunchTemplates[0], &versions.LaunchTemplateVersions[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha4.LaunchTemplate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, latest, err := e.describe(ctx, meta.GetExternalName(cr))
+	if ec2.IsLaunchTemplateNotFoundErr(errors.Cause(err)) {
+		return managed.ExternalObservation{}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeLaunchTemplate(&cr.Spec.ForProvider, latest)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateLaunchTemplateObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsLaunchTemplateUpToDate(cr.Spec.ForProvider, *observed, *latest),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha4.LaunchTemplate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	if err := e.kube.Status().Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errStatusUpdate)
+	}
+
+	input := &awsec2.CreateLaunchTemplateInput{
+		LaunchTemplateName: aws.String(cr.Spec.ForProvider.LaunchTemplateName),
+		LaunchTemplateData: ec2.GenerateLaunchTemplateData(cr.Spec.ForProvider.LaunchTemplateData),
+		VersionDescription: cr.Spec.ForProvider.VersionDescription,
+	}
+	if len(cr.Spec.ForProvider.Tags) != 0 {
+		input.TagSpecifications = []awsec2.TagSpecification{{
+			ResourceType: awsec2.ResourceTypeLaunchTemplate,
+			Tags:         v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+		}}
+	}
+
+	result, err := e.client.CreateLaunchTemplateRequest(input).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	if result.LaunchTemplate == nil {
+		return managed.ExternalCreation{}, errors.New(errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(result.LaunchTemplate.LaunchTemplateId))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha4.LaunchTemplate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, latest, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if !v1beta1.CompareTags(cr.Spec.ForProvider.Tags, observed.Tags) {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
+	latestVersion := aws.Int64Value(observed.LatestVersionNumber)
+	if !ec2.IsLaunchTemplateDataUpToDate(cr.Spec.ForProvider.LaunchTemplateData, *latest) {
+		rsp, err := e.client.CreateLaunchTemplateVersionRequest(&awsec2.CreateLaunchTemplateVersionInput{
+			LaunchTemplateId:   aws.String(meta.GetExternalName(cr)),
+			LaunchTemplateData: ec2.GenerateLaunchTemplateData(cr.Spec.ForProvider.LaunchTemplateData),
+			VersionDescription: cr.Spec.ForProvider.VersionDescription,
+		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errCreateVersion)
+		}
+		if rsp.LaunchTemplateVersion != nil {
+			latestVersion = aws.Int64Value(rsp.LaunchTemplateVersion.VersionNumber)
+		}
+	}
+
+	if aws.BoolValue(cr.Spec.ForProvider.SetDefaultVersion) && aws.Int64Value(observed.DefaultVersionNumber) != latestVersion {
+		if _, err := e.client.ModifyLaunchTemplateRequest(&awsec2.ModifyLaunchTemplateInput{
+			LaunchTemplateId: aws.String(meta.GetExternalName(cr)),
+			DefaultVersion:   aws.String(strconv.FormatInt(latestVersion, 10)),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetDefault)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha4.LaunchTemplate)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeleteLaunchTemplateRequest(&awsec2.DeleteLaunchTemplateInput{
+		LaunchTemplateId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(ec2.IsLaunchTemplateNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,466 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package launchtemplate
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+const (
+	providerName = "aws-creds"
+)
+
+var (
+	ltID         = "some lt"
+	ltName       = "some name"
+	imageID      = "some image"
+	otherImageID = "some other image"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	lt   ec2.LaunchTemplateClient
+	kube client.Client
+	cr   *v1alpha4.LaunchTemplate
+}
+
+type ltModifier func(*v1alpha4.LaunchTemplate)
+
+func withExternalName(name string) ltModifier {
+	return func(r *v1alpha4.LaunchTemplate) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p v1alpha4.LaunchTemplateParameters) ltModifier {
+	return func(r *v1alpha4.LaunchTemplate) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha4.LaunchTemplateObservation) ltModifier {
+	return func(r *v1alpha4.LaunchTemplate) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) ltModifier {
+	return func(r *v1alpha4.LaunchTemplate) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func lt(m ...ltModifier) *v1alpha4.LaunchTemplate {
+	cr := &v1alpha4.LaunchTemplate{
+		Spec: v1alpha4.LaunchTemplateSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func describe(t awsec2.LaunchTemplate) func(*awsec2.DescribeLaunchTemplatesInput) awsec2.DescribeLaunchTemplatesRequest {
+	return func(*awsec2.DescribeLaunchTemplatesInput) awsec2.DescribeLaunchTemplatesRequest {
+		return awsec2.DescribeLaunchTemplatesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeLaunchTemplatesOutput{
+				LaunchTemplates: []awsec2.LaunchTemplate{t},
+			}},
+		}
+	}
+}
+
+func describeVersions(d awsec2.ResponseLaunchTemplateData) func(*awsec2.DescribeLaunchTemplateVersionsInput) awsec2.DescribeLaunchTemplateVersionsRequest {
+	return func(*awsec2.DescribeLaunchTemplateVersionsInput) awsec2.DescribeLaunchTemplateVersionsRequest {
+		return awsec2.DescribeLaunchTemplateVersionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeLaunchTemplateVersionsOutput{
+				LaunchTemplateVersions: []awsec2.LaunchTemplateVersion{{LaunchTemplateData: &d}},
+			}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.LaunchTemplate
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDescribe: describe(awsec2.LaunchTemplate{
+						LaunchTemplateId:     aws.String(ltID),
+						DefaultVersionNumber: aws.Int64(1),
+						LatestVersionNumber:  aws.Int64(1),
+					}),
+					MockDescribeVersions: describeVersions(awsec2.ResponseLaunchTemplateData{ImageId: aws.String(imageID)}),
+				},
+				cr: lt(withSpec(v1alpha4.LaunchTemplateParameters{
+					LaunchTemplateData: v1alpha4.LaunchTemplateData{ImageID: aws.String(imageID)},
+				}), withExternalName(ltID)),
+			},
+			want: want{
+				cr: lt(withSpec(v1alpha4.LaunchTemplateParameters{
+					LaunchTemplateData: v1alpha4.LaunchTemplateData{ImageID: aws.String(imageID)},
+				}), withExternalName(ltID), withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha4.LaunchTemplateObservation{
+						LaunchTemplateID:     ltID,
+						DefaultVersionNumber: 1,
+						LatestVersionNumber:  1,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DataChanged": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDescribe:         describe(awsec2.LaunchTemplate{LaunchTemplateId: aws.String(ltID)}),
+					MockDescribeVersions: describeVersions(awsec2.ResponseLaunchTemplateData{ImageId: aws.String(otherImageID)}),
+				},
+				cr: lt(withSpec(v1alpha4.LaunchTemplateParameters{
+					LaunchTemplateData: v1alpha4.LaunchTemplateData{ImageID: aws.String(imageID)},
+				}), withExternalName(ltID)),
+			},
+			want: want{
+				cr: lt(withSpec(v1alpha4.LaunchTemplateParameters{
+					LaunchTemplateData: v1alpha4.LaunchTemplateData{ImageID: aws.String(imageID)},
+				}), withExternalName(ltID), withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha4.LaunchTemplateObservation{LaunchTemplateID: ltID})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"MultipleTemplates": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDescribe: func(input *awsec2.DescribeLaunchTemplatesInput) awsec2.DescribeLaunchTemplatesRequest {
+						return awsec2.DescribeLaunchTemplatesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeLaunchTemplatesOutput{
+								LaunchTemplates: []awsec2.LaunchTemplate{{}, {}},
+							}},
+						}
+					},
+				},
+				cr: lt(withExternalName(ltID)),
+			},
+			want: want{
+				cr:  lt(withExternalName(ltID)),
+				err: errors.New(errMultipleItems),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDescribe: func(input *awsec2.DescribeLaunchTemplatesInput) awsec2.DescribeLaunchTemplatesRequest {
+						return awsec2.DescribeLaunchTemplatesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lt(withExternalName(ltID)),
+			},
+			want: want{
+				cr:  lt(withExternalName(ltID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: lt(),
+			},
+			want: want{
+				cr: lt(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lt}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.LaunchTemplate
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().MockUpdate,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				lt: &fake.MockLaunchTemplateClient{
+					MockCreate: func(input *awsec2.CreateLaunchTemplateInput) awsec2.CreateLaunchTemplateRequest {
+						return awsec2.CreateLaunchTemplateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateLaunchTemplateOutput{
+								LaunchTemplate: &awsec2.LaunchTemplate{LaunchTemplateId: aws.String(ltID)},
+							}},
+						}
+					},
+				},
+				cr: lt(withSpec(v1alpha4.LaunchTemplateParameters{
+					LaunchTemplateName: ltName,
+				})),
+			},
+			want: want{
+				cr: lt(withSpec(v1alpha4.LaunchTemplateParameters{
+					LaunchTemplateName: ltName,
+				}), withExternalName(ltID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().MockUpdate,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				lt: &fake.MockLaunchTemplateClient{
+					MockCreate: func(input *awsec2.CreateLaunchTemplateInput) awsec2.CreateLaunchTemplateRequest {
+						return awsec2.CreateLaunchTemplateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lt(withSpec(v1alpha4.LaunchTemplateParameters{
+					LaunchTemplateName: ltName,
+				})),
+			},
+			want: want{
+				cr: lt(withSpec(v1alpha4.LaunchTemplateParameters{
+					LaunchTemplateName: ltName,
+				}), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lt}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.LaunchTemplate
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NewDefaultVersion": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDescribe: describe(awsec2.LaunchTemplate{
+						LaunchTemplateId:     aws.String(ltID),
+						DefaultVersionNumber: aws.Int64(1),
+						LatestVersionNumber:  aws.Int64(1),
+					}),
+					MockDescribeVersions: describeVersions(awsec2.ResponseLaunchTemplateData{ImageId: aws.String(otherImageID)}),
+					MockCreateVersion: func(input *awsec2.CreateLaunchTemplateVersionInput) awsec2.CreateLaunchTemplateVersionRequest {
+						if diff := cmp.Diff(imageID, aws.StringValue(input.LaunchTemplateData.ImageId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateLaunchTemplateVersionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateLaunchTemplateVersionOutput{
+								LaunchTemplateVersion: &awsec2.LaunchTemplateVersion{VersionNumber: aws.Int64(2)},
+							}},
+						}
+					},
+					MockModify: func(input *awsec2.ModifyLaunchTemplateInput) awsec2.ModifyLaunchTemplateRequest {
+						if diff := cmp.Diff("2", aws.StringValue(input.DefaultVersion)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.ModifyLaunchTemplateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyLaunchTemplateOutput{}},
+						}
+					},
+				},
+				cr: lt(withSpec(v1alpha4.LaunchTemplateParameters{
+					SetDefaultVersion:  aws.Bool(true),
+					LaunchTemplateData: v1alpha4.LaunchTemplateData{ImageID: aws.String(imageID)},
+				}), withExternalName(ltID)),
+			},
+			want: want{
+				cr: lt(withSpec(v1alpha4.LaunchTemplateParameters{
+					SetDefaultVersion:  aws.Bool(true),
+					LaunchTemplateData: v1alpha4.LaunchTemplateData{ImageID: aws.String(imageID)},
+				}), withExternalName(ltID)),
+			},
+		},
+		"CreateVersionFail": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDescribe:         describe(awsec2.LaunchTemplate{LaunchTemplateId: aws.String(ltID)}),
+					MockDescribeVersions: describeVersions(awsec2.ResponseLaunchTemplateData{ImageId: aws.String(otherImageID)}),
+					MockCreateVersion: func(input *awsec2.CreateLaunchTemplateVersionInput) awsec2.CreateLaunchTemplateVersionRequest {
+						return awsec2.CreateLaunchTemplateVersionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lt(withSpec(v1alpha4.LaunchTemplateParameters{
+					LaunchTemplateData: v1alpha4.LaunchTemplateData{ImageID: aws.String(imageID)},
+				}), withExternalName(ltID)),
+			},
+			want: want{
+				cr: lt(withSpec(v1alpha4.LaunchTemplateParameters{
+					LaunchTemplateData: v1alpha4.LaunchTemplateData{ImageID: aws.String(imageID)},
+				}), withExternalName(ltID)),
+				err: errors.Wrap(errBoom, errCreateVersion),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lt}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha4.LaunchTemplate
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDelete: func(input *awsec2.DeleteLaunchTemplateInput) awsec2.DeleteLaunchTemplateRequest {
+						return awsec2.DeleteLaunchTemplateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteLaunchTemplateOutput{}},
+						}
+					},
+				},
+				cr: lt(withExternalName(ltID)),
+			},
+			want: want{
+				cr: lt(withExternalName(ltID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				lt: &fake.MockLaunchTemplateClient{
+					MockDelete: func(input *awsec2.DeleteLaunchTemplateInput) awsec2.DeleteLaunchTemplateRequest {
+						return awsec2.DeleteLaunchTemplateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lt(withExternalName(ltID)),
+			},
+			want: want{
+				cr:  lt(withExternalName(ltID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lt}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,371 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkacl
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a NetworkACL resource"
+
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errKubeUpdateFailed  = "cannot update NetworkACL custom resource"
+
+	errClient             = "cannot create a new NetworkACL client"
+	errDescribe           = "failed to describe NetworkACL"
+	errMultipleItems      = "retrieved multiple NetworkACLs for the given networkAclId"
+	errNoDefault          = "cannot find the default NetworkACL of the VPC"
+	errNoSubnetACL        = "cannot find the NetworkACL that subnet %v is associated with"
+	errCreate             = "failed to create the NetworkACL resource"
+	errDelete             = "failed to delete the NetworkACL resource"
+	errCreateEntry        = "failed to create an entry in the NetworkACL resource"
+	errReplaceEntry       = "failed to replace an entry in the NetworkACL resource"
+	errDeleteEntry        = "failed to delete an entry from the NetworkACL resource"
+	errAssociateSubnet    = "failed to associate subnet %v to the NetworkACL resource"
+	errDisassociateSubnet = "failed to move subnet %v back to the default NetworkACL"
+	errSpecUpdate         = "cannot update spec of the NetworkACL custom resource"
+	errStatusUpdate       = "cannot update status of the NetworkACL custom resource"
+	errCreateTags         = "failed to create tags for the NetworkACL resource"
+
+	filterVPCID          = "vpc-id"
+	filterDefault        = "default"
+	filterAssociatedWith = "association.subnet-id"
+)
+
+// SetupNetworkACL adds a controller that reconciles NetworkACLs.
+func SetupNetworkACL(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha4.NetworkACLGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha4.NetworkACL{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha4.NetworkACLGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewNetworkACLClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ec2.NetworkACLClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha4.NetworkACL)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		aclClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: aclClient, kube: c.client}, errors.Wrap(err, errClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.client.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	aclClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: aclClient, kube: c.client}, errors.Wrap(err, errClient)
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.NetworkACLClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha4.NetworkACL)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	response, err := e.client.DescribeNetworkAclsRequest(&awsec2.DescribeNetworkAclsInput{
+		NetworkAclIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.NetworkAcls) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+
+	observed := response.NetworkAcls[0]
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeNetworkACL(&cr.Spec.ForProvider, &observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateNetworkACLObservation(observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsNetworkACLUpToDate(cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha4.NetworkACL)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	if err := e.kube.Status().Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errStatusUpdate)
+	}
+
+	// Taking over the default network ACL of the VPC rather than creating a
+	// new one.
+	if aws.BoolValue(cr.Spec.ForProvider.Default) {
+		id, err := e.getDefaultID(ctx, aws.StringValue(cr.Spec.ForProvider.VPCID))
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		meta.SetExternalName(cr, id)
+		return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+	}
+
+	result, err := e.client.CreateNetworkAclRequest(&awsec2.CreateNetworkAclInput{
+		VpcId: cr.Spec.ForProvider.VPCID,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	if result.NetworkAcl == nil {
+		return managed.ExternalCreation{}, errors.New(errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(result.NetworkAcl.NetworkAclId))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha4.NetworkACL)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	response, err := e.client.DescribeNetworkAclsRequest(&awsec2.DescribeNetworkAclsInput{
+		NetworkAclIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if len(response.NetworkAcls) != 1 {
+		return managed.ExternalUpdate{}, errors.New(errMultipleItems)
+	}
+	acl := response.NetworkAcls[0]
+
+	if !v1beta1.CompareTags(cr.Spec.ForProvider.Tags, acl.Tags) {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
+	if err := e.syncEntries(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider.Ingress, acl.Entries, false); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := e.syncEntries(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider.Egress, acl.Entries, true); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{}, e.createAssociations(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider.Associations, acl.Associations)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha4.NetworkACL)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	// The default network ACL of a VPC cannot be deleted.
+	if aws.BoolValue(cr.Spec.ForProvider.Default) {
+		return nil
+	}
+
+	// the subnets have to be moved back to the default network ACL before
+	// deleting the network ACL.
+	if len(cr.Status.AtProvider.Associations) != 0 {
+		defaultID, err := e.getDefaultID(ctx, aws.StringValue(cr.Spec.ForProvider.VPCID))
+		if err != nil {
+			return err
+		}
+		for _, asc := range cr.Status.AtProvider.Associations {
+			if _, err := e.client.ReplaceNetworkAclAssociationRequest(&awsec2.ReplaceNetworkAclAssociationInput{
+				AssociationId: aws.String(asc.AssociationID),
+				NetworkAclId:  aws.String(defaultID),
+			}).Send(ctx); err != nil {
+				return errors.Wrapf(err, errDisassociateSubnet, asc.SubnetID)
+			}
+		}
+	}
+
+	_, err := e.client.DeleteNetworkAclRequest(&awsec2.DeleteNetworkAclInput{
+		NetworkAclId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDelete)
+}
+
+func (e *external) getDefaultID(ctx context.Context, vpcID string) (string, error) {
+	response, err := e.client.DescribeNetworkAclsRequest(&awsec2.DescribeNetworkAclsInput{
+		Filters: []awsec2.Filter{
+			{Name: aws.String(filterVPCID), Values: []string{vpcID}},
+			{Name: aws.String(filterDefault), Values: []string{"true"}},
+		},
+	}).Send(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, errDescribe)
+	}
+	if len(response.NetworkAcls) != 1 {
+		return "", errors.New(errNoDefault)
+	}
+	return aws.StringValue(response.NetworkAcls[0].NetworkAclId), nil
+}
+
+func (e *external) syncEntries(ctx context.Context, aclID string, desired []v1alpha4.NetworkACLEntry, observed []awsec2.NetworkAclEntry, egress bool) error {
+	create, replace, remove := ec2.DiffNetworkACLEntries(desired, observed, egress)
+
+	for _, n := range remove {
+		if _, err := e.client.DeleteNetworkAclEntryRequest(&awsec2.DeleteNetworkAclEntryInput{
+			NetworkAclId: aws.String(aclID),
+			Egress:       aws.Bool(egress),
+			RuleNumber:   aws.Int64(n),
+		}).Send(ctx); err != nil && !ec2.IsNetworkACLEntryNotFoundErr(err) {
+			return errors.Wrap(err, errDeleteEntry)
+		}
+	}
+
+	for _, entry := range replace {
+		if _, err := e.client.ReplaceNetworkAclEntryRequest(ec2.GenerateReplaceNetworkACLEntryInput(aclID, egress, entry)).Send(ctx); err != nil {
+			return errors.Wrap(err, errReplaceEntry)
+		}
+	}
+
+	for _, entry := range create {
+		if _, err := e.client.CreateNetworkAclEntryRequest(ec2.GenerateCreateNetworkACLEntryInput(aclID, egress, entry)).Send(ctx); err != nil {
+			return errors.Wrap(err, errCreateEntry)
+		}
+	}
+
+	return nil
+}
+
+func (e *external) createAssociations(ctx context.Context, aclID string, desired []v1alpha4.NetworkACLAssociation, observed []awsec2.NetworkAclAssociation) error {
+	for _, asc := range desired {
+		isObserved := false
+		for _, ob := range observed {
+			if aws.StringValue(ob.SubnetId) == aws.StringValue(asc.SubnetID) {
+				isObserved = true
+				break
+			}
+		}
+		// if the subnet is already associated, skip it
+		if isObserved {
+			continue
+		}
+
+		// every subnet is associated with exactly one network ACL, so we need
+		// to find its current association in order to replace it.
+		response, err := e.client.DescribeNetworkAclsRequest(&awsec2.DescribeNetworkAclsInput{
+			Filters: []awsec2.Filter{{Name: aws.String(filterAssociatedWith), Values: []string{aws.StringValue(asc.SubnetID)}}},
+		}).Send(ctx)
+		if err != nil {
+			return errors.Wrap(err, errDescribe)
+		}
+		associationID := ""
+		for _, acl := range response.NetworkAcls {
+			for _, a := range acl.Associations {
+				if aws.StringValue(a.SubnetId) == aws.StringValue(asc.SubnetID) {
+					associationID = aws.StringValue(a.NetworkAclAssociationId)
+				}
+			}
+		}
+		if associationID == "" {
+			return errors.Errorf(errNoSubnetACL, aws.StringValue(asc.SubnetID))
+		}
+
+		if _, err := e.client.ReplaceNetworkAclAssociationRequest(&awsec2.ReplaceNetworkAclAssociationInput{
+			AssociationId: aws.String(associationID),
+			NetworkAclId:  aws.String(aclID),
+		}).Send(ctx); err != nil {
+			return errors.Wrapf(err, errAssociateSubnet, aws.StringValue(asc.SubnetID))
+		}
+	}
+
+	return nil
+}
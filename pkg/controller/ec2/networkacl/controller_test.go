@@ -0,0 +1,536 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkacl
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+const (
+	providerName = "aws-creds"
+)
+
+var (
+	aclID         = "some acl"
+	defaultACLID  = "default acl"
+	vpcID         = "some vpc"
+	subnetID      = "some subnet"
+	associationID = "some association"
+	cidr          = "10.0.0.0/16"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	acl  ec2.NetworkACLClient
+	kube client.Client
+	cr   *v1alpha4.NetworkACL
+}
+
+type aclModifier func(*v1alpha4.NetworkACL)
+
+func withExternalName(name string) aclModifier {
+	return func(r *v1alpha4.NetworkACL) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p v1alpha4.NetworkACLParameters) aclModifier {
+	return func(r *v1alpha4.NetworkACL) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha4.NetworkACLObservation) aclModifier {
+	return func(r *v1alpha4.NetworkACL) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) aclModifier {
+	return func(r *v1alpha4.NetworkACL) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func acl(m ...aclModifier) *v1alpha4.NetworkACL {
+	cr := &v1alpha4.NetworkACL{
+		Spec: v1alpha4.NetworkACLSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func entry(n int64) v1alpha4.NetworkACLEntry {
+	return v1alpha4.NetworkACLEntry{
+		RuleNumber: n,
+		Protocol:   "-1",
+		RuleAction: "allow",
+		CIDRBlock:  aws.String(cidr),
+	}
+}
+
+func describe(acls ...awsec2.NetworkAcl) func(*awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+	return func(*awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+		return awsec2.DescribeNetworkAclsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeNetworkAclsOutput{
+				NetworkAcls: acls,
+			}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.NetworkACL
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: describe(awsec2.NetworkAcl{
+						NetworkAclId: aws.String(aclID),
+						VpcId:        aws.String(vpcID),
+						Entries: []awsec2.NetworkAclEntry{{
+							RuleNumber: aws.Int64(100),
+							Protocol:   aws.String("-1"),
+							RuleAction: awsec2.RuleActionAllow,
+							CidrBlock:  aws.String(cidr),
+							Egress:     aws.Bool(false),
+						}},
+					}),
+				},
+				cr: acl(withSpec(v1alpha4.NetworkACLParameters{
+					VPCID:   aws.String(vpcID),
+					Ingress: []v1alpha4.NetworkACLEntry{entry(100)},
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				cr: acl(withSpec(v1alpha4.NetworkACLParameters{
+					VPCID:   aws.String(vpcID),
+					Ingress: []v1alpha4.NetworkACLEntry{entry(100)},
+				}), withExternalName(aclID), withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha4.NetworkACLObservation{NetworkACLID: aclID})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"MissingEntry": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: describe(awsec2.NetworkAcl{
+						NetworkAclId: aws.String(aclID),
+						VpcId:        aws.String(vpcID),
+					}),
+				},
+				cr: acl(withSpec(v1alpha4.NetworkACLParameters{
+					VPCID:  aws.String(vpcID),
+					Egress: []v1alpha4.NetworkACLEntry{entry(100)},
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				cr: acl(withSpec(v1alpha4.NetworkACLParameters{
+					VPCID:  aws.String(vpcID),
+					Egress: []v1alpha4.NetworkACLEntry{entry(100)},
+				}), withExternalName(aclID), withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha4.NetworkACLObservation{NetworkACLID: aclID})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"MultipleACLs": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: describe(awsec2.NetworkAcl{}, awsec2.NetworkAcl{}),
+				},
+				cr: acl(withExternalName(aclID)),
+			},
+			want: want{
+				cr:  acl(withExternalName(aclID)),
+				err: errors.New(errMultipleItems),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(input *awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+						return awsec2.DescribeNetworkAclsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: acl(withExternalName(aclID)),
+			},
+			want: want{
+				cr:  acl(withExternalName(aclID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: acl(),
+			},
+			want: want{
+				cr: acl(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.acl}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.NetworkACL
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().MockUpdate,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				acl: &fake.MockNetworkACLClient{
+					MockCreate: func(input *awsec2.CreateNetworkAclInput) awsec2.CreateNetworkAclRequest {
+						return awsec2.CreateNetworkAclRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateNetworkAclOutput{
+								NetworkAcl: &awsec2.NetworkAcl{NetworkAclId: aws.String(aclID)},
+							}},
+						}
+					},
+				},
+				cr: acl(withSpec(v1alpha4.NetworkACLParameters{VPCID: aws.String(vpcID)})),
+			},
+			want: want{
+				cr: acl(withSpec(v1alpha4.NetworkACLParameters{VPCID: aws.String(vpcID)}),
+					withExternalName(aclID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"DefaultTakeover": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().MockUpdate,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: describe(awsec2.NetworkAcl{NetworkAclId: aws.String(defaultACLID)}),
+				},
+				cr: acl(withSpec(v1alpha4.NetworkACLParameters{VPCID: aws.String(vpcID), Default: aws.Bool(true)})),
+			},
+			want: want{
+				cr: acl(withSpec(v1alpha4.NetworkACLParameters{VPCID: aws.String(vpcID), Default: aws.Bool(true)}),
+					withExternalName(defaultACLID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"NoDefault": {
+			args: args{
+				kube: &test.MockClient{
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: describe(),
+				},
+				cr: acl(withSpec(v1alpha4.NetworkACLParameters{VPCID: aws.String(vpcID), Default: aws.Bool(true)})),
+			},
+			want: want{
+				cr: acl(withSpec(v1alpha4.NetworkACLParameters{VPCID: aws.String(vpcID), Default: aws.Bool(true)}),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.New(errNoDefault),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().MockUpdate,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				acl: &fake.MockNetworkACLClient{
+					MockCreate: func(input *awsec2.CreateNetworkAclInput) awsec2.CreateNetworkAclRequest {
+						return awsec2.CreateNetworkAclRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: acl(),
+			},
+			want: want{
+				cr:  acl(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.acl}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.NetworkACL
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulEntriesAndAssociation": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(input *awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+						if len(input.Filters) != 0 {
+							return describe(awsec2.NetworkAcl{
+								NetworkAclId: aws.String(defaultACLID),
+								Associations: []awsec2.NetworkAclAssociation{{
+									NetworkAclAssociationId: aws.String(associationID),
+									SubnetId:                aws.String(subnetID),
+								}},
+							})(input)
+						}
+						return describe(awsec2.NetworkAcl{
+							NetworkAclId: aws.String(aclID),
+							Entries: []awsec2.NetworkAclEntry{{
+								RuleNumber: aws.Int64(200),
+								Egress:     aws.Bool(false),
+							}},
+						})(input)
+					},
+					MockDeleteEntry: func(input *awsec2.DeleteNetworkAclEntryInput) awsec2.DeleteNetworkAclEntryRequest {
+						if diff := cmp.Diff(int64(200), aws.Int64Value(input.RuleNumber)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.DeleteNetworkAclEntryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteNetworkAclEntryOutput{}},
+						}
+					},
+					MockCreateEntry: func(input *awsec2.CreateNetworkAclEntryInput) awsec2.CreateNetworkAclEntryRequest {
+						if diff := cmp.Diff(int64(100), aws.Int64Value(input.RuleNumber)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateNetworkAclEntryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateNetworkAclEntryOutput{}},
+						}
+					},
+					MockReplaceAssociation: func(input *awsec2.ReplaceNetworkAclAssociationInput) awsec2.ReplaceNetworkAclAssociationRequest {
+						if diff := cmp.Diff(associationID, aws.StringValue(input.AssociationId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(aclID, aws.StringValue(input.NetworkAclId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.ReplaceNetworkAclAssociationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ReplaceNetworkAclAssociationOutput{}},
+						}
+					},
+				},
+				cr: acl(withSpec(v1alpha4.NetworkACLParameters{
+					Ingress:      []v1alpha4.NetworkACLEntry{entry(100)},
+					Associations: []v1alpha4.NetworkACLAssociation{{SubnetID: aws.String(subnetID)}},
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				cr: acl(withSpec(v1alpha4.NetworkACLParameters{
+					Ingress:      []v1alpha4.NetworkACLEntry{entry(100)},
+					Associations: []v1alpha4.NetworkACLAssociation{{SubnetID: aws.String(subnetID)}},
+				}), withExternalName(aclID)),
+			},
+		},
+		"CreateEntryFail": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: describe(awsec2.NetworkAcl{NetworkAclId: aws.String(aclID)}),
+					MockCreateEntry: func(input *awsec2.CreateNetworkAclEntryInput) awsec2.CreateNetworkAclEntryRequest {
+						return awsec2.CreateNetworkAclEntryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: acl(withSpec(v1alpha4.NetworkACLParameters{
+					Egress: []v1alpha4.NetworkACLEntry{entry(100)},
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				cr: acl(withSpec(v1alpha4.NetworkACLParameters{
+					Egress: []v1alpha4.NetworkACLEntry{entry(100)},
+				}), withExternalName(aclID)),
+				err: errors.Wrap(errBoom, errCreateEntry),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.acl}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha4.NetworkACL
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: describe(awsec2.NetworkAcl{NetworkAclId: aws.String(defaultACLID)}),
+					MockReplaceAssociation: func(input *awsec2.ReplaceNetworkAclAssociationInput) awsec2.ReplaceNetworkAclAssociationRequest {
+						if diff := cmp.Diff(defaultACLID, aws.StringValue(input.NetworkAclId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.ReplaceNetworkAclAssociationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ReplaceNetworkAclAssociationOutput{}},
+						}
+					},
+					MockDelete: func(input *awsec2.DeleteNetworkAclInput) awsec2.DeleteNetworkAclRequest {
+						return awsec2.DeleteNetworkAclRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteNetworkAclOutput{}},
+						}
+					},
+				},
+				cr: acl(withExternalName(aclID), withStatus(v1alpha4.NetworkACLObservation{
+					Associations: []v1alpha4.NetworkACLAssociationState{{AssociationID: associationID, SubnetID: subnetID}},
+				})),
+			},
+			want: want{
+				cr: acl(withExternalName(aclID), withConditions(runtimev1alpha1.Deleting()), withStatus(v1alpha4.NetworkACLObservation{
+					Associations: []v1alpha4.NetworkACLAssociationState{{AssociationID: associationID, SubnetID: subnetID}},
+				})),
+			},
+		},
+		"DefaultNotDeleted": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{},
+				cr:  acl(withExternalName(defaultACLID), withSpec(v1alpha4.NetworkACLParameters{Default: aws.Bool(true)})),
+			},
+			want: want{
+				cr: acl(withExternalName(defaultACLID), withSpec(v1alpha4.NetworkACLParameters{Default: aws.Bool(true)}),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDelete: func(input *awsec2.DeleteNetworkAclInput) awsec2.DeleteNetworkAclRequest {
+						return awsec2.DeleteNetworkAclRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: acl(withExternalName(aclID)),
+			},
+			want: want{
+				cr:  acl(withExternalName(aclID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.acl}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
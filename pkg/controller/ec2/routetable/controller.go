@@ -286,7 +286,9 @@ func (e *external) createRoutes(ctx context.Context, tableID string, desired []v
 	for _, rt := range desired {
 		isObserved := false
 		for _, ob := range observed {
-			if ob.GatewayID == aws.StringValue(rt.GatewayID) && ob.DestinationCIDRBlock == aws.StringValue(rt.DestinationCIDRBlock) {
+			if ob.GatewayID == aws.StringValue(rt.GatewayID) &&
+				ob.DestinationCIDRBlock == aws.StringValue(rt.DestinationCIDRBlock) &&
+				ob.DestinationIPv6CIDRBlock == aws.StringValue(rt.DestinationIPv6CIDRBlock) {
 				isObserved = true
 				break
 			}
@@ -294,9 +296,10 @@ func (e *external) createRoutes(ctx context.Context, tableID string, desired []v
 		// if the route is already created, skip it
 		if !isObserved {
 			_, err := e.client.CreateRouteRequest(&awsec2.CreateRouteInput{
-				RouteTableId:         aws.String(tableID),
-				DestinationCidrBlock: rt.DestinationCIDRBlock,
-				GatewayId:            rt.GatewayID,
+				RouteTableId:             aws.String(tableID),
+				DestinationCidrBlock:     rt.DestinationCIDRBlock,
+				DestinationIpv6CidrBlock: rt.DestinationIPv6CIDRBlock,
+				GatewayId:                rt.GatewayID,
 			}).Send(ctx)
 
 			if err != nil {
@@ -0,0 +1,351 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+const (
+	providerName = "aws-creds"
+)
+
+var (
+	snapID = "some snapshot"
+	volID  = "some volume"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	snap ec2.SnapshotClient
+	kube client.Client
+	cr   *v1alpha4.Snapshot
+}
+
+type snapModifier func(*v1alpha4.Snapshot)
+
+func withExternalName(name string) snapModifier {
+	return func(r *v1alpha4.Snapshot) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p v1alpha4.SnapshotParameters) snapModifier {
+	return func(r *v1alpha4.Snapshot) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha4.SnapshotObservation) snapModifier {
+	return func(r *v1alpha4.Snapshot) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) snapModifier {
+	return func(r *v1alpha4.Snapshot) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func snapshot(m ...snapModifier) *v1alpha4.Snapshot {
+	cr := &v1alpha4.Snapshot{
+		Spec: v1alpha4.SnapshotSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func describe(snaps ...awsec2.Snapshot) func(*awsec2.DescribeSnapshotsInput) awsec2.DescribeSnapshotsRequest {
+	return func(*awsec2.DescribeSnapshotsInput) awsec2.DescribeSnapshotsRequest {
+		return awsec2.DescribeSnapshotsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeSnapshotsOutput{
+				Snapshots: snaps,
+			}},
+		}
+	}
+}
+
+func params() v1alpha4.SnapshotParameters {
+	return v1alpha4.SnapshotParameters{
+		Description: aws.String("nightly"),
+		VolumeID:    aws.String(volID),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.Snapshot
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Pending": {
+			args: args{
+				snap: &fake.MockSnapshotClient{
+					MockDescribe: describe(awsec2.Snapshot{
+						SnapshotId:  aws.String(snapID),
+						VolumeId:    aws.String(volID),
+						Description: aws.String("nightly"),
+						State:       awsec2.SnapshotStatePending,
+						Progress:    aws.String("42%"),
+						VolumeSize:  aws.Int64(20),
+					}),
+				},
+				cr: snapshot(withSpec(params()), withExternalName(snapID)),
+			},
+			want: want{
+				cr: snapshot(withSpec(params()), withExternalName(snapID),
+					withConditions(runtimev1alpha1.Creating()),
+					withStatus(v1alpha4.SnapshotObservation{
+						SnapshotID: snapID,
+						State:      string(awsec2.SnapshotStatePending),
+						Progress:   "42%",
+						VolumeSize: 20,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Completed": {
+			args: args{
+				snap: &fake.MockSnapshotClient{
+					MockDescribe: describe(awsec2.Snapshot{
+						SnapshotId:  aws.String(snapID),
+						VolumeId:    aws.String(volID),
+						Description: aws.String("nightly"),
+						State:       awsec2.SnapshotStateCompleted,
+						Progress:    aws.String("100%"),
+					}),
+				},
+				cr: snapshot(withSpec(params()), withExternalName(snapID)),
+			},
+			want: want{
+				cr: snapshot(withSpec(params()), withExternalName(snapID),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha4.SnapshotObservation{
+						SnapshotID: snapID,
+						State:      string(awsec2.SnapshotStateCompleted),
+						Progress:   "100%",
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				snap: &fake.MockSnapshotClient{
+					MockDescribe: func(input *awsec2.DescribeSnapshotsInput) awsec2.DescribeSnapshotsRequest {
+						return awsec2.DescribeSnapshotsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: snapshot(withExternalName(snapID)),
+			},
+			want: want{
+				cr:  snapshot(withExternalName(snapID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: snapshot(),
+			},
+			want: want{
+				cr: snapshot(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.snap}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.Snapshot
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().MockUpdate,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				snap: &fake.MockSnapshotClient{
+					MockCreate: func(input *awsec2.CreateSnapshotInput) awsec2.CreateSnapshotRequest {
+						if diff := cmp.Diff(volID, aws.StringValue(input.VolumeId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateSnapshotOutput{
+								SnapshotId: aws.String(snapID),
+							}},
+						}
+					},
+				},
+				cr: snapshot(withSpec(params())),
+			},
+			want: want{
+				cr: snapshot(withSpec(params()), withExternalName(snapID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().MockUpdate,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				snap: &fake.MockSnapshotClient{
+					MockCreate: func(input *awsec2.CreateSnapshotInput) awsec2.CreateSnapshotRequest {
+						return awsec2.CreateSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: snapshot(withSpec(params())),
+			},
+			want: want{
+				cr:  snapshot(withSpec(params()), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.snap}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha4.Snapshot
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				snap: &fake.MockSnapshotClient{
+					MockDelete: func(input *awsec2.DeleteSnapshotInput) awsec2.DeleteSnapshotRequest {
+						return awsec2.DeleteSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteSnapshotOutput{}},
+						}
+					},
+				},
+				cr: snapshot(withExternalName(snapID)),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				snap: &fake.MockSnapshotClient{
+					MockDelete: func(input *awsec2.DeleteSnapshotInput) awsec2.DeleteSnapshotRequest {
+						return awsec2.DeleteSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: snapshot(withExternalName(snapID)),
+			},
+			want: want{
+				cr:  snapshot(withExternalName(snapID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.snap}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
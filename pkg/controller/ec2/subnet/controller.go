@@ -49,14 +49,16 @@ const (
 	errGetProvider        = "cannot get provider"
 	errGetProviderSecret  = "cannot get provider secret"
 
-	errDescribe      = "failed to describe Subnet"
-	errMultipleItems = "retrieved multiple Subnets"
-	errCreate        = "failed to create the Subnet resource"
-	errDelete        = "failed to delete the Subnet resource"
-	errUpdate        = "failed to update the Subnet resource"
-	errSpecUpdate    = "cannot update spec of the Subnet custom resource"
-	errStatusUpdate  = "cannot update status of the Subnet custom resource"
-	errCreateTags    = "failed to create tags for the Subnet resource"
+	errDescribe         = "failed to describe Subnet"
+	errMultipleItems    = "retrieved multiple Subnets"
+	errCreate           = "failed to create the Subnet resource"
+	errDelete           = "failed to delete the Subnet resource"
+	errUpdate           = "failed to update the Subnet resource"
+	errSpecUpdate       = "cannot update spec of the Subnet custom resource"
+	errStatusUpdate     = "cannot update status of the Subnet custom resource"
+	errCreateTags       = "failed to create tags for the Subnet resource"
+	errAssociateCIDR    = "failed to associate the IPv6 CIDR block with the Subnet resource"
+	errDisassociateCIDR = "failed to disassociate the IPv6 CIDR block from the Subnet resource"
 )
 
 // SetupSubnet adds a controller that reconciles Subnets.
@@ -223,7 +225,11 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	if subnet.MapPublicIpOnLaunch != cr.Spec.ForProvider.MapPublicIPOnLaunch {
+	if err := e.updateIPv6CIDRBlock(ctx, cr, subnet); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if aws.BoolValue(subnet.MapPublicIpOnLaunch) != aws.BoolValue(cr.Spec.ForProvider.MapPublicIPOnLaunch) {
 		_, err = e.client.ModifySubnetAttributeRequest(&awsec2.ModifySubnetAttributeInput{
 			MapPublicIpOnLaunch: &awsec2.AttributeBooleanValue{
 				Value: cr.Spec.ForProvider.MapPublicIPOnLaunch,
@@ -235,7 +241,7 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	if subnet.AssignIpv6AddressOnCreation != cr.Spec.ForProvider.AssignIPv6AddressOnCreation {
+	if aws.BoolValue(subnet.AssignIpv6AddressOnCreation) != aws.BoolValue(cr.Spec.ForProvider.AssignIPv6AddressOnCreation) {
 		_, err = e.client.ModifySubnetAttributeRequest(&awsec2.ModifySubnetAttributeInput{
 			AssignIpv6AddressOnCreation: &awsec2.AttributeBooleanValue{
 				Value: cr.Spec.ForProvider.AssignIPv6AddressOnCreation,
@@ -247,6 +253,34 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
 }
 
+func (e *external) updateIPv6CIDRBlock(ctx context.Context, cr *v1beta1.Subnet, subnet awsec2.Subnet) error {
+	observed := ec2.GetActiveSubnetIPv6CIDRBlock(subnet)
+	if observed == nil && cr.Spec.ForProvider.IPv6CIDRBlock == nil ||
+		observed != nil && aws.StringValue(cr.Spec.ForProvider.IPv6CIDRBlock) == aws.StringValue(observed.Ipv6CidrBlock) {
+		return nil
+	}
+
+	// a subnet can have only one IPv6 CIDR block, so the current one has to be
+	// disassociated before a new one can be associated.
+	if observed != nil {
+		if _, err := e.client.DisassociateSubnetCidrBlockRequest(&awsec2.DisassociateSubnetCidrBlockInput{
+			AssociationId: observed.AssociationId,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDisassociateCIDR)
+		}
+	}
+
+	if cr.Spec.ForProvider.IPv6CIDRBlock == nil {
+		return nil
+	}
+
+	_, err := e.client.AssociateSubnetCidrBlockRequest(&awsec2.AssociateSubnetCidrBlockInput{
+		SubnetId:      aws.String(meta.GetExternalName(cr)),
+		Ipv6CidrBlock: cr.Spec.ForProvider.IPv6CIDRBlock,
+	}).Send(ctx)
+	return errors.Wrap(err, errAssociateCIDR)
+}
+
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	cr, ok := mgd.(*v1beta1.Subnet)
 	if !ok {
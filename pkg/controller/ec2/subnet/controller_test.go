@@ -55,6 +55,10 @@ const (
 var (
 	subnetID = "some Id"
 
+	ipv6CIDR          = "2600:1f16::/64"
+	otherIPv6CIDR     = "2600:1f17::/64"
+	ipv6AssociationID = "some association"
+
 	errBoom = errors.New("boom")
 )
 
@@ -509,6 +513,50 @@ func TestUpdate(t *testing.T) {
 				})),
 			},
 		},
+		"ReplaceIPv6CIDRBlock": {
+			args: args{
+				subnet: &fake.MockSubnetClient{
+					MockDescribe: func(input *awsec2.DescribeSubnetsInput) awsec2.DescribeSubnetsRequest {
+						return awsec2.DescribeSubnetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeSubnetsOutput{
+								Subnets: []awsec2.Subnet{{
+									SubnetId: aws.String(subnetID),
+									Ipv6CidrBlockAssociationSet: []awsec2.SubnetIpv6CidrBlockAssociation{{
+										AssociationId:      aws.String(ipv6AssociationID),
+										Ipv6CidrBlock:      aws.String(otherIPv6CIDR),
+										Ipv6CidrBlockState: &awsec2.SubnetCidrBlockState{State: awsec2.SubnetCidrBlockStateCodeAssociated},
+									}},
+								}},
+							}},
+						}
+					},
+					MockDisassociateCIDRBlock: func(input *awsec2.DisassociateSubnetCidrBlockInput) awsec2.DisassociateSubnetCidrBlockRequest {
+						if diff := cmp.Diff(ipv6AssociationID, aws.StringValue(input.AssociationId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.DisassociateSubnetCidrBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DisassociateSubnetCidrBlockOutput{}},
+						}
+					},
+					MockAssociateCIDRBlock: func(input *awsec2.AssociateSubnetCidrBlockInput) awsec2.AssociateSubnetCidrBlockRequest {
+						if diff := cmp.Diff(ipv6CIDR, aws.StringValue(input.Ipv6CidrBlock)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.AssociateSubnetCidrBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.AssociateSubnetCidrBlockOutput{}},
+						}
+					},
+				},
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					IPv6CIDRBlock: aws.String(ipv6CIDR),
+				})),
+			},
+			want: want{
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					IPv6CIDRBlock: aws.String(ipv6CIDR),
+				})),
+			},
+		},
 		"ModifyFailed": {
 			args: args{
 				subnet: &fake.MockSubnetClient{
@@ -0,0 +1,275 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volume
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a Volume resource"
+
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errKubeUpdateFailed  = "cannot update Volume custom resource"
+
+	errClient               = "cannot create a new Volume client"
+	errDescribe             = "failed to describe Volume"
+	errDescribeModification = "failed to describe the modifications of the Volume"
+	errMultipleItems        = "retrieved multiple Volumes for the given volumeId"
+	errCreate               = "failed to create the Volume resource"
+	errModify               = "failed to modify the Volume resource"
+	errDelete               = "failed to delete the Volume resource"
+	errSpecUpdate           = "cannot update spec of the Volume custom resource"
+	errStatusUpdate         = "cannot update status of the Volume custom resource"
+	errCreateTags           = "failed to create tags for the Volume resource"
+)
+
+// SetupVolume adds a controller that reconciles Volumes.
+func SetupVolume(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha4.VolumeGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha4.Volume{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha4.VolumeGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewVolumeClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ec2.VolumeClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha4.Volume)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		volClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: volClient, kube: c.client}, errors.Wrap(err, errClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.client.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	volClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: volClient, kube: c.client}, errors.Wrap(err, errClient)
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.VolumeClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2.Volume, error) {
+	response, err := e.client.DescribeVolumesRequest(&awsec2.DescribeVolumesInput{
+		VolumeIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.Volumes) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &response.Volumes[0], nil
+}
+
+// describeModification returns the latest modification of the volume, or nil
+// if it has never been modified.
+func (e *external) describeModification(ctx context.Context, id string) (*awsec2.VolumeModification, error) {
+	response, err := e.client.DescribeVolumesModificationsRequest(&awsec2.DescribeVolumesModificationsInput{
+		VolumeIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, resource.Ignore(ec2.IsVolumeModificationNotFoundErr, err)
+	}
+	return ec2.LatestVolumeModification(response.VolumesModifications), nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha4.Volume)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsVolumeNotFoundErr, err), errDescribe)
+	}
+
+	// deleted volumes are still returned for a while.
+	if observed.State == awsec2.VolumeStateDeleted {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	modification, err := e.describeModification(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeModification)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeVolume(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateVolumeObservation(*observed, modification)
+
+	switch observed.State {
+	case awsec2.VolumeStateAvailable, awsec2.VolumeStateInUse:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awsec2.VolumeStateCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awsec2.VolumeStateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsVolumeUpToDate(cr.Spec.ForProvider, *observed, modification),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha4.Volume)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	if err := e.kube.Status().Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errStatusUpdate)
+	}
+
+	result, err := e.client.CreateVolumeRequest(ec2.GenerateCreateVolumeInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(result.VolumeId))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha4.Volume)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	if !v1beta1.CompareTags(cr.Spec.ForProvider.Tags, observed.Tags) {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
+	// A volume cannot be modified again until the previous modification
+	// has reached the optimizing state.
+	modification, err := e.describeModification(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeModification)
+	}
+	if ec2.IsVolumeModifying(modification) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	input, changed := ec2.GenerateModifyVolumeInput(meta.GetExternalName(cr), cr.Spec.ForProvider, *observed)
+	if !changed {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	_, err = e.client.ModifyVolumeRequest(input).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha4.Volume)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeleteVolumeRequest(&awsec2.DeleteVolumeInput{
+		VolumeId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(ec2.IsVolumeNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,490 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volume
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+const (
+	providerName = "aws-creds"
+)
+
+var (
+	volID = "some volume"
+	az    = "us-east-1a"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	vol  ec2.VolumeClient
+	kube client.Client
+	cr   *v1alpha4.Volume
+}
+
+type volModifier func(*v1alpha4.Volume)
+
+func withExternalName(name string) volModifier {
+	return func(r *v1alpha4.Volume) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p v1alpha4.VolumeParameters) volModifier {
+	return func(r *v1alpha4.Volume) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha4.VolumeObservation) volModifier {
+	return func(r *v1alpha4.Volume) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) volModifier {
+	return func(r *v1alpha4.Volume) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func volume(m ...volModifier) *v1alpha4.Volume {
+	cr := &v1alpha4.Volume{
+		Spec: v1alpha4.VolumeSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func describe(vols ...awsec2.Volume) func(*awsec2.DescribeVolumesInput) awsec2.DescribeVolumesRequest {
+	return func(*awsec2.DescribeVolumesInput) awsec2.DescribeVolumesRequest {
+		return awsec2.DescribeVolumesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVolumesOutput{
+				Volumes: vols,
+			}},
+		}
+	}
+}
+
+func describeModifications(ms ...awsec2.VolumeModification) func(*awsec2.DescribeVolumesModificationsInput) awsec2.DescribeVolumesModificationsRequest {
+	return func(*awsec2.DescribeVolumesModificationsInput) awsec2.DescribeVolumesModificationsRequest {
+		if len(ms) == 0 {
+			return awsec2.DescribeVolumesModificationsRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(ec2.VolumeModificationNotFound, "", nil)},
+			}
+		}
+		return awsec2.DescribeVolumesModificationsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVolumesModificationsOutput{
+				VolumesModifications: ms,
+			}},
+		}
+	}
+}
+
+func params() v1alpha4.VolumeParameters {
+	return v1alpha4.VolumeParameters{
+		AvailabilityZone: az,
+		Size:             aws.Int64(20),
+		VolumeType:       aws.String("gp2"),
+		Encrypted:        aws.Bool(true),
+	}
+}
+
+func observed(size int64) awsec2.Volume {
+	return awsec2.Volume{
+		VolumeId:         aws.String(volID),
+		AvailabilityZone: aws.String(az),
+		Size:             aws.Int64(size),
+		VolumeType:       awsec2.VolumeTypeGp2,
+		Encrypted:        aws.Bool(true),
+		State:            awsec2.VolumeStateAvailable,
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.Volume
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				vol: &fake.MockVolumeClient{
+					MockDescribe:              describe(observed(20)),
+					MockDescribeModifications: describeModifications(),
+				},
+				cr: volume(withSpec(params()), withExternalName(volID)),
+			},
+			want: want{
+				cr: volume(withSpec(params()), withExternalName(volID),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha4.VolumeObservation{
+						VolumeID: volID,
+						State:    string(awsec2.VolumeStateAvailable),
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Modifying": {
+			args: args{
+				vol: &fake.MockVolumeClient{
+					MockDescribe: describe(observed(10)),
+					MockDescribeModifications: describeModifications(awsec2.VolumeModification{
+						ModificationState: awsec2.VolumeModificationStateModifying,
+						Progress:          aws.Int64(40),
+						TargetSize:        aws.Int64(20),
+					}),
+				},
+				cr: volume(withSpec(params()), withExternalName(volID)),
+			},
+			want: want{
+				cr: volume(withSpec(params()), withExternalName(volID),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha4.VolumeObservation{
+						VolumeID: volID,
+						State:    string(awsec2.VolumeStateAvailable),
+						Modification: &v1alpha4.VolumeModification{
+							ModificationState: string(awsec2.VolumeModificationStateModifying),
+							Progress:          40,
+							TargetSize:        20,
+						},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NeedsResize": {
+			args: args{
+				vol: &fake.MockVolumeClient{
+					MockDescribe:              describe(observed(10)),
+					MockDescribeModifications: describeModifications(),
+				},
+				cr: volume(withSpec(params()), withExternalName(volID)),
+			},
+			want: want{
+				cr: volume(withSpec(params()), withExternalName(volID),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha4.VolumeObservation{
+						VolumeID: volID,
+						State:    string(awsec2.VolumeStateAvailable),
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				vol: &fake.MockVolumeClient{
+					MockDescribe: describe(awsec2.Volume{VolumeId: aws.String(volID), State: awsec2.VolumeStateDeleted}),
+				},
+				cr: volume(withSpec(params()), withExternalName(volID)),
+			},
+			want: want{
+				cr: volume(withSpec(params()), withExternalName(volID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				vol: &fake.MockVolumeClient{
+					MockDescribe: func(input *awsec2.DescribeVolumesInput) awsec2.DescribeVolumesRequest {
+						return awsec2.DescribeVolumesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: volume(withExternalName(volID)),
+			},
+			want: want{
+				cr:  volume(withExternalName(volID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: volume(),
+			},
+			want: want{
+				cr: volume(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.vol}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.Volume
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().MockUpdate,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				vol: &fake.MockVolumeClient{
+					MockCreate: func(input *awsec2.CreateVolumeInput) awsec2.CreateVolumeRequest {
+						if diff := cmp.Diff(az, aws.StringValue(input.AvailabilityZone)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateVolumeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateVolumeOutput{
+								VolumeId: aws.String(volID),
+							}},
+						}
+					},
+				},
+				cr: volume(withSpec(params())),
+			},
+			want: want{
+				cr: volume(withSpec(params()), withExternalName(volID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().MockUpdate,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				vol: &fake.MockVolumeClient{
+					MockCreate: func(input *awsec2.CreateVolumeInput) awsec2.CreateVolumeRequest {
+						return awsec2.CreateVolumeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: volume(withSpec(params())),
+			},
+			want: want{
+				cr:  volume(withSpec(params()), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.vol}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.Volume
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Resize": {
+			args: args{
+				vol: &fake.MockVolumeClient{
+					MockDescribe:              describe(observed(10)),
+					MockDescribeModifications: describeModifications(),
+					MockModify: func(input *awsec2.ModifyVolumeInput) awsec2.ModifyVolumeRequest {
+						if diff := cmp.Diff(int64(20), aws.Int64Value(input.Size)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.ModifyVolumeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyVolumeOutput{}},
+						}
+					},
+				},
+				cr: volume(withSpec(params()), withExternalName(volID)),
+			},
+			want: want{
+				cr: volume(withSpec(params()), withExternalName(volID)),
+			},
+		},
+		"StillModifying": {
+			args: args{
+				vol: &fake.MockVolumeClient{
+					MockDescribe: describe(observed(10)),
+					MockDescribeModifications: describeModifications(awsec2.VolumeModification{
+						ModificationState: awsec2.VolumeModificationStateModifying,
+					}),
+				},
+				cr: volume(withSpec(params()), withExternalName(volID)),
+			},
+			want: want{
+				cr: volume(withSpec(params()), withExternalName(volID)),
+			},
+		},
+		"ModifyFail": {
+			args: args{
+				vol: &fake.MockVolumeClient{
+					MockDescribe:              describe(observed(10)),
+					MockDescribeModifications: describeModifications(),
+					MockModify: func(input *awsec2.ModifyVolumeInput) awsec2.ModifyVolumeRequest {
+						return awsec2.ModifyVolumeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: volume(withSpec(params()), withExternalName(volID)),
+			},
+			want: want{
+				cr:  volume(withSpec(params()), withExternalName(volID)),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.vol}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha4.Volume
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				vol: &fake.MockVolumeClient{
+					MockDelete: func(input *awsec2.DeleteVolumeInput) awsec2.DeleteVolumeRequest {
+						return awsec2.DeleteVolumeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteVolumeOutput{}},
+						}
+					},
+				},
+				cr: volume(withExternalName(volID)),
+			},
+			want: want{
+				cr: volume(withExternalName(volID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				vol: &fake.MockVolumeClient{
+					MockDelete: func(input *awsec2.DeleteVolumeInput) awsec2.DeleteVolumeRequest {
+						return awsec2.DeleteVolumeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: volume(withExternalName(volID)),
+			},
+			want: want{
+				cr:  volume(withExternalName(volID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.vol}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumeattachment
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a VolumeAttachment resource"
+
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+
+	errClient        = "cannot create a new VolumeAttachment client"
+	errDescribe      = "failed to describe the Volume of the VolumeAttachment"
+	errMultipleItems = "retrieved multiple Volumes for the given volumeId"
+	errAttach        = "failed to attach the Volume"
+	errDetach        = "failed to detach the Volume"
+	errStatusUpdate  = "cannot update status of the VolumeAttachment custom resource"
+)
+
+// SetupVolumeAttachment adds a controller that reconciles VolumeAttachments.
+func SetupVolumeAttachment(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha4.VolumeAttachmentGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha4.VolumeAttachment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha4.VolumeAttachmentGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewVolumeAttachmentClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ec2.VolumeAttachmentClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha4.VolumeAttachment)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		attClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: attClient, kube: c.client}, errors.Wrap(err, errClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.client.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	attClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: attClient, kube: c.client}, errors.Wrap(err, errClient)
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.VolumeAttachmentClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha4.VolumeAttachment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	response, err := e.client.DescribeVolumesRequest(&awsec2.DescribeVolumesInput{
+		VolumeIds: []string{aws.StringValue(cr.Spec.ForProvider.VolumeID)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsVolumeNotFoundErr, err), errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.Volumes) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+
+	attachment := ec2.FindVolumeAttachment(response.Volumes[0], cr.Spec.ForProvider.InstanceID)
+	if attachment == nil || attachment.State == awsec2.VolumeAttachmentStateDetached {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	cr.Status.AtProvider = ec2.GenerateVolumeAttachmentObservation(*attachment)
+
+	switch attachment.State {
+	case awsec2.VolumeAttachmentStateAttached:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awsec2.VolumeAttachmentStateAttaching:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awsec2.VolumeAttachmentStateDetaching:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	// All parameters of an attachment are immutable.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha4.VolumeAttachment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	if err := e.kube.Status().Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errStatusUpdate)
+	}
+
+	_, err := e.client.AttachVolumeRequest(&awsec2.AttachVolumeInput{
+		Device:     aws.String(cr.Spec.ForProvider.Device),
+		InstanceId: aws.String(cr.Spec.ForProvider.InstanceID),
+		VolumeId:   cr.Spec.ForProvider.VolumeID,
+	}).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errAttach)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha4.VolumeAttachment)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DetachVolumeRequest(&awsec2.DetachVolumeInput{
+		Device:     aws.String(cr.Spec.ForProvider.Device),
+		InstanceId: aws.String(cr.Spec.ForProvider.InstanceID),
+		VolumeId:   cr.Spec.ForProvider.VolumeID,
+		Force:      cr.Spec.ForProvider.ForceDetach,
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(ec2.IsVolumeAttachmentNotFoundErr, err), errDetach)
+}
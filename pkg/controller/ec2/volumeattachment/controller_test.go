@@ -0,0 +1,342 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumeattachment
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+const (
+	providerName = "aws-creds"
+)
+
+var (
+	volID      = "some volume"
+	instanceID = "some instance"
+	device     = "/dev/sdh"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	att  ec2.VolumeAttachmentClient
+	kube client.Client
+	cr   *v1alpha4.VolumeAttachment
+}
+
+type attModifier func(*v1alpha4.VolumeAttachment)
+
+func withStatus(s v1alpha4.VolumeAttachmentObservation) attModifier {
+	return func(r *v1alpha4.VolumeAttachment) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) attModifier {
+	return func(r *v1alpha4.VolumeAttachment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func attachment(m ...attModifier) *v1alpha4.VolumeAttachment {
+	cr := &v1alpha4.VolumeAttachment{
+		Spec: v1alpha4.VolumeAttachmentSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha4.VolumeAttachmentParameters{
+				Device:     device,
+				InstanceID: instanceID,
+				VolumeID:   aws.String(volID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func describe(attachments ...awsec2.VolumeAttachment) func(*awsec2.DescribeVolumesInput) awsec2.DescribeVolumesRequest {
+	return func(*awsec2.DescribeVolumesInput) awsec2.DescribeVolumesRequest {
+		return awsec2.DescribeVolumesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVolumesOutput{
+				Volumes: []awsec2.Volume{{VolumeId: aws.String(volID), Attachments: attachments}},
+			}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.VolumeAttachment
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Attached": {
+			args: args{
+				att: &fake.MockVolumeAttachmentClient{
+					MockDescribe: describe(awsec2.VolumeAttachment{
+						InstanceId:          aws.String(instanceID),
+						Device:              aws.String(device),
+						State:               awsec2.VolumeAttachmentStateAttached,
+						DeleteOnTermination: aws.Bool(false),
+					}),
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha4.VolumeAttachmentObservation{
+						State: string(awsec2.VolumeAttachmentStateAttached),
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AttachedElsewhere": {
+			args: args{
+				att: &fake.MockVolumeAttachmentClient{
+					MockDescribe: describe(awsec2.VolumeAttachment{
+						InstanceId: aws.String("other instance"),
+						State:      awsec2.VolumeAttachmentStateAttached,
+					}),
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(),
+			},
+		},
+		"VolumeNotFound": {
+			args: args{
+				att: &fake.MockVolumeAttachmentClient{
+					MockDescribe: func(input *awsec2.DescribeVolumesInput) awsec2.DescribeVolumesRequest {
+						return awsec2.DescribeVolumesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(ec2.VolumeIDNotFound, "", nil)},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				att: &fake.MockVolumeAttachmentClient{
+					MockDescribe: func(input *awsec2.DescribeVolumesInput) awsec2.DescribeVolumesRequest {
+						return awsec2.DescribeVolumesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:  attachment(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.att}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha4.VolumeAttachment
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				att: &fake.MockVolumeAttachmentClient{
+					MockAttach: func(input *awsec2.AttachVolumeInput) awsec2.AttachVolumeRequest {
+						want := &awsec2.AttachVolumeInput{
+							Device:     aws.String(device),
+							InstanceId: aws.String(instanceID),
+							VolumeId:   aws.String(volID),
+						}
+						if diff := cmp.Diff(want, input); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.AttachVolumeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.AttachVolumeOutput{}},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"AttachFail": {
+			args: args{
+				kube: &test.MockClient{
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				att: &fake.MockVolumeAttachmentClient{
+					MockAttach: func(input *awsec2.AttachVolumeInput) awsec2.AttachVolumeRequest {
+						return awsec2.AttachVolumeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:  attachment(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errAttach),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.att}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha4.VolumeAttachment
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				att: &fake.MockVolumeAttachmentClient{
+					MockDetach: func(input *awsec2.DetachVolumeInput) awsec2.DetachVolumeRequest {
+						return awsec2.DetachVolumeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DetachVolumeOutput{}},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDetached": {
+			args: args{
+				att: &fake.MockVolumeAttachmentClient{
+					MockDetach: func(input *awsec2.DetachVolumeInput) awsec2.DetachVolumeRequest {
+						return awsec2.DetachVolumeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(ec2.VolumeIncorrectState, "", nil)},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DetachFail": {
+			args: args{
+				att: &fake.MockVolumeAttachmentClient{
+					MockDetach: func(input *awsec2.DetachVolumeInput) awsec2.DetachVolumeRequest {
+						return awsec2.DetachVolumeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:  attachment(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDetach),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.att}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -56,6 +56,8 @@ const (
 	errUpdate              = "failed to update VPC resource"
 	errModifyVPCAttributes = "failed to modify the VPC resource attributes"
 	errCreateTags          = "failed to create tags for the VPC resource"
+	errAssociateCIDR       = "failed to associate a CIDR block with the VPC resource"
+	errDisassociateCIDR    = "failed to disassociate a CIDR block from the VPC resource"
 	errDelete              = "failed to delete the VPC resource"
 	errSpecUpdate          = "cannot update spec of VPC custom resource"
 	errStatusUpdate        = "cannot update status of VPC custom resource"
@@ -203,8 +205,9 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	}
 
 	result, err := e.client.CreateVpcRequest(&awsec2.CreateVpcInput{
-		CidrBlock:       aws.String(cr.Spec.ForProvider.CIDRBlock),
-		InstanceTenancy: awsec2.Tenancy(aws.StringValue(cr.Spec.ForProvider.InstanceTenancy)),
+		CidrBlock:                   aws.String(cr.Spec.ForProvider.CIDRBlock),
+		InstanceTenancy:             awsec2.Tenancy(aws.StringValue(cr.Spec.ForProvider.InstanceTenancy)),
+		AmazonProvidedIpv6CidrBlock: cr.Spec.ForProvider.AmazonProvidedIPv6CIDRBlock,
 	}).Send(ctx)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
@@ -221,6 +224,20 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
+	response, err := e.client.DescribeVpcsRequest(&awsec2.DescribeVpcsInput{
+		VpcIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if len(response.Vpcs) != 1 {
+		return managed.ExternalUpdate{}, errors.New(errMultipleItems)
+	}
+
+	if err := e.updateCIDRBlocks(ctx, cr, response.Vpcs[0]); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
 	for _, input := range []*awsec2.ModifyVpcAttributeInput{
 		{
 			VpcId:            aws.String(meta.GetExternalName(cr)),
@@ -245,7 +262,7 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
 	}
 
-	_, err := e.client.ModifyVpcTenancyRequest(&awsec2.ModifyVpcTenancyInput{
+	_, err = e.client.ModifyVpcTenancyRequest(&awsec2.ModifyVpcTenancyInput{
 		InstanceTenancy: awsec2.VpcTenancy(aws.StringValue(cr.Spec.ForProvider.InstanceTenancy)),
 		VpcId:           aws.String(meta.GetExternalName(cr)),
 	}).Send(ctx)
@@ -268,6 +285,39 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	return errors.Wrap(resource.Ignore(ec2.IsVPCNotFoundErr, err), errDelete)
 }
 
+func (e *external) updateCIDRBlocks(ctx context.Context, cr *v1beta1.VPC, vpc awsec2.Vpc) error {
+	associate, disassociate := ec2.DiffVPCCIDRBlocks(cr.Spec.ForProvider, vpc)
+	associateIPv6, disassociateIPv6 := ec2.DiffVPCIPv6CIDRBlocks(cr.Spec.ForProvider, vpc)
+
+	for _, id := range append(disassociate, disassociateIPv6...) {
+		if _, err := e.client.DisassociateVpcCidrBlockRequest(&awsec2.DisassociateVpcCidrBlockInput{
+			AssociationId: aws.String(id),
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDisassociateCIDR)
+		}
+	}
+
+	for _, cidr := range associate {
+		if _, err := e.client.AssociateVpcCidrBlockRequest(&awsec2.AssociateVpcCidrBlockInput{
+			VpcId:     aws.String(meta.GetExternalName(cr)),
+			CidrBlock: aws.String(cidr),
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errAssociateCIDR)
+		}
+	}
+
+	if associateIPv6 {
+		if _, err := e.client.AssociateVpcCidrBlockRequest(&awsec2.AssociateVpcCidrBlockInput{
+			VpcId:                       aws.String(meta.GetExternalName(cr)),
+			AmazonProvidedIpv6CidrBlock: aws.Bool(true),
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errAssociateCIDR)
+		}
+	}
+
+	return nil
+}
+
 type tagger struct {
 	kube client.Client
 }
@@ -60,6 +60,10 @@ var (
 	cidr           = "192.168.0.0/32"
 	tenancyDefault = "default"
 
+	secondaryCIDR          = "10.1.0.0/16"
+	otherSecondaryCIDR     = "10.2.0.0/16"
+	secondaryAssociationID = "some association"
+
 	errBoom = errors.New("boom")
 )
 
@@ -114,6 +118,16 @@ func vpc(m ...vpcModifier) *v1beta1.VPC {
 var _ managed.ExternalClient = &external{}
 var _ managed.ExternalConnecter = &connector{}
 
+func describe(v awsec2.Vpc) func(*awsec2.DescribeVpcsInput) awsec2.DescribeVpcsRequest {
+	return func(*awsec2.DescribeVpcsInput) awsec2.DescribeVpcsRequest {
+		return awsec2.DescribeVpcsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVpcsOutput{
+				Vpcs: []awsec2.Vpc{v},
+			}},
+		}
+	}
+}
+
 func TestConnect(t *testing.T) {
 	secret := corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -321,8 +335,9 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				cr: vpc(withSpec(v1beta1.VPCParameters{
-					InstanceTenancy: aws.String(tenancyDefault),
-					CIDRBlock:       cidr,
+					InstanceTenancy:             aws.String(tenancyDefault),
+					CIDRBlock:                   cidr,
+					AmazonProvidedIPv6CIDRBlock: aws.Bool(false),
 				}), withStatus(v1beta1.VPCObservation{
 					VPCState: "available",
 				}), withExternalName(vpcID),
@@ -500,6 +515,7 @@ func TestUpdate(t *testing.T) {
 		"Successful": {
 			args: args{
 				vpc: &fake.MockVPCClient{
+					MockDescribe: describe(awsec2.Vpc{}),
 					MockModifyTenancy: func(input *awsec2.ModifyVpcTenancyInput) awsec2.ModifyVpcTenancyRequest {
 						return awsec2.ModifyVpcTenancyRequest{
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyVpcTenancyOutput{}},
@@ -526,9 +542,95 @@ func TestUpdate(t *testing.T) {
 				})),
 			},
 		},
+		"AssociateCIDRBlocks": {
+			args: args{
+				vpc: &fake.MockVPCClient{
+					MockDescribe: describe(awsec2.Vpc{
+						CidrBlock: aws.String(cidr),
+						CidrBlockAssociationSet: []awsec2.VpcCidrBlockAssociation{
+							{
+								AssociationId:  aws.String("primary"),
+								CidrBlock:      aws.String(cidr),
+								CidrBlockState: &awsec2.VpcCidrBlockState{State: awsec2.VpcCidrBlockStateCodeAssociated},
+							},
+							{
+								AssociationId:  aws.String(secondaryAssociationID),
+								CidrBlock:      aws.String(otherSecondaryCIDR),
+								CidrBlockState: &awsec2.VpcCidrBlockState{State: awsec2.VpcCidrBlockStateCodeAssociated},
+							},
+						},
+					}),
+					MockDisassociateCIDRBlock: func(input *awsec2.DisassociateVpcCidrBlockInput) awsec2.DisassociateVpcCidrBlockRequest {
+						if diff := cmp.Diff(secondaryAssociationID, aws.StringValue(input.AssociationId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.DisassociateVpcCidrBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DisassociateVpcCidrBlockOutput{}},
+						}
+					},
+					MockAssociateCIDRBlock: func(input *awsec2.AssociateVpcCidrBlockInput) awsec2.AssociateVpcCidrBlockRequest {
+						if input.CidrBlock != nil && aws.StringValue(input.CidrBlock) != secondaryCIDR {
+							t.Errorf("unexpected CIDR block %s", aws.StringValue(input.CidrBlock))
+						}
+						return awsec2.AssociateVpcCidrBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.AssociateVpcCidrBlockOutput{}},
+						}
+					},
+					MockModifyTenancy: func(input *awsec2.ModifyVpcTenancyInput) awsec2.ModifyVpcTenancyRequest {
+						return awsec2.ModifyVpcTenancyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyVpcTenancyOutput{}},
+						}
+					},
+					MockCreateTagsRequest: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
+						}
+					},
+					MockModifyAttribute: func(input *awsec2.ModifyVpcAttributeInput) awsec2.ModifyVpcAttributeRequest {
+						return awsec2.ModifyVpcAttributeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyVpcAttributeOutput{}},
+						}
+					},
+				},
+				cr: vpc(withSpec(v1beta1.VPCParameters{
+					CIDRBlock:                   cidr,
+					SecondaryCIDRBlocks:         []string{secondaryCIDR},
+					AmazonProvidedIPv6CIDRBlock: aws.Bool(true),
+				})),
+			},
+			want: want{
+				cr: vpc(withSpec(v1beta1.VPCParameters{
+					CIDRBlock:                   cidr,
+					SecondaryCIDRBlocks:         []string{secondaryCIDR},
+					AmazonProvidedIPv6CIDRBlock: aws.Bool(true),
+				})),
+			},
+		},
+		"AssociateFailed": {
+			args: args{
+				vpc: &fake.MockVPCClient{
+					MockDescribe: describe(awsec2.Vpc{}),
+					MockAssociateCIDRBlock: func(input *awsec2.AssociateVpcCidrBlockInput) awsec2.AssociateVpcCidrBlockRequest {
+						return awsec2.AssociateVpcCidrBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: vpc(withSpec(v1beta1.VPCParameters{
+					SecondaryCIDRBlocks: []string{secondaryCIDR},
+				})),
+			},
+			want: want{
+				cr: vpc(withSpec(v1beta1.VPCParameters{
+					SecondaryCIDRBlocks: []string{secondaryCIDR},
+				})),
+				err: errors.Wrap(errBoom, errAssociateCIDR),
+			},
+		},
 		"ModifyFailed": {
 			args: args{
 				vpc: &fake.MockVPCClient{
+					MockDescribe: describe(awsec2.Vpc{}),
 					MockModifyTenancy: func(input *awsec2.ModifyVpcTenancyInput) awsec2.ModifyVpcTenancyRequest {
 						return awsec2.ModifyVpcTenancyRequest{
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
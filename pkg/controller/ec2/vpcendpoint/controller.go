@@ -0,0 +1,275 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcendpoint
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a VPCEndpoint resource"
+
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errKubeUpdateFailed  = "cannot update VPCEndpoint custom resource"
+
+	errClient        = "cannot create a new VPCEndpoint client"
+	errDescribe      = "failed to describe VPCEndpoint"
+	errMultipleItems = "retrieved multiple VPCEndpoints for the given vpcEndpointId"
+	errCreate        = "failed to create the VPCEndpoint resource"
+	errModify        = "failed to modify the VPCEndpoint resource"
+	errDelete        = "failed to delete the VPCEndpoint resource"
+	errUpToDate      = "cannot check whether the VPCEndpoint is up to date"
+	errSpecUpdate    = "cannot update spec of the VPCEndpoint custom resource"
+	errStatusUpdate  = "cannot update status of the VPCEndpoint custom resource"
+	errCreateTags    = "failed to create tags for the VPCEndpoint resource"
+)
+
+// SetupVPCEndpoint adds a controller that reconciles VPCEndpoints.
+func SetupVPCEndpoint(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha4.VPCEndpointGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha4.VPCEndpoint{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha4.VPCEndpointGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewVPCEndpointClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ec2.VPCEndpointClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha4.VPCEndpoint)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		epClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: epClient, kube: c.client}, errors.Wrap(err, errClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.client.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	epClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: epClient, kube: c.client}, errors.Wrap(err, errClient)
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.VPCEndpointClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2.VpcEndpoint, error) {
+	response, err := e.client.DescribeVpcEndpointsRequest(&awsec2.DescribeVpcEndpointsInput{
+		VpcEndpointIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.VpcEndpoints) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &response.VpcEndpoints[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha4.VPCEndpoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsVPCEndpointNotFoundErr, err), errDescribe)
+	}
+
+	// deleted endpoints are still returned for a while.
+	if observed.State == awsec2.StateDeleted {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeVPCEndpoint(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateVPCEndpointObservation(*observed)
+
+	switch observed.State {
+	case awsec2.StateAvailable:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awsec2.StatePending, awsec2.StatePendingAcceptance:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awsec2.StateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	upToDate, err := ec2.IsVPCEndpointUpToDate(cr.Spec.ForProvider, *observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: ec2.GetVPCEndpointConnectionDetails(*observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha4.VPCEndpoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	if err := e.kube.Status().Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errStatusUpdate)
+	}
+
+	result, err := e.client.CreateVpcEndpointRequest(ec2.GenerateCreateVPCEndpointInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	if result.VpcEndpoint == nil {
+		return managed.ExternalCreation{}, errors.New(errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(result.VpcEndpoint.VpcEndpointId))
+
+	return managed.ExternalCreation{
+		ConnectionDetails: ec2.GetVPCEndpointConnectionDetails(*result.VpcEndpoint),
+	}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha4.VPCEndpoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	if !v1beta1.CompareTags(cr.Spec.ForProvider.Tags, observed.Tags) {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
+	input, changed, err := ec2.GenerateModifyVPCEndpointInput(meta.GetExternalName(cr), cr.Spec.ForProvider, *observed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+	}
+	if !changed {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	_, err = e.client.ModifyVpcEndpointRequest(input).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha4.VPCEndpoint)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	response, err := e.client.DeleteVpcEndpointsRequest(&awsec2.DeleteVpcEndpointsInput{
+		VpcEndpointIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ec2.IsVPCEndpointNotFoundErr, err), errDelete)
+	}
+
+	// DeleteVpcEndpoints reports per-endpoint failures in the response rather
+	// than as an error.
+	for _, u := range response.Unsuccessful {
+		if u.Error == nil || aws.StringValue(u.Error.Code) == ec2.VPCEndpointIDNotFound {
+			continue
+		}
+		return errors.Wrap(errors.New(aws.StringValue(u.Error.Message)), errDelete)
+	}
+	return nil
+}
@@ -0,0 +1,435 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcendpoint
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+const (
+	providerName = "aws-creds"
+)
+
+var (
+	epID         = "some endpoint"
+	vpcID        = "some vpc"
+	serviceName  = "com.amazonaws.us-east-1.s3"
+	endpointType = "Gateway"
+	rtID         = "some rt"
+	dnsName      = "vpce-1.s3.us-east-1.vpce.amazonaws.com"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ep   ec2.VPCEndpointClient
+	kube client.Client
+	cr   *v1alpha4.VPCEndpoint
+}
+
+type epModifier func(*v1alpha4.VPCEndpoint)
+
+func withExternalName(name string) epModifier {
+	return func(r *v1alpha4.VPCEndpoint) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p v1alpha4.VPCEndpointParameters) epModifier {
+	return func(r *v1alpha4.VPCEndpoint) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha4.VPCEndpointObservation) epModifier {
+	return func(r *v1alpha4.VPCEndpoint) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) epModifier {
+	return func(r *v1alpha4.VPCEndpoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func endpoint(m ...epModifier) *v1alpha4.VPCEndpoint {
+	cr := &v1alpha4.VPCEndpoint{
+		Spec: v1alpha4.VPCEndpointSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func describe(eps ...awsec2.VpcEndpoint) func(*awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+	return func(*awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+		return awsec2.DescribeVpcEndpointsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVpcEndpointsOutput{
+				VpcEndpoints: eps,
+			}},
+		}
+	}
+}
+
+func params() v1alpha4.VPCEndpointParameters {
+	return v1alpha4.VPCEndpointParameters{
+		ServiceName:     serviceName,
+		VPCEndpointType: aws.String(endpointType),
+		VPCID:           aws.String(vpcID),
+		RouteTableIDs:   []string{rtID},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.VPCEndpoint
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				ep: &fake.MockVPCEndpointClient{
+					MockDescribe: describe(awsec2.VpcEndpoint{
+						VpcEndpointId:   aws.String(epID),
+						VpcEndpointType: awsec2.VpcEndpointTypeGateway,
+						VpcId:           aws.String(vpcID),
+						State:           awsec2.StateAvailable,
+						RouteTableIds:   []string{rtID},
+						DnsEntries:      []awsec2.DnsEntry{{DnsName: aws.String(dnsName)}},
+					}),
+				},
+				cr: endpoint(withSpec(params()), withExternalName(epID)),
+			},
+			want: want{
+				cr: endpoint(withSpec(params()), withExternalName(epID),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha4.VPCEndpointObservation{
+						VPCEndpointID: epID,
+						State:         string(awsec2.StateAvailable),
+						DNSEntries:    []v1alpha4.DNSEntry{{DNSName: dnsName}},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(dnsName),
+						"dnsName-0": []byte(dnsName),
+					},
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				ep: &fake.MockVPCEndpointClient{
+					MockDescribe: describe(awsec2.VpcEndpoint{
+						VpcEndpointId: aws.String(epID),
+						State:         awsec2.StateDeleted,
+					}),
+				},
+				cr: endpoint(withSpec(params()), withExternalName(epID)),
+			},
+			want: want{
+				cr: endpoint(withSpec(params()), withExternalName(epID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				ep: &fake.MockVPCEndpointClient{
+					MockDescribe: func(input *awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+						return awsec2.DescribeVpcEndpointsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: endpoint(withExternalName(epID)),
+			},
+			want: want{
+				cr:  endpoint(withExternalName(epID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: endpoint(),
+			},
+			want: want{
+				cr: endpoint(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.ep}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.VPCEndpoint
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().MockUpdate,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				ep: &fake.MockVPCEndpointClient{
+					MockCreate: func(input *awsec2.CreateVpcEndpointInput) awsec2.CreateVpcEndpointRequest {
+						if diff := cmp.Diff([]string{rtID}, input.RouteTableIds); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateVpcEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateVpcEndpointOutput{
+								VpcEndpoint: &awsec2.VpcEndpoint{VpcEndpointId: aws.String(epID)},
+							}},
+						}
+					},
+				},
+				cr: endpoint(withSpec(params())),
+			},
+			want: want{
+				cr: endpoint(withSpec(params()), withExternalName(epID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().MockUpdate,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				ep: &fake.MockVPCEndpointClient{
+					MockCreate: func(input *awsec2.CreateVpcEndpointInput) awsec2.CreateVpcEndpointRequest {
+						return awsec2.CreateVpcEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: endpoint(withSpec(params())),
+			},
+			want: want{
+				cr:  endpoint(withSpec(params()), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.ep}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.VPCEndpoint
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AddRouteTable": {
+			args: args{
+				ep: &fake.MockVPCEndpointClient{
+					MockDescribe: describe(awsec2.VpcEndpoint{VpcEndpointId: aws.String(epID)}),
+					MockModify: func(input *awsec2.ModifyVpcEndpointInput) awsec2.ModifyVpcEndpointRequest {
+						if diff := cmp.Diff([]string{rtID}, input.AddRouteTableIds); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.ModifyVpcEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyVpcEndpointOutput{}},
+						}
+					},
+				},
+				cr: endpoint(withSpec(params()), withExternalName(epID)),
+			},
+			want: want{
+				cr: endpoint(withSpec(params()), withExternalName(epID)),
+			},
+		},
+		"ModifyFail": {
+			args: args{
+				ep: &fake.MockVPCEndpointClient{
+					MockDescribe: describe(awsec2.VpcEndpoint{VpcEndpointId: aws.String(epID)}),
+					MockModify: func(input *awsec2.ModifyVpcEndpointInput) awsec2.ModifyVpcEndpointRequest {
+						return awsec2.ModifyVpcEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: endpoint(withSpec(params()), withExternalName(epID)),
+			},
+			want: want{
+				cr:  endpoint(withSpec(params()), withExternalName(epID)),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.ep}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha4.VPCEndpoint
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				ep: &fake.MockVPCEndpointClient{
+					MockDelete: func(input *awsec2.DeleteVpcEndpointsInput) awsec2.DeleteVpcEndpointsRequest {
+						return awsec2.DeleteVpcEndpointsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteVpcEndpointsOutput{}},
+						}
+					},
+				},
+				cr: endpoint(withExternalName(epID)),
+			},
+			want: want{
+				cr: endpoint(withExternalName(epID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Unsuccessful": {
+			args: args{
+				ep: &fake.MockVPCEndpointClient{
+					MockDelete: func(input *awsec2.DeleteVpcEndpointsInput) awsec2.DeleteVpcEndpointsRequest {
+						return awsec2.DeleteVpcEndpointsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteVpcEndpointsOutput{
+								Unsuccessful: []awsec2.UnsuccessfulItem{{
+									Error:      &awsec2.UnsuccessfulItemError{Code: aws.String("Boom"), Message: aws.String(errBoom.Error())},
+									ResourceId: aws.String(epID),
+								}},
+							}},
+						}
+					},
+				},
+				cr: endpoint(withExternalName(epID)),
+			},
+			want: want{
+				cr:  endpoint(withExternalName(epID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				ep: &fake.MockVPCEndpointClient{
+					MockDelete: func(input *awsec2.DeleteVpcEndpointsInput) awsec2.DeleteVpcEndpointsRequest {
+						return awsec2.DeleteVpcEndpointsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: endpoint(withExternalName(epID)),
+			},
+			want: want{
+				cr:  endpoint(withExternalName(epID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.ep}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,8 @@ package elb
 
 import (
 	"context"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awselb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
@@ -50,14 +52,27 @@ const (
 
 	errDescribe      = "cannot describe ELB with given name"
 	errDescribeTags  = "cannot describe tags for ELB with given name"
+	errDescribeAttrs = "cannot describe attributes for ELB with given name"
+	errDescribePols  = "cannot describe policies for ELB with given name"
 	errMultipleItems = "retrieved multiple ELBs for the given name"
 	errCreate        = "cannot create the ELB resource"
 	errUpdate        = "cannot update ELB resource"
 	errDelete        = "cannot delete the ELB resource"
 	errSpecUpdate    = "cannot update spec of ELB custom resource"
 	errUpToDate      = "cannot check if the resource is up to date"
+
+	errCreatePolicy          = "cannot create policy for ELB"
+	errSetListenerPolicies   = "cannot set policies of ELB listener"
+	errSetBackendPolicies    = "cannot set policies for ELB back-end server"
+	errDeletePolicy          = "cannot delete policy of ELB"
+	errRecordCreatedPolicies = "cannot record created policies of ELB custom resource"
 )
 
+// annotationKeyCreatedPolicies is the annotation under which the names of the
+// policies that were created by an ELB are kept, so that only those are
+// deleted once they are removed from its spec.
+const annotationKeyCreatedPolicies = "elasticloadbalancing.aws.crossplane.io/created-policies"
+
 // SetupELB adds a controller that reconciles ELBs.
 func SetupELB(mgr ctrl.Manager, l logging.Logger) error {
 	name := managed.ControllerName(v1alpha1.ELBGroupKind)
@@ -140,9 +155,23 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(elb.IsELBNotFound, err), errDescribeTags)
 	}
 
+	attrsResponse, err := e.client.DescribeLoadBalancerAttributesRequest(&awselb.DescribeLoadBalancerAttributesInput{
+		LoadBalancerName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(elb.IsELBNotFound, err), errDescribeAttrs)
+	}
+
+	policiesResponse, err := e.client.DescribeLoadBalancerPoliciesRequest(&awselb.DescribeLoadBalancerPoliciesInput{
+		LoadBalancerName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(elb.IsELBNotFound, err), errDescribePols)
+	}
+
 	// update the CRD spec for any new values from provider
 	current := cr.Spec.ForProvider.DeepCopy()
-	elb.LateInitializeELB(&cr.Spec.ForProvider, &observed, tagsResponse.TagDescriptions[0].Tags)
+	elb.LateInitializeELB(&cr.Spec.ForProvider, &observed, tagsResponse.TagDescriptions[0].Tags, attrsResponse.LoadBalancerAttributes)
 	if !cmp.Equal(current, &cr.Spec.ForProvider) {
 		if err := e.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
@@ -153,7 +182,8 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	cr.Status.AtProvider = elb.GenerateELBObservation(observed)
 
-	upToDate, err := elb.IsUpToDate(cr.Spec.ForProvider, observed, tagsResponse.TagDescriptions[0].Tags)
+	upToDate, err := elb.IsUpToDate(cr.Spec.ForProvider, observed, tagsResponse.TagDescriptions[0].Tags,
+		attrsResponse.LoadBalancerAttributes, policiesResponse.PolicyDescriptions, createdPolicies(cr))
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDate)
 	}
@@ -204,9 +234,23 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(elb.IsELBNotFound, err), errDescribeTags)
 	}
 
+	attrsResponse, err := e.client.DescribeLoadBalancerAttributesRequest(&awselb.DescribeLoadBalancerAttributesInput{
+		LoadBalancerName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(elb.IsELBNotFound, err), errDescribeAttrs)
+	}
+
+	policiesResponse, err := e.client.DescribeLoadBalancerPoliciesRequest(&awselb.DescribeLoadBalancerPoliciesInput{
+		LoadBalancerName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(elb.IsELBNotFound, err), errDescribePols)
+	}
+
 	// AWS ELB API doesn't have a single PUT/PATCH API.
 	// Hence, create a patch to figure which fields are to be updated.
-	patch, err := elb.CreatePatch(observed, cr.Spec.ForProvider, tagsResponse.TagDescriptions[0].Tags)
+	patch, err := elb.CreatePatch(observed, cr.Spec.ForProvider, tagsResponse.TagDescriptions[0].Tags, attrsResponse.LoadBalancerAttributes)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(elb.IsELBNotFound, err), errUpdate)
 	}
@@ -259,7 +303,16 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	return managed.ExternalUpdate{}, nil
+	if patch.Attributes != nil {
+		if _, err := e.client.ModifyLoadBalancerAttributesRequest(&awselb.ModifyLoadBalancerAttributesInput{
+			LoadBalancerName:       aws.String(meta.GetExternalName(cr)),
+			LoadBalancerAttributes: elb.GenerateLoadBalancerAttributes(cr.Spec.ForProvider.Attributes),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	return managed.ExternalUpdate{}, e.updatePolicies(ctx, cr, observed, policiesResponse.PolicyDescriptions)
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -382,6 +435,108 @@ func (e *external) updateTags(ctx context.Context, tags []v1alpha1.Tag, elbTags
 	return nil
 }
 
+func (e *external) updatePolicies(ctx context.Context, cr *v1alpha1.ELB, observed awselb.LoadBalancerDescription, policies []awselb.PolicyDescription) error {
+	p := cr.Spec.ForProvider
+	name := meta.GetExternalName(cr)
+
+	// Policies have to exist before they can be enabled for a listener or a
+	// back-end server. They are recorded before they are created so that
+	// they are never created without this resource knowing about it.
+	missing := elb.GetMissingPolicies(p.Policies, policies)
+	if len(missing) != 0 {
+		names := createdPolicies(cr)
+		for _, policy := range missing {
+			names = append(names, policy.PolicyName)
+		}
+		if err := e.setCreatedPolicies(ctx, cr, names); err != nil {
+			return err
+		}
+	}
+	for _, policy := range missing {
+		if _, err := e.client.CreateLoadBalancerPolicyRequest(elb.GenerateCreatePolicyInput(name, policy)).Send(ctx); err != nil {
+			return errors.Wrap(err, errCreatePolicy)
+		}
+	}
+
+	for _, l := range elb.GetOutdatedListenerPolicies(p.ListenerPolicies, observed.ListenerDescriptions) {
+		if _, err := e.client.SetLoadBalancerPoliciesOfListenerRequest(&awselb.SetLoadBalancerPoliciesOfListenerInput{
+			LoadBalancerName: aws.String(name),
+			LoadBalancerPort: aws.Int64(l.LoadBalancerPort),
+			PolicyNames:      l.PolicyNames,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errSetListenerPolicies)
+		}
+	}
+
+	for _, b := range elb.GetOutdatedBackendServerPolicies(p.BackendServerPolicies, observed.BackendServerDescriptions) {
+		if _, err := e.client.SetLoadBalancerPoliciesForBackendServerRequest(&awselb.SetLoadBalancerPoliciesForBackendServerInput{
+			LoadBalancerName: aws.String(name),
+			InstancePort:     aws.Int64(b.InstancePort),
+			PolicyNames:      b.PolicyNames,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errSetBackendPolicies)
+		}
+	}
+
+	// Policies can only be deleted once they are not assigned anymore.
+	obsolete := elb.GetObsoletePolicies(p, observed, policies, createdPolicies(cr))
+	for _, policy := range obsolete {
+		if _, err := e.client.DeleteLoadBalancerPolicyRequest(&awselb.DeleteLoadBalancerPolicyInput{
+			LoadBalancerName: aws.String(name),
+			PolicyName:       aws.String(policy),
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDeletePolicy)
+		}
+	}
+	if len(obsolete) != 0 {
+		return e.setCreatedPolicies(ctx, cr, stringSliceDiff(createdPolicies(cr), obsolete))
+	}
+
+	return nil
+}
+
+// createdPolicies returns the names of the policies that were created by the
+// given ELB, as recorded in its annotations.
+func createdPolicies(cr *v1alpha1.ELB) []string {
+	v := cr.GetAnnotations()[annotationKeyCreatedPolicies]
+	if v == "" {
+		return nil
+	}
+	return strings.Split(v, ",")
+}
+
+// setCreatedPolicies records the given policy names as created by the given
+// ELB. Only the annotation is patched so that the status observed during this
+// reconcile is kept.
+func (e *external) setCreatedPolicies(ctx context.Context, cr *v1alpha1.ELB, names []string) error {
+	set := map[string]struct{}{}
+	for _, n := range names {
+		set[n] = struct{}{}
+	}
+	sorted := make([]string, 0, len(set))
+	for n := range set {
+		sorted = append(sorted, n)
+	}
+	sort.Strings(sorted)
+
+	v := strings.Join(sorted, ",")
+	if cr.GetAnnotations()[annotationKeyCreatedPolicies] == v {
+		return nil
+	}
+	patched := cr.DeepCopy()
+	if v == "" {
+		meta.RemoveAnnotations(patched, annotationKeyCreatedPolicies)
+	} else {
+		meta.AddAnnotations(patched, map[string]string{annotationKeyCreatedPolicies: v})
+	}
+	if err := e.kube.Patch(ctx, patched, client.MergeFrom(cr)); err != nil {
+		return errors.Wrap(err, errRecordCreatedPolicies)
+	}
+	cr.SetAnnotations(patched.GetAnnotations())
+	cr.SetResourceVersion(patched.GetResourceVersion())
+	return nil
+}
+
 // stringSliceDiff generate a difference between given string slices a and b.
 func stringSliceDiff(a, b []string) []string {
 	mb := make(map[string]struct{}, len(b))
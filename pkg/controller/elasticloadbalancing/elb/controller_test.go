@@ -96,6 +96,12 @@ func withExternalName(name string) elbModifier {
 	return func(r *v1alpha1.ELB) { meta.SetExternalName(r, name) }
 }
 
+func withCreatedPolicies(names string) elbModifier {
+	return func(r *v1alpha1.ELB) {
+		meta.AddAnnotations(r, map[string]string{annotationKeyCreatedPolicies: names})
+	}
+}
+
 func elbResource(m ...elbModifier) *v1alpha1.ELB {
 	cr := &v1alpha1.ELB{
 		Spec: v1alpha1.ELBSpec{
@@ -308,6 +314,16 @@ func TestObserve(t *testing.T) {
 							}},
 						}
 					},
+					MockDescribeLoadBalancerAttributesRequest: func(input *awselb.DescribeLoadBalancerAttributesInput) awselb.DescribeLoadBalancerAttributesRequest {
+						return awselb.DescribeLoadBalancerAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerAttributesOutput{}},
+						}
+					},
+					MockDescribeLoadBalancerPoliciesRequest: func(input *awselb.DescribeLoadBalancerPoliciesInput) awselb.DescribeLoadBalancerPoliciesRequest {
+						return awselb.DescribeLoadBalancerPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerPoliciesOutput{}},
+						}
+					},
 				},
 				cr: elbResource(withExternalName(elbName)),
 			},
@@ -383,6 +399,16 @@ func TestObserve(t *testing.T) {
 							}},
 						}
 					},
+					MockDescribeLoadBalancerAttributesRequest: func(input *awselb.DescribeLoadBalancerAttributesInput) awselb.DescribeLoadBalancerAttributesRequest {
+						return awselb.DescribeLoadBalancerAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerAttributesOutput{}},
+						}
+					},
+					MockDescribeLoadBalancerPoliciesRequest: func(input *awselb.DescribeLoadBalancerPoliciesInput) awselb.DescribeLoadBalancerPoliciesRequest {
+						return awselb.DescribeLoadBalancerPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerPoliciesOutput{}},
+						}
+					},
 				},
 				cr: elbResource(withExternalName(elbName)),
 			},
@@ -416,6 +442,16 @@ func TestObserve(t *testing.T) {
 							}},
 						}
 					},
+					MockDescribeLoadBalancerAttributesRequest: func(input *awselb.DescribeLoadBalancerAttributesInput) awselb.DescribeLoadBalancerAttributesRequest {
+						return awselb.DescribeLoadBalancerAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerAttributesOutput{}},
+						}
+					},
+					MockDescribeLoadBalancerPoliciesRequest: func(input *awselb.DescribeLoadBalancerPoliciesInput) awselb.DescribeLoadBalancerPoliciesRequest {
+						return awselb.DescribeLoadBalancerPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerPoliciesOutput{}},
+						}
+					},
 				},
 				cr: elbResource(withExternalName(elbName),
 					withSpec(v1alpha1.ELBParameters{
@@ -573,6 +609,16 @@ func TestUpdate(t *testing.T) {
 							}},
 						}
 					},
+					MockDescribeLoadBalancerPoliciesRequest: func(input *awselb.DescribeLoadBalancerPoliciesInput) awselb.DescribeLoadBalancerPoliciesRequest {
+						return awselb.DescribeLoadBalancerPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerPoliciesOutput{}},
+						}
+					},
+					MockDescribeLoadBalancerAttributesRequest: func(input *awselb.DescribeLoadBalancerAttributesInput) awselb.DescribeLoadBalancerAttributesRequest {
+						return awselb.DescribeLoadBalancerAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerAttributesOutput{}},
+						}
+					},
 				},
 				cr: elbResource(withExternalName(elbName),
 					withSpec(v1alpha1.ELBParameters{
@@ -619,6 +665,16 @@ func TestUpdate(t *testing.T) {
 							}},
 						}
 					},
+					MockDescribeLoadBalancerPoliciesRequest: func(input *awselb.DescribeLoadBalancerPoliciesInput) awselb.DescribeLoadBalancerPoliciesRequest {
+						return awselb.DescribeLoadBalancerPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerPoliciesOutput{}},
+						}
+					},
+					MockDescribeLoadBalancerAttributesRequest: func(input *awselb.DescribeLoadBalancerAttributesInput) awselb.DescribeLoadBalancerAttributesRequest {
+						return awselb.DescribeLoadBalancerAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerAttributesOutput{}},
+						}
+					},
 				},
 				cr: elbResource(withExternalName(elbName),
 					withSpec(v1alpha1.ELBParameters{
@@ -660,6 +716,16 @@ func TestUpdate(t *testing.T) {
 							}},
 						}
 					},
+					MockDescribeLoadBalancerPoliciesRequest: func(input *awselb.DescribeLoadBalancerPoliciesInput) awselb.DescribeLoadBalancerPoliciesRequest {
+						return awselb.DescribeLoadBalancerPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerPoliciesOutput{}},
+						}
+					},
+					MockDescribeLoadBalancerAttributesRequest: func(input *awselb.DescribeLoadBalancerAttributesInput) awselb.DescribeLoadBalancerAttributesRequest {
+						return awselb.DescribeLoadBalancerAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerAttributesOutput{}},
+						}
+					},
 				},
 				cr: elbResource(withExternalName(elbName),
 					withSpec(v1alpha1.ELBParameters{
@@ -710,6 +776,16 @@ func TestUpdate(t *testing.T) {
 							}},
 						}
 					},
+					MockDescribeLoadBalancerPoliciesRequest: func(input *awselb.DescribeLoadBalancerPoliciesInput) awselb.DescribeLoadBalancerPoliciesRequest {
+						return awselb.DescribeLoadBalancerPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerPoliciesOutput{}},
+						}
+					},
+					MockDescribeLoadBalancerAttributesRequest: func(input *awselb.DescribeLoadBalancerAttributesInput) awselb.DescribeLoadBalancerAttributesRequest {
+						return awselb.DescribeLoadBalancerAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerAttributesOutput{}},
+						}
+					},
 				},
 				cr: elbResource(withExternalName(elbName),
 					withSpec(v1alpha1.ELBParameters{
@@ -737,11 +813,246 @@ func TestUpdate(t *testing.T) {
 					})),
 			},
 		},
+		"UpdateAttributesAndPolicies": {
+			args: args{
+				kube: &test.MockClient{MockPatch: test.NewMockPatchFn(nil)},
+				elb: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: func(input *awselb.DescribeLoadBalancersInput) awselb.DescribeLoadBalancersRequest {
+						return awselb.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancersOutput{
+								LoadBalancerDescriptions: []awselb.LoadBalancerDescription{
+									{ListenerDescriptions: []awselb.ListenerDescription{{Listener: &listener}}},
+								},
+							}},
+						}
+					},
+					MockDescribeTagsRequest: func(input *awselb.DescribeTagsInput) awselb.DescribeTagsRequest {
+						return awselb.DescribeTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeTagsOutput{
+								TagDescriptions: []awselb.TagDescription{
+									{LoadBalancerName: &elbName},
+								},
+							}},
+						}
+					},
+					MockDescribeLoadBalancerAttributesRequest: func(input *awselb.DescribeLoadBalancerAttributesInput) awselb.DescribeLoadBalancerAttributesRequest {
+						return awselb.DescribeLoadBalancerAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerAttributesOutput{
+								LoadBalancerAttributes: &awselb.LoadBalancerAttributes{
+									CrossZoneLoadBalancing: &awselb.CrossZoneLoadBalancing{Enabled: aws.Bool(false)},
+								},
+							}},
+						}
+					},
+					MockModifyLoadBalancerAttributesRequest: func(input *awselb.ModifyLoadBalancerAttributesInput) awselb.ModifyLoadBalancerAttributesRequest {
+						if !aws.BoolValue(input.LoadBalancerAttributes.CrossZoneLoadBalancing.Enabled) {
+							return awselb.ModifyLoadBalancerAttributesRequest{
+								Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+							}
+						}
+						return awselb.ModifyLoadBalancerAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.ModifyLoadBalancerAttributesOutput{}},
+						}
+					},
+					MockDescribeLoadBalancerPoliciesRequest: func(input *awselb.DescribeLoadBalancerPoliciesInput) awselb.DescribeLoadBalancerPoliciesRequest {
+						return awselb.DescribeLoadBalancerPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerPoliciesOutput{}},
+						}
+					},
+					MockCreateLoadBalancerPolicyRequest: func(input *awselb.CreateLoadBalancerPolicyInput) awselb.CreateLoadBalancerPolicyRequest {
+						return awselb.CreateLoadBalancerPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.CreateLoadBalancerPolicyOutput{}},
+						}
+					},
+					MockSetLoadBalancerPoliciesOfListenerRequest: func(input *awselb.SetLoadBalancerPoliciesOfListenerInput) awselb.SetLoadBalancerPoliciesOfListenerRequest {
+						return awselb.SetLoadBalancerPoliciesOfListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.SetLoadBalancerPoliciesOfListenerOutput{}},
+						}
+					},
+				},
+				cr: elbResource(withExternalName(elbName),
+					withSpec(v1alpha1.ELBParameters{
+						Attributes: &v1alpha1.LoadBalancerAttributes{
+							CrossZoneLoadBalancing: &v1alpha1.CrossZoneLoadBalancing{Enabled: true},
+						},
+						Policies:         []v1alpha1.Policy{{PolicyName: "stickiness", PolicyTypeName: "LBCookieStickinessPolicyType"}},
+						ListenerPolicies: []v1alpha1.ListenerPolicy{{LoadBalancerPort: port80, PolicyNames: []string{"stickiness"}}},
+					})),
+			},
+			want: want{
+				cr: elbResource(withExternalName(elbName), withCreatedPolicies("stickiness"),
+					withSpec(v1alpha1.ELBParameters{
+						Attributes: &v1alpha1.LoadBalancerAttributes{
+							CrossZoneLoadBalancing: &v1alpha1.CrossZoneLoadBalancing{Enabled: true},
+						},
+						Policies:         []v1alpha1.Policy{{PolicyName: "stickiness", PolicyTypeName: "LBCookieStickinessPolicyType"}},
+						ListenerPolicies: []v1alpha1.ListenerPolicy{{LoadBalancerPort: port80, PolicyNames: []string{"stickiness"}}},
+					})),
+			},
+		},
+		"DeleteObsoletePolicyFailed": {
+			args: args{
+				kube: &test.MockClient{MockPatch: test.NewMockPatchFn(nil)},
+				elb: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: func(input *awselb.DescribeLoadBalancersInput) awselb.DescribeLoadBalancersRequest {
+						return awselb.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancersOutput{
+								LoadBalancerDescriptions: []awselb.LoadBalancerDescription{
+									{ListenerDescriptions: []awselb.ListenerDescription{{Listener: &listener}}},
+								},
+							}},
+						}
+					},
+					MockDescribeTagsRequest: func(input *awselb.DescribeTagsInput) awselb.DescribeTagsRequest {
+						return awselb.DescribeTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeTagsOutput{
+								TagDescriptions: []awselb.TagDescription{
+									{LoadBalancerName: &elbName},
+								},
+							}},
+						}
+					},
+					MockDescribeLoadBalancerAttributesRequest: func(input *awselb.DescribeLoadBalancerAttributesInput) awselb.DescribeLoadBalancerAttributesRequest {
+						return awselb.DescribeLoadBalancerAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerAttributesOutput{}},
+						}
+					},
+					MockDescribeLoadBalancerPoliciesRequest: func(input *awselb.DescribeLoadBalancerPoliciesInput) awselb.DescribeLoadBalancerPoliciesRequest {
+						return awselb.DescribeLoadBalancerPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerPoliciesOutput{
+								PolicyDescriptions: []awselb.PolicyDescription{{PolicyName: aws.String("proxy")}, {PolicyName: aws.String("stickiness")}},
+							}},
+						}
+					},
+					MockDeleteLoadBalancerPolicyRequest: func(input *awselb.DeleteLoadBalancerPolicyInput) awselb.DeleteLoadBalancerPolicyRequest {
+						return awselb.DeleteLoadBalancerPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: elbResource(withExternalName(elbName), withCreatedPolicies("proxy,stickiness"),
+					withSpec(v1alpha1.ELBParameters{
+						Policies: []v1alpha1.Policy{{PolicyName: "proxy", PolicyTypeName: "ProxyProtocolPolicyType"}},
+					})),
+			},
+			want: want{
+				cr: elbResource(withExternalName(elbName), withCreatedPolicies("proxy,stickiness"),
+					withSpec(v1alpha1.ELBParameters{
+						Policies: []v1alpha1.Policy{{PolicyName: "proxy", PolicyTypeName: "ProxyProtocolPolicyType"}},
+					})),
+				err: errors.Wrap(errBoom, errDeletePolicy),
+			},
+		},
+		"DeleteObsoletePolicy": {
+			args: args{
+				kube: &test.MockClient{MockPatch: test.NewMockPatchFn(nil)},
+				elb: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: func(input *awselb.DescribeLoadBalancersInput) awselb.DescribeLoadBalancersRequest {
+						return awselb.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancersOutput{
+								LoadBalancerDescriptions: []awselb.LoadBalancerDescription{
+									{ListenerDescriptions: []awselb.ListenerDescription{{Listener: &listener}}},
+								},
+							}},
+						}
+					},
+					MockDescribeTagsRequest: func(input *awselb.DescribeTagsInput) awselb.DescribeTagsRequest {
+						return awselb.DescribeTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeTagsOutput{
+								TagDescriptions: []awselb.TagDescription{
+									{LoadBalancerName: &elbName},
+								},
+							}},
+						}
+					},
+					MockDescribeLoadBalancerAttributesRequest: func(input *awselb.DescribeLoadBalancerAttributesInput) awselb.DescribeLoadBalancerAttributesRequest {
+						return awselb.DescribeLoadBalancerAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerAttributesOutput{}},
+						}
+					},
+					MockDescribeLoadBalancerPoliciesRequest: func(input *awselb.DescribeLoadBalancerPoliciesInput) awselb.DescribeLoadBalancerPoliciesRequest {
+						return awselb.DescribeLoadBalancerPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerPoliciesOutput{
+								PolicyDescriptions: []awselb.PolicyDescription{{PolicyName: aws.String("proxy")}, {PolicyName: aws.String("stickiness")}},
+							}},
+						}
+					},
+					MockDeleteLoadBalancerPolicyRequest: func(input *awselb.DeleteLoadBalancerPolicyInput) awselb.DeleteLoadBalancerPolicyRequest {
+						return awselb.DeleteLoadBalancerPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DeleteLoadBalancerPolicyOutput{}},
+						}
+					},
+				},
+				cr: elbResource(withExternalName(elbName), withCreatedPolicies("proxy,stickiness"),
+					withSpec(v1alpha1.ELBParameters{
+						Policies: []v1alpha1.Policy{{PolicyName: "proxy", PolicyTypeName: "ProxyProtocolPolicyType"}},
+					})),
+			},
+			want: want{
+				cr: elbResource(withExternalName(elbName), withCreatedPolicies("proxy"),
+					withSpec(v1alpha1.ELBParameters{
+						Policies: []v1alpha1.Policy{{PolicyName: "proxy", PolicyTypeName: "ProxyProtocolPolicyType"}},
+					})),
+			},
+		},
+		"RecordCreatedPoliciesFailed": {
+			args: args{
+				kube: &test.MockClient{MockPatch: test.NewMockPatchFn(errBoom)},
+				elb: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: func(input *awselb.DescribeLoadBalancersInput) awselb.DescribeLoadBalancersRequest {
+						return awselb.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancersOutput{
+								LoadBalancerDescriptions: []awselb.LoadBalancerDescription{
+									{ListenerDescriptions: []awselb.ListenerDescription{{Listener: &listener}}},
+								},
+							}},
+						}
+					},
+					MockDescribeTagsRequest: func(input *awselb.DescribeTagsInput) awselb.DescribeTagsRequest {
+						return awselb.DescribeTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeTagsOutput{
+								TagDescriptions: []awselb.TagDescription{
+									{LoadBalancerName: &elbName},
+								},
+							}},
+						}
+					},
+					MockDescribeLoadBalancerAttributesRequest: func(input *awselb.DescribeLoadBalancerAttributesInput) awselb.DescribeLoadBalancerAttributesRequest {
+						return awselb.DescribeLoadBalancerAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerAttributesOutput{}},
+						}
+					},
+					MockDescribeLoadBalancerPoliciesRequest: func(input *awselb.DescribeLoadBalancerPoliciesInput) awselb.DescribeLoadBalancerPoliciesRequest {
+						return awselb.DescribeLoadBalancerPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancerPoliciesOutput{
+								PolicyDescriptions: []awselb.PolicyDescription{{PolicyName: aws.String("proxy")}, {PolicyName: aws.String("stickiness")}},
+							}},
+						}
+					},
+					MockDeleteLoadBalancerPolicyRequest: func(input *awselb.DeleteLoadBalancerPolicyInput) awselb.DeleteLoadBalancerPolicyRequest {
+						return awselb.DeleteLoadBalancerPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DeleteLoadBalancerPolicyOutput{}},
+						}
+					},
+				},
+				cr: elbResource(withExternalName(elbName), withCreatedPolicies("proxy,stickiness"),
+					withSpec(v1alpha1.ELBParameters{
+						Policies: []v1alpha1.Policy{{PolicyName: "proxy", PolicyTypeName: "ProxyProtocolPolicyType"}},
+					})),
+			},
+			want: want{
+				cr: elbResource(withExternalName(elbName), withCreatedPolicies("proxy,stickiness"),
+					withSpec(v1alpha1.ELBParameters{
+						Policies: []v1alpha1.Policy{{PolicyName: "proxy", PolicyTypeName: "ProxyProtocolPolicyType"}},
+					})),
+				err: errors.Wrap(errBoom, errRecordCreatedPolicies),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.elb}
+			e := &external{client: tc.elb, kube: tc.kube}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -18,6 +18,8 @@ package elbattachment
 
 import (
 	"context"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awselb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
@@ -30,13 +32,13 @@ import (
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	v1alpha1 "github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
-	"github.com/crossplane/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane/provider-aws/pkg/clients/elasticloadbalancing/elb"
 )
 
@@ -47,12 +49,18 @@ const (
 	errGetProvider       = "cannot get provider"
 	errGetProviderSecret = "cannot get provider secret"
 
-	errDescribe      = "failed to list instances for given ELB"
-	errMultipleItems = "retrieved multiple ELBs for the given name"
-	errCreate        = "failed to register instance to ELB"
-	errDelete        = "failed to deregister instance from the ELB"
+	errDescribe  = "failed to list instances for given ELB"
+	errCreate    = "failed to register instance to ELB"
+	errDelete    = "failed to deregister instance from the ELB"
+	errKubePatch = "cannot patch ELBAttachment custom resource"
 )
 
+// annotationKeyRegisteredInstances is the annotation under which the IDs of
+// the instances that were registered by an ELBAttachment are kept, so that
+// instances removed from its spec can be deregistered even if its status was
+// lost.
+const annotationKeyRegisteredInstances = "elasticloadbalancing.aws.crossplane.io/registered-instances"
+
 // SetupELBAttachment adds a controller that reconciles ELBAttachmets.
 func SetupELBAttachment(mgr ctrl.Manager, l logging.Logger) error {
 	name := managed.ControllerName(v1alpha1.ELBAttachmentGroupKind)
@@ -108,42 +116,36 @@ type external struct {
 	client elb.Client
 }
 
-func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
 	cr, ok := mgd.(*v1alpha1.ELBAttachment)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
 	}
 
-	response, err := e.client.DescribeLoadBalancersRequest(&awselb.DescribeLoadBalancersInput{
-		LoadBalancerNames: []string{cr.Spec.ForProvider.ELBName},
+	response, err := e.client.DescribeInstanceHealthRequest(&awselb.DescribeInstanceHealthInput{
+		LoadBalancerName: aws.String(cr.Spec.ForProvider.ELBName),
 	}).Send(ctx)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(elb.IsELBNotFound, err), errDescribe)
 	}
 
-	// in a successful response, there should be one and only one object
-	if len(response.LoadBalancerDescriptions) != 1 {
-		return managed.ExternalObservation{}, errors.New(errMultipleItems)
-	}
-
-	observed := response.LoadBalancerDescriptions[0]
+	// Only the instances that are desired or that were registered by this
+	// attachment before are considered, so that multiple attachments can
+	// share the same ELB.
+	desired := elb.GetAttachmentInstanceIDs(cr.Spec.ForProvider)
+	cr.Status.AtProvider.Instances = elb.GenerateInstanceHealth(response.InstanceStates,
+		append(desired, ownedInstanceIDs(cr)...))
 
-	var instance string
-	for k, v := range observed.Instances {
-		if *v.InstanceId == cr.Spec.ForProvider.InstanceID {
-			instance = aws.StringValue(observed.Instances[k].InstanceId)
-		}
-	}
-
-	if instance == "" {
+	if len(cr.Status.AtProvider.Instances) == 0 {
 		return managed.ExternalObservation{}, nil
 	}
 
 	cr.Status.SetConditions(runtimev1alpha1.Available())
 
+	registered := registeredInstanceIDs(cr)
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: true,
+		ResourceUpToDate: len(stringSliceDiff(desired, registered)) == 0 && len(stringSliceDiff(registered, desired)) == 0,
 	}, nil
 }
 
@@ -155,8 +157,19 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 
 	cr.Status.SetConditions(runtimev1alpha1.Creating())
 
+	ids := elb.GetAttachmentInstanceIDs(cr.Spec.ForProvider)
+	if len(ids) == 0 {
+		return managed.ExternalCreation{}, nil
+	}
+
+	// The instances are recorded before they are registered so that they
+	// are never registered without this attachment knowing about it.
+	if err := e.setOwnedInstanceIDs(ctx, cr, append(ownedInstanceIDs(cr), ids...)); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
 	_, err := e.client.RegisterInstancesWithLoadBalancerRequest(&awselb.RegisterInstancesWithLoadBalancerInput{
-		Instances:        []awselb.Instance{{InstanceId: aws.String(cr.Spec.ForProvider.InstanceID)}},
+		Instances:        elb.BuildELBInstances(ids),
 		LoadBalancerName: aws.String(cr.Spec.ForProvider.ELBName),
 	}).Send(ctx)
 
@@ -164,7 +177,36 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 }
 
 func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
-	return managed.ExternalUpdate{}, nil
+	cr, ok := mgd.(*v1alpha1.ELBAttachment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	desired := elb.GetAttachmentInstanceIDs(cr.Spec.ForProvider)
+	registered := registeredInstanceIDs(cr)
+
+	if add := stringSliceDiff(desired, registered); len(add) != 0 {
+		if err := e.setOwnedInstanceIDs(ctx, cr, append(ownedInstanceIDs(cr), add...)); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		if _, err := e.client.RegisterInstancesWithLoadBalancerRequest(&awselb.RegisterInstancesWithLoadBalancerInput{
+			Instances:        elb.BuildELBInstances(add),
+			LoadBalancerName: aws.String(cr.Spec.ForProvider.ELBName),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errCreate)
+		}
+	}
+
+	if remove := stringSliceDiff(registered, desired); len(remove) != 0 {
+		if _, err := e.client.DeregisterInstancesFromLoadBalancerRequest(&awselb.DeregisterInstancesFromLoadBalancerInput{
+			Instances:        elb.BuildELBInstances(remove),
+			LoadBalancerName: aws.String(cr.Spec.ForProvider.ELBName),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDelete)
+		}
+	}
+
+	return managed.ExternalUpdate{}, e.setOwnedInstanceIDs(ctx, cr, desired)
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -175,10 +217,84 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(runtimev1alpha1.Deleting())
 
+	ids := ownedInstanceIDs(cr)
+	ids = append(ids, stringSliceDiff(elb.GetAttachmentInstanceIDs(cr.Spec.ForProvider), ids)...)
+	if len(ids) == 0 {
+		return nil
+	}
+
 	_, err := e.client.DeregisterInstancesFromLoadBalancerRequest(&awselb.DeregisterInstancesFromLoadBalancerInput{
-		Instances:        []awselb.Instance{{InstanceId: aws.String(cr.Spec.ForProvider.InstanceID)}},
+		Instances:        elb.BuildELBInstances(ids),
 		LoadBalancerName: aws.String(cr.Spec.ForProvider.ELBName),
 	}).Send(ctx)
 
-	return errors.Wrap(resource.Ignore(ec2.IsVPCNotFoundErr, err), errDelete)
+	return errors.Wrap(resource.Ignore(elb.IsELBNotFound, err), errDelete)
+}
+
+// registeredInstanceIDs returns the IDs of the instances that were last
+// observed as registered by the given attachment.
+func registeredInstanceIDs(cr *v1alpha1.ELBAttachment) []string {
+	ids := make([]string, len(cr.Status.AtProvider.Instances))
+	for i, v := range cr.Status.AtProvider.Instances {
+		ids[i] = v.InstanceID
+	}
+	return ids
+}
+
+// ownedInstanceIDs returns the IDs of the instances that were registered by
+// the given attachment, as recorded in its annotations or last observed in its
+// status.
+func ownedInstanceIDs(cr *v1alpha1.ELBAttachment) []string {
+	ids := registeredInstanceIDs(cr)
+	if v := cr.GetAnnotations()[annotationKeyRegisteredInstances]; v != "" {
+		ids = append(ids, stringSliceDiff(strings.Split(v, ","), ids)...)
+	}
+	return ids
+}
+
+// setOwnedInstanceIDs records the given instance IDs as registered by the given
+// attachment. Only the annotation is patched so that the status observed
+// during this reconcile is kept.
+func (e *external) setOwnedInstanceIDs(ctx context.Context, cr *v1alpha1.ELBAttachment, ids []string) error {
+	set := map[string]struct{}{}
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	sorted := make([]string, 0, len(set))
+	for id := range set {
+		sorted = append(sorted, id)
+	}
+	sort.Strings(sorted)
+
+	v := strings.Join(sorted, ",")
+	if cr.GetAnnotations()[annotationKeyRegisteredInstances] == v {
+		return nil
+	}
+	patched := cr.DeepCopy()
+	if v == "" {
+		meta.RemoveAnnotations(patched, annotationKeyRegisteredInstances)
+	} else {
+		meta.AddAnnotations(patched, map[string]string{annotationKeyRegisteredInstances: v})
+	}
+	if err := e.kube.Patch(ctx, patched, client.MergeFrom(cr)); err != nil {
+		return errors.Wrap(err, errKubePatch)
+	}
+	cr.SetAnnotations(patched.GetAnnotations())
+	cr.SetResourceVersion(patched.GetResourceVersion())
+	return nil
+}
+
+// stringSliceDiff generate a difference between given string slices a and b.
+func stringSliceDiff(a, b []string) []string {
+	mb := make(map[string]struct{}, len(b))
+	for _, x := range b {
+		mb[x] = struct{}{}
+	}
+	var diff []string
+	for _, x := range a {
+		if _, found := mb[x]; !found {
+			diff = append(diff, x)
+		}
+	}
+	return diff
 }
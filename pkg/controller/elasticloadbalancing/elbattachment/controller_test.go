@@ -48,15 +48,17 @@ import (
 var (
 	elbName    = "some-elb"
 	instanceID = "someID"
+	otherID    = "otherID"
 
 	errBoom = errors.New("boom")
 
-	loadBalancer = awselb.LoadBalancerDescription{
-		Instances: []awselb.Instance{
-			{
-				InstanceId: &instanceID,
-			},
-		},
+	instanceState = awselb.InstanceState{
+		InstanceId: &instanceID,
+		State:      aws.String("InService"),
+	}
+	otherState = awselb.InstanceState{
+		InstanceId: &otherID,
+		State:      aws.String("InService"),
 	}
 )
 
@@ -71,8 +73,9 @@ const (
 )
 
 type args struct {
-	elb elb.Client
-	cr  resource.Managed
+	elb  elb.Client
+	kube client.Client
+	cr   resource.Managed
 }
 
 type elbAttachmentModifier func(*v1alpha1.ELBAttachment)
@@ -85,6 +88,16 @@ func withSpec(p v1alpha1.ELBAttachmentParameters) elbAttachmentModifier {
 	return func(r *v1alpha1.ELBAttachment) { r.Spec.ForProvider = p }
 }
 
+func withInstances(h ...v1alpha1.InstanceHealth) elbAttachmentModifier {
+	return func(r *v1alpha1.ELBAttachment) { r.Status.AtProvider.Instances = h }
+}
+
+func withRegisteredInstances(ids string) elbAttachmentModifier {
+	return func(r *v1alpha1.ELBAttachment) {
+		meta.AddAnnotations(r, map[string]string{annotationKeyRegisteredInstances: ids})
+	}
+}
+
 func withExternalName(name string) elbAttachmentModifier {
 	return func(r *v1alpha1.ELBAttachment) { meta.SetExternalName(r, name) }
 }
@@ -275,6 +288,16 @@ func TestObserve(t *testing.T) {
 		err    error
 	}
 
+	describe := func(states ...awselb.InstanceState) func(*awselb.DescribeInstanceHealthInput) awselb.DescribeInstanceHealthRequest {
+		return func(input *awselb.DescribeInstanceHealthInput) awselb.DescribeInstanceHealthRequest {
+			return awselb.DescribeInstanceHealthRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeInstanceHealthOutput{
+					InstanceStates: states,
+				}},
+			}
+		}
+	}
+
 	cases := map[string]struct {
 		args
 		want
@@ -282,13 +305,7 @@ func TestObserve(t *testing.T) {
 		"Successful": {
 			args: args{
 				elb: &fake.MockClient{
-					MockDescribeLoadBalancersRequest: func(input *awselb.DescribeLoadBalancersInput) awselb.DescribeLoadBalancersRequest {
-						return awselb.DescribeLoadBalancersRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancersOutput{
-								LoadBalancerDescriptions: []awselb.LoadBalancerDescription{loadBalancer},
-							}},
-						}
-					},
+					MockDescribeInstanceHealthRequest: describe(instanceState, otherState),
 				},
 				cr: elbAttachmentResource(withExternalName(elbName),
 					withSpec(v1alpha1.ELBAttachmentParameters{
@@ -302,6 +319,7 @@ func TestObserve(t *testing.T) {
 					InstanceID: instanceID,
 				}),
 					withExternalName(elbName),
+					withInstances(v1alpha1.InstanceHealth{InstanceID: instanceID, State: "InService"}),
 					withConditions(corev1alpha1.Available())),
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
@@ -309,24 +327,101 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"MissingInstance": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeInstanceHealthRequest: describe(instanceState),
+				},
+				cr: elbAttachmentResource(withSpec(v1alpha1.ELBAttachmentParameters{
+					ELBName:     elbName,
+					InstanceIDs: []string{instanceID, otherID},
+				})),
+			},
+			want: want{
+				cr: elbAttachmentResource(withSpec(v1alpha1.ELBAttachmentParameters{
+					ELBName:     elbName,
+					InstanceIDs: []string{instanceID, otherID},
+				}),
+					withInstances(v1alpha1.InstanceHealth{InstanceID: instanceID, State: "InService"}),
+					withConditions(corev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"RemovedInstance": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeInstanceHealthRequest: describe(instanceState, otherState),
+				},
+				cr: elbAttachmentResource(withSpec(v1alpha1.ELBAttachmentParameters{
+					ELBName:     elbName,
+					InstanceIDs: []string{instanceID},
+				}),
+					withInstances(
+						v1alpha1.InstanceHealth{InstanceID: instanceID},
+						v1alpha1.InstanceHealth{InstanceID: otherID},
+					)),
+			},
+			want: want{
+				cr: elbAttachmentResource(withSpec(v1alpha1.ELBAttachmentParameters{
+					ELBName:     elbName,
+					InstanceIDs: []string{instanceID},
+				}),
+					withInstances(
+						v1alpha1.InstanceHealth{InstanceID: instanceID, State: "InService"},
+						v1alpha1.InstanceHealth{InstanceID: otherID, State: "InService"},
+					),
+					withConditions(corev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"RemovedRecordedInstance": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeInstanceHealthRequest: describe(instanceState, otherState),
+				},
+				cr: elbAttachmentResource(withSpec(v1alpha1.ELBAttachmentParameters{
+					ELBName:     elbName,
+					InstanceIDs: []string{instanceID},
+				}),
+					withRegisteredInstances(instanceID+","+otherID)),
+			},
+			want: want{
+				cr: elbAttachmentResource(withSpec(v1alpha1.ELBAttachmentParameters{
+					ELBName:     elbName,
+					InstanceIDs: []string{instanceID},
+				}),
+					withRegisteredInstances(instanceID+","+otherID),
+					withInstances(
+						v1alpha1.InstanceHealth{InstanceID: instanceID, State: "InService"},
+						v1alpha1.InstanceHealth{InstanceID: otherID, State: "InService"},
+					),
+					withConditions(corev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"NoAttachment": {
 			args: args{
 				elb: &fake.MockClient{
-					MockDescribeLoadBalancersRequest: func(input *awselb.DescribeLoadBalancersInput) awselb.DescribeLoadBalancersRequest {
-						return awselb.DescribeLoadBalancersRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancersOutput{
-								LoadBalancerDescriptions: []awselb.LoadBalancerDescription{loadBalancer},
-							}},
-						}
-					},
+					MockDescribeInstanceHealthRequest: describe(otherState),
 				},
 				cr: elbAttachmentResource(withSpec(v1alpha1.ELBAttachmentParameters{
-					ELBName: elbName,
+					ELBName:    elbName,
+					InstanceID: instanceID,
 				})),
 			},
 			want: want{
 				cr: elbAttachmentResource(withSpec(v1alpha1.ELBAttachmentParameters{
-					ELBName: elbName,
+					ELBName:    elbName,
+					InstanceID: instanceID,
 				})),
 				result: managed.ExternalObservation{
 					ResourceExists:   false,
@@ -337,8 +432,8 @@ func TestObserve(t *testing.T) {
 		"DescribeError": {
 			args: args{
 				elb: &fake.MockClient{
-					MockDescribeLoadBalancersRequest: func(input *awselb.DescribeLoadBalancersInput) awselb.DescribeLoadBalancersRequest {
-						return awselb.DescribeLoadBalancersRequest{
+					MockDescribeInstanceHealthRequest: func(input *awselb.DescribeInstanceHealthInput) awselb.DescribeInstanceHealthRequest {
+						return awselb.DescribeInstanceHealthRequest{
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
 						}
 					},
@@ -360,7 +455,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.elb}
+			e := &external{kube: tc.kube, client: tc.elb}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -397,6 +492,9 @@ func TestCreate(t *testing.T) {
 						}
 					},
 				},
+				kube: &test.MockClient{
+					MockPatch: test.NewMockPatchFn(nil),
+				},
 				cr: elbAttachmentResource(withExternalName(elbName),
 					withSpec(v1alpha1.ELBAttachmentParameters{
 						ELBName:    elbName,
@@ -409,6 +507,7 @@ func TestCreate(t *testing.T) {
 						ELBName:    elbName,
 						InstanceID: instanceID,
 					}),
+					withRegisteredInstances(instanceID),
 					withConditions(corev1alpha1.Creating())),
 			},
 		},
@@ -421,6 +520,9 @@ func TestCreate(t *testing.T) {
 						}
 					},
 				},
+				kube: &test.MockClient{
+					MockPatch: test.NewMockPatchFn(nil),
+				},
 				cr: elbAttachmentResource(withExternalName(elbName),
 					withSpec(v1alpha1.ELBAttachmentParameters{
 						ELBName:    elbName,
@@ -433,15 +535,37 @@ func TestCreate(t *testing.T) {
 						ELBName:    elbName,
 						InstanceID: instanceID,
 					}),
+					withRegisteredInstances(instanceID),
 					withConditions(corev1alpha1.Creating())),
 				err: errors.Wrap(errBoom, errCreate),
 			},
 		},
+		"KubePatchError": {
+			args: args{
+				kube: &test.MockClient{
+					MockPatch: test.NewMockPatchFn(errBoom),
+				},
+				cr: elbAttachmentResource(withExternalName(elbName),
+					withSpec(v1alpha1.ELBAttachmentParameters{
+						ELBName:    elbName,
+						InstanceID: instanceID,
+					})),
+			},
+			want: want{
+				cr: elbAttachmentResource(withExternalName(elbName),
+					withSpec(v1alpha1.ELBAttachmentParameters{
+						ELBName:    elbName,
+						InstanceID: instanceID,
+					}),
+					withConditions(corev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errKubePatch),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.elb}
+			e := &external{kube: tc.kube, client: tc.elb}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -457,6 +581,135 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestUpdate(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"RegisterAndDeregister": {
+			args: args{
+				elb: &fake.MockClient{
+					MockRegisterInstancesWithLoadBalancerRequest: func(input *awselb.RegisterInstancesWithLoadBalancerInput) awselb.RegisterInstancesWithLoadBalancerRequest {
+						if diff := cmp.Diff([]awselb.Instance{{InstanceId: &otherID}}, input.Instances); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselb.RegisterInstancesWithLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.RegisterInstancesWithLoadBalancerOutput{}},
+						}
+					},
+					MockDeregisterInstancesFromLoadBalancerRequest: func(input *awselb.DeregisterInstancesFromLoadBalancerInput) awselb.DeregisterInstancesFromLoadBalancerRequest {
+						if diff := cmp.Diff([]awselb.Instance{{InstanceId: &instanceID}}, input.Instances); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselb.DeregisterInstancesFromLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DeregisterInstancesFromLoadBalancerOutput{}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockPatch: test.NewMockPatchFn(nil),
+				},
+				cr: elbAttachmentResource(withSpec(v1alpha1.ELBAttachmentParameters{
+					ELBName:     elbName,
+					InstanceIDs: []string{otherID},
+				}),
+					withInstances(v1alpha1.InstanceHealth{InstanceID: instanceID})),
+			},
+			want: want{
+				cr: elbAttachmentResource(withSpec(v1alpha1.ELBAttachmentParameters{
+					ELBName:     elbName,
+					InstanceIDs: []string{otherID},
+				}),
+					withRegisteredInstances(otherID),
+					withInstances(v1alpha1.InstanceHealth{InstanceID: instanceID})),
+			},
+		},
+		"RegisterError": {
+			args: args{
+				elb: &fake.MockClient{
+					MockRegisterInstancesWithLoadBalancerRequest: func(input *awselb.RegisterInstancesWithLoadBalancerInput) awselb.RegisterInstancesWithLoadBalancerRequest {
+						return awselb.RegisterInstancesWithLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockPatch: test.NewMockPatchFn(nil),
+				},
+				cr: elbAttachmentResource(withSpec(v1alpha1.ELBAttachmentParameters{
+					ELBName:     elbName,
+					InstanceIDs: []string{otherID},
+				})),
+			},
+			want: want{
+				cr: elbAttachmentResource(withSpec(v1alpha1.ELBAttachmentParameters{
+					ELBName:     elbName,
+					InstanceIDs: []string{otherID},
+				}),
+					withRegisteredInstances(otherID)),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"DeregisterRecordedInstance": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDeregisterInstancesFromLoadBalancerRequest: func(input *awselb.DeregisterInstancesFromLoadBalancerInput) awselb.DeregisterInstancesFromLoadBalancerRequest {
+						if diff := cmp.Diff([]awselb.Instance{{InstanceId: &otherID}}, input.Instances); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselb.DeregisterInstancesFromLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DeregisterInstancesFromLoadBalancerOutput{}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockPatch: test.NewMockPatchFn(nil),
+				},
+				cr: elbAttachmentResource(withSpec(v1alpha1.ELBAttachmentParameters{
+					ELBName:     elbName,
+					InstanceIDs: []string{instanceID},
+				}),
+					withRegisteredInstances(instanceID+","+otherID),
+					withInstances(
+						v1alpha1.InstanceHealth{InstanceID: instanceID},
+						v1alpha1.InstanceHealth{InstanceID: otherID},
+					)),
+			},
+			want: want{
+				cr: elbAttachmentResource(withSpec(v1alpha1.ELBAttachmentParameters{
+					ELBName:     elbName,
+					InstanceIDs: []string{instanceID},
+				}),
+					withRegisteredInstances(instanceID),
+					withInstances(
+						v1alpha1.InstanceHealth{InstanceID: instanceID},
+						v1alpha1.InstanceHealth{InstanceID: otherID},
+					)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elb}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 
 	type want struct {
@@ -477,10 +730,12 @@ func TestDelete(t *testing.T) {
 						}
 					},
 				},
-				cr: elbAttachmentResource(withExternalName(elbName)),
+				cr: elbAttachmentResource(withExternalName(elbName),
+					withSpec(v1alpha1.ELBAttachmentParameters{ELBName: elbName, InstanceID: instanceID})),
 			},
 			want: want{
 				cr: elbAttachmentResource(withExternalName(elbName),
+					withSpec(v1alpha1.ELBAttachmentParameters{ELBName: elbName, InstanceID: instanceID}),
 					withConditions(corev1alpha1.Deleting())),
 			},
 		},
@@ -493,10 +748,12 @@ func TestDelete(t *testing.T) {
 						}
 					},
 				},
-				cr: elbAttachmentResource(withExternalName(elbName)),
+				cr: elbAttachmentResource(withExternalName(elbName),
+					withSpec(v1alpha1.ELBAttachmentParameters{ELBName: elbName, InstanceID: instanceID})),
 			},
 			want: want{
 				cr: elbAttachmentResource(withExternalName(elbName),
+					withSpec(v1alpha1.ELBAttachmentParameters{ELBName: elbName, InstanceID: instanceID}),
 					withConditions(corev1alpha1.Deleting())),
 				err: errors.Wrap(errBoom, errDelete),
 			},
@@ -505,7 +762,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.elb}
+			e := &external{kube: tc.kube, client: tc.elb}
 			err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
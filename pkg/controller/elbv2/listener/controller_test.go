@@ -0,0 +1,410 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package listener
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2/fake"
+)
+
+const (
+	providerName = "aws-creds"
+)
+
+var (
+	lbARN       = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/some-lb/50dc6c495c0c9188"
+	listenerARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:listener/app/some-lb/50dc6c495c0c9188/f2f7dc8efc522ab2"
+	tgARN       = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/some-tg/73e2d6bc24d8a067"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	elb  elbv2.Client
+	kube client.Client
+	cr   *v1alpha1.Listener
+}
+
+type listenerModifier func(*v1alpha1.Listener)
+
+func withExternalName(n string) listenerModifier {
+	return func(r *v1alpha1.Listener) { meta.SetExternalName(r, n) }
+}
+
+func withSpec(p v1alpha1.ListenerParameters) listenerModifier {
+	return func(r *v1alpha1.Listener) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.ListenerObservation) listenerModifier {
+	return func(r *v1alpha1.Listener) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) listenerModifier {
+	return func(r *v1alpha1.Listener) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func listener(m ...listenerModifier) *v1alpha1.Listener {
+	cr := &v1alpha1.Listener{
+		Spec: v1alpha1.ListenerSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params() v1alpha1.ListenerParameters {
+	return v1alpha1.ListenerParameters{
+		LoadBalancerARN: aws.String(lbARN),
+		Port:            80,
+		Protocol:        "HTTP",
+		DefaultActions: []v1alpha1.Action{{
+			Type:           "forward",
+			TargetGroupARN: aws.String(tgARN),
+		}},
+	}
+}
+
+func observed(port int64) awselbv2.Listener {
+	return awselbv2.Listener{
+		ListenerArn:     aws.String(listenerARN),
+		LoadBalancerArn: aws.String(lbARN),
+		Port:            aws.Int64(port),
+		Protocol:        awselbv2.ProtocolEnumHttp,
+		DefaultActions: []awselbv2.Action{{
+			Type:           awselbv2.ActionTypeEnumForward,
+			TargetGroupArn: aws.String(tgARN),
+		}},
+	}
+}
+
+func describe(ls ...awselbv2.Listener) func(*awselbv2.DescribeListenersInput) awselbv2.DescribeListenersRequest {
+	return func(*awselbv2.DescribeListenersInput) awselbv2.DescribeListenersRequest {
+		return awselbv2.DescribeListenersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeListenersOutput{Listeners: ls}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Listener
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeListenersRequest: describe(observed(80)),
+				},
+				cr: listener(withExternalName(listenerARN), withSpec(params())),
+			},
+			want: want{
+				cr: listener(withExternalName(listenerARN), withSpec(params()),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha1.ListenerObservation{ListenerARN: listenerARN})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PortChanged": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeListenersRequest: describe(observed(8080)),
+				},
+				cr: listener(withExternalName(listenerARN), withSpec(params())),
+			},
+			want: want{
+				cr: listener(withExternalName(listenerARN), withSpec(params()),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha1.ListenerObservation{ListenerARN: listenerARN})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: listener(withSpec(params())),
+			},
+			want: want{
+				cr: listener(withSpec(params())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeListenersRequest: func(*awselbv2.DescribeListenersInput) awselbv2.DescribeListenersRequest {
+						return awselbv2.DescribeListenersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awselbv2.ErrCodeListenerNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN), withSpec(params())),
+			},
+			want: want{
+				cr: listener(withExternalName(listenerARN), withSpec(params())),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeListenersRequest: func(*awselbv2.DescribeListenersInput) awselbv2.DescribeListenersRequest {
+						return awselbv2.DescribeListenersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN), withSpec(params())),
+			},
+			want: want{
+				cr:  listener(withExternalName(listenerARN), withSpec(params())),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elb}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Listener
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().MockUpdate,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				elb: &fake.MockClient{
+					MockCreateListenerRequest: func(in *awselbv2.CreateListenerInput) awselbv2.CreateListenerRequest {
+						if diff := cmp.Diff(elbv2.GenerateCreateListenerInput(params()), in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.CreateListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateListenerOutput{
+								Listeners: []awselbv2.Listener{observed(80)},
+							}},
+						}
+					},
+				},
+				cr: listener(withSpec(params())),
+			},
+			want: want{
+				cr: listener(withExternalName(listenerARN), withSpec(params()), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				kube: &test.MockClient{
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				elb: &fake.MockClient{
+					MockCreateListenerRequest: func(*awselbv2.CreateListenerInput) awselbv2.CreateListenerRequest {
+						return awselbv2.CreateListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: listener(withSpec(params())),
+			},
+			want: want{
+				cr:  listener(withSpec(params()), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elb}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elb: &fake.MockClient{
+					MockModifyListenerRequest: func(in *awselbv2.ModifyListenerInput) awselbv2.ModifyListenerRequest {
+						if diff := cmp.Diff(elbv2.GenerateModifyListenerInput(listenerARN, params()), in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.ModifyListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.ModifyListenerOutput{}},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN), withSpec(params())),
+			},
+		},
+		"ModifyFail": {
+			args: args{
+				elb: &fake.MockClient{
+					MockModifyListenerRequest: func(*awselbv2.ModifyListenerInput) awselbv2.ModifyListenerRequest {
+						return awselbv2.ModifyListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN), withSpec(params())),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elb}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Listener
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDeleteListenerRequest: func(*awselbv2.DeleteListenerInput) awselbv2.DeleteListenerRequest {
+						return awselbv2.DeleteListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DeleteListenerOutput{}},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN)),
+			},
+			want: want{
+				cr: listener(withExternalName(listenerARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDeleteListenerRequest: func(*awselbv2.DeleteListenerInput) awselbv2.DeleteListenerRequest {
+						return awselbv2.DeleteListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN)),
+			},
+			want: want{
+				cr:  listener(withExternalName(listenerARN), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elb}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
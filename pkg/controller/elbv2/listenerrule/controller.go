@@ -0,0 +1,222 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package listenerrule
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a ListenerRule resource"
+
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errClient            = "cannot create a new ListenerRule client"
+
+	errDescribe      = "failed to describe ListenerRule with given ARN"
+	errMultipleItems = "retrieved multiple ListenerRules for the given ARN"
+	errSpecUpdate    = "cannot update spec of ListenerRule custom resource"
+	errStatusUpdate  = "cannot update status of ListenerRule custom resource"
+	errCreate        = "failed to create the ListenerRule resource"
+	errModify        = "failed to modify the ListenerRule resource"
+	errSetPriority   = "failed to set the priority of the ListenerRule"
+	errDelete        = "failed to delete the ListenerRule resource"
+)
+
+// SetupListenerRule adds a controller that reconciles ListenerRules.
+func SetupListenerRule(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ListenerRuleGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ListenerRule{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ListenerRuleGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elbv2.NewClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (elbv2.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ListenerRule)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		elbClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: elbClient, kube: c.kube}, errors.Wrap(err, errClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	elbClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: elbClient, kube: c.kube}, errors.Wrap(err, errClient)
+}
+
+type external struct {
+	kube   client.Client
+	client elbv2.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.ListenerRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	rsp, err := e.client.DescribeRulesRequest(&awselbv2.DescribeRulesInput{
+		RuleArns: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(elbv2.IsRuleNotFound, err), errDescribe)
+	}
+	if len(rsp.Rules) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+	r := rsp.Rules[0]
+
+	cr.Status.AtProvider = v1alpha1.ListenerRuleObservation{RuleARN: aws.StringValue(r.RuleArn)}
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: elbv2.IsRulePriorityUpToDate(cr.Spec.ForProvider, r) && elbv2.IsRuleUpToDate(cr.Spec.ForProvider, r),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.ListenerRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	if err := e.kube.Status().Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errStatusUpdate)
+	}
+
+	rsp, err := e.client.CreateRuleRequest(elbv2.GenerateCreateRuleInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if len(rsp.Rules) == 0 {
+		return managed.ExternalCreation{}, errors.New(errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.Rules[0].RuleArn))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.ListenerRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.DescribeRulesRequest(&awselbv2.DescribeRulesInput{
+		RuleArns: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if len(rsp.Rules) != 1 {
+		return managed.ExternalUpdate{}, errors.New(errMultipleItems)
+	}
+	r := rsp.Rules[0]
+
+	if !elbv2.IsRulePriorityUpToDate(cr.Spec.ForProvider, r) {
+		if _, err := e.client.SetRulePrioritiesRequest(&awselbv2.SetRulePrioritiesInput{
+			RulePriorities: []awselbv2.RulePriorityPair{{
+				RuleArn:  aws.String(meta.GetExternalName(cr)),
+				Priority: aws.Int64(cr.Spec.ForProvider.Priority),
+			}},
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetPriority)
+		}
+	}
+
+	if !elbv2.IsRuleUpToDate(cr.Spec.ForProvider, r) {
+		if _, err := e.client.ModifyRuleRequest(elbv2.GenerateModifyRuleInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.ListenerRule)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeleteRuleRequest(&awselbv2.DeleteRuleInput{
+		RuleArn: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(elbv2.IsRuleNotFound, err), errDelete)
+}
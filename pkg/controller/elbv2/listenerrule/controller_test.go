@@ -0,0 +1,427 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package listenerrule
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2/fake"
+)
+
+const (
+	providerName = "aws-creds"
+)
+
+var (
+	listenerARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:listener/app/some-lb/50dc6c495c0c9188/f2f7dc8efc522ab2"
+	ruleARN     = "arn:aws:elasticloadbalancing:us-east-1:123456789012:listener-rule/app/some-lb/50dc6c495c0c9188/f2f7dc8efc522ab2/9683b2d02a6cabee"
+	tgARN       = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/some-tg/73e2d6bc24d8a067"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	elb  elbv2.Client
+	kube client.Client
+	cr   *v1alpha1.ListenerRule
+}
+
+type ruleModifier func(*v1alpha1.ListenerRule)
+
+func withExternalName(n string) ruleModifier {
+	return func(r *v1alpha1.ListenerRule) { meta.SetExternalName(r, n) }
+}
+
+func withSpec(p v1alpha1.ListenerRuleParameters) ruleModifier {
+	return func(r *v1alpha1.ListenerRule) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.ListenerRuleObservation) ruleModifier {
+	return func(r *v1alpha1.ListenerRule) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) ruleModifier {
+	return func(r *v1alpha1.ListenerRule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func listenerRule(m ...ruleModifier) *v1alpha1.ListenerRule {
+	cr := &v1alpha1.ListenerRule{
+		Spec: v1alpha1.ListenerRuleSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params() v1alpha1.ListenerRuleParameters {
+	return v1alpha1.ListenerRuleParameters{
+		ListenerARN: aws.String(listenerARN),
+		Priority:    10,
+		Conditions: []v1alpha1.RuleCondition{{
+			Field:  "path-pattern",
+			Values: []string{"/api/*"},
+		}},
+		Actions: []v1alpha1.Action{{
+			Type:           "forward",
+			TargetGroupARN: aws.String(tgARN),
+		}},
+	}
+}
+
+func rule(priority, path string) awselbv2.Rule {
+	return awselbv2.Rule{
+		RuleArn:  aws.String(ruleARN),
+		Priority: aws.String(priority),
+		Conditions: []awselbv2.RuleCondition{{
+			Field:             aws.String("path-pattern"),
+			Values:            []string{path},
+			PathPatternConfig: &awselbv2.PathPatternConditionConfig{Values: []string{path}},
+		}},
+		Actions: []awselbv2.Action{{
+			Type:           awselbv2.ActionTypeEnumForward,
+			Order:          aws.Int64(1),
+			TargetGroupArn: aws.String(tgARN),
+		}},
+	}
+}
+
+func describe(rules ...awselbv2.Rule) func(*awselbv2.DescribeRulesInput) awselbv2.DescribeRulesRequest {
+	return func(*awselbv2.DescribeRulesInput) awselbv2.DescribeRulesRequest {
+		return awselbv2.DescribeRulesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeRulesOutput{Rules: rules}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ListenerRule
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeRulesRequest: describe(rule("10", "/api/*")),
+				},
+				cr: listenerRule(withExternalName(ruleARN), withSpec(params())),
+			},
+			want: want{
+				cr: listenerRule(withExternalName(ruleARN), withSpec(params()),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha1.ListenerRuleObservation{RuleARN: ruleARN})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PriorityChanged": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeRulesRequest: describe(rule("20", "/api/*")),
+				},
+				cr: listenerRule(withExternalName(ruleARN), withSpec(params())),
+			},
+			want: want{
+				cr: listenerRule(withExternalName(ruleARN), withSpec(params()),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha1.ListenerRuleObservation{RuleARN: ruleARN})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: listenerRule(withSpec(params())),
+			},
+			want: want{
+				cr: listenerRule(withSpec(params())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeRulesRequest: func(*awselbv2.DescribeRulesInput) awselbv2.DescribeRulesRequest {
+						return awselbv2.DescribeRulesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awselbv2.ErrCodeRuleNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: listenerRule(withExternalName(ruleARN), withSpec(params())),
+			},
+			want: want{
+				cr: listenerRule(withExternalName(ruleARN), withSpec(params())),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeRulesRequest: func(*awselbv2.DescribeRulesInput) awselbv2.DescribeRulesRequest {
+						return awselbv2.DescribeRulesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: listenerRule(withExternalName(ruleARN), withSpec(params())),
+			},
+			want: want{
+				cr:  listenerRule(withExternalName(ruleARN), withSpec(params())),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elb}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ListenerRule
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockClient().MockUpdate,
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				elb: &fake.MockClient{
+					MockCreateRuleRequest: func(in *awselbv2.CreateRuleInput) awselbv2.CreateRuleRequest {
+						if diff := cmp.Diff(elbv2.GenerateCreateRuleInput(params()), in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.CreateRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateRuleOutput{
+								Rules: []awselbv2.Rule{rule("10", "/api/*")},
+							}},
+						}
+					},
+				},
+				cr: listenerRule(withSpec(params())),
+			},
+			want: want{
+				cr: listenerRule(withExternalName(ruleARN), withSpec(params()), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				kube: &test.MockClient{
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				elb: &fake.MockClient{
+					MockCreateRuleRequest: func(*awselbv2.CreateRuleInput) awselbv2.CreateRuleRequest {
+						return awselbv2.CreateRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: listenerRule(withSpec(params())),
+			},
+			want: want{
+				cr:  listenerRule(withSpec(params()), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elb}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PriorityAndConditions": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeRulesRequest: describe(rule("20", "/old/*")),
+					MockSetRulePrioritiesRequest: func(in *awselbv2.SetRulePrioritiesInput) awselbv2.SetRulePrioritiesRequest {
+						if diff := cmp.Diff(int64(10), aws.Int64Value(in.RulePriorities[0].Priority)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.SetRulePrioritiesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.SetRulePrioritiesOutput{}},
+						}
+					},
+					MockModifyRuleRequest: func(in *awselbv2.ModifyRuleInput) awselbv2.ModifyRuleRequest {
+						if diff := cmp.Diff(elbv2.GenerateModifyRuleInput(ruleARN, params()), in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.ModifyRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.ModifyRuleOutput{}},
+						}
+					},
+				},
+				cr: listenerRule(withExternalName(ruleARN), withSpec(params())),
+			},
+		},
+		"ModifyFail": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeRulesRequest: describe(rule("10", "/old/*")),
+					MockModifyRuleRequest: func(*awselbv2.ModifyRuleInput) awselbv2.ModifyRuleRequest {
+						return awselbv2.ModifyRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: listenerRule(withExternalName(ruleARN), withSpec(params())),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elb}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ListenerRule
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDeleteRuleRequest: func(*awselbv2.DeleteRuleInput) awselbv2.DeleteRuleRequest {
+						return awselbv2.DeleteRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DeleteRuleOutput{}},
+						}
+					},
+				},
+				cr: listenerRule(withExternalName(ruleARN)),
+			},
+			want: want{
+				cr: listenerRule(withExternalName(ruleARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDeleteRuleRequest: func(*awselbv2.DeleteRuleInput) awselbv2.DeleteRuleRequest {
+						return awselbv2.DeleteRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: listenerRule(withExternalName(ruleARN)),
+			},
+			want: want{
+				cr:  listenerRule(withExternalName(ruleARN), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elb}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
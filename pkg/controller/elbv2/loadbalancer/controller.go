@@ -0,0 +1,272 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a LoadBalancer resource"
+
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errClient            = "cannot create a new LoadBalancer client"
+
+	errDescribe          = "failed to describe LoadBalancer with given name"
+	errDescribeTags      = "failed to describe tags of the LoadBalancer"
+	errMultipleItems     = "retrieved multiple LoadBalancers for the given name"
+	errSpecUpdate        = "cannot update spec of LoadBalancer custom resource"
+	errCreate            = "failed to create the LoadBalancer resource"
+	errSetSubnets        = "failed to set subnets of the LoadBalancer"
+	errSetSecurityGroups = "failed to set security groups of the LoadBalancer"
+	errSetIPAddressType  = "failed to set IP address type of the LoadBalancer"
+	errAddTags           = "failed to add tags to the LoadBalancer"
+	errRemoveTags        = "failed to remove tags from the LoadBalancer"
+	errDelete            = "failed to delete the LoadBalancer resource"
+)
+
+// SetupLoadBalancer adds a controller that reconciles LoadBalancers.
+func SetupLoadBalancer(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.LoadBalancerGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.LoadBalancer{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LoadBalancerGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elbv2.NewClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (elbv2.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		elbClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: elbClient, kube: c.kube}, errors.Wrap(err, errClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	elbClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: elbClient, kube: c.kube}, errors.Wrap(err, errClient)
+}
+
+type external struct {
+	kube   client.Client
+	client elbv2.Client
+}
+
+func (e *external) describe(ctx context.Context, name string) (*awselbv2.LoadBalancer, []awselbv2.Tag, error) {
+	rsp, err := e.client.DescribeLoadBalancersRequest(&awselbv2.DescribeLoadBalancersInput{
+		Names: []string{name},
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errDescribe)
+	}
+	if len(rsp.LoadBalancers) != 1 {
+		return nil, nil, errors.New(errMultipleItems)
+	}
+	lb := rsp.LoadBalancers[0]
+
+	tags, err := e.client.DescribeTagsRequest(&awselbv2.DescribeTagsInput{
+		ResourceArns: []string{aws.StringValue(lb.LoadBalancerArn)},
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errDescribeTags)
+	}
+	if len(tags.TagDescriptions) == 0 {
+		return &lb, nil, nil
+	}
+	return &lb, tags.TagDescriptions[0].Tags, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	lb, tags, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		if elbv2.IsLoadBalancerNotFound(errors.Cause(err)) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	elbv2.LateInitializeLoadBalancer(&cr.Spec.ForProvider, *lb)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = elbv2.GenerateLoadBalancerObservation(*lb)
+
+	switch cr.Status.AtProvider.State {
+	case string(awselbv2.LoadBalancerStateEnumActive):
+		cr.SetConditions(runtimev1alpha1.Available())
+	case string(awselbv2.LoadBalancerStateEnumProvisioning):
+		cr.SetConditions(runtimev1alpha1.Creating())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: elbv2.IsLoadBalancerUpToDate(cr.Spec.ForProvider, *lb, tags),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	_, err := e.client.CreateLoadBalancerRequest(elbv2.GenerateCreateLoadBalancerInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	lb, tags, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	arn := lb.LoadBalancerArn
+	p := cr.Spec.ForProvider
+
+	if p.IPAddressType != nil && aws.StringValue(p.IPAddressType) != string(lb.IpAddressType) {
+		if _, err := e.client.SetIpAddressTypeRequest(&awselbv2.SetIpAddressTypeInput{
+			LoadBalancerArn: arn,
+			IpAddressType:   awselbv2.IpAddressType(aws.StringValue(p.IPAddressType)),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetIPAddressType)
+		}
+	}
+
+	if len(p.SubnetIDs) != 0 && !elbv2.AreStringSetsEqual(p.SubnetIDs, elbv2.GetSubnetIDs(*lb)) {
+		if _, err := e.client.SetSubnetsRequest(&awselbv2.SetSubnetsInput{
+			LoadBalancerArn: arn,
+			Subnets:         p.SubnetIDs,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetSubnets)
+		}
+	}
+
+	if len(p.SecurityGroupIDs) != 0 && !elbv2.AreStringSetsEqual(p.SecurityGroupIDs, lb.SecurityGroups) {
+		if _, err := e.client.SetSecurityGroupsRequest(&awselbv2.SetSecurityGroupsInput{
+			LoadBalancerArn: arn,
+			SecurityGroups:  p.SecurityGroupIDs,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetSecurityGroups)
+		}
+	}
+
+	add, remove := elbv2.DiffTags(p.Tags, tags)
+	if len(remove) != 0 {
+		if _, err := e.client.RemoveTagsRequest(&awselbv2.RemoveTagsInput{
+			ResourceArns: []string{aws.StringValue(arn)},
+			TagKeys:      remove,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.AddTagsRequest(&awselbv2.AddTagsInput{
+			ResourceArns: []string{aws.StringValue(arn)},
+			Tags:         add,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	if cr.Status.AtProvider.LoadBalancerARN == "" {
+		return nil
+	}
+
+	_, err := e.client.DeleteLoadBalancerRequest(&awselbv2.DeleteLoadBalancerInput{
+		LoadBalancerArn: aws.String(cr.Status.AtProvider.LoadBalancerARN),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(elbv2.IsLoadBalancerNotFound, err), errDelete)
+}
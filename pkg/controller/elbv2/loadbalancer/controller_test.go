@@ -0,0 +1,484 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2/fake"
+)
+
+const (
+	providerName = "aws-creds"
+)
+
+var (
+	lbName = "some-lb"
+	lbARN  = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/some-lb/50dc6c495c0c9188"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	elb  elbv2.Client
+	kube client.Client
+	cr   *v1alpha1.LoadBalancer
+}
+
+type lbModifier func(*v1alpha1.LoadBalancer)
+
+func withSpec(p v1alpha1.LoadBalancerParameters) lbModifier {
+	return func(r *v1alpha1.LoadBalancer) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.LoadBalancerObservation) lbModifier {
+	return func(r *v1alpha1.LoadBalancer) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) lbModifier {
+	return func(r *v1alpha1.LoadBalancer) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func loadBalancer(m ...lbModifier) *v1alpha1.LoadBalancer {
+	cr := &v1alpha1.LoadBalancer{
+		Spec: v1alpha1.LoadBalancerSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	meta.SetExternalName(cr, lbName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params() v1alpha1.LoadBalancerParameters {
+	return v1alpha1.LoadBalancerParameters{
+		Type:             aws.String("application"),
+		Scheme:           aws.String("internet-facing"),
+		IPAddressType:    aws.String("ipv4"),
+		SubnetIDs:        []string{"subnet-1", "subnet-2"},
+		SecurityGroupIDs: []string{"sg-1"},
+	}
+}
+
+func lb(state awselbv2.LoadBalancerStateEnum) awselbv2.LoadBalancer {
+	return awselbv2.LoadBalancer{
+		LoadBalancerArn:       aws.String(lbARN),
+		LoadBalancerName:      aws.String(lbName),
+		DNSName:               aws.String("some-lb-1234.us-east-1.elb.amazonaws.com"),
+		CanonicalHostedZoneId: aws.String("Z35SXDOTRQ7X7K"),
+		Type:                  awselbv2.LoadBalancerTypeEnumApplication,
+		Scheme:                awselbv2.LoadBalancerSchemeEnumInternetFacing,
+		IpAddressType:         awselbv2.IpAddressTypeIpv4,
+		VpcId:                 aws.String("vpc-1"),
+		AvailabilityZones: []awselbv2.AvailabilityZone{
+			{SubnetId: aws.String("subnet-2")},
+			{SubnetId: aws.String("subnet-1")},
+		},
+		SecurityGroups: []string{"sg-1"},
+		State:          &awselbv2.LoadBalancerState{Code: state},
+	}
+}
+
+func observation(state string) v1alpha1.LoadBalancerObservation {
+	return v1alpha1.LoadBalancerObservation{
+		LoadBalancerARN:       lbARN,
+		DNSName:               "some-lb-1234.us-east-1.elb.amazonaws.com",
+		CanonicalHostedZoneID: "Z35SXDOTRQ7X7K",
+		State:                 state,
+		VPCID:                 "vpc-1",
+	}
+}
+
+func describe(lbs ...awselbv2.LoadBalancer) func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+	return func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+		return awselbv2.DescribeLoadBalancersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeLoadBalancersOutput{LoadBalancers: lbs}},
+		}
+	}
+}
+
+func describeTags(tags ...awselbv2.Tag) func(*awselbv2.DescribeTagsInput) awselbv2.DescribeTagsRequest {
+	return func(*awselbv2.DescribeTagsInput) awselbv2.DescribeTagsRequest {
+		return awselbv2.DescribeTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeTagsOutput{
+				TagDescriptions: []awselbv2.TagDescription{{ResourceArn: aws.String(lbARN), Tags: tags}},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LoadBalancer
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: describe(lb(awselbv2.LoadBalancerStateEnumActive)),
+					MockDescribeTagsRequest:          describeTags(),
+				},
+				cr: loadBalancer(withSpec(params())),
+			},
+			want: want{
+				cr: loadBalancer(withSpec(params()),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(observation("active"))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ProvisioningWithTagDrift": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: describe(lb(awselbv2.LoadBalancerStateEnumProvisioning)),
+					MockDescribeTagsRequest:          describeTags(awselbv2.Tag{Key: aws.String("k"), Value: aws.String("v")}),
+				},
+				cr: loadBalancer(withSpec(params())),
+			},
+			want: want{
+				cr: loadBalancer(withSpec(params()),
+					withConditions(runtimev1alpha1.Creating()),
+					withStatus(observation("provisioning"))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().MockUpdate,
+				},
+				elb: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: describe(lb(awselbv2.LoadBalancerStateEnumActive)),
+					MockDescribeTagsRequest:          describeTags(),
+				},
+				cr: loadBalancer(),
+			},
+			want: want{
+				cr: loadBalancer(withSpec(v1alpha1.LoadBalancerParameters{
+					Type:             aws.String("application"),
+					Scheme:           aws.String("internet-facing"),
+					IPAddressType:    aws.String("ipv4"),
+					SubnetIDs:        []string{"subnet-2", "subnet-1"},
+					SecurityGroupIDs: []string{"sg-1"},
+				}),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(observation("active"))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+						return awselbv2.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awselbv2.ErrCodeLoadBalancerNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: loadBalancer(withSpec(params())),
+			},
+			want: want{
+				cr: loadBalancer(withSpec(params())),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+						return awselbv2.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: loadBalancer(withSpec(params())),
+			},
+			want: want{
+				cr:  loadBalancer(withSpec(params())),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elb}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.LoadBalancer
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elb: &fake.MockClient{
+					MockCreateLoadBalancerRequest: func(in *awselbv2.CreateLoadBalancerInput) awselbv2.CreateLoadBalancerRequest {
+						if diff := cmp.Diff(elbv2.GenerateCreateLoadBalancerInput(lbName, params()), in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.CreateLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateLoadBalancerOutput{}},
+						}
+					},
+				},
+				cr: loadBalancer(withSpec(params())),
+			},
+			want: want{
+				cr: loadBalancer(withSpec(params()), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				elb: &fake.MockClient{
+					MockCreateLoadBalancerRequest: func(*awselbv2.CreateLoadBalancerInput) awselbv2.CreateLoadBalancerRequest {
+						return awselbv2.CreateLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: loadBalancer(withSpec(params())),
+			},
+			want: want{
+				cr:  loadBalancer(withSpec(params()), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elb}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	p := params()
+	p.SubnetIDs = []string{"subnet-1", "subnet-3"}
+	p.Tags = []v1alpha1.Tag{{Key: "new", Value: aws.String("v")}}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: describe(lb(awselbv2.LoadBalancerStateEnumActive)),
+					MockDescribeTagsRequest:          describeTags(awselbv2.Tag{Key: aws.String("old"), Value: aws.String("v")}),
+					MockSetSubnetsRequest: func(in *awselbv2.SetSubnetsInput) awselbv2.SetSubnetsRequest {
+						if diff := cmp.Diff([]string{"subnet-1", "subnet-3"}, in.Subnets); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.SetSubnetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.SetSubnetsOutput{}},
+						}
+					},
+					MockRemoveTagsRequest: func(in *awselbv2.RemoveTagsInput) awselbv2.RemoveTagsRequest {
+						if diff := cmp.Diff([]string{"old"}, in.TagKeys); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.RemoveTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.RemoveTagsOutput{}},
+						}
+					},
+					MockAddTagsRequest: func(in *awselbv2.AddTagsInput) awselbv2.AddTagsRequest {
+						return awselbv2.AddTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.AddTagsOutput{}},
+						}
+					},
+				},
+				cr: loadBalancer(withSpec(p)),
+			},
+		},
+		"SetSubnetsFail": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: describe(lb(awselbv2.LoadBalancerStateEnumActive)),
+					MockDescribeTagsRequest:          describeTags(),
+					MockSetSubnetsRequest: func(in *awselbv2.SetSubnetsInput) awselbv2.SetSubnetsRequest {
+						return awselbv2.SetSubnetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: loadBalancer(withSpec(p)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errSetSubnets),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elb}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.LoadBalancer
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDeleteLoadBalancerRequest: func(in *awselbv2.DeleteLoadBalancerInput) awselbv2.DeleteLoadBalancerRequest {
+						if diff := cmp.Diff(lbARN, aws.StringValue(in.LoadBalancerArn)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.DeleteLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DeleteLoadBalancerOutput{}},
+						}
+					},
+				},
+				cr: loadBalancer(withStatus(v1alpha1.LoadBalancerObservation{LoadBalancerARN: lbARN})),
+			},
+			want: want{
+				cr: loadBalancer(withStatus(v1alpha1.LoadBalancerObservation{LoadBalancerARN: lbARN}),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDeleteLoadBalancerRequest: func(*awselbv2.DeleteLoadBalancerInput) awselbv2.DeleteLoadBalancerRequest {
+						return awselbv2.DeleteLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awselbv2.ErrCodeLoadBalancerNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: loadBalancer(withStatus(v1alpha1.LoadBalancerObservation{LoadBalancerARN: lbARN})),
+			},
+			want: want{
+				cr: loadBalancer(withStatus(v1alpha1.LoadBalancerObservation{LoadBalancerARN: lbARN}),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDeleteLoadBalancerRequest: func(*awselbv2.DeleteLoadBalancerInput) awselbv2.DeleteLoadBalancerRequest {
+						return awselbv2.DeleteLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: loadBalancer(withStatus(v1alpha1.LoadBalancerObservation{LoadBalancerARN: lbARN})),
+			},
+			want: want{
+				cr: loadBalancer(withStatus(v1alpha1.LoadBalancerObservation{LoadBalancerARN: lbARN}),
+					withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elb}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targetgroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a TargetGroup resource"
+
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errClient            = "cannot create a new TargetGroup client"
+
+	errDescribe      = "failed to describe TargetGroup with given name"
+	errDescribeTags  = "failed to describe tags of the TargetGroup"
+	errMultipleItems = "retrieved multiple TargetGroups for the given name"
+	errSpecUpdate    = "cannot update spec of TargetGroup custom resource"
+	errCreate        = "failed to create the TargetGroup resource"
+	errModify        = "failed to modify the TargetGroup resource"
+	errAddTags       = "failed to add tags to the TargetGroup"
+	errRemoveTags    = "failed to remove tags from the TargetGroup"
+	errDelete        = "failed to delete the TargetGroup resource"
+)
+
+// SetupTargetGroup adds a controller that reconciles TargetGroups.
+func SetupTargetGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.TargetGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.TargetGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TargetGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elbv2.NewClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (elbv2.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.TargetGroup)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		elbClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: elbClient, kube: c.kube}, errors.Wrap(err, errClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	elbClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: elbClient, kube: c.kube}, errors.Wrap(err, errClient)
+}
+
+type external struct {
+	kube   client.Client
+	client elbv2.Client
+}
+
+func (e *external) describe(ctx context.Context, name string) (*awselbv2.TargetGroup, []awselbv2.Tag, error) {
+	rsp, err := e.client.DescribeTargetGroupsRequest(&awselbv2.DescribeTargetGroupsInput{
+		Names: []string{name},
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errDescribe)
+	}
+	if len(rsp.TargetGroups) != 1 {
+		return nil, nil, errors.New(errMultipleItems)
+	}
+	tg := rsp.TargetGroups[0]
+
+	tags, err := e.client.DescribeTagsRequest(&awselbv2.DescribeTagsInput{
+		ResourceArns: []string{aws.StringValue(tg.TargetGroupArn)},
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errDescribeTags)
+	}
+	if len(tags.TagDescriptions) == 0 {
+		return &tg, nil, nil
+	}
+	return &tg, tags.TagDescriptions[0].Tags, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.TargetGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	tg, tags, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		if elbv2.IsTargetGroupNotFound(errors.Cause(err)) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	elbv2.LateInitializeTargetGroup(&cr.Spec.ForProvider, *tg)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = elbv2.GenerateTargetGroupObservation(*tg)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: elbv2.IsTargetGroupUpToDate(cr.Spec.ForProvider, *tg, tags),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.TargetGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	rsp, err := e.client.CreateTargetGroupRequest(elbv2.GenerateCreateTargetGroupInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	// CreateTargetGroup doesn't accept tags, so they are added right after
+	// the target group is created.
+	if len(cr.Spec.ForProvider.Tags) == 0 || len(rsp.TargetGroups) == 0 {
+		return managed.ExternalCreation{}, nil
+	}
+	_, err = e.client.AddTagsRequest(&awselbv2.AddTagsInput{
+		ResourceArns: []string{aws.StringValue(rsp.TargetGroups[0].TargetGroupArn)},
+		Tags:         elbv2.GenerateTags(cr.Spec.ForProvider.Tags),
+	}).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errAddTags)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.TargetGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	tg, tags, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	arn := aws.StringValue(tg.TargetGroupArn)
+
+	if _, err := e.client.ModifyTargetGroupRequest(elbv2.GenerateModifyTargetGroupInput(arn, cr.Spec.ForProvider)).Send(ctx); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+	}
+
+	add, remove := elbv2.DiffTags(cr.Spec.ForProvider.Tags, tags)
+	if len(remove) != 0 {
+		if _, err := e.client.RemoveTagsRequest(&awselbv2.RemoveTagsInput{
+			ResourceArns: []string{arn},
+			TagKeys:      remove,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.AddTagsRequest(&awselbv2.AddTagsInput{
+			ResourceArns: []string{arn},
+			Tags:         add,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.TargetGroup)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	if cr.Status.AtProvider.TargetGroupARN == "" {
+		return nil
+	}
+
+	_, err := e.client.DeleteTargetGroupRequest(&awselbv2.DeleteTargetGroupInput{
+		TargetGroupArn: aws.String(cr.Status.AtProvider.TargetGroupARN),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(elbv2.IsTargetGroupNotFound, err), errDelete)
+}
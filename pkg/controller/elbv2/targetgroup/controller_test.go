@@ -0,0 +1,431 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targetgroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2/fake"
+)
+
+const (
+	providerName = "aws-creds"
+)
+
+var (
+	tgName = "some-tg"
+	tgARN  = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/some-tg/73e2d6bc24d8a067"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	elb  elbv2.Client
+	kube client.Client
+	cr   *v1alpha1.TargetGroup
+}
+
+type tgModifier func(*v1alpha1.TargetGroup)
+
+func withSpec(p v1alpha1.TargetGroupParameters) tgModifier {
+	return func(r *v1alpha1.TargetGroup) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.TargetGroupObservation) tgModifier {
+	return func(r *v1alpha1.TargetGroup) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) tgModifier {
+	return func(r *v1alpha1.TargetGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func targetGroup(m ...tgModifier) *v1alpha1.TargetGroup {
+	cr := &v1alpha1.TargetGroup{
+		Spec: v1alpha1.TargetGroupSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	meta.SetExternalName(cr, tgName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params() v1alpha1.TargetGroupParameters {
+	return v1alpha1.TargetGroupParameters{
+		TargetType:                 aws.String("instance"),
+		Protocol:                   aws.String("HTTP"),
+		Port:                       aws.Int64(80),
+		VPCID:                      aws.String("vpc-1"),
+		HealthCheckEnabled:         aws.Bool(true),
+		HealthCheckProtocol:        aws.String("HTTP"),
+		HealthCheckPort:            aws.String("traffic-port"),
+		HealthCheckPath:            aws.String("/"),
+		HealthCheckIntervalSeconds: aws.Int64(30),
+		HealthCheckTimeoutSeconds:  aws.Int64(5),
+		HealthyThresholdCount:      aws.Int64(5),
+		UnhealthyThresholdCount:    aws.Int64(2),
+		Matcher:                    aws.String("200"),
+	}
+}
+
+func tg(path string) awselbv2.TargetGroup {
+	return awselbv2.TargetGroup{
+		TargetGroupArn:             aws.String(tgARN),
+		TargetGroupName:            aws.String(tgName),
+		TargetType:                 awselbv2.TargetTypeEnumInstance,
+		Protocol:                   awselbv2.ProtocolEnumHttp,
+		Port:                       aws.Int64(80),
+		VpcId:                      aws.String("vpc-1"),
+		HealthCheckEnabled:         aws.Bool(true),
+		HealthCheckProtocol:        awselbv2.ProtocolEnumHttp,
+		HealthCheckPort:            aws.String("traffic-port"),
+		HealthCheckPath:            aws.String(path),
+		HealthCheckIntervalSeconds: aws.Int64(30),
+		HealthCheckTimeoutSeconds:  aws.Int64(5),
+		HealthyThresholdCount:      aws.Int64(5),
+		UnhealthyThresholdCount:    aws.Int64(2),
+		Matcher:                    &awselbv2.Matcher{HttpCode: aws.String("200")},
+		LoadBalancerArns:           []string{"lb-arn"},
+	}
+}
+
+func describe(tgs ...awselbv2.TargetGroup) func(*awselbv2.DescribeTargetGroupsInput) awselbv2.DescribeTargetGroupsRequest {
+	return func(*awselbv2.DescribeTargetGroupsInput) awselbv2.DescribeTargetGroupsRequest {
+		return awselbv2.DescribeTargetGroupsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeTargetGroupsOutput{TargetGroups: tgs}},
+		}
+	}
+}
+
+func describeTags(*awselbv2.DescribeTagsInput) awselbv2.DescribeTagsRequest {
+	return awselbv2.DescribeTagsRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeTagsOutput{
+			TagDescriptions: []awselbv2.TagDescription{{ResourceArn: aws.String(tgARN)}},
+		}},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TargetGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	obs := v1alpha1.TargetGroupObservation{TargetGroupARN: tgARN, LoadBalancerARNs: []string{"lb-arn"}}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeTargetGroupsRequest: describe(tg("/")),
+					MockDescribeTagsRequest:         describeTags,
+				},
+				cr: targetGroup(withSpec(params())),
+			},
+			want: want{
+				cr: targetGroup(withSpec(params()),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(obs)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"HealthCheckChanged": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeTargetGroupsRequest: describe(tg("/healthz")),
+					MockDescribeTagsRequest:         describeTags,
+				},
+				cr: targetGroup(withSpec(params())),
+			},
+			want: want{
+				cr: targetGroup(withSpec(params()),
+					withConditions(runtimev1alpha1.Available()),
+					withStatus(obs)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeTargetGroupsRequest: func(*awselbv2.DescribeTargetGroupsInput) awselbv2.DescribeTargetGroupsRequest {
+						return awselbv2.DescribeTargetGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awselbv2.ErrCodeTargetGroupNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: targetGroup(withSpec(params())),
+			},
+			want: want{
+				cr: targetGroup(withSpec(params())),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeTargetGroupsRequest: func(*awselbv2.DescribeTargetGroupsInput) awselbv2.DescribeTargetGroupsRequest {
+						return awselbv2.DescribeTargetGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: targetGroup(withSpec(params())),
+			},
+			want: want{
+				cr:  targetGroup(withSpec(params())),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elb}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.TargetGroup
+		err error
+	}
+
+	p := params()
+	p.Tags = []v1alpha1.Tag{{Key: "k", Value: aws.String("v")}}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulWithTags": {
+			args: args{
+				elb: &fake.MockClient{
+					MockCreateTargetGroupRequest: func(*awselbv2.CreateTargetGroupInput) awselbv2.CreateTargetGroupRequest {
+						return awselbv2.CreateTargetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateTargetGroupOutput{
+								TargetGroups: []awselbv2.TargetGroup{tg("/")},
+							}},
+						}
+					},
+					MockAddTagsRequest: func(in *awselbv2.AddTagsInput) awselbv2.AddTagsRequest {
+						if diff := cmp.Diff([]string{tgARN}, in.ResourceArns); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.AddTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.AddTagsOutput{}},
+						}
+					},
+				},
+				cr: targetGroup(withSpec(p)),
+			},
+			want: want{
+				cr: targetGroup(withSpec(p), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				elb: &fake.MockClient{
+					MockCreateTargetGroupRequest: func(*awselbv2.CreateTargetGroupInput) awselbv2.CreateTargetGroupRequest {
+						return awselbv2.CreateTargetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: targetGroup(withSpec(p)),
+			},
+			want: want{
+				cr:  targetGroup(withSpec(p), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elb}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeTargetGroupsRequest: describe(tg("/healthz")),
+					MockDescribeTagsRequest:         describeTags,
+					MockModifyTargetGroupRequest: func(in *awselbv2.ModifyTargetGroupInput) awselbv2.ModifyTargetGroupRequest {
+						if diff := cmp.Diff(elbv2.GenerateModifyTargetGroupInput(tgARN, params()), in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.ModifyTargetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.ModifyTargetGroupOutput{}},
+						}
+					},
+				},
+				cr: targetGroup(withSpec(params())),
+			},
+		},
+		"ModifyFail": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeTargetGroupsRequest: describe(tg("/healthz")),
+					MockDescribeTagsRequest:         describeTags,
+					MockModifyTargetGroupRequest: func(*awselbv2.ModifyTargetGroupInput) awselbv2.ModifyTargetGroupRequest {
+						return awselbv2.ModifyTargetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: targetGroup(withSpec(params())),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elb}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.TargetGroup
+		err error
+	}
+
+	obs := v1alpha1.TargetGroupObservation{TargetGroupARN: tgARN}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDeleteTargetGroupRequest: func(*awselbv2.DeleteTargetGroupInput) awselbv2.DeleteTargetGroupRequest {
+						return awselbv2.DeleteTargetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DeleteTargetGroupOutput{}},
+						}
+					},
+				},
+				cr: targetGroup(withStatus(obs)),
+			},
+			want: want{
+				cr: targetGroup(withStatus(obs), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDeleteTargetGroupRequest: func(*awselbv2.DeleteTargetGroupInput) awselbv2.DeleteTargetGroupRequest {
+						return awselbv2.DeleteTargetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: targetGroup(withStatus(obs)),
+			},
+			want: want{
+				cr:  targetGroup(withStatus(obs), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.elb}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}